@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/radius-project/radius/bicep-tools/pkg/cli"
+	"github.com/radius-project/radius/bicep-tools/pkg/sdkgen"
 	"github.com/spf13/cobra"
 )
 
@@ -46,6 +48,9 @@ from your resource provider manifest files.`,
 	// Add generate command
 	cmd.AddCommand(newGenerateCommand())
 
+	// Add generate-sdk command
+	cmd.AddCommand(newGenerateSDKCommand())
+
 	return cmd
 }
 
@@ -79,6 +84,45 @@ manifest format with resource type definitions and API versions.`,
 	return cmd
 }
 
+func newGenerateSDKCommand() *cobra.Command {
+	var language string
+	var packageName string
+
+	cmd := &cobra.Command{
+		Use:   "generate-sdk <manifest> <output>",
+		Short: "Generate a client SDK from Radius Resource Provider manifest",
+		Long: `Generate a typed client SDK from a Radius Resource Provider manifest.
+
+For each resource type in the manifest, this command generates typed models for
+the resource and its properties, plus a thin client for the UCP generic resource
+API with CreateOrUpdate, Get, Delete and List operations. Each type is generated
+for its defaultApiVersion, or its latest API version when no default is declared.
+
+Supported languages:
+
+- go: models.go and client.go. The package has no dependencies outside the
+  standard library. Use --package to set the package name.
+- typescript: models.ts and client.ts. The client uses the fetch API.`,
+		Example: `  # Generate a Go SDK
+  manifest-to-bicep generate-sdk manifest.yaml ./sdk/go --language go --package platformsdk
+
+  # Generate a TypeScript SDK
+  manifest-to-bicep generate-sdk manifest.yaml ./sdk/ts --language typescript`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGenerateSDK(args[0], args[1], sdkgen.Options{
+				Language:    sdkgen.Language(language),
+				PackageName: packageName,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&language, "language", "l", string(sdkgen.LanguageGo), "The SDK language (go, typescript)")
+	cmd.Flags().StringVar(&packageName, "package", "", "The Go package name. Defaults to the last segment of the manifest namespace")
+
+	return cmd
+}
+
 func RunGenerate(manifestFile, outputDir string) error {
 	// Validate input file exists
 	if _, err := os.Stat(manifestFile); os.IsNotExist(err) {
@@ -129,6 +173,42 @@ func RunGenerate(manifestFile, outputDir string) error {
 	return nil
 }
 
+func RunGenerateSDK(manifestFile, outputDir string, options sdkgen.Options) error {
+	// Validate input file exists
+	if _, err := os.Stat(manifestFile); os.IsNotExist(err) {
+		return fmt.Errorf("manifest file does not exist: %s", manifestFile)
+	}
+
+	generator := cli.NewGenerator()
+	files, err := generator.GenerateSDKFromFile(manifestFile, options)
+	if err != nil {
+		return fmt.Errorf("failed to generate from manifest: %w", err)
+	}
+
+	// Create output directory if it doesn't exist
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		outputPath := filepath.Join(outputDir, filename)
+
+		fmt.Printf("Writing %s to %s\n", filename, outputPath)
+		if err := os.WriteFile(outputPath, []byte(files[filename]), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", filename, err)
+		}
+	}
+
+	fmt.Printf("Successfully generated %s SDK in %s\n", options.Language, outputDir)
+	return nil
+}
+
 func removeIfExists(path string) error {
 	if _, err := os.Stat(path); err == nil {
 		return os.Remove(path)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/radius-project/radius/bicep-tools/pkg/converter"
 	"github.com/radius-project/radius/bicep-tools/pkg/manifest"
+	"github.com/radius-project/radius/bicep-tools/pkg/sdkgen"
 )
 
 // Generator handles the generation of Bicep extensions from manifests
@@ -80,3 +81,36 @@ func (g *Generator) GenerateFromString(manifestContent string) (*GenerationResul
 		DocumentationContent: conversionResult.DocumentationContent,
 	}, nil
 }
+
+// GenerateSDKFromFile generates client SDK files from a manifest file path. The result maps
+// file names to file contents.
+func (g *Generator) GenerateSDKFromFile(manifestPath string, options sdkgen.Options) (map[string]string, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	return g.GenerateSDKFromString(string(data), options)
+}
+
+// GenerateSDKFromString generates client SDK files from a manifest string. The result maps
+// file names to file contents.
+func (g *Generator) GenerateSDKFromString(manifestContent string, options sdkgen.Options) (map[string]string, error) {
+	// Parse the manifest
+	provider, err := manifest.ParseManifest(manifestContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	// Validate the manifest
+	if err := provider.Validate(); err != nil {
+		return nil, fmt.Errorf("manifest validation failed: %w", err)
+	}
+
+	files, err := sdkgen.Generate(provider, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate SDK: %w", err)
+	}
+
+	return files, nil
+}
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/sdkgen"
 )
 
 const testManifestYAML = `name: MyCompany.Resources
@@ -128,4 +131,40 @@ func TestGenerator_GenerateFromString_InvalidManifest(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for invalid manifest, got nil")
 	}
-}
\ No newline at end of file
+}
+func TestGenerator_GenerateSDKFromString(t *testing.T) {
+	generator := NewGenerator()
+
+	manifestYAML := `namespace: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema:
+          type: object
+          properties:
+            count:
+              type: integer`
+
+	files, err := generator.GenerateSDKFromString(manifestYAML, sdkgen.Options{Language: sdkgen.LanguageGo})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(files["models.go"], "type TestResourcesProperties struct") {
+		t.Error("Expected models.go to contain the properties type")
+	}
+
+	if !strings.Contains(files["client.go"], "type TestResourcesClient struct") {
+		t.Error("Expected client.go to contain the resource client")
+	}
+}
+
+func TestGenerator_GenerateSDKFromFile_FileNotExists(t *testing.T) {
+	generator := NewGenerator()
+
+	_, err := generator.GenerateSDKFromFile("non-existent-file.yaml", sdkgen.Options{Language: sdkgen.LanguageGo})
+	if err == nil {
+		t.Error("Expected error for non-existent file, got nil")
+	}
+}
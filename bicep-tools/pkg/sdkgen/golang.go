@@ -0,0 +1,401 @@
+package sdkgen
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// generateGo renders the Go SDK for the given resource models.
+func generateGo(namespace string, models []*resourceModel, packageName string) (map[string]string, error) {
+	if !token.IsIdentifier(packageName) || token.IsKeyword(packageName) {
+		return nil, fmt.Errorf("invalid Go package name '%s'", packageName)
+	}
+
+	files := map[string]string{
+		"models.go": renderGoModels(namespace, models, packageName),
+		"client.go": renderGoClient(namespace, models, packageName),
+	}
+
+	for name, content := range files {
+		formatted, err := format.Source([]byte(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to format generated %s: %w", name, err)
+		}
+		files[name] = string(formatted)
+	}
+
+	return files, nil
+}
+
+func writeGoHeader(sb *strings.Builder, namespace string, packageName string, packageDoc bool) {
+	fmt.Fprintf(sb, "// %s\n\n", generatedHeader)
+	if packageDoc {
+		fmt.Fprintf(sb, "// Package %s is a client SDK for the %s resource types.\n", packageName, namespace)
+	}
+	fmt.Fprintf(sb, "package %s\n\n", packageName)
+}
+
+func renderGoModels(namespace string, models []*resourceModel, packageName string) string {
+	sb := &strings.Builder{}
+	writeGoHeader(sb, namespace, packageName, true)
+
+	for _, model := range models {
+		fmt.Fprintf(sb, "// %sResourceType is the fully-qualified name of the %s resource type.\n", model.Name, model.ResourceType)
+		fmt.Fprintf(sb, "const %sResourceType = %s\n\n", model.Name, strconv.Quote(model.ResourceType))
+		fmt.Fprintf(sb, "// %sAPIVersion is the API version used by %sClient.\n", model.Name, model.Name)
+		fmt.Fprintf(sb, "const %sAPIVersion = %s\n\n", model.Name, strconv.Quote(model.APIVersion))
+
+		fmt.Fprintf(sb, "// %sResource - A %s resource.\n", model.Name, model.ResourceType)
+		fmt.Fprintf(sb, "type %sResource struct {\n", model.Name)
+		sb.WriteString("// ID - READ-ONLY; The fully-qualified resource ID.\n")
+		sb.WriteString("ID *string `json:\"id,omitempty\"`\n\n")
+		sb.WriteString("// Name - READ-ONLY; The resource name.\n")
+		sb.WriteString("Name *string `json:\"name,omitempty\"`\n\n")
+		sb.WriteString("// Type - READ-ONLY; The resource type.\n")
+		sb.WriteString("Type *string `json:\"type,omitempty\"`\n\n")
+		sb.WriteString("// Location - The resource location.\n")
+		sb.WriteString("Location *string `json:\"location,omitempty\"`\n\n")
+		sb.WriteString("// Tags - The resource tags.\n")
+		sb.WriteString("Tags map[string]string `json:\"tags,omitempty\"`\n\n")
+		sb.WriteString("// Properties - The resource properties.\n")
+		fmt.Fprintf(sb, "Properties %s `json:\"properties\"`\n", model.Properties.Name)
+		sb.WriteString("}\n\n")
+
+		writeGoObject(sb, model.Properties)
+		for _, object := range model.Objects {
+			writeGoObject(sb, object)
+		}
+		for _, enum := range model.Enums {
+			writeGoEnum(sb, enum)
+		}
+	}
+
+	return sb.String()
+}
+
+func writeGoObject(sb *strings.Builder, object *objectModel) {
+	writeGoComment(sb, object.Name, object.Description, "")
+	fmt.Fprintf(sb, "type %s struct {\n", object.Name)
+	for i, field := range object.Fields {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		writeGoComment(sb, field.Name, field.Description, fieldQualifiers(field))
+
+		goType := goTypeName(field.Type)
+		tag := field.JSONName
+		if !field.Required || field.ReadOnly {
+			tag += ",omitempty"
+			if field.Type.Kind != kindArray && field.Type.Kind != kindMap && field.Type.Kind != kindAny {
+				goType = "*" + goType
+			}
+		}
+		fmt.Fprintf(sb, "%s %s `json:%s`\n", field.Name, goType, strconv.Quote(tag))
+	}
+	sb.WriteString("}\n\n")
+}
+
+func writeGoEnum(sb *strings.Builder, enum *enumModel) {
+	writeGoComment(sb, enum.Name, enum.Description, "")
+	fmt.Fprintf(sb, "type %s string\n\n", enum.Name)
+	sb.WriteString("const (\n")
+	for i, value := range enum.Values {
+		fmt.Fprintf(sb, "%s%s %s = %s\n", enum.Name, enum.ConstNames[i], enum.Name, strconv.Quote(value))
+	}
+	sb.WriteString(")\n\n")
+
+	fmt.Fprintf(sb, "// Possible%sValues returns the possible values for the %s const type.\n", enum.Name, enum.Name)
+	fmt.Fprintf(sb, "func Possible%sValues() []%s {\n", enum.Name, enum.Name)
+	fmt.Fprintf(sb, "return []%s{\n", enum.Name)
+	for _, constName := range enum.ConstNames {
+		fmt.Fprintf(sb, "%s%s,\n", enum.Name, constName)
+	}
+	sb.WriteString("}\n}\n\n")
+}
+
+// writeGoComment writes a doc comment in the "Name - QUALIFIERS; Description" form used by
+// the generated Azure SDK clients.
+func writeGoComment(sb *strings.Builder, name string, description string, qualifiers string) {
+	text := description
+	if qualifiers != "" && text != "" {
+		text = qualifiers + "; " + text
+	} else if qualifiers != "" {
+		text = qualifiers
+	}
+	if text == "" {
+		return
+	}
+
+	lines := strings.Split(text, "\n")
+	fmt.Fprintf(sb, "// %s - %s\n", name, strings.TrimSpace(lines[0]))
+	for _, line := range lines[1:] {
+		fmt.Fprintf(sb, "// %s\n", strings.TrimSpace(line))
+	}
+}
+
+func fieldQualifiers(field fieldModel) string {
+	qualifiers := []string{}
+	if field.Required && !field.ReadOnly {
+		qualifiers = append(qualifiers, "REQUIRED")
+	}
+	if field.ReadOnly {
+		qualifiers = append(qualifiers, "READ-ONLY")
+	}
+	if field.Sensitive {
+		qualifiers = append(qualifiers, "SENSITIVE")
+	}
+	return strings.Join(qualifiers, "; ")
+}
+
+func goTypeName(ref typeRef) string {
+	switch ref.Kind {
+	case kindString:
+		return "string"
+	case kindInteger:
+		return "int64"
+	case kindBoolean:
+		return "bool"
+	case kindArray:
+		return "[]" + goTypeName(*ref.Elem)
+	case kindMap:
+		return "map[string]" + goTypeName(*ref.Elem)
+	case kindObject, kindEnum:
+		return ref.Name
+	default:
+		return "any"
+	}
+}
+
+func renderGoClient(namespace string, models []*resourceModel, packageName string) string {
+	sb := &strings.Builder{}
+	writeGoHeader(sb, namespace, packageName, false)
+	sb.WriteString(goClientCore)
+
+	for _, model := range models {
+		fmt.Fprintf(sb, goResourceClientTemplate[1:], model.Name, model.ResourceType)
+	}
+
+	return sb.String()
+}
+
+// goClientCore is the part of the Go client shared by all resource types. It talks to the
+// UCP generic resource API using only the standard library so that the generated package
+// has no dependencies.
+const goClientCore = `import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ClientOptions contains the optional settings for Client.
+type ClientOptions struct {
+	// HTTPClient is the HTTP client used to send requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Header is added to every request, for example to set an Authorization header.
+	Header http.Header
+}
+
+// Client sends requests to the Radius UCP generic resource API.
+type Client struct {
+	endpoint   string
+	scope      string
+	httpClient *http.Client
+	header     http.Header
+}
+
+// NewClient creates a Client. endpoint is the UCP endpoint, for example
+// "http://localhost:9000/apis/api.ucp.dev/v1alpha3", and scope is the resource group the
+// client operates on, for example "/planes/radius/local/resourceGroups/default".
+func NewClient(endpoint string, scope string, options *ClientOptions) (*Client, error) {
+	if endpoint == "" {
+		return nil, errors.New("endpoint is required")
+	}
+	if !strings.HasPrefix(scope, "/planes/") {
+		return nil, fmt.Errorf("scope %q must start with /planes/", scope)
+	}
+
+	client := &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		scope:      strings.TrimSuffix(scope, "/"),
+		httpClient: http.DefaultClient,
+	}
+	if options != nil {
+		if options.HTTPClient != nil {
+			client.httpClient = options.HTTPClient
+		}
+		client.header = options.Header.Clone()
+	}
+
+	return client, nil
+}
+
+// ResponseError is returned when the server responds with an unexpected status code.
+type ResponseError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Code is the error code reported by the server, if any.
+	Code string
+
+	// Message is the error message reported by the server, if any.
+	Message string
+}
+
+// Error returns the error message.
+func (e *ResponseError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("request failed with status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound returns true if err is a ResponseError with status 404.
+func IsNotFound(err error) bool {
+	var responseError *ResponseError
+	return errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) resourceURL(resourceType string, name string, apiVersion string) string {
+	path := c.scope + "/providers/" + resourceType
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return c.endpoint + path + "?api-version=" + url.QueryEscape(apiVersion)
+}
+
+// do sends a request and decodes the JSON response body into out, if out is not nil.
+func (c *Client) do(ctx context.Context, method string, requestURL string, body any, out any, expected ...int) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return err
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	ok := false
+	for _, code := range expected {
+		ok = ok || resp.StatusCode == code
+	}
+	if !ok {
+		responseError := &ResponseError{StatusCode: resp.StatusCode}
+		errorResponse := struct {
+			Error struct {
+				Code    string ` + "`json:\"code\"`" + `
+				Message string ` + "`json:\"message\"`" + `
+			} ` + "`json:\"error\"`" + `
+		}{}
+		if json.Unmarshal(b, &errorResponse) == nil {
+			responseError.Code = errorResponse.Error.Code
+			responseError.Message = errorResponse.Error.Message
+		}
+		return responseError
+	}
+
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	return nil
+}
+
+// list fetches every page of a list operation, following nextLink.
+func list[T any](ctx context.Context, c *Client, requestURL string) ([]T, error) {
+	results := []T{}
+	for requestURL != "" {
+		page := struct {
+			Value    []T    ` + "`json:\"value\"`" + `
+			NextLink string ` + "`json:\"nextLink\"`" + `
+		}{}
+		if err := c.do(ctx, http.MethodGet, requestURL, nil, &page, http.StatusOK); err != nil {
+			return nil, err
+		}
+		results = append(results, page.Value...)
+		requestURL = page.NextLink
+	}
+	return results, nil
+}
+
+`
+
+// goResourceClientTemplate is the per-type client. It is formatted with the type identifier
+// and the fully-qualified resource type.
+const goResourceClientTemplate = `
+// %[1]sClient manages %[2]s resources.
+type %[1]sClient struct {
+	client *Client
+}
+
+// %[1]s returns a client for %[2]s resources.
+func (c *Client) %[1]s() *%[1]sClient {
+	return &%[1]sClient{client: c}
+}
+
+// CreateOrUpdate creates or updates the named resource. Radius deploys the resource
+// asynchronously; the returned resource reflects the state accepted by the server.
+func (c *%[1]sClient) CreateOrUpdate(ctx context.Context, name string, resource *%[1]sResource) (*%[1]sResource, error) {
+	requestURL := c.client.resourceURL(%[1]sResourceType, name, %[1]sAPIVersion)
+	result := &%[1]sResource{}
+	if err := c.client.do(ctx, http.MethodPut, requestURL, resource, result, http.StatusOK, http.StatusCreated, http.StatusAccepted); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get returns the named resource. Use IsNotFound to check whether the resource exists.
+func (c *%[1]sClient) Get(ctx context.Context, name string) (*%[1]sResource, error) {
+	requestURL := c.client.resourceURL(%[1]sResourceType, name, %[1]sAPIVersion)
+	result := &%[1]sResource{}
+	if err := c.client.do(ctx, http.MethodGet, requestURL, nil, result, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete deletes the named resource. Deleting a resource that does not exist is not an error.
+func (c *%[1]sClient) Delete(ctx context.Context, name string) error {
+	requestURL := c.client.resourceURL(%[1]sResourceType, name, %[1]sAPIVersion)
+	return c.client.do(ctx, http.MethodDelete, requestURL, nil, nil, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+}
+
+// List returns every resource of this type in the client scope.
+func (c *%[1]sClient) List(ctx context.Context) ([]%[1]sResource, error) {
+	return list[%[1]sResource](ctx, c.client, c.client.resourceURL(%[1]sResourceType, "", %[1]sAPIVersion))
+}
+`
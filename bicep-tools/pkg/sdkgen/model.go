@@ -0,0 +1,360 @@
+package sdkgen
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/manifest"
+)
+
+// typeKind identifies the shape of a property type in the intermediate model shared by
+// the language emitters.
+type typeKind int
+
+const (
+	kindString typeKind = iota
+	kindInteger
+	kindBoolean
+	kindAny
+	kindArray
+	kindMap
+	kindObject
+	kindEnum
+)
+
+// typeRef is a reference to a property type. Name is set for objects and enums, Elem is
+// set for arrays and maps.
+type typeRef struct {
+	Kind typeKind
+	Name string
+	Elem *typeRef
+}
+
+// fieldModel is a single property of an object.
+type fieldModel struct {
+	JSONName    string
+	Name        string
+	Description string
+	Type        typeRef
+	Required    bool
+	ReadOnly    bool
+	Sensitive   bool
+}
+
+// objectModel is a named object type with a fixed set of properties.
+type objectModel struct {
+	Name        string
+	Description string
+	Fields      []fieldModel
+}
+
+// enumModel is a named string type restricted to a set of values.
+type enumModel struct {
+	Name        string
+	Description string
+	Values      []string
+
+	// ConstNames holds the identifier suffix for each value, in the same order as Values.
+	ConstNames []string
+}
+
+// resourceModel describes a single resource type at the API version an SDK is generated for.
+type resourceModel struct {
+	// Name is the exported identifier for the resource type, e.g. "PostgreSQLDatabases".
+	Name string
+
+	// ResourceType is the fully-qualified resource type, e.g. "Radius.Data/postgreSQLDatabases".
+	ResourceType string
+
+	// APIVersion is the API version the SDK targets.
+	APIVersion string
+
+	// Properties is the object model for the resource properties.
+	Properties *objectModel
+
+	// Objects holds the nested object types in declaration order, excluding Properties.
+	Objects []*objectModel
+
+	// Enums holds the enum types in declaration order.
+	Enums []*enumModel
+}
+
+// buildModels converts every resource type in the manifest into a resourceModel, sorted by name.
+func buildModels(provider *manifest.ResourceProvider) ([]*resourceModel, error) {
+	typeNames := make([]string, 0, len(provider.Types))
+	for name := range provider.Types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	models := []*resourceModel{}
+	seen := map[string]string{}
+	for _, typeName := range typeNames {
+		resourceType := provider.Types[typeName]
+		apiVersion, err := selectAPIVersion(typeName, &resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		model, err := buildResourceModel(provider.Namespace, typeName, apiVersion, resourceType.APIVersions[apiVersion].Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate SDK for type '%s': %w", typeName, err)
+		}
+
+		if other, ok := seen[model.Name]; ok {
+			return nil, fmt.Errorf("resource types '%s' and '%s' both map to the identifier '%s'", other, typeName, model.Name)
+		}
+		seen[model.Name] = typeName
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+// selectAPIVersion returns the default API version of a resource type, or the latest API
+// version when no default is declared.
+func selectAPIVersion(typeName string, resourceType *manifest.ResourceType) (string, error) {
+	if resourceType.DefaultAPIVersion != nil && *resourceType.DefaultAPIVersion != "" {
+		if _, ok := resourceType.APIVersions[*resourceType.DefaultAPIVersion]; !ok {
+			return "", fmt.Errorf("default API version '%s' of type '%s' is not defined", *resourceType.DefaultAPIVersion, typeName)
+		}
+		return *resourceType.DefaultAPIVersion, nil
+	}
+
+	if len(resourceType.APIVersions) == 0 {
+		return "", fmt.Errorf("resource type '%s' must have at least one API version", typeName)
+	}
+
+	versions := make([]string, 0, len(resourceType.APIVersions))
+	for version := range resourceType.APIVersions {
+		versions = append(versions, version)
+	}
+
+	// API versions are dates with an optional suffix, so the lexical maximum is the latest.
+	return slices.Max(versions), nil
+}
+
+// modelBuilder accumulates the named types produced while walking a resource schema.
+type modelBuilder struct {
+	model *resourceModel
+	names map[string]bool
+}
+
+func buildResourceModel(namespace string, typeName string, apiVersion string, schema manifest.Schema) (*resourceModel, error) {
+	name := exportedName(typeName)
+	if name == "" {
+		return nil, fmt.Errorf("type name '%s' cannot be converted to an identifier", typeName)
+	}
+
+	b := &modelBuilder{
+		model: &resourceModel{
+			Name:         name,
+			ResourceType: namespace + "/" + typeName,
+			APIVersion:   apiVersion,
+		},
+		names: map[string]bool{},
+	}
+
+	if schema.Type != "" && schema.Type != "object" {
+		return nil, fmt.Errorf("resource schema must be an object, got '%s'", schema.Type)
+	}
+
+	properties, err := b.addObject(&schema, name+"Properties", name, "")
+	if err != nil {
+		return nil, err
+	}
+	if properties.Description == "" {
+		properties.Description = fmt.Sprintf("The properties of a %s resource.", b.model.ResourceType)
+	}
+
+	// addObject appends every object it creates, so the properties object is the last one
+	// added. Move it out of the nested object list.
+	b.model.Objects = b.model.Objects[:len(b.model.Objects)-1]
+	b.model.Properties = properties
+
+	return b.model, nil
+}
+
+// reserve claims a type name, failing if a previous type already uses it.
+func (b *modelBuilder) reserve(name string) error {
+	if b.names[name] {
+		return fmt.Errorf("generated type name '%s' is used more than once", name)
+	}
+	b.names[name] = true
+	return nil
+}
+
+// addObject creates an object model for a schema with properties. Nested types are named
+// by appending the property name to prefix.
+func (b *modelBuilder) addObject(schema *manifest.Schema, name string, prefix string, path string) (*objectModel, error) {
+	if err := b.reserve(name); err != nil {
+		return nil, err
+	}
+
+	object := &objectModel{Name: name, Description: description(schema)}
+
+	keys := make([]string, 0, len(schema.Properties))
+	for key := range schema.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fieldNames := map[string]string{}
+	for _, key := range keys {
+		property := schema.Properties[key]
+		fieldName := exportedName(key)
+		if fieldName == "" {
+			return nil, fmt.Errorf("property '%s' cannot be converted to an identifier", joinPath(path, key))
+		}
+		if other, ok := fieldNames[fieldName]; ok {
+			return nil, fmt.Errorf("properties '%s' and '%s' both map to the identifier '%s'", joinPath(path, other), joinPath(path, key), fieldName)
+		}
+		fieldNames[fieldName] = key
+
+		ref, err := b.addType(&property, prefix+fieldName, joinPath(path, key))
+		if err != nil {
+			return nil, err
+		}
+
+		object.Fields = append(object.Fields, fieldModel{
+			JSONName:    key,
+			Name:        fieldName,
+			Description: description(&property),
+			Type:        ref,
+			Required:    slices.Contains(schema.Required, key),
+			ReadOnly:    property.ReadOnly != nil && *property.ReadOnly,
+			Sensitive:   property.IsSensitive != nil && *property.IsSensitive,
+		})
+	}
+
+	b.model.Objects = append(b.model.Objects, object)
+	return object, nil
+}
+
+// addType returns the type reference for a schema, creating named types as needed.
+func (b *modelBuilder) addType(schema *manifest.Schema, name string, path string) (typeRef, error) {
+	switch schema.Type {
+	case "string":
+		if len(schema.Enum) > 0 {
+			return b.addEnum(schema, name)
+		}
+		return typeRef{Kind: kindString}, nil
+
+	case "enum":
+		if len(schema.Enum) == 0 {
+			return typeRef{}, fmt.Errorf("enum '%s' must have at least one value", path)
+		}
+		return b.addEnum(schema, name)
+
+	case "integer":
+		return typeRef{Kind: kindInteger}, nil
+
+	case "boolean":
+		return typeRef{Kind: kindBoolean}, nil
+
+	case "any":
+		return typeRef{Kind: kindAny}, nil
+
+	case "array":
+		if schema.Items == nil {
+			return typeRef{}, fmt.Errorf("array '%s' must have an 'items' property", path)
+		}
+		elem, err := b.addType(schema.Items, name+"Item", path+"[]")
+		if err != nil {
+			return typeRef{}, err
+		}
+		return typeRef{Kind: kindArray, Elem: &elem}, nil
+
+	case "", "object":
+		if len(schema.Properties) > 0 {
+			object, err := b.addObject(schema, name, name, path)
+			if err != nil {
+				return typeRef{}, err
+			}
+			return typeRef{Kind: kindObject, Name: object.Name}, nil
+		}
+
+		// An object without fixed properties is a dictionary, typed by additionalProperties
+		// when present.
+		elem := typeRef{Kind: kindAny}
+		if schema.AdditionalProperties != nil {
+			var err error
+			elem, err = b.addType(schema.AdditionalProperties, name+"Value", path+".*")
+			if err != nil {
+				return typeRef{}, err
+			}
+		}
+		return typeRef{Kind: kindMap, Elem: &elem}, nil
+
+	default:
+		return typeRef{}, fmt.Errorf("unsupported schema type '%s' at '%s'", schema.Type, path)
+	}
+}
+
+func (b *modelBuilder) addEnum(schema *manifest.Schema, name string) (typeRef, error) {
+	if err := b.reserve(name); err != nil {
+		return typeRef{}, err
+	}
+
+	enum := &enumModel{
+		Name:        name,
+		Description: description(schema),
+		Values:      slices.Clone(schema.Enum),
+	}
+
+	seen := map[string]string{}
+	for _, value := range enum.Values {
+		constName := exportedName(value)
+		if constName == "" {
+			constName = "Empty"
+		}
+		if other, ok := seen[constName]; ok {
+			return typeRef{}, fmt.Errorf("enum values '%s' and '%s' of '%s' both map to the identifier '%s'", other, value, name, constName)
+		}
+		seen[constName] = value
+		enum.ConstNames = append(enum.ConstNames, constName)
+	}
+
+	b.model.Enums = append(b.model.Enums, enum)
+	return typeRef{Kind: kindEnum, Name: name}, nil
+}
+
+func description(schema *manifest.Schema) string {
+	if schema.Description == nil {
+		return ""
+	}
+	return strings.TrimSpace(*schema.Description)
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// exportedName converts a manifest name such as "postgreSQLDatabases" or "connection-string"
+// into an exported identifier such as "PostgreSQLDatabases" or "ConnectionString".
+func exportedName(name string) string {
+	var sb strings.Builder
+	upperNext := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+			continue
+		}
+		if sb.Len() == 0 && unicode.IsDigit(r) {
+			sb.WriteRune('X')
+		}
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,75 @@
+package sdkgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/manifest"
+)
+
+// generatedHeader is the first line of every generated file. For Go it matches the
+// convention recognized by linters and editors.
+const generatedHeader = "Code generated by manifest-to-bicep generate-sdk. DO NOT EDIT."
+
+// Language is a target language for SDK generation.
+type Language string
+
+const (
+	// LanguageGo generates Go structs and a client built on net/http.
+	LanguageGo Language = "go"
+
+	// LanguageTypeScript generates TypeScript interfaces and a client built on fetch.
+	LanguageTypeScript Language = "typescript"
+)
+
+// SupportedLanguages returns the languages accepted by Generate.
+func SupportedLanguages() []Language {
+	return []Language{LanguageGo, LanguageTypeScript}
+}
+
+// Options configures SDK generation.
+type Options struct {
+	// Language is the target language.
+	Language Language
+
+	// PackageName is the Go package name of the generated files. Ignored for TypeScript.
+	// Defaults to the lower-cased last segment of the manifest namespace.
+	PackageName string
+}
+
+// Generate produces a client SDK for every resource type in the manifest. Each type is
+// generated for its default API version, or its latest API version when no default is
+// declared. The result maps file names to file contents.
+func Generate(provider *manifest.ResourceProvider, options Options) (map[string]string, error) {
+	models, err := buildModels(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	switch options.Language {
+	case LanguageGo:
+		packageName := options.PackageName
+		if packageName == "" {
+			packageName = defaultPackageName(provider.Namespace)
+		}
+		return generateGo(provider.Namespace, models, packageName)
+	case LanguageTypeScript:
+		return generateTypeScript(provider.Namespace, models)
+	default:
+		return nil, fmt.Errorf("unsupported language '%s', supported languages are: %s", options.Language, supportedLanguageList())
+	}
+}
+
+func supportedLanguageList() string {
+	names := []string{}
+	for _, language := range SupportedLanguages() {
+		names = append(names, string(language))
+	}
+	return strings.Join(names, ", ")
+}
+
+// defaultPackageName derives a Go package name from a namespace, e.g. "Radius.Data" becomes "data".
+func defaultPackageName(namespace string) string {
+	segment := namespace[strings.LastIndex(namespace, ".")+1:]
+	return strings.ToLower(exportedName(segment))
+}
@@ -0,0 +1,271 @@
+package sdkgen
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/manifest"
+)
+
+const testManifestYAML = `namespace: MyCompany.Resources
+types:
+  postgreSQLDatabases:
+    defaultApiVersion: '2025-01-01-preview'
+    apiVersions:
+      '2024-06-01-preview':
+        schema:
+          type: object
+          properties:
+            legacy:
+              type: string
+      '2025-01-01-preview':
+        schema:
+          type: object
+          properties:
+            environment:
+              type: string
+              description: "The environment ID."
+            size:
+              type: enum
+              enum: ['S', 'M', 'L']
+              description: "The size of the database."
+            password:
+              type: string
+              x-radius-sensitive: true
+            port:
+              type: integer
+              readOnly: true
+            replicas:
+              type: array
+              items:
+                type: object
+                properties:
+                  region:
+                    type: string
+                  primary:
+                    type: boolean
+                required: ['region']
+            connection-settings:
+              type: object
+              additionalProperties:
+                type: string
+          required: ['environment']
+  caches:
+    apiVersions:
+      '2024-01-01':
+        schema: {}
+      '2025-03-01':
+        schema:
+          type: object
+          properties:
+            ttl:
+              type: integer
+`
+
+func parseTestManifest(t *testing.T, input string) *manifest.ResourceProvider {
+	t.Helper()
+	provider, err := manifest.ParseManifest(input)
+	if err != nil {
+		t.Fatalf("Failed to parse manifest: %v", err)
+	}
+	if err := provider.Validate(); err != nil {
+		t.Fatalf("Failed to validate manifest: %v", err)
+	}
+	return provider
+}
+
+func TestGenerate_Go(t *testing.T) {
+	provider := parseTestManifest(t, testManifestYAML)
+
+	files, err := Generate(provider, Options{Language: LanguageGo})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(files) != 2 || files["models.go"] == "" || files["client.go"] == "" {
+		t.Fatalf("Expected models.go and client.go, got: %v", keys(files))
+	}
+
+	// The generated package must compile on its own.
+	fset := token.NewFileSet()
+	parsed := []*ast.File{}
+	for name, content := range files {
+		file, err := parser.ParseFile(fset, name, content, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v\n%s", name, err, content)
+		}
+		parsed = append(parsed, file)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check("resources", fset, parsed, nil)
+	if err != nil {
+		t.Fatalf("Generated code does not type-check: %v\n%s\n%s", err, files["models.go"], files["client.go"])
+	}
+
+	if pkg.Name() != "resources" {
+		t.Errorf("Expected package name 'resources' derived from the namespace, got '%s'", pkg.Name())
+	}
+
+	models := files["models.go"]
+	expected := []string{
+		"// Code generated by manifest-to-bicep generate-sdk. DO NOT EDIT.",
+		`PostgreSQLDatabasesResourceType = "MyCompany.Resources/postgreSQLDatabases"`,
+		`PostgreSQLDatabasesAPIVersion = "2025-01-01-preview"`,
+		`CachesAPIVersion = "2025-03-01"`,
+		"Properties PostgreSQLDatabasesProperties `json:\"properties\"`",
+		"Environment string `json:\"environment\"`",
+		"Size *PostgreSQLDatabasesSize `json:\"size,omitempty\"`",
+		"// Password - SENSITIVE",
+		"// Port - READ-ONLY",
+		"Port *int64 `json:\"port,omitempty\"`",
+		"Replicas []PostgreSQLDatabasesReplicasItem `json:\"replicas,omitempty\"`",
+		"Region string `json:\"region\"`",
+		"ConnectionSettings map[string]string `json:\"connection-settings,omitempty\"`",
+		`PostgreSQLDatabasesSizeM PostgreSQLDatabasesSize = "M"`,
+	}
+	for _, s := range expected {
+		if !strings.Contains(models, s) {
+			t.Errorf("Expected models.go to contain %q", s)
+		}
+	}
+
+	if strings.Contains(models, "Legacy") {
+		t.Error("Expected only the default API version to be generated")
+	}
+
+	if !strings.Contains(files["client.go"], "func (c *Client) PostgreSQLDatabases() *PostgreSQLDatabasesClient") {
+		t.Error("Expected client.go to contain the per-type client accessor")
+	}
+}
+
+func TestGenerate_GoPackageName(t *testing.T) {
+	provider := parseTestManifest(t, testManifestYAML)
+
+	files, err := Generate(provider, Options{Language: LanguageGo, PackageName: "platformsdk"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(files["models.go"], "package platformsdk\n") {
+		t.Error("Expected the package name to be used")
+	}
+
+	_, err = Generate(provider, Options{Language: LanguageGo, PackageName: "not-valid"})
+	if err == nil || !strings.Contains(err.Error(), "invalid Go package name") {
+		t.Errorf("Expected invalid package name error, got: %v", err)
+	}
+}
+
+func TestGenerate_TypeScript(t *testing.T) {
+	provider := parseTestManifest(t, testManifestYAML)
+
+	files, err := Generate(provider, Options{Language: LanguageTypeScript})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(files) != 2 || files["models.ts"] == "" || files["client.ts"] == "" {
+		t.Fatalf("Expected models.ts and client.ts, got: %v", keys(files))
+	}
+
+	models := files["models.ts"]
+	expected := []string{
+		`export const PostgreSQLDatabasesResourceType = "MyCompany.Resources/postgreSQLDatabases";`,
+		"export interface PostgreSQLDatabasesProperties {",
+		"  environment: string;",
+		"  size?: PostgreSQLDatabasesSize;",
+		"  readonly port?: number;",
+		"  replicas?: Array<PostgreSQLDatabasesReplicasItem>;",
+		`  "connection-settings"?: Record<string, string>;`,
+		`export type PostgreSQLDatabasesSize = "S" | "M" | "L";`,
+		"Sensitive; the value is redacted when the resource is read.",
+	}
+	for _, s := range expected {
+		if !strings.Contains(models, s) {
+			t.Errorf("Expected models.ts to contain %q", s)
+		}
+	}
+
+	client := files["client.ts"]
+	if !strings.Contains(client, "export class PostgreSQLDatabasesClient {") {
+		t.Error("Expected client.ts to contain the per-type client")
+	}
+	if !strings.Contains(client, `from "./models";`) {
+		t.Error("Expected client.ts to import the models")
+	}
+}
+
+func TestGenerate_UnsupportedLanguage(t *testing.T) {
+	provider := parseTestManifest(t, testManifestYAML)
+
+	_, err := Generate(provider, Options{Language: "python"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported language 'python'") {
+		t.Errorf("Expected unsupported language error, got: %v", err)
+	}
+}
+
+func TestGenerate_UndefinedDefaultAPIVersion(t *testing.T) {
+	provider := parseTestManifest(t, `namespace: MyCompany.Resources
+types:
+  caches:
+    defaultApiVersion: '2030-01-01'
+    apiVersions:
+      '2025-03-01':
+        schema: {}
+`)
+
+	_, err := Generate(provider, Options{Language: LanguageGo})
+	if err == nil || !strings.Contains(err.Error(), "default API version '2030-01-01'") {
+		t.Errorf("Expected undefined default API version error, got: %v", err)
+	}
+}
+
+func TestGenerate_NameCollision(t *testing.T) {
+	provider := parseTestManifest(t, `namespace: MyCompany.Resources
+types:
+  caches:
+    apiVersions:
+      '2025-03-01':
+        schema:
+          type: object
+          properties:
+            max-size:
+              type: integer
+            maxSize:
+              type: integer
+`)
+
+	_, err := Generate(provider, Options{Language: LanguageGo})
+	if err == nil || !strings.Contains(err.Error(), "both map to the identifier 'MaxSize'") {
+		t.Errorf("Expected name collision error, got: %v", err)
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	testCases := map[string]string{
+		"postgreSQLDatabases": "PostgreSQLDatabases",
+		"connection-string":   "ConnectionString",
+		"snake_case":          "SnakeCase",
+		"v1.0":                "V10",
+		"2fa":                 "X2fa",
+		"---":                 "",
+	}
+
+	for input, expected := range testCases {
+		if actual := exportedName(input); actual != expected {
+			t.Errorf("exportedName(%q) = %q, expected %q", input, actual, expected)
+		}
+	}
+}
+
+func keys(m map[string]string) []string {
+	result := []string{}
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,304 @@
+package sdkgen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var tsIdentifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// generateTypeScript renders the TypeScript SDK for the given resource models.
+func generateTypeScript(namespace string, models []*resourceModel) (map[string]string, error) {
+	return map[string]string{
+		"models.ts": renderTypeScriptModels(namespace, models),
+		"client.ts": renderTypeScriptClient(models),
+	}, nil
+}
+
+func renderTypeScriptModels(namespace string, models []*resourceModel) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "// %s\n\n", generatedHeader)
+	fmt.Fprintf(sb, "// Models for the %s resource types.\n\n", namespace)
+
+	for _, model := range models {
+		fmt.Fprintf(sb, "/** The fully-qualified name of the %s resource type. */\n", model.ResourceType)
+		fmt.Fprintf(sb, "export const %sResourceType = %s;\n\n", model.Name, strconv.Quote(model.ResourceType))
+		fmt.Fprintf(sb, "/** The API version used by %sClient. */\n", model.Name)
+		fmt.Fprintf(sb, "export const %sAPIVersion = %s;\n\n", model.Name, strconv.Quote(model.APIVersion))
+
+		fmt.Fprintf(sb, "/** A %s resource. */\n", model.ResourceType)
+		fmt.Fprintf(sb, "export interface %sResource {\n", model.Name)
+		sb.WriteString("  /** The fully-qualified resource ID. */\n")
+		sb.WriteString("  readonly id?: string;\n")
+		sb.WriteString("  /** The resource name. */\n")
+		sb.WriteString("  readonly name?: string;\n")
+		sb.WriteString("  /** The resource type. */\n")
+		sb.WriteString("  readonly type?: string;\n")
+		sb.WriteString("  /** The resource location. */\n")
+		sb.WriteString("  location?: string;\n")
+		sb.WriteString("  /** The resource tags. */\n")
+		sb.WriteString("  tags?: Record<string, string>;\n")
+		sb.WriteString("  /** The resource properties. */\n")
+		fmt.Fprintf(sb, "  properties: %s;\n", model.Properties.Name)
+		sb.WriteString("}\n\n")
+
+		writeTypeScriptObject(sb, model.Properties)
+		for _, object := range model.Objects {
+			writeTypeScriptObject(sb, object)
+		}
+		for _, enum := range model.Enums {
+			writeTypeScriptEnum(sb, enum)
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func writeTypeScriptObject(sb *strings.Builder, object *objectModel) {
+	writeTypeScriptComment(sb, "", object.Description, "")
+	fmt.Fprintf(sb, "export interface %s {\n", object.Name)
+	for _, field := range object.Fields {
+		qualifiers := ""
+		if field.Sensitive {
+			qualifiers = "Sensitive; the value is redacted when the resource is read."
+		}
+		writeTypeScriptComment(sb, "  ", field.Description, qualifiers)
+
+		name := field.JSONName
+		if !tsIdentifierPattern.MatchString(name) {
+			name = strconv.Quote(name)
+		}
+
+		modifier := ""
+		if field.ReadOnly {
+			modifier = "readonly "
+		}
+		optional := ""
+		if !field.Required || field.ReadOnly {
+			optional = "?"
+		}
+		fmt.Fprintf(sb, "  %s%s%s: %s;\n", modifier, name, optional, typeScriptTypeName(field.Type))
+	}
+	sb.WriteString("}\n\n")
+}
+
+func writeTypeScriptEnum(sb *strings.Builder, enum *enumModel) {
+	writeTypeScriptComment(sb, "", enum.Description, "")
+	values := make([]string, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		values = append(values, strconv.Quote(value))
+	}
+	fmt.Fprintf(sb, "export type %s = %s;\n\n", enum.Name, strings.Join(values, " | "))
+}
+
+func writeTypeScriptComment(sb *strings.Builder, indent string, description string, qualifiers string) {
+	lines := []string{}
+	for _, line := range strings.Split(description, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, strings.ReplaceAll(line, "*/", "*\\/"))
+		}
+	}
+	if qualifiers != "" {
+		lines = append(lines, qualifiers)
+	}
+
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		fmt.Fprintf(sb, "%s/** %s */\n", indent, lines[0])
+	default:
+		fmt.Fprintf(sb, "%s/**\n", indent)
+		for _, line := range lines {
+			fmt.Fprintf(sb, "%s * %s\n", indent, line)
+		}
+		fmt.Fprintf(sb, "%s */\n", indent)
+	}
+}
+
+func typeScriptTypeName(ref typeRef) string {
+	switch ref.Kind {
+	case kindString:
+		return "string"
+	case kindInteger:
+		return "number"
+	case kindBoolean:
+		return "boolean"
+	case kindArray:
+		return "Array<" + typeScriptTypeName(*ref.Elem) + ">"
+	case kindMap:
+		return "Record<string, " + typeScriptTypeName(*ref.Elem) + ">"
+	case kindObject, kindEnum:
+		return ref.Name
+	default:
+		return "unknown"
+	}
+}
+
+func renderTypeScriptClient(models []*resourceModel) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "// %s\n\n", generatedHeader)
+
+	if len(models) > 0 {
+		sb.WriteString("import {\n")
+		for _, model := range models {
+			fmt.Fprintf(sb, "  %sAPIVersion,\n", model.Name)
+			fmt.Fprintf(sb, "  type %sResource,\n", model.Name)
+			fmt.Fprintf(sb, "  %sResourceType,\n", model.Name)
+		}
+		sb.WriteString("} from \"./models\";\n\n")
+	}
+
+	sb.WriteString(typeScriptClientCore)
+
+	for _, model := range models {
+		fmt.Fprintf(sb, typeScriptResourceClientTemplate, model.Name, model.ResourceType)
+	}
+
+	return sb.String()
+}
+
+// typeScriptClientCore is the part of the TypeScript client shared by all resource types.
+// It uses the global fetch API so that the generated module has no dependencies.
+const typeScriptClientCore = `/** Optional settings for RadiusClient. */
+export interface RadiusClientOptions {
+  /** The fetch implementation used to send requests. Defaults to the global fetch. */
+  fetch?: typeof fetch;
+  /** Headers added to every request, for example an Authorization header. */
+  headers?: Record<string, string>;
+}
+
+/** The error thrown when the server responds with an unexpected status code. */
+export class ResponseError extends Error {
+  constructor(
+    readonly statusCode: number,
+    readonly code?: string,
+    readonly serverMessage?: string,
+  ) {
+    super(
+      code
+        ? ` + "`request failed with status ${statusCode}: ${code}: ${serverMessage}`" + `
+        : ` + "`request failed with status ${statusCode}`" + `,
+    );
+    this.name = "ResponseError";
+  }
+}
+
+/** Returns true if err is a ResponseError with status 404. */
+export function isNotFound(err: unknown): boolean {
+  return err instanceof ResponseError && err.statusCode === 404;
+}
+
+/** Sends requests to the Radius UCP generic resource API. */
+export class RadiusClient {
+  private readonly endpoint: string;
+  private readonly scope: string;
+  private readonly fetchImpl: typeof fetch;
+  private readonly headers: Record<string, string>;
+
+  /**
+   * @param endpoint The UCP endpoint, for example "http://localhost:9000/apis/api.ucp.dev/v1alpha3".
+   * @param scope The resource group the client operates on, for example "/planes/radius/local/resourceGroups/default".
+   */
+  constructor(endpoint: string, scope: string, options: RadiusClientOptions = {}) {
+    if (!endpoint) {
+      throw new Error("endpoint is required");
+    }
+    if (!scope.startsWith("/planes/")) {
+      throw new Error(` + "`scope \"${scope}\" must start with /planes/`" + `);
+    }
+    this.endpoint = endpoint.replace(/\/$/, "");
+    this.scope = scope.replace(/\/$/, "");
+    this.fetchImpl = options.fetch ?? globalThis.fetch.bind(globalThis);
+    this.headers = { ...(options.headers ?? {}) };
+  }
+
+  /** @internal */
+  resourceUrl(resourceType: string, name: string, apiVersion: string): string {
+    let path = ` + "`${this.scope}/providers/${resourceType}`" + `;
+    if (name) {
+      path += ` + "`/${encodeURIComponent(name)}`" + `;
+    }
+    return ` + "`${this.endpoint}${path}?api-version=${encodeURIComponent(apiVersion)}`" + `;
+  }
+
+  /** @internal */
+  async send<T>(method: string, url: string, body: unknown, expected: number[]): Promise<T | undefined> {
+    const headers: Record<string, string> = { ...this.headers, Accept: "application/json" };
+    if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+    }
+
+    const response = await this.fetchImpl(url, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+    const text = await response.text();
+
+    if (!expected.includes(response.status)) {
+      let code: string | undefined;
+      let message: string | undefined;
+      try {
+        const parsed = JSON.parse(text);
+        code = parsed?.error?.code;
+        message = parsed?.error?.message;
+      } catch {
+        // The body is not an ARM error response.
+      }
+      throw new ResponseError(response.status, code, message);
+    }
+
+    return text ? (JSON.parse(text) as T) : undefined;
+  }
+
+  /** @internal */
+  async list<T>(url: string): Promise<T[]> {
+    const results: T[] = [];
+    let next: string | undefined = url;
+    while (next) {
+      const page: { value?: T[]; nextLink?: string } | undefined = await this.send("GET", next, undefined, [200]);
+      results.push(...(page?.value ?? []));
+      next = page?.nextLink;
+    }
+    return results;
+  }
+}
+`
+
+// typeScriptResourceClientTemplate is the per-type client. It is formatted with the type
+// identifier and the fully-qualified resource type.
+const typeScriptResourceClientTemplate = `
+/** Manages %[2]s resources. */
+export class %[1]sClient {
+  constructor(private readonly client: RadiusClient) {}
+
+  /**
+   * Creates or updates the named resource. Radius deploys the resource asynchronously;
+   * the returned resource reflects the state accepted by the server.
+   */
+  async createOrUpdate(name: string, resource: %[1]sResource): Promise<%[1]sResource> {
+    const url = this.client.resourceUrl(%[1]sResourceType, name, %[1]sAPIVersion);
+    return (await this.client.send<%[1]sResource>("PUT", url, resource, [200, 201, 202]))!;
+  }
+
+  /** Returns the named resource. Use isNotFound to check whether the resource exists. */
+  async get(name: string): Promise<%[1]sResource> {
+    const url = this.client.resourceUrl(%[1]sResourceType, name, %[1]sAPIVersion);
+    return (await this.client.send<%[1]sResource>("GET", url, undefined, [200]))!;
+  }
+
+  /** Deletes the named resource. Deleting a resource that does not exist is not an error. */
+  async delete(name: string): Promise<void> {
+    const url = this.client.resourceUrl(%[1]sResourceType, name, %[1]sAPIVersion);
+    await this.client.send("DELETE", url, undefined, [200, 202, 204]);
+  }
+
+  /** Returns every resource of this type in the client scope. */
+  async list(): Promise<%[1]sResource[]> {
+    return this.client.list<%[1]sResource>(this.client.resourceUrl(%[1]sResourceType, "", %[1]sAPIVersion));
+  }
+}
+`
@@ -42,6 +42,7 @@ db-init: db-dependency-docker-running ## Initialize a local PostgresSQL database
 			--env POSTGRES_USER=$(POSTGRES_USER) \
 			--env POSTGRES_PASSWORD=$(POSTGRES_PASSWORD) \
 			--volume $(PWD)/deploy/init-db/:/docker-entrypoint-initdb.d/ \
+			--volume $(PWD)/pkg/components/database/postgres/migrations/:/radius/migrations/:ro \
 			$(POSTGRES_IMAGE) 1> /dev/null; \
 		echo "Started PostgresSQL container $(POSTGRES_CONTAINER_NAME)"; \
 	fi;
@@ -227,6 +227,10 @@ else
 fi
 psql_exec "GRANT ALL PRIVILEGES ON DATABASE ucp TO ucp;" || true
 
+# The schema migrations are also applied by the resource providers when they start. The table and the objects
+# created by the migrations are owned by the provider's user so that it can apply them again.
+POSTGRES_MIGRATIONS_DIR="$REPO_ROOT/pkg/components/database/postgres/migrations"
+
 # Helper: create the resources table and grant permissions in a given database
 # Usage: init_db_tables <db_name> <db_user>
 init_db_tables() {
@@ -244,6 +248,10 @@ CREATE TABLE IF NOT EXISTS resources (
   resource_data jsonb NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_resource_query ON resources (resource_type, root_scope);
+ALTER TABLE resources OWNER TO ${db_user};
+SET ROLE ${db_user};
+$(cat "$POSTGRES_MIGRATIONS_DIR"/*.sql)
+RESET ROLE;
 GRANT ALL PRIVILEGES ON TABLE resources TO ${db_user};
 GRANT ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public TO ${db_user};
 "
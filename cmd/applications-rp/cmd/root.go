@@ -87,6 +87,11 @@ var rootCmd = &cobra.Command{
 			server.NewAsyncWorker(options, builders),
 		)
 
+		// Drift detection of the infrastructure deployed by recipes is provided via an opt-in service.
+		if service := server.NewDriftDetectionService(options); service != nil {
+			services = append(services, service)
+		}
+
 		host := &hosting.Host{
 			Services: services,
 		}
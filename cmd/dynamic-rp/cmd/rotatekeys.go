@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+)
+
+var rotateKeysCmd = &cobra.Command{
+	Use:   "rotate-keys",
+	Short: "Rotate the keys encrypting sensitive fields",
+	Long: `Adds a new key encrypting sensitive fields to the radius-encryption-key secret, and removes the keys that are no longer needed.
+
+The key rotation CronJob runs this command when the keys are wrapped by a key management service, so that new keys are wrapped before they are written.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configFilePath := cmd.Flag("config-file").Value.String()
+		bs, err := os.ReadFile(configFilePath)
+		if err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", configFilePath, err)
+		}
+
+		config, err := dynamicrp.LoadConfig(bs)
+		if err != nil {
+			return fmt.Errorf("failed to parse config file %s: %w", configFilePath, err)
+		}
+
+		intervalDays, err := cmd.Flags().GetInt("interval-days")
+		if err != nil {
+			return err
+		}
+
+		gracePeriodDays, err := cmd.Flags().GetInt("grace-period-days")
+		if err != nil {
+			return err
+		}
+
+		kubernetesProvider, err := kubernetesclientprovider.FromOptions(config.Kubernetes)
+		if err != nil {
+			return err
+		}
+
+		client, err := kubernetesProvider.RuntimeClient()
+		if err != nil {
+			return fmt.Errorf("failed to get Kubernetes runtime client: %w", err)
+		}
+
+		var wrapper encryption.KeyWrapper
+		if config.Encryption.KMS != nil {
+			wrapper, err = encryption.NewKeyWrapper(cmd.Context(), *config.Encryption.KMS)
+			if err != nil {
+				return err
+			}
+		}
+
+		source := encryption.NewKubernetesKeyProvider(client, nil)
+		reencryptedVersion, err := source.GetReencryptedVersion(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		version, err := encryption.RotateKeys(cmd.Context(), source, wrapper, encryption.RotationOptions{
+			Interval:           time.Duration(intervalDays) * 24 * time.Hour,
+			GracePeriod:        time.Duration(gracePeriodDays) * 24 * time.Hour,
+			ReencryptedVersion: reencryptedVersion,
+		}, now)
+		if err != nil {
+			return err
+		}
+
+		if err := source.SaveLastRotation(cmd.Context(), now); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Key rotation completed successfully, new version: %d\n", version)
+		return nil
+	},
+}
+
+func init() {
+	rotateKeysCmd.Flags().String("config-file", "", "The service configuration file.")
+	rotateKeysCmd.Flags().Int("interval-days", 90, "The number of days a new key remains valid for encryption.")
+	rotateKeysCmd.Flags().Int("grace-period-days", 1, "The number of days expired keys are kept to decrypt stored data.")
+	_ = rotateKeysCmd.MarkFlagRequired("config-file")
+
+	rootCmd.AddCommand(rotateKeysCmd)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/helm"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/upgrade/preupgrade"
 )
@@ -40,6 +42,15 @@ type Config struct {
 	Timeout       time.Duration
 	RetryAttempts int
 	RetryDelay    time.Duration
+
+	// ApplyMigrations saves migrated resources when the "migrations" check is enabled.
+	ApplyMigrations bool
+
+	// MigrationTimeout is the timeout for the "migrations" check.
+	MigrationTimeout time.Duration
+
+	// Database configures the connection to the Radius database for the "migrations" check.
+	Database databaseprovider.Options
 }
 
 var rootCmd = &cobra.Command{
@@ -93,15 +104,27 @@ func Execute() error {
 		Output: outputWriter,
 	}
 
+	// The database is only needed to run data migrations
+	if slices.Contains(cfg.EnabledChecks, "migrations") {
+		client, err := databaseprovider.FromOptions(cfg.Database).GetClient(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to connect to the database")
+			return err
+		}
+		preflightConfig.DatabaseClient = client
+	}
+
 	// Get current version from cluster
 	currentVersion := getCurrentVersion(preflightConfig, logger)
 
 	// Prepare options for preflight checks
 	options := preupgrade.Options{
-		EnabledChecks:  cfg.EnabledChecks,
-		TargetVersion:  cfg.TargetVersion,
-		CurrentVersion: currentVersion,
-		Timeout:        cfg.Timeout,
+		EnabledChecks:    cfg.EnabledChecks,
+		TargetVersion:    cfg.TargetVersion,
+		CurrentVersion:   currentVersion,
+		Timeout:          cfg.Timeout,
+		ApplyMigrations:  cfg.ApplyMigrations,
+		MigrationTimeout: cfg.MigrationTimeout,
 	}
 
 	// Run preflight checks with retry
@@ -132,6 +155,18 @@ func parseConfig() Config {
 		Timeout:       getEnvDuration("PREFLIGHT_TIMEOUT_SECONDS", 1*time.Minute),
 		RetryAttempts: getEnvInt("RETRY_ATTEMPTS", 1),
 		RetryDelay:    getEnvDuration("RETRY_DELAY_SECONDS", 2*time.Second),
+
+		ApplyMigrations:  getEnvBool("APPLY_MIGRATIONS", false),
+		MigrationTimeout: getEnvDuration("MIGRATION_TIMEOUT_SECONDS", 4*time.Minute),
+		Database: databaseprovider.Options{
+			Provider: databaseprovider.DatabaseProviderType(getEnvString("DATABASE_PROVIDER", string(databaseprovider.TypeAPIServer))),
+			APIServer: databaseprovider.APIServerOptions{
+				Namespace: getEnvString("DATABASE_NAMESPACE", "radius-system"),
+			},
+			PostgreSQL: databaseprovider.PostgreSQLOptions{
+				URL: getEnvString("DATABASE_POSTGRESQL_URL", ""),
+			},
+		},
 	}
 }
 
@@ -211,6 +246,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if v := os.Getenv(key); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	v := os.Getenv(key)
 	if v == "" {
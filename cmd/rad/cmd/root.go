@@ -31,6 +31,11 @@ import (
 	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/admin"
+	admin_queue "github.com/radius-project/radius/pkg/cli/cmd/admin/queue"
+	admin_queue_list "github.com/radius-project/radius/pkg/cli/cmd/admin/queue/list"
+	admin_queue_purge "github.com/radius-project/radius/pkg/cli/cmd/admin/queue/purge"
+	admin_queue_requeue "github.com/radius-project/radius/pkg/cli/cmd/admin/queue/requeue"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
 	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
@@ -65,8 +70,11 @@ import (
 	recipe_pack_delete "github.com/radius-project/radius/pkg/cli/cmd/recipepack/delete"
 	recipe_pack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
+	resource_cancel "github.com/radius-project/radius/pkg/cli/cmd/resource/cancel"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_drift "github.com/radius-project/radius/pkg/cli/cmd/resource/drift"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
@@ -75,8 +83,10 @@ import (
 	resourceprovider_show "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/show"
 	resourcetype_create "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/create"
 	resourcetype_delete "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/delete"
+	resourcetype_lint "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/lint"
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
+	resourcetype_validateinstances "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/validateinstances"
 	"github.com/radius-project/radius/pkg/cli/cmd/rollback"
 	rollback_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/rollback/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -290,6 +300,15 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceCancelCmd, _ := resource_cancel.NewCommand(framework)
+	resourceCmd.AddCommand(resourceCancelCmd)
+
+	resourceHistoryCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(resourceHistoryCmd)
+
+	resourceDriftCmd, _ := resource_drift.NewCommand(framework)
+	resourceCmd.AddCommand(resourceDriftCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -314,6 +333,12 @@ func initSubCommands() {
 	resourceTypeCreateCmd, _ := resourcetype_create.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeCreateCmd)
 
+	resourceTypeLintCmd, _ := resourcetype_lint.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeLintCmd)
+
+	resourceTypeValidateInstancesCmd, _ := resourcetype_validateinstances.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeValidateInstancesCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -449,6 +474,21 @@ func initSubCommands() {
 	rollbackKubernetesCmd, _ := rollback_kubernetes.NewCommand(framework)
 	rollbackCmd.AddCommand(rollbackKubernetesCmd)
 
+	adminCmd := admin.NewCommand()
+	RootCmd.AddCommand(adminCmd)
+
+	adminQueueCmd := admin_queue.NewCommand()
+	adminCmd.AddCommand(adminQueueCmd)
+
+	adminQueueListCmd, _ := admin_queue_list.NewCommand(framework)
+	adminQueueCmd.AddCommand(adminQueueListCmd)
+
+	adminQueueRequeueCmd, _ := admin_queue_requeue.NewCommand(framework)
+	adminQueueCmd.AddCommand(adminQueueRequeueCmd)
+
+	adminQueuePurgeCmd, _ := admin_queue_purge.NewCommand(framework)
+	adminQueueCmd.AddCommand(adminQueuePurgeCmd)
+
 	versionCmd, _ := version.NewCommand(framework)
 	RootCmd.AddCommand(versionCmd)
 }
@@ -3,6 +3,9 @@ set -e
 
 SCRIPT_DIR=$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )
 
+# Directory holding the schema migrations from pkg/components/database/postgres/migrations.
+MIGRATIONS_DIR=${MIGRATIONS_DIR:-/radius/migrations}
+
 # Array of usernames
 RESOURCE_PROVIDERS=("ucp" "applications_rp")
 
@@ -20,4 +23,9 @@ done
 for RESOURCE_PROVIDER in "${RESOURCE_PROVIDERS[@]}"; do
     echo "Creating tables in database $RESOURCE_PROVIDER"
     psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$RESOURCE_PROVIDER" < $SCRIPT_DIR/db.sql.txt
+
+    for MIGRATION in "$MIGRATIONS_DIR"/*.sql; do
+        echo "Applying schema migration $(basename "$MIGRATION") in database $RESOURCE_PROVIDER"
+        psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$RESOURCE_PROVIDER" < "$MIGRATION"
+    done
 done
\ No newline at end of file
@@ -213,7 +213,7 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 		ClientTenantID:      r.Header.Get(ClientTenantIDHeader),
 		ClientApplicationID: r.Header.Get(ClientApplicationIDHeader),
 		ClientObjectID:      r.Header.Get(ClientObjectIDHeader),
-		ClientPrincipalName: r.Header.Get(ClientPrincipalIDHeader),
+		ClientPrincipalName: r.Header.Get(ClientPrincipalNameHeader),
 		ClientPrincipalID:   r.Header.Get(ClientPrincipalIDHeader),
 
 		APIVersion:        r.URL.Query().Get(APIVersionParameterName),
@@ -87,6 +87,9 @@ const (
 	OperationPutSubscriptions OperationMethod = "PUTSUBSCRIPTIONS"
 	OperationPost             OperationMethod = "POST"
 
+	// OperationCancel is used to request cancellation of an async operation.
+	OperationCancel OperationMethod = "CANCEL"
+
 	// Imperative operation methods for non-idempotent lifecycle operations.
 	// UCP extends the ARM resource lifecycle to support using POST for non-idempotent resource types.
 	//
@@ -105,9 +108,17 @@ const (
 	// OperationProxy is used for controllers that proxy the underlying request without classifying the type of operation.
 	OperationProxy OperationMethod = "PROXY"
 
+	// OperationActionPrefix is the prefix of the operation methods of custom actions, such as ACTIONLISTSECRETS.
+	OperationActionPrefix = "ACTION"
+
 	Separator = "|"
 )
 
+// IsAction returns true if the method is the method of a custom action.
+func (o OperationMethod) IsAction() bool {
+	return strings.HasPrefix(string(o), OperationActionPrefix)
+}
+
 // OperationType represents the operation type which includes resource type name and its method.
 // OperationType is used as a route name in the frontend API server router. Each valid ARM RPC call should have
 // its own operation type name. For Asynchronous API, the frontend API server queues the async operation
@@ -22,6 +22,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +44,10 @@ type Options struct {
 
 	// UcpClient is the UCP client factory.
 	UcpClient *v20231001preview.ClientFactory
+
+	// KeyProvider provides the keys decrypting sensitive fields. The keys are loaded from the Kubernetes Secret of
+	// the key rotation CronJob with KubeClient if not set.
+	KeyProvider encryption.KeyProvider
 }
 
 // Validate validates that required fields are set on the options.
@@ -95,6 +100,14 @@ func (b *BaseController) KubeClient() runtimeclient.Client {
 	return b.options.KubeClient
 }
 
+// KeyProvider gets the provider of the keys decrypting sensitive fields for this controller.
+func (b *BaseController) KeyProvider() encryption.KeyProvider {
+	if b.options.KeyProvider == nil && b.options.KubeClient != nil {
+		return encryption.NewKubernetesKeyProvider(b.options.KubeClient, nil)
+	}
+	return b.options.KeyProvider
+}
+
 // ResourceType gets the resource type for this controller.
 func (b *BaseController) ResourceType() string {
 	return b.options.ResourceType
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -56,6 +57,9 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// Body represents the request body of a custom action, which the controller needs to run the action.
+	Body json.RawMessage `json:"body,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
@@ -28,6 +28,10 @@ type Result struct {
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
+	// Response represents the response body of a custom action. It is returned by the operation result once the
+	// operation succeeded.
+	Response any
+
 	// state represents the provisioning status.
 	state *v1.ProvisioningState
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// Entry is the datamodel of an entry in the operation history of a resource.
+type Entry struct {
+	// ID is the id of the history entry: {planeScope}/providers/{namespace}/locations/{location}/operations/{operationId}.
+	ID string `json:"id"`
+
+	// Name is the id of the async operation.
+	Name string `json:"name"`
+
+	// ResourceID is the id of the resource the operation applies to.
+	ResourceID string `json:"resourceId"`
+
+	// ResourceKey is the lowercased resource id. Resource ids are case-insensitive, so the history of a resource is
+	// queried by its key.
+	ResourceKey string `json:"resourceKey,omitempty"`
+
+	// OperationType is the type of the operation, for example "APPLICATIONS.CORE/CONTAINERS|PUT".
+	OperationType string `json:"operationType"`
+
+	// APIVersion is the api version of the request that started the operation.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Status is the provisioning state of the operation.
+	Status v1.ProvisioningState `json:"status"`
+
+	// StartTime is the time the operation was accepted.
+	StartTime time.Time `json:"startTime"`
+
+	// EndTime is the time the operation reached a terminal state.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error is the error of a failed or canceled operation.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+
+	// Caller is the identity of the caller who started the operation.
+	Caller Caller `json:"caller"`
+
+	// Timestamp is StartTime in unix seconds. It is used to query the entries past the retention.
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+// Caller is the identity of the caller of an operation, as provided by the request headers.
+type Caller struct {
+	// PrincipalName is the principal name of the caller such as the value from x-ms-client-principal-name header.
+	PrincipalName string `json:"principalName,omitempty"`
+
+	// ObjectID is the object id of the caller such as the value from x-ms-client-object-id header.
+	ObjectID string `json:"objectId,omitempty"`
+
+	// ApplicationID is the application id of the caller such as the value from x-ms-client-app-id header.
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// HomeTenantID is the tenant id of the caller such as the value from x-ms-home-tenant-id header.
+	HomeTenantID string `json:"homeTenantId,omitempty"`
+
+	// UserAgent is the user agent of the client.
+	UserAgent string `json:"userAgent,omitempty"`
+
+	// CorrelationID is the correlation id of the request that started the operation.
+	CorrelationID string `json:"correlationId,omitempty"`
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultRetention is the default duration the history of an operation is kept.
+	DefaultRetention = 30 * 24 * time.Hour
+
+	// ResourceKeyField is the field of the history entries holding the lowercased resource id.
+	ResourceKeyField = "resourceKey"
+
+	// pruneInterval is the interval between two removals of the entries past the retention.
+	pruneInterval = 10 * time.Minute
+
+	// pruneBatchSize is the maximum number of entries queried at once when removing the entries past the retention.
+	pruneBatchSize = 100
+
+	// scopeResourceType is the resource type of the index of the scopes holding history entries. History entries are
+	// stored under the provider namespace of their resource, so the database cannot be queried for all of them at
+	// once. Instead, the plane scopes and provider namespaces are indexed, and each of them is pruned.
+	scopeResourceType = "System.Resources/operationHistoryScopes"
+)
+
+// scope is an entry of the index of the scopes holding history entries.
+type scope struct {
+	PlaneScope        string `json:"planeScope"`
+	ProviderNamespace string `json:"providerNamespace"`
+}
+
+// Recorder records the history of the async operations in the database. The history is durable: unlike
+// operation statuses, an entry is kept until it is past the retention.
+type Recorder struct {
+	databaseClient database.Client
+	location       string
+	retention      time.Duration
+
+	scopesMu sync.Mutex
+	// indexed are the ids of the scope index entries saved by this process, so that each is only saved once.
+	indexed map[string]struct{}
+}
+
+// NewRecorder creates a Recorder that keeps the history of an operation for the given retention.
+func NewRecorder(databaseClient database.Client, location string, retention time.Duration) *Recorder {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &Recorder{
+		databaseClient: databaseClient,
+		location:       location,
+		retention:      retention,
+		indexed:        map[string]struct{}{},
+	}
+}
+
+// FromOptions creates a Recorder from the host options. It returns nil if the operation history is disabled.
+func FromOptions(databaseClient database.Client, location string, options hostoptions.OperationHistoryOptions) (*Recorder, error) {
+	if options.Enabled != nil && !*options.Enabled {
+		return nil, nil
+	}
+
+	retention := DefaultRetention
+	if options.Retention != "" {
+		d, err := time.ParseDuration(options.Retention)
+		if err != nil {
+			return nil, fmt.Errorf("invalid operation history retention %q: %w", options.Retention, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid operation history retention %q: must be positive", options.Retention)
+		}
+		retention = d
+	}
+
+	return NewRecorder(databaseClient, location, retention), nil
+}
+
+// EntryID returns the id of the history entry of the operation on the resource.
+func (r *Recorder) EntryID(id resources.ID, operationID uuid.UUID) string {
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operations/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), r.location, operationID)
+}
+
+// RecordQueued records a new operation accepted by the frontend.
+func (r *Recorder) RecordQueued(ctx context.Context, sCtx *v1.ARMRequestContext, startTime time.Time) error {
+	entry := &Entry{
+		ID:            r.EntryID(sCtx.ResourceID, sCtx.OperationID),
+		Name:          sCtx.OperationID.String(),
+		ResourceID:    sCtx.ResourceID.String(),
+		ResourceKey:   strings.ToLower(sCtx.ResourceID.String()),
+		OperationType: sCtx.OperationType.String(),
+		APIVersion:    sCtx.APIVersion,
+		Status:        v1.ProvisioningStateAccepted,
+		StartTime:     startTime,
+		Timestamp:     startTime.Unix(),
+		Caller: Caller{
+			PrincipalName: sCtx.ClientPrincipalName,
+			ObjectID:      sCtx.ClientObjectID,
+			ApplicationID: sCtx.ClientApplicationID,
+			HomeTenantID:  sCtx.HomeTenantID,
+			UserAgent:     sCtx.UserAgent,
+			CorrelationID: sCtx.CorrelationID,
+		},
+	}
+
+	err := r.databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: entry.ID},
+		Data:     entry,
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.indexScope(ctx, sCtx.ResourceID)
+}
+
+// RecordUpdate records the new state of an operation processed by the worker. Operations without a history entry,
+// for example the operations queued before the history was enabled, are ignored.
+func (r *Recorder) RecordUpdate(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+	obj, err := r.databaseClient.Get(ctx, r.EntryID(id, operationID))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	entry := &Entry{}
+	if err := obj.As(entry); err != nil {
+		return err
+	}
+
+	// Entries recorded by older versions were not indexed.
+	if err := r.indexScope(ctx, id); err != nil {
+		return err
+	}
+
+	entry.Status = state
+	if endTime != nil {
+		entry.EndTime = endTime
+	}
+	if opError != nil {
+		entry.Error = opError
+	}
+
+	obj.Data = entry
+	return r.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+}
+
+// Delete deletes the history entry of an operation that could not be queued.
+func (r *Recorder) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	err := r.databaseClient.Delete(ctx, r.EntryID(id, operationID))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	}
+	return err
+}
+
+// Prune deletes the history entries of the plane scope and provider namespace that are past the retention. The
+// entries are queried and deleted in batches.
+func (r *Recorder) Prune(ctx context.Context, planeScope string, providerNamespace string) error {
+	cutoff := time.Now().Add(-r.retention).Unix()
+	query := database.Query{
+		RootScope:    planeScope,
+		ResourceType: providerNamespace + "/locations/operations",
+		Filters: []database.QueryFilter{
+			{Field: "timestamp", Operator: database.FilterOperatorLessThan, Value: strconv.FormatInt(cutoff, 10)},
+		},
+	}
+
+	for {
+		// The deleted entries no longer match the query, so the first page is queried every time.
+		result, err := r.databaseClient.Query(ctx, query, database.WithMaxQueryItemCount(pruneBatchSize))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			err := r.databaseClient.Delete(ctx, item.ID)
+			if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+				return err
+			}
+		}
+
+		if result.PaginationToken == "" || len(result.Items) == 0 {
+			return nil
+		}
+	}
+}
+
+// Run prunes the history of every indexed scope every pruneInterval until the context is cancelled. Pruning is
+// best-effort: the entries left over are removed by the next pass.
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.pruneAll(ctx)
+		}
+	}
+}
+
+// pruneAll prunes the history of every scope in the index, including the scopes indexed by other processes.
+func (r *Recorder) pruneAll(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	query := database.Query{
+		RootScope:      "/planes",
+		ScopeRecursive: true,
+		ResourceType:   scopeResourceType,
+	}
+
+	token := ""
+	for {
+		result, err := r.databaseClient.Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(pruneBatchSize))
+		if err != nil {
+			logger.Error(err, "failed to list the operation history scopes")
+			return
+		}
+
+		for _, item := range result.Items {
+			s := scope{}
+			if err := item.As(&s); err != nil {
+				logger.Error(err, "invalid operation history scope", "id", item.ID)
+				continue
+			}
+
+			if err := r.Prune(ctx, s.PlaneScope, s.ProviderNamespace); err != nil {
+				logger.Error(err, "failed to prune the operation history", "planeScope", s.PlaneScope, "providerNamespace", s.ProviderNamespace)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return
+		}
+		token = result.PaginationToken
+	}
+}
+
+// indexScope adds the plane scope and provider namespace of the resource to the index of the scopes pruned by Run.
+func (r *Recorder) indexScope(ctx context.Context, id resources.ID) error {
+	namespace := strings.ToLower(id.ProviderNamespace())
+	scopeID := fmt.Sprintf("%s/providers/%s/%s", id.PlaneScope(), scopeResourceType, namespace)
+
+	r.scopesMu.Lock()
+	_, ok := r.indexed[strings.ToLower(scopeID)]
+	r.scopesMu.Unlock()
+	if ok {
+		return nil
+	}
+
+	err := r.databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: scopeID},
+		Data:     &scope{PlaneScope: id.PlaneScope(), ProviderNamespace: namespace},
+	})
+	if err != nil {
+		return err
+	}
+
+	r.scopesMu.Lock()
+	defer r.scopesMu.Unlock()
+	r.indexed[strings.ToLower(scopeID)] = struct{}{}
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0"
+
+func newRequestContext() *v1.ARMRequestContext {
+	return &v1.ARMRequestContext{
+		ResourceID:          resources.MustParse(testResourceID),
+		OperationID:         uuid.New(),
+		OperationType:       v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut},
+		APIVersion:          "2023-10-01-preview",
+		CorrelationID:       "correlation-id",
+		HomeTenantID:        "home-tenant-id",
+		ClientObjectID:      "client-object-id",
+		ClientPrincipalName: "user@contoso.com",
+		UserAgent:           "rad",
+	}
+}
+
+func getEntry(t *testing.T, client database.Client, id string) *Entry {
+	obj, err := client.Get(context.Background(), id)
+	require.NoError(t, err)
+
+	entry := &Entry{}
+	require.NoError(t, obj.As(entry))
+	return entry
+}
+
+func TestFromOptions(t *testing.T) {
+	client := inmemory.NewClient()
+
+	recorder, err := FromOptions(client, v1.LocationGlobal, hostoptions.OperationHistoryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultRetention, recorder.retention)
+
+	recorder, err = FromOptions(client, v1.LocationGlobal, hostoptions.OperationHistoryOptions{Retention: "48h"})
+	require.NoError(t, err)
+	require.Equal(t, 48*time.Hour, recorder.retention)
+
+	recorder, err = FromOptions(client, v1.LocationGlobal, hostoptions.OperationHistoryOptions{Enabled: to.Ptr(false)})
+	require.NoError(t, err)
+	require.Nil(t, recorder)
+
+	_, err = FromOptions(client, v1.LocationGlobal, hostoptions.OperationHistoryOptions{Retention: "a week"})
+	require.ErrorContains(t, err, "invalid operation history retention")
+
+	_, err = FromOptions(client, v1.LocationGlobal, hostoptions.OperationHistoryOptions{Retention: "-1h"})
+	require.ErrorContains(t, err, "must be positive")
+}
+
+func TestEntryID(t *testing.T) {
+	recorder := NewRecorder(inmemory.NewClient(), v1.LocationGlobal, 0)
+	id := recorder.EntryID(resources.MustParse(testResourceID), uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+	require.Equal(t, "/planes/radius/local/providers/applications.core/locations/global/operations/00000000-0000-0000-0000-000000000001", id)
+}
+
+func TestRecordQueuedAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	recorder := NewRecorder(client, v1.LocationGlobal, time.Hour)
+
+	sCtx := newRequestContext()
+	startTime := time.Now().UTC()
+	require.NoError(t, recorder.RecordQueued(ctx, sCtx, startTime))
+
+	id := recorder.EntryID(sCtx.ResourceID, sCtx.OperationID)
+	entry := getEntry(t, client, id)
+	require.Equal(t, sCtx.OperationID.String(), entry.Name)
+	require.Equal(t, testResourceID, entry.ResourceID)
+	require.Equal(t, "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/containers/c0", entry.ResourceKey)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", entry.OperationType)
+	require.Equal(t, "2023-10-01-preview", entry.APIVersion)
+	require.Equal(t, v1.ProvisioningStateAccepted, entry.Status)
+	require.Equal(t, Caller{
+		PrincipalName: "user@contoso.com",
+		ObjectID:      "client-object-id",
+		HomeTenantID:  "home-tenant-id",
+		UserAgent:     "rad",
+		CorrelationID: "correlation-id",
+	}, entry.Caller)
+
+	require.NoError(t, recorder.RecordUpdate(ctx, sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateUpdating, nil, nil))
+	require.Equal(t, v1.ProvisioningStateUpdating, getEntry(t, client, id).Status)
+
+	endTime := time.Now().UTC()
+	opErr := &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"}
+	require.NoError(t, recorder.RecordUpdate(ctx, sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateFailed, &endTime, opErr))
+	entry = getEntry(t, client, id)
+	require.Equal(t, v1.ProvisioningStateFailed, entry.Status)
+	require.NotNil(t, entry.EndTime)
+	require.Equal(t, opErr, entry.Error)
+
+	// Operations without a history entry are ignored.
+	require.NoError(t, recorder.RecordUpdate(ctx, sCtx.ResourceID, uuid.New(), v1.ProvisioningStateSucceeded, &endTime, nil))
+
+	require.NoError(t, recorder.Delete(ctx, sCtx.ResourceID, sCtx.OperationID))
+	_, err := client.Get(ctx, id)
+	require.ErrorIs(t, err, &database.ErrNotFound{})
+	require.NoError(t, recorder.Delete(ctx, sCtx.ResourceID, sCtx.OperationID))
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	recorder := NewRecorder(client, v1.LocationGlobal, time.Hour)
+
+	expired := newRequestContext()
+	require.NoError(t, recorder.RecordQueued(ctx, expired, time.Now().Add(-2*time.Hour)))
+	recent := newRequestContext()
+	require.NoError(t, recorder.RecordQueued(ctx, recent, time.Now()))
+
+	require.NoError(t, recorder.Prune(ctx, "/planes/radius/local", "Applications.Core"))
+
+	_, err := client.Get(ctx, recorder.EntryID(expired.ResourceID, expired.OperationID))
+	require.ErrorIs(t, err, &database.ErrNotFound{})
+	_, err = client.Get(ctx, recorder.EntryID(recent.ResourceID, recent.OperationID))
+	require.NoError(t, err)
+}
+
+func TestPrune_Batches(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	recorder := NewRecorder(client, v1.LocationGlobal, time.Hour)
+
+	expired := []*v1.ARMRequestContext{}
+	for i := 0; i < pruneBatchSize+5; i++ {
+		sCtx := newRequestContext()
+		require.NoError(t, recorder.RecordQueued(ctx, sCtx, time.Now().Add(-2*time.Hour)))
+		expired = append(expired, sCtx)
+	}
+
+	// The scopes are read from the database, so a recorder that did not record the entries prunes them too.
+	NewRecorder(client, v1.LocationGlobal, time.Hour).pruneAll(ctx)
+
+	for _, sCtx := range expired {
+		_, err := client.Get(ctx, recorder.EntryID(sCtx.ResourceID, sCtx.OperationID))
+		require.ErrorIs(t, err, &database.ErrNotFound{})
+	}
+}
@@ -43,4 +43,12 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+
+	// CancelRequested is true when the user requested cancellation of the async operation. The worker processing
+	// the operation cancels it and sets its status to Canceled.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
+
+	// Response is the response body of a custom action, returned by the operation result once the operation
+	// succeeded.
+	Response any `json:"response,omitempty"`
 }
@@ -18,6 +18,7 @@ package statusmanager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -25,11 +26,13 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/components/trace"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
 )
@@ -39,6 +42,7 @@ type statusManager struct {
 	databaseClient database.Client
 	queue          queue.Client
 	location       string
+	history        *history.Recorder
 }
 
 // QueueOperationOptions is the options type provided when queueing an async operation.
@@ -47,6 +51,8 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Body specifies the request body of a custom action, passed to the async operation controller.
+	Body json.RawMessage
 }
 
 //go:generate mockgen -typed -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -57,19 +63,39 @@ type StatusManager interface {
 	Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error)
 	// QueueAsyncOperation creates an async operation status object and queue async operation.
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
-	// Update updates an async operation status.
-	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// Update updates an async operation status. response is the response body of a custom action, if any.
+	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, response any) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// QueueCancellation queues a message asking the worker to cancel an async operation. The status must already
+	// be marked as cancel-requested.
+	QueueCancellation(ctx context.Context, status *Status) error
+}
+
+// Option configures the status manager.
+type Option func(*statusManager)
+
+// WithHistory records the history of the async operations with the given recorder. A nil recorder disables
+// the history.
+func WithHistory(recorder *history.Recorder) Option {
+	return func(aom *statusManager) {
+		aom.history = recorder
+	}
 }
 
 // New creates statusManager instance.
-func New(databaseClient database.Client, queueClient queue.Client, location string) StatusManager {
-	return &statusManager{
+func New(databaseClient database.Client, queueClient queue.Client, location string, options ...Option) StatusManager {
+	aom := &statusManager{
 		databaseClient: databaseClient,
 		queue:          queueClient,
 		location:       location,
 	}
+
+	for _, option := range options {
+		option(aom)
+	}
+
+	return aom
 }
 
 // operationStatusResourceID function is to build the operationStatus resourceID.
@@ -77,8 +103,9 @@ func (aom *statusManager) operationStatusResourceID(id resources.ID, operationID
 	return fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), aom.location, operationID)
 }
 
-// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
-// a request message. If an error occurs, the status is deleted using the databaseClient.
+// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, records the
+// operation in the history, and queues a request message. If queueing fails, the status and the history entry are
+// deleted using the databaseClient. Failures to record the history are logged.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
 	ctx, span := trace.StartProducerSpan(ctx, "statusmanager.QueueAsyncOperation publish", trace.FrontendTracerName)
 	defer span.End()
@@ -115,11 +142,23 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	// The history is auxiliary: failing to record it does not fail the operation.
+	if aom.history != nil {
+		if err = aom.history.RecordQueued(ctx, sCtx, aos.StartTime); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to record the operation history", "operationID", sCtx.OperationID.String())
+		}
+	}
+
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := aom.databaseClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
 		}
+		if aom.history != nil {
+			if delErr := aom.history.Delete(ctx, sCtx.ResourceID, sCtx.OperationID); delErr != nil {
+				ucplog.FromContextOrDiscard(ctx).Error(delErr, "failed to delete the operation history", "operationID", sCtx.OperationID.String())
+			}
+		}
 		return err
 	}
 
@@ -144,8 +183,9 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 }
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
-// given parameters, and saves it back to the store.
-func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+// given parameters, and saves it back to the store. The new state is also recorded in the history, and failures to
+// record it are logged.
+func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, response any) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	obj, err := aom.databaseClient.Get(ctx, opID)
 	if err != nil {
@@ -166,11 +206,25 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		s.Error = opError
 	}
 
+	if response != nil {
+		s.Response = response
+	}
+
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
 
-	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if err := aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	if aom.history != nil {
+		if err := aom.history.RecordUpdate(ctx, id, operationID, state, endTime, opError); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to record the operation history", "operationID", operationID.String())
+		}
+	}
+
+	return nil
 }
 
 // Delete deletes the operation status resource associated with the given ID and
@@ -179,8 +233,38 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 	return aom.databaseClient.Delete(ctx, aom.operationStatusResourceID(id, operationID))
 }
 
+// QueueCancellation queues a cancellation message for the async operation of the given status. Cancellation messages
+// are scheduled ahead of other operations, so that a worker running the operation cancels it without waiting for its
+// next cancellation check.
+func (aom *statusManager) QueueCancellation(ctx context.Context, status *Status) error {
+	if aom.queue == nil {
+		return errors.New("queue client is unset")
+	}
+
+	id, err := resources.ParseResource(status.LinkedResourceID)
+	if err != nil {
+		return err
+	}
+
+	operationID, err := uuid.Parse(status.Name)
+	if err != nil {
+		return err
+	}
+
+	msg := &ctrl.Request{
+		OperationID:    operationID,
+		OperationType:  v1.OperationType{Type: strings.ToUpper(id.Type()), Method: v1.OperationCancel}.String(),
+		ResourceID:     status.LinkedResourceID,
+		TraceparentID:  trace.ExtractTraceparent(ctx),
+		HomeTenantID:   status.HomeTenantID,
+		ClientObjectID: status.ClientObjectID,
+	}
+
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+}
+
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -191,7 +275,8 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		AcceptLanguage:   sCtx.AcceptLanguage,
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
-		OperationTimeout: &operationTimeout,
+		OperationTimeout: &options.OperationTimeout,
+		Body:             options.Body,
 	}
 
 	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
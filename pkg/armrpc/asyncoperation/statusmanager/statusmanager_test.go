@@ -18,6 +18,7 @@ package statusmanager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -25,8 +26,11 @@ import (
 
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
@@ -309,7 +313,7 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 			testAos.Status = v1.ProvisioningStateSucceeded
 			rid, err := resources.ParseResource(azureEnvResourceID)
 			require.NoError(t, err)
-			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil)
+			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil, nil)
 
 			if tt.GetErr == nil && tt.SaveErr == nil {
 				require.NoError(t, err)
@@ -325,3 +329,132 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestOperationHistory(t *testing.T) {
+	ctx := context.Background()
+	mctrl := gomock.NewController(t)
+	databaseClient := inmemory.NewClient()
+	queueClient := queue.NewMockClient(mctrl)
+	recorder := history.NewRecorder(databaseClient, "test-location", time.Hour)
+	sm := New(databaseClient, queueClient, "test-location", WithHistory(recorder))
+
+	sCtx := *reqCtx
+	sCtx.OperationID = uuid.New()
+	entryID := recorder.EntryID(sCtx.ResourceID, sCtx.OperationID)
+
+	t.Run("queue error deletes history entry", func(t *testing.T) {
+		queueClient.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(enqueueErr))
+
+		err := sm.QueueAsyncOperation(ctx, &sCtx, QueueOperationOptions{})
+		require.EqualError(t, err, enqueueErr)
+
+		_, err = databaseClient.Get(ctx, entryID)
+		require.ErrorIs(t, err, &database.ErrNotFound{})
+	})
+
+	t.Run("queue and update record history", func(t *testing.T) {
+		queueClient.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := sm.QueueAsyncOperation(ctx, &sCtx, QueueOperationOptions{})
+		require.NoError(t, err)
+
+		endTime := time.Now().UTC()
+		err = sm.Update(ctx, sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateSucceeded, &endTime, nil, nil)
+		require.NoError(t, err)
+
+		obj, err := databaseClient.Get(ctx, entryID)
+		require.NoError(t, err)
+		entry := &history.Entry{}
+		require.NoError(t, obj.As(entry))
+		require.Equal(t, v1.ProvisioningStateSucceeded, entry.Status)
+		require.Equal(t, sCtx.ResourceID.String(), entry.ResourceID)
+		require.NotNil(t, entry.EndTime)
+	})
+}
+
+func TestOperationHistory_Failure(t *testing.T) {
+	ctx := context.Background()
+	mctrl := gomock.NewController(t)
+	databaseClient := inmemory.NewClient()
+	queueClient := queue.NewMockClient(mctrl)
+
+	historyClient := database.NewMockClient(mctrl)
+	historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("history error")).AnyTimes()
+	historyClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("history error")).AnyTimes()
+
+	sm := New(databaseClient, queueClient, "test-location", WithHistory(history.NewRecorder(historyClient, "test-location", time.Hour)))
+
+	sCtx := *reqCtx
+	sCtx.OperationID = uuid.New()
+
+	queueClient.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := sm.QueueAsyncOperation(ctx, &sCtx, QueueOperationOptions{})
+	require.NoError(t, err)
+
+	endTime := time.Now().UTC()
+	err = sm.Update(ctx, sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateSucceeded, &endTime, nil, nil)
+	require.NoError(t, err)
+
+	status, err := sm.Get(ctx, sCtx.ResourceID, sCtx.OperationID)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+}
+
+func TestAction_BodyAndResponse(t *testing.T) {
+	ctx := context.Background()
+	mctrl := gomock.NewController(t)
+	databaseClient := inmemory.NewClient()
+	queueClient := queue.NewMockClient(mctrl)
+	sm := New(databaseClient, queueClient, "test-location")
+
+	sCtx := *reqCtx
+	sCtx.OperationID = uuid.New()
+	body := json.RawMessage(`{"resourceId":"test"}`)
+
+	queueClient.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *queue.Message, _ ...queue.EnqueueOptions) error {
+			req := &ctrl.Request{}
+			require.NoError(t, json.Unmarshal(msg.Data, req))
+			require.JSONEq(t, string(body), string(req.Body))
+			return nil
+		})
+
+	err := sm.QueueAsyncOperation(ctx, &sCtx, QueueOperationOptions{Body: body})
+	require.NoError(t, err)
+
+	endTime := time.Now().UTC()
+	err = sm.Update(ctx, sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateSucceeded, &endTime, nil, map[string]any{"result": "value"})
+	require.NoError(t, err)
+
+	status, err := sm.Get(ctx, sCtx.ResourceID, sCtx.OperationID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"result": "value"}, status.Response)
+}
+
+func TestQueueCancellation(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	operationID := uuid.New()
+	status := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{Name: operationID.String(), Status: v1.ProvisioningStateUpdating},
+		LinkedResourceID:     ucpEnvResourceID,
+		CancelRequested:      true,
+	}
+
+	aomTest.queueClient.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *queue.Message, _ ...queue.EnqueueOptions) error {
+			req := &ctrl.Request{}
+			require.NoError(t, json.Unmarshal(msg.Data, req))
+			require.Equal(t, operationID, req.OperationID)
+			require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|CANCEL", req.OperationType)
+			require.Equal(t, ucpEnvResourceID, req.ResourceID)
+			return nil
+		})
+
+	err := aomTest.manager.QueueCancellation(context.Background(), status)
+	require.NoError(t, err)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// FairnessScope is the scope at which async operations share the worker's concurrency fairly.
+type FairnessScope string
+
+const (
+	// FairnessScopeResourceGroup shares the concurrency fairly between resource groups. Resources that do not belong
+	// to a resource group share the concurrency of their root scope.
+	FairnessScopeResourceGroup FairnessScope = "resourceGroup"
+
+	// FairnessScopePlane shares the concurrency fairly between planes.
+	FairnessScopePlane FairnessScope = "plane"
+
+	// FairnessScopeNone processes operations in queue order.
+	FairnessScopeNone FairnessScope = "none"
+)
+
+var (
+	// defaultOperationPriorities is the default priority of operations. Cancellations are processed first because they
+	// are cheap and stop work that the user no longer wants. DELETE operations are processed ahead of the remaining
+	// operations because they free resources and unblock other operations on the same resource.
+	defaultOperationPriorities = map[v1.OperationMethod]int{
+		v1.OperationCancel:           20,
+		v1.OperationDelete:           10,
+		v1.OperationDeleteImperative: 10,
+	}
+)
+
+// ParseFairnessScope parses the fairness scope. The empty string is parsed as FairnessScopeResourceGroup.
+func ParseFairnessScope(s string) (FairnessScope, error) {
+	switch {
+	case s == "" || strings.EqualFold(s, string(FairnessScopeResourceGroup)):
+		return FairnessScopeResourceGroup, nil
+	case strings.EqualFold(s, string(FairnessScopePlane)):
+		return FairnessScopePlane, nil
+	case strings.EqualFold(s, string(FairnessScopeNone)):
+		return FairnessScopeNone, nil
+	default:
+		return "", fmt.Errorf("unsupported fairness scope %q, supported values are %q, %q and %q", s, FairnessScopeResourceGroup, FairnessScopePlane, FairnessScopeNone)
+	}
+}
+
+// scheduledOperation is an async operation message waiting to be processed or being processed by the worker.
+type scheduledOperation struct {
+	message      *queue.Message
+	request      *ctrl.Request
+	scope        string
+	priority     int
+	cancellation bool
+	queuedAt     time.Time
+}
+
+// operationQueue holds the pending operations of a single priority, grouped by fairness scope.
+type operationQueue struct {
+	// scopes is the round-robin order of the scopes that have pending operations.
+	scopes []string
+
+	// operations holds the pending operations of each scope in queue order.
+	operations map[string][]*scheduledOperation
+}
+
+// scheduler decides the order in which the worker processes the messages it has dequeued. Operations with a higher
+// priority are processed first. Operations of the same priority are processed round-robin between fairness scopes,
+// so that a single resource group (or plane) that queues many operations does not starve the others.
+//
+// scheduler is not safe for concurrent use.
+type scheduler struct {
+	fairnessScope       FairnessScope
+	maxRunningPerScope  int
+	operationPriorities map[v1.OperationMethod]int
+
+	pending   map[int]*operationQueue
+	pendingID map[string]*scheduledOperation
+	running   map[string]int
+}
+
+func newScheduler(options Options) *scheduler {
+	return &scheduler{
+		fairnessScope:       options.FairnessScope,
+		maxRunningPerScope:  options.MaxOperationConcurrencyPerScope,
+		operationPriorities: options.OperationPriorities,
+		pending:             map[int]*operationQueue{},
+		pendingID:           map[string]*scheduledOperation{},
+		running:             map[string]int{},
+	}
+}
+
+// Len returns the number of pending operations.
+func (s *scheduler) Len() int {
+	return len(s.pendingID)
+}
+
+// Push adds the operation of the message to the pending operations. Push returns false if the message was already
+// pending, which happens when its lease expired before it was processed and it was dequeued again. In that case the
+// pending operation is updated to use the new lease.
+func (s *scheduler) Push(msg *queue.Message, req *ctrl.Request) (*scheduledOperation, bool) {
+	if op, ok := s.pendingID[msg.ID]; ok {
+		op.message = msg
+		return op, false
+	}
+
+	opType, _ := v1.ParseOperationType(req.OperationType)
+	op := &scheduledOperation{
+		message:      msg,
+		request:      req,
+		scope:        s.scopeOf(req),
+		priority:     s.priorityOf(req),
+		cancellation: opType.Method == v1.OperationCancel,
+		queuedAt:     time.Now(),
+	}
+
+	q, ok := s.pending[op.priority]
+	if !ok {
+		q = &operationQueue{operations: map[string][]*scheduledOperation{}}
+		s.pending[op.priority] = q
+	}
+
+	if len(q.operations[op.scope]) == 0 {
+		q.scopes = append(q.scopes, op.scope)
+	}
+	q.operations[op.scope] = append(q.operations[op.scope], op)
+	s.pendingID[msg.ID] = op
+
+	return op, true
+}
+
+// Next removes and returns the next operation to process, or nil if no pending operation can be processed because
+// of the concurrency limit of its scope. The caller must call Done when the operation is processed.
+func (s *scheduler) Next() *scheduledOperation {
+	priorities := slices.SortedFunc(maps.Keys(s.pending), func(a, b int) int { return cmp.Compare(b, a) })
+	for _, priority := range priorities {
+		q := s.pending[priority]
+		for i, scope := range q.scopes {
+			op := q.operations[scope][0]
+
+			// Cancellations are not limited by the concurrency of their scope, since the operation they cancel is
+			// usually running in the same scope.
+			if s.maxRunningPerScope > 0 && s.running[scope] >= s.maxRunningPerScope && !op.cancellation {
+				continue
+			}
+
+			q.operations[scope] = q.operations[scope][1:]
+
+			// Move the scope to the end of the round-robin order, or remove it if it has no pending operations left.
+			q.scopes = slices.Delete(q.scopes, i, i+1)
+			if len(q.operations[scope]) > 0 {
+				q.scopes = append(q.scopes, scope)
+			} else {
+				delete(q.operations, scope)
+			}
+
+			if len(q.scopes) == 0 {
+				delete(s.pending, priority)
+			}
+
+			delete(s.pendingID, op.message.ID)
+			s.running[scope]++
+			return op
+		}
+	}
+
+	return nil
+}
+
+// Done records that the operation returned by Next has been processed.
+func (s *scheduler) Done(op *scheduledOperation) {
+	s.running[op.scope]--
+	if s.running[op.scope] <= 0 {
+		delete(s.running, op.scope)
+	}
+}
+
+func (s *scheduler) scopeOf(req *ctrl.Request) string {
+	if s.fairnessScope == FairnessScopeNone {
+		return ""
+	}
+
+	id, err := resources.Parse(req.ResourceID)
+	if err != nil {
+		return ""
+	}
+
+	if s.fairnessScope == FairnessScopePlane {
+		return strings.ToLower(id.PlaneScope())
+	}
+
+	return strings.ToLower(id.RootScope())
+}
+
+func (s *scheduler) priorityOf(req *ctrl.Request) int {
+	opType, ok := v1.ParseOperationType(req.OperationType)
+	if !ok {
+		return 0
+	}
+
+	return s.operationPriorities[opType.Method]
+}
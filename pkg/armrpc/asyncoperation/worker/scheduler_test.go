@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduledMessage(resourceGroup string, method v1.OperationMethod) (*queue.Message, *ctrl.Request) {
+	req := &ctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|" + string(method),
+		ResourceID:    "/planes/radius/local/resourceGroups/" + resourceGroup + "/providers/Applications.Core/containers/" + uuid.NewString(),
+	}
+
+	msg := queue.NewMessage(req)
+	msg.Metadata.ID = uuid.NewString()
+	return msg, req
+}
+
+func drainScheduler(s *scheduler) []*scheduledOperation {
+	ops := []*scheduledOperation{}
+	for {
+		op := s.Next()
+		if op == nil {
+			return ops
+		}
+		ops = append(ops, op)
+	}
+}
+
+func TestParseFairnessScope(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected FairnessScope
+		err      bool
+	}{
+		{in: "", expected: FairnessScopeResourceGroup},
+		{in: "resourceGroup", expected: FairnessScopeResourceGroup},
+		{in: "PLANE", expected: FairnessScopePlane},
+		{in: "none", expected: FairnessScopeNone},
+		{in: "subscription", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			scope, err := ParseFairnessScope(tt.in)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, scope)
+		})
+	}
+}
+
+func TestScheduler_RoundRobin(t *testing.T) {
+	s := newScheduler(New(Options{}, nil, nil, nil).options)
+
+	// rg-a queues three operations before rg-b and rg-c queue one each.
+	for _, rg := range []string{"rg-a", "rg-a", "rg-a", "rg-b", "rg-c"} {
+		msg, req := newTestScheduledMessage(rg, v1.OperationPut)
+		_, added := s.Push(msg, req)
+		require.True(t, added)
+	}
+	require.Equal(t, 5, s.Len())
+
+	scopes := []string{}
+	for _, op := range drainScheduler(s) {
+		scopes = append(scopes, op.scope)
+	}
+
+	require.Equal(t, []string{
+		"/planes/radius/local/resourcegroups/rg-a",
+		"/planes/radius/local/resourcegroups/rg-b",
+		"/planes/radius/local/resourcegroups/rg-c",
+		"/planes/radius/local/resourcegroups/rg-a",
+		"/planes/radius/local/resourcegroups/rg-a",
+	}, scopes)
+	require.Equal(t, 0, s.Len())
+}
+
+func TestScheduler_Priority(t *testing.T) {
+	s := newScheduler(New(Options{}, nil, nil, nil).options)
+
+	msg, req := newTestScheduledMessage("rg-a", v1.OperationPut)
+	s.Push(msg, req)
+	msg, req = newTestScheduledMessage("rg-a", v1.OperationDelete)
+	s.Push(msg, req)
+
+	ops := drainScheduler(s)
+	require.Len(t, ops, 2)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|DELETE", ops[0].request.OperationType)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", ops[1].request.OperationType)
+}
+
+func TestScheduler_CancellationPriority(t *testing.T) {
+	s := newScheduler(New(Options{}, nil, nil, nil).options)
+
+	for _, method := range []v1.OperationMethod{v1.OperationPut, v1.OperationDelete, v1.OperationCancel} {
+		msg, req := newTestScheduledMessage("rg-a", method)
+		s.Push(msg, req)
+	}
+
+	ops := drainScheduler(s)
+	require.Len(t, ops, 3)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|CANCEL", ops[0].request.OperationType)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|DELETE", ops[1].request.OperationType)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", ops[2].request.OperationType)
+}
+
+func TestScheduler_CancellationIgnoresMaxOperationConcurrencyPerScope(t *testing.T) {
+	s := newScheduler(New(Options{MaxOperationConcurrencyPerScope: 1}, nil, nil, nil).options)
+
+	msg, req := newTestScheduledMessage("rg-a", v1.OperationPut)
+	s.Push(msg, req)
+	put := s.Next()
+	require.NotNil(t, put)
+
+	// The PUT is running, so another PUT in the same scope waits but the cancellation does not.
+	msg, req = newTestScheduledMessage("rg-a", v1.OperationPut)
+	s.Push(msg, req)
+	msg, req = newTestScheduledMessage("rg-a", v1.OperationCancel)
+	s.Push(msg, req)
+
+	next := s.Next()
+	require.NotNil(t, next)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|CANCEL", next.request.OperationType)
+	require.Nil(t, s.Next())
+	require.Equal(t, 1, s.Len())
+}
+
+func TestScheduler_MaxOperationConcurrencyPerScope(t *testing.T) {
+	s := newScheduler(New(Options{MaxOperationConcurrencyPerScope: 1}, nil, nil, nil).options)
+
+	for _, rg := range []string{"rg-a", "rg-a", "rg-b"} {
+		msg, req := newTestScheduledMessage(rg, v1.OperationPut)
+		s.Push(msg, req)
+	}
+
+	// Only one operation of each resource group can run at a time.
+	ops := drainScheduler(s)
+	require.Len(t, ops, 2)
+	require.Equal(t, 1, s.Len())
+
+	s.Done(ops[0])
+	next := s.Next()
+	require.NotNil(t, next)
+	require.Equal(t, ops[0].scope, next.scope)
+	require.Nil(t, s.Next())
+}
+
+func TestScheduler_FairnessScope(t *testing.T) {
+	t.Run("plane", func(t *testing.T) {
+		s := newScheduler(New(Options{FairnessScope: FairnessScopePlane}, nil, nil, nil).options)
+		msg, req := newTestScheduledMessage("rg-a", v1.OperationPut)
+		op, _ := s.Push(msg, req)
+		require.Equal(t, "/planes/radius/local", op.scope)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		s := newScheduler(New(Options{FairnessScope: FairnessScopeNone}, nil, nil, nil).options)
+		for _, rg := range []string{"rg-a", "rg-a", "rg-b"} {
+			msg, req := newTestScheduledMessage(rg, v1.OperationPut)
+			s.Push(msg, req)
+		}
+
+		// Operations are processed in queue order.
+		ops := drainScheduler(s)
+		require.Len(t, ops, 3)
+		require.Contains(t, ops[0].request.ResourceID, "rg-a")
+		require.Contains(t, ops[1].request.ResourceID, "rg-a")
+		require.Contains(t, ops[2].request.ResourceID, "rg-b")
+	})
+}
+
+func TestScheduler_PushDuplicate(t *testing.T) {
+	s := newScheduler(New(Options{}, nil, nil, nil).options)
+
+	msg, req := newTestScheduledMessage("rg-a", v1.OperationPut)
+	op, added := s.Push(msg, req)
+	require.True(t, added)
+
+	// The message is dequeued again after its lease expired.
+	redelivered := *msg
+	redelivered.DequeueCount++
+	dup, added := s.Push(&redelivered, req)
+	require.False(t, added)
+	require.Same(t, op, dup)
+	require.Same(t, &redelivered, op.message)
+	require.Equal(t, 1, s.Len())
+}
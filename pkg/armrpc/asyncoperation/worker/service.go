@@ -20,6 +20,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/queue"
@@ -32,6 +33,10 @@ type Service struct {
 	// DatabaseClient is database client.
 	DatabaseClient database.Client
 
+	// HistoryRecorder is the recorder of the operation history. The worker prunes the history past the retention.
+	// A nil recorder disables pruning.
+	HistoryRecorder *history.Recorder
+
 	// OperationStatusManager is the manager of the operation status.
 	OperationStatusManager manager.StatusManager
 
@@ -64,6 +69,10 @@ func (s *Service) Start(ctx context.Context) error {
 	// Create and start worker.
 	worker := New(s.Options, s.OperationStatusManager, s.QueueClient, s.Controllers())
 
+	if s.HistoryRecorder != nil {
+		go s.HistoryRecorder.Run(ctx)
+	}
+
 	logger.Info("Start Worker...")
 	if err := worker.Start(ctx); err != nil {
 		logger.Error(err, "failed to start worker...")
@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/queue"
@@ -37,7 +39,6 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -58,6 +59,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationPollInterval is the default interval for checking whether cancellation of a running operation was requested.
+	defaultCancellationPollInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +83,55 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationPollInterval is the interval for checking whether cancellation of a running operation was requested.
+	CancellationPollInterval time.Duration
+
+	// FairnessScope is the scope at which async operations share the concurrency fairly.
+	FairnessScope FairnessScope
+
+	// MaxOperationConcurrencyPerScope is the maximum concurrency to process async request operations of a single
+	// fairness scope. Zero means that a single scope can use all of MaxOperationConcurrency.
+	MaxOperationConcurrencyPerScope int
+
+	// MaxPendingOperations is the maximum number of messages dequeued ahead of processing, so that they can be
+	// scheduled by priority and fairness scope.
+	MaxPendingOperations int
+
+	// OperationPriorities is the priority of each operation method. Operations with a higher priority are processed
+	// first. Operations without a priority have priority 0.
+	OperationPriorities map[v1.OperationMethod]int
+}
+
+// ApplyConfig applies the worker server configuration to the options.
+func (o *Options) ApplyConfig(config hostoptions.WorkerServerOptions) error {
+	if config.MaxOperationConcurrency != nil {
+		o.MaxOperationConcurrency = *config.MaxOperationConcurrency
+	}
+	if config.MaxOperationRetryCount != nil {
+		o.MaxOperationRetryCount = *config.MaxOperationRetryCount
+	}
+	if config.MaxOperationConcurrencyPerScope != nil {
+		o.MaxOperationConcurrencyPerScope = *config.MaxOperationConcurrencyPerScope
+	}
+	if config.MaxPendingOperations != nil {
+		o.MaxPendingOperations = *config.MaxPendingOperations
+	}
+
+	scope, err := ParseFairnessScope(config.FairnessScope)
+	if err != nil {
+		return err
+	}
+	o.FairnessScope = scope
+
+	if config.OperationPriorities != nil {
+		o.OperationPriorities = map[v1.OperationMethod]int{}
+		for method, priority := range config.OperationPriorities {
+			o.OperationPriorities[v1.OperationMethod(strings.ToUpper(method))] = priority
+		}
+	}
+
+	return nil
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -88,7 +141,11 @@ type AsyncRequestProcessWorker struct {
 	registry     *ControllerRegistry
 	requestQueue queue.Client
 
-	sem *semaphore.Weighted
+	// runningMu protects running.
+	runningMu sync.Mutex
+	// running holds a channel for each operation running in this worker. The channel is closed to cancel the
+	// operation when its cancellation message is processed.
+	running map[uuid.UUID]chan struct{}
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -115,18 +172,33 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationPollInterval == time.Duration(0) {
+		options.CancellationPollInterval = defaultCancellationPollInterval
+	}
+	if options.FairnessScope == "" {
+		options.FairnessScope = FairnessScopeResourceGroup
+	}
+	if options.MaxPendingOperations == 0 {
+		options.MaxPendingOperations = options.MaxOperationConcurrency
+	}
+	if options.OperationPriorities == nil {
+		options.OperationPriorities = defaultOperationPriorities
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
 		sm:           sm,
 		registry:     ctrlRegistry,
 		requestQueue: qu,
-		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
+		running:      map[uuid.UUID]chan struct{}{},
 	}
 }
 
 // Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
 // resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
+//
+// Dequeued messages are scheduled by priority and fairness scope: operations with a higher priority are processed first, and
+// operations of the same priority are processed round-robin between fairness scopes.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
@@ -134,92 +206,155 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		return err
 	}
 
+	sched := newScheduler(w.options)
+	doneCh := make(chan *scheduledOperation, w.options.MaxOperationConcurrency)
+	running := 0
+
 	// this loop will run until msgCh is closed (or when ctx is canceled)
-	for msg := range msgCh {
-		// This semaphore will maintain the number of go routines to process the messages concurrently.
-		if err := w.sem.Acquire(ctx, 1); err != nil {
-			break
+	for {
+		// Start as many pending operations as the concurrency limits allow.
+		for running < w.options.MaxOperationConcurrency {
+			op := sched.Next()
+			if op == nil {
+				break
+			}
+
+			metrics.DefaultAsyncOperationMetrics.RecordPendingAsyncOperation(ctx, op.request, -1)
+			metrics.DefaultAsyncOperationMetrics.RecordAsyncOperationSchedulingDelay(ctx, op.request, op.queuedAt)
+
+			running++
+			go func(op *scheduledOperation) {
+				defer func() { doneCh <- op }()
+				w.processMessage(ctx, op.message, op.request)
+			}(op)
 		}
 
-		go func(msgreq *queue.Message) {
-			defer w.sem.Release(1)
+		// Stop receiving messages while the scheduler is full, so that they stay available in the queue
+		// instead of waiting here for their lease to expire.
+		recvCh := msgCh
+		if sched.Len() >= w.options.MaxPendingOperations {
+			recvCh = nil
+		}
+
+		select {
+		case msg, ok := <-recvCh:
+			if !ok {
+				logger.Info("Message loop stopped...")
+				return nil
+			}
 
 			op := &ctrl.Request{}
-			if err := json.Unmarshal(msgreq.Data, op); err != nil {
+			if err := json.Unmarshal(msg.Data, op); err != nil {
 				logger.Error(err, "failed to unmarshal queue message.")
-				return
+				continue
 			}
 
-			reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+			if _, added := sched.Push(msg, op); added {
+				metrics.DefaultAsyncOperationMetrics.RecordPendingAsyncOperation(ctx, op, 1)
+			}
 
-			// Populate the default attributes in the current context so all logs will have these fields.
-			reqCtx = ucplog.WrapLogContext(reqCtx,
-				logging.LogFieldResourceID, op.ResourceID,
-				logging.LogFieldOperationID, op.OperationID,
-				logging.LogFieldOperationType, op.OperationType,
-				logging.LogFieldDequeueCount, msgreq.DequeueCount)
+		case op := <-doneCh:
+			running--
+			sched.Done(op)
 
-			opLogger := ucplog.FromContextOrDiscard(reqCtx)
+		case <-ctx.Done():
+			logger.Info("Message loop stopped...")
+			return nil
+		}
+	}
+}
 
-			armReqCtx, err := op.ARMRequestContext()
-			if err != nil {
-				opLogger.Error(err, "failed to get ARM request context.")
-				return
-			}
-			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
+func (w *AsyncRequestProcessWorker) processMessage(ctx context.Context, msgreq *queue.Message, op *ctrl.Request) {
+	reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
 
-			asyncCtrl, err := w.registry.Get(armReqCtx.OperationType)
-			if err != nil {
-				opLogger.Error(err, "failed to get async controller.")
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
-				return
-			}
+	// Populate the default attributes in the current context so all logs will have these fields.
+	reqCtx = ucplog.WrapLogContext(reqCtx,
+		logging.LogFieldResourceID, op.ResourceID,
+		logging.LogFieldOperationID, op.OperationID,
+		logging.LogFieldOperationType, op.OperationType,
+		logging.LogFieldDequeueCount, msgreq.DequeueCount)
 
-			if asyncCtrl == nil {
-				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
-				return
-			}
+	opLogger := ucplog.FromContextOrDiscard(reqCtx)
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
-				opLogger.Error(nil, errMsg)
-				failed := ctrl.NewFailedResult(v1.ErrorDetails{
-					Code:    v1.CodeInternal,
-					Message: errMsg,
-				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.DatabaseClient())
-				return
-			}
+	// The message may have waited to be scheduled long enough for its lease to be close to expiring.
+	if time.Until(msgreq.NextVisibleAt) < w.options.MessageExtendMargin {
+		if err := w.requestQueue.ExtendMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to extend the message lock of the scheduled message.")
+			return
+		}
+	}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+	armReqCtx, err := op.ARMRequestContext()
+	if err != nil {
+		opLogger.Error(err, "failed to get ARM request context.")
+		return
+	}
+	reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
 
-			dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID)
-			if err != nil {
-				opLogger.Error(err, "failed to check potential deduplication.")
-				return
-			}
-			if dup {
-				opLogger.Info("duplicated message detected")
-				return
-			}
+	if armReqCtx.OperationType.Method == v1.OperationCancel {
+		w.processCancellation(reqCtx, msgreq, op)
+		return
+	}
 
-			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.DatabaseClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
-				return
-			}
+	asyncCtrl, err := w.registry.Get(armReqCtx.OperationType)
+	if err != nil {
+		opLogger.Error(err, "failed to get async controller.")
+		if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to finish the message")
+		}
+		return
+	}
 
-			w.runOperation(reqCtx, msgreq, asyncCtrl)
-		}(msg)
+	if asyncCtrl == nil {
+		opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
+		if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
+			opLogger.Error(err, "failed to finish the message")
+		}
+		return
 	}
 
-	logger.Info("Message loop stopped...")
-	return nil
+	if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
+		errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+		opLogger.Error(nil, errMsg)
+		failed := ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: errMsg,
+		})
+		w.deadLetterOperation(reqCtx, msgreq, failed, asyncCtrl.DatabaseClient())
+		return
+	}
+
+	// TODO: Handle the edge cases:
+	// 1. The same message is delivered twice in multiple instances.
+	// 2. provisioningState is not matched between resource and operationStatuses
+
+	dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID, msgreq.EnqueueAt)
+	if err != nil {
+		opLogger.Error(err, "failed to check potential deduplication.")
+		return
+	}
+	if dup {
+		opLogger.Info("duplicated message detected")
+		return
+	}
+
+	// The operation may have been canceled while it was waiting in the queue.
+	canceled, err := w.isCancelRequested(reqCtx, op.ResourceID, op.OperationID)
+	if err != nil {
+		opLogger.Error(err, "failed to check cancellation of the operation.")
+		return
+	}
+	if canceled {
+		opLogger.Info("Operation was canceled before it started.")
+		w.completeOperation(reqCtx, msgreq, newUserCanceledResult(op), asyncCtrl.DatabaseClient())
+		return
+	}
+
+	if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.DatabaseClient(), op, v1.ProvisioningStateUpdating, nil, nil); err != nil {
+		return
+	}
+
+	w.runOperation(reqCtx, msgreq, asyncCtrl)
 }
 
 func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
@@ -232,6 +367,9 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
+	userCancel := w.startRunning(asyncReq.OperationID)
+	defer w.stopRunning(asyncReq.OperationID)
+
 	asyncReqCtx, opCancel := context.WithCancel(ctx)
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
@@ -285,18 +423,21 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+	cancellationPoll := time.NewTicker(w.options.CancellationPollInterval)
+	defer cancellationPoll.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
@@ -308,6 +449,29 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
 			return
 
+		case <-userCancel:
+			logger.Info("Cancelling async operation as requested by the user.")
+
+			opCancel()
+			w.completeOperation(ctx, message, newUserCanceledResult(asyncReq), asyncCtrl.DatabaseClient())
+			return
+
+		case <-cancellationPoll.C:
+			canceled, err := w.isCancelRequested(ctx, asyncReq.ResourceID, asyncReq.OperationID)
+			if err != nil {
+				logger.Error(err, "failed to check cancellation of the operation.")
+				continue
+			}
+			if !canceled {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested by the user.")
+
+			opCancel()
+			w.completeOperation(ctx, message, newUserCanceledResult(asyncReq), asyncCtrl.DatabaseClient())
+			return
+
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
 			return
@@ -323,6 +487,63 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// processCancellation processes a cancellation message queued by the status manager. If the operation is running in
+// this worker it is canceled immediately. Otherwise the worker that runs the operation cancels it when it next checks
+// the cancellation flag of the operation, or when its message is dequeued. The message is always finished, since the
+// cancellation flag is the source of truth.
+func (w *AsyncRequestProcessWorker) processCancellation(ctx context.Context, message *queue.Message, op *ctrl.Request) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	canceled, err := w.isCancelRequested(ctx, op.ResourceID, op.OperationID)
+	if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+		logger.Error(err, "failed to check cancellation of the operation.")
+	}
+
+	if canceled {
+		w.runningMu.Lock()
+		ch, ok := w.running[op.OperationID]
+		if ok {
+			close(ch)
+			delete(w.running, op.OperationID)
+		}
+		w.runningMu.Unlock()
+
+		if ok {
+			logger.Info("Signaled cancellation to the running operation.")
+		}
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+// startRunning records that the operation is running in this worker, and returns the channel that is closed when
+// the user cancels it.
+func (w *AsyncRequestProcessWorker) startRunning(operationID uuid.UUID) <-chan struct{} {
+	w.runningMu.Lock()
+	defer w.runningMu.Unlock()
+
+	ch := make(chan struct{})
+	w.running[operationID] = ch
+	return ch
+}
+
+// stopRunning records that the operation is no longer running in this worker.
+func (w *AsyncRequestProcessWorker) stopRunning(operationID uuid.UUID) {
+	w.runningMu.Lock()
+	defer w.runningMu.Unlock()
+
+	delete(w.running, operationID)
+}
+
+// newUserCanceledResult creates the result of an operation that was canceled by the user.
+func newUserCanceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) was canceled by the user.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -339,7 +560,7 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
-	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, result.Response)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
 		return
@@ -355,7 +576,30 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
-func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
+// deadLetterOperation fails the operation and moves the message to the dead-letter queue so that it is not processed again.
+// The message is dead-lettered even when the status cannot be updated, since processing it again would fail the same way.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, result ctrl.Result, sc database.Client) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	// Errors are logged by updateResourceAndOperationStatus.
+	_ = w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, nil)
+
+	if err := queue.DeadLetterMessage(ctx, w.requestQueue, message, result.Error.Message); err != nil {
+		logger.Error(err, "failed to move the message to the dead-letter queue")
+		return
+	}
+
+	logger.Info("Moved message to the dead-letter queue.")
+	metrics.DefaultAsyncOperationMetrics.RecordDeadLetteredAsyncOperation(ctx, req)
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails, response any) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	rID, err := resources.ParseResource(req.ResourceID)
@@ -364,17 +608,20 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
-	if errors.Is(err, &database.ErrNotFound{}) {
-		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
-	} else if err != nil {
-		logger.Error(err, "failed to update the provisioningState in resource.")
-		return err
+	// Custom actions do not change the resource, so only the provisioningState of the other operations is updated.
+	if opType, ok := v1.ParseOperationType(req.OperationType); !ok || !opType.Method.IsAction() {
+		err = updateResourceState(ctx, sc, rID.String(), state)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			logger.Info("failed to update the provisioningState in resource because it no longer exists.")
+		} else if err != nil {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return err
+		}
 	}
 
 	// Otherwise we update the operationStatus to the result.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr, response)
 	if err != nil {
 		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
 		return err
@@ -383,7 +630,7 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
+func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID, enqueueAt time.Time) (bool, error) {
 	rID, err := resources.ParseResource(resourceID)
 	if err != nil {
 		return false, err
@@ -395,7 +642,12 @@ func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID
 	}
 
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
-	// 2. If the operation is in terminal state, we consider it as a duplicated operation.
+	// 2. If the operation is in terminal state, we consider it as a duplicated operation, unless the message was enqueued after the operation
+	//    completed. This happens when a dead-lettered message is requeued to retry the operation.
+	if status.Status.IsTerminal() && !status.LastUpdatedTime.IsZero() && status.LastUpdatedTime.Before(enqueueAt) {
+		return false, nil
+	}
+
 	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
 		status.Status.IsTerminal() {
@@ -405,6 +657,21 @@ func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID
 	return false, nil
 }
 
+// isCancelRequested returns true if the user requested cancellation of the operation.
+func (w *AsyncRequestProcessWorker) isCancelRequested(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
+	rID, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := w.sm.Get(ctx, rID, operationID)
+	if err != nil {
+		return false, err
+	}
+
+	return status.CancelRequested && !status.Status.IsTerminal(), nil
+}
+
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
 	d := time.Until(visibleAt.Add(-w.options.MessageExtendMargin))
 	if d <= 0 {
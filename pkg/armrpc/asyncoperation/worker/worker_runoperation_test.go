@@ -206,7 +206,7 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	expectedDequeueCount := 2
 
@@ -254,6 +254,10 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	// The message is moved to the dead-letter queue rather than deleted.
+	require.Equal(t, 1, tCtx.internalQ.DeadLetterLen())
+	require.Contains(t, testMessage.DeadLetterReason, "exceeded max retry count")
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -267,7 +271,7 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -343,7 +347,7 @@ func TestStart_RunOperation(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -411,7 +415,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -449,7 +453,8 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -540,8 +545,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -579,6 +584,65 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	cancelRequested := atomic.NewBool(false)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID) (*manager.Status, error) {
+			return &manager.Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateUpdating},
+				CancelRequested:      cancelRequested.Load(),
+			}, nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
+			if state == v1.ProvisioningStateCanceled && opError.Message == "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) was canceled by the user." &&
+				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
+				return nil
+			}
+			return errors.New("!!! failed to update status !!!")
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationPollInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			cancelRequested.Store(true)
+			<-ctx.Done()
+			close(done)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
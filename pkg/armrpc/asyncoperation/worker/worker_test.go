@@ -22,8 +22,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -35,6 +40,46 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMessageExtendMargin, worker.options.MessageExtendMargin)
 	require.Equal(t, defaultMinMessageLockDuration, worker.options.MinMessageLockDuration)
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
+	require.Equal(t, FairnessScopeResourceGroup, worker.options.FairnessScope)
+	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxPendingOperations)
+	require.Equal(t, defaultOperationPriorities, worker.options.OperationPriorities)
+	require.Equal(t, defaultCancellationPollInterval, worker.options.CancellationPollInterval)
+}
+
+func TestApplyConfig(t *testing.T) {
+	t.Run("empty config", func(t *testing.T) {
+		options := Options{}
+		err := options.ApplyConfig(hostoptions.WorkerServerOptions{})
+		require.NoError(t, err)
+		require.Equal(t, Options{FairnessScope: FairnessScopeResourceGroup}, options)
+	})
+
+	t.Run("full config", func(t *testing.T) {
+		options := Options{}
+		err := options.ApplyConfig(hostoptions.WorkerServerOptions{
+			MaxOperationConcurrency:         new(20),
+			MaxOperationRetryCount:          new(5),
+			FairnessScope:                   "plane",
+			MaxOperationConcurrencyPerScope: new(4),
+			MaxPendingOperations:            new(40),
+			OperationPriorities:             map[string]int{"delete": 10, "Put": 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, Options{
+			MaxOperationConcurrency:         20,
+			MaxOperationRetryCount:          5,
+			FairnessScope:                   FairnessScopePlane,
+			MaxOperationConcurrencyPerScope: 4,
+			MaxPendingOperations:            40,
+			OperationPriorities:             map[v1.OperationMethod]int{v1.OperationDelete: 10, v1.OperationPut: 1},
+		}, options)
+	})
+
+	t.Run("invalid fairness scope", func(t *testing.T) {
+		options := Options{}
+		err := options.ApplyConfig(hostoptions.WorkerServerOptions{FairnessScope: "tenant"})
+		require.Error(t, err)
+	})
 }
 
 func TestUpdateResourceState(t *testing.T) {
@@ -114,6 +159,148 @@ func TestUpdateResourceState(t *testing.T) {
 
 }
 
+func TestUpdateResourceAndOperationStatus_Action(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	operationID := uuid.New()
+
+	mctrl := gomock.NewController(t)
+	sm := manager.NewMockStatusManager(mctrl)
+	sm.EXPECT().
+		Update(gomock.Any(), gomock.Any(), operationID, v1.ProvisioningStateSucceeded, gomock.Any(), gomock.Nil(), map[string]any{"result": "value"}).
+		Return(nil)
+
+	// The resource is not read or saved: custom actions do not change its provisioningState.
+	databaseClient := database.NewMockClient(mctrl)
+
+	worker := New(Options{}, sm, nil, nil)
+	err := worker.updateResourceAndOperationStatus(context.Background(), databaseClient, &ctrl.Request{
+		OperationID:   operationID,
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|ACTIONPLANRECIPE",
+		ResourceID:    resourceID,
+	}, v1.ProvisioningStateSucceeded, nil, map[string]any{"result": "value"})
+	require.NoError(t, err)
+}
+
+func TestIsDuplicated(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	completedAt := time.Now().UTC()
+
+	tests := []struct {
+		name      string
+		status    v1.ProvisioningState
+		enqueueAt time.Time
+		dup       bool
+	}{
+		{name: "in progress", status: v1.ProvisioningStateAccepted, enqueueAt: completedAt.Add(-time.Minute), dup: false},
+		{name: "terminal", status: v1.ProvisioningStateFailed, enqueueAt: completedAt.Add(-time.Minute), dup: true},
+		{name: "terminal, requeued after completion", status: v1.ProvisioningStateFailed, enqueueAt: completedAt.Add(time.Minute), dup: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			sm := manager.NewMockStatusManager(mctrl)
+			sm.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{Status: tt.status},
+				LastUpdatedTime:      completedAt,
+			}, nil)
+
+			worker := New(Options{}, sm, nil, nil)
+			dup, err := worker.isDuplicated(context.Background(), resourceID, uuid.New(), tt.enqueueAt)
+			require.NoError(t, err)
+			require.Equal(t, tt.dup, dup)
+		})
+	}
+}
+
+func TestIsCancelRequested(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          v1.ProvisioningState
+		cancelRequested bool
+		expected        bool
+	}{
+		{"not requested", v1.ProvisioningStateUpdating, false, false},
+		{"requested for running operation", v1.ProvisioningStateUpdating, true, true},
+		{"requested for queued operation", v1.ProvisioningStateAccepted, true, true},
+		{"requested for completed operation", v1.ProvisioningStateSucceeded, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			sm := manager.NewMockStatusManager(mctrl)
+			sm.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{Status: tt.status},
+				CancelRequested:      tt.cancelRequested,
+			}, nil)
+
+			worker := New(Options{}, sm, nil, nil)
+			resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+			canceled, err := worker.isCancelRequested(context.Background(), resourceID, uuid.New())
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, canceled)
+		})
+	}
+}
+
+func TestProcessCancellation(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+
+	tests := []struct {
+		name            string
+		running         bool
+		cancelRequested bool
+		signaled        bool
+	}{
+		{"running operation", true, true, true},
+		{"operation not running in this worker", false, true, false},
+		{"cancellation not requested", true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			sm := manager.NewMockStatusManager(mctrl)
+			sm.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateUpdating},
+				CancelRequested:      tt.cancelRequested,
+			}, nil)
+
+			qu := queue.NewMockClient(mctrl)
+			qu.EXPECT().FinishMessage(gomock.Any(), gomock.Any()).Return(nil)
+
+			worker := New(Options{}, sm, qu, nil)
+			op := &ctrl.Request{
+				OperationID:   uuid.New(),
+				OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|CANCEL",
+				ResourceID:    resourceID,
+			}
+
+			var userCancel <-chan struct{}
+			if tt.running {
+				userCancel = worker.startRunning(op.OperationID)
+			}
+
+			worker.processCancellation(context.Background(), queue.NewMessage(op), op)
+
+			if !tt.running {
+				return
+			}
+
+			select {
+			case <-userCancel:
+				require.True(t, tt.signaled, "operation should not be canceled")
+			default:
+				require.False(t, tt.signaled, "operation should be canceled")
+			}
+
+			// stopRunning must be safe to call after the cancellation closed the channel.
+			worker.stopRunning(op.OperationID)
+		})
+	}
+}
+
 func TestGetMessageExtendDuration(t *testing.T) {
 	tests := []struct {
 		in  time.Time
@@ -73,6 +73,30 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operations", rootScopePath, namespace),
+		ResourceType:      namespace + "/operationhistory",
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationHistory,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -28,7 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 )
 
-const customActionPrefix = "ACTION"
+const customActionPrefix = v1.OperationActionPrefix
 
 // Operation defines converters for request and response, update and delete filters,
 // asynchronous operation controller, and the options for API operation.
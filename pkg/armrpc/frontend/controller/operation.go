@@ -189,6 +189,30 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 	return nil, nil
 }
 
+// QueueAsyncAction queues the async operation of a custom action with the given request body, and returns the response
+// accepting the action. Unlike PrepareAsyncOperation, the resource is not changed: the result of the action is returned
+// by the operation result once the operation succeeded.
+func (c *Operation[P, T]) QueueAsyncAction(ctx context.Context, body []byte) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	options := sm.QueueOperationOptions{
+		OperationTimeout: c.AsyncOperationTimeout(),
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Body:             body,
+	}
+	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
+		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
+	}
+
+	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		return nil, err
+	}
+
+	response := rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", "")
+	response.RetryAfter = options.RetryAfter
+	return response, nil
+}
+
 // ConstructSyncResponse constructs synchronous API response.
 func (c *Operation[P, T]) ConstructSyncResponse(ctx context.Context, method, etag string, resource *T) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to request cancellation of an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run marks the async operation as cancel-requested and queues a cancellation message for the worker. The worker processing the operation cancels it and sets the
+// provisioning state of the operation and its resource to Canceled. Run returns NotFound if the operation is not found,
+// and Conflict if the operation has already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	os := &manager.Status{}
+	etag, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %q has already completed with status %q.", os.Name, os.Status)), nil
+	}
+
+	if !os.CancelRequested {
+		os.CancelRequested = true
+		_, err = e.SaveResource(ctx, serviceCtx.ResourceID.String(), os, etag)
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			return rest.NewConflictResponse(fmt.Sprintf("Operation %q was updated while requesting cancellation. Please try again.", os.Name)), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		// The worker also checks the cancellation flag periodically, so failing to queue the message only delays
+		// the cancellation.
+		err = e.StatusManager().QueueCancellation(ctx, os)
+		if err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to queue cancellation of the async operation", "operationID", os.Name)
+		}
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testOperationStatusID = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       *manager.Status
+		getErr       error
+		saveErr      error
+		expectSave   bool
+		expectQueue  bool
+		expectedCode int
+	}{
+		{
+			name:         "not found",
+			getErr:       &database.ErrNotFound{ID: testOperationStatusID},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "completed operation",
+			status:       &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Name: "op0", Status: v1.ProvisioningStateSucceeded}},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "running operation",
+			status:       &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Name: "op0", Status: v1.ProvisioningStateUpdating}},
+			expectSave:   true,
+			expectQueue:  true,
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "cancellation already requested",
+			status:       &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Name: "op0", Status: v1.ProvisioningStateUpdating}, CancelRequested: true},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "concurrent update",
+			status:       &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Name: "op0", Status: v1.ProvisioningStateAccepted}},
+			saveErr:      &database.ErrConcurrency{},
+			expectSave:   true,
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(mctrl)
+
+			databaseClient.
+				EXPECT().
+				Get(gomock.Any(), testOperationStatusID).
+				DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &database.Object{
+						Metadata: database.Metadata{ID: id, ETag: "etag"},
+						Data:     tt.status,
+					}, nil
+				})
+
+			statusManager := manager.NewMockStatusManager(mctrl)
+			if tt.expectQueue {
+				statusManager.
+					EXPECT().
+					QueueCancellation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, status *manager.Status) error {
+						require.True(t, status.CancelRequested)
+						return nil
+					})
+			}
+
+			var saved *manager.Status
+			if tt.expectSave {
+				databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+						saved = obj.Data.(*manager.Status)
+						return tt.saveErr
+					})
+			}
+
+			req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPost, "http://localhost"+testOperationStatusID+"/cancel?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+			w := httptest.NewRecorder()
+
+			ctl, err := NewCancelOperation(ctrl.Options{
+				DatabaseClient: databaseClient,
+				StatusManager:  statusManager,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedCode, w.Result().StatusCode)
+
+			if tt.expectSave {
+				require.True(t, saved.CancelRequested)
+			}
+		})
+	}
+}
@@ -45,8 +45,8 @@ func NewGetOperationResult(opts ctrl.Options) (ctrl.Controller, error) {
 
 // Run returns the response with necessary headers about the async operation - it checks if the operation is in a terminal state,
 // and if not, returns an AsyncOperationResultResponse with the Location and Retry-After headers set. If the operation is in a
-// terminal state, it returns a NoContentResponse. If the operation is not found, it returns a NotFoundResponse. If an error occurs,
-// it returns a BadRequestResponse.
+// terminal state, it returns the response of the custom action that succeeded, or a NoContentResponse. If the operation is not
+// found, it returns a NotFoundResponse. If an error occurs, it returns a BadRequestResponse.
 // Spec: https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/async-api-reference.md#azure-asyncoperation-resource-format
 func (e *GetOperationResult) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -75,6 +75,10 @@ func (e *GetOperationResult) Run(ctx context.Context, w http.ResponseWriter, req
 		return rest.NewAsyncOperationResultResponse(headers), nil
 	}
 
+	if os.Status == v1.ProvisioningStateSucceeded && os.Response != nil {
+		return rest.NewOKResponse(os.Response), nil
+	}
+
 	return rest.NewNoContentResponse(), nil
 }
 
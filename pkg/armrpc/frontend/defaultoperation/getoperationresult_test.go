@@ -78,39 +78,52 @@ func TestGetOperationResultRun(t *testing.T) {
 	opResTestCases := []struct {
 		desc              string
 		provisioningState v1.ProvisioningState
+		response          any
 		respCode          int
 		headersCheck      bool
 	}{
 		{
 			"not-in-terminal-state",
 			v1.ProvisioningStateAccepted,
+			nil,
 			http.StatusAccepted,
 			true,
 		},
 		{
 			"put-succeeded-state",
 			v1.ProvisioningStateSucceeded,
+			nil,
 			http.StatusNoContent,
 			false,
 		},
 		{
 			"delete-succeeded-state",
 			v1.ProvisioningStateSucceeded,
+			nil,
 			http.StatusNoContent,
 			false,
 		},
 		{
 			"put-failed-state",
 			v1.ProvisioningStateFailed,
+			nil,
 			http.StatusNoContent,
 			false,
 		},
 		{
 			"delete-failed-state",
 			v1.ProvisioningStateFailed,
+			nil,
 			http.StatusNoContent,
 			false,
 		},
+		{
+			"action-succeeded-state",
+			v1.ProvisioningStateSucceeded,
+			map[string]any{"result": "value"},
+			http.StatusOK,
+			false,
+		},
 	}
 
 	for _, tt := range opResTestCases {
@@ -124,6 +137,7 @@ func TestGetOperationResultRun(t *testing.T) {
 			ctx := rpctest.NewARMRequestContext(req)
 
 			osDataModel.Status = tt.provisioningState
+			osDataModel.Response = tt.response
 			osDataModel.RetryAfter = time.Second * 5
 
 			databaseClient.
@@ -146,6 +160,12 @@ func TestGetOperationResultRun(t *testing.T) {
 			_ = resp.Apply(ctx, w, req)
 			require.Equal(t, tt.respCode, w.Result().StatusCode)
 
+			if tt.response != nil {
+				actual := map[string]any{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+				require.Equal(t, tt.response, actual)
+			}
+
 			if tt.headersCheck {
 				require.NotNil(t, w.Header().Get("Location"))
 				require.Equal(t, req.URL.String(), w.Header().Get("Location"))
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+var _ ctrl.Controller = (*ListOperationHistory)(nil)
+
+// ListOperationHistory is the controller implementation to list the operation history of a location.
+type ListOperationHistory struct {
+	ctrl.BaseController
+}
+
+// NewListOperationHistory creates a new ListOperationHistory.
+func NewListOperationHistory(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationHistory{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns a page of the operation history of the location. The operations of each page are sorted with the most
+// recent operations first. When the resourceId query parameter is set, only the history of that resource is returned.
+func (e *ListOperationHistory) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+	}
+
+	// Resource ids are case-insensitive.
+	resourceID := req.URL.Query().Get(ResourceIDQueryParam)
+	if resourceID != "" {
+		query.Filters = []database.QueryFilter{{Field: history.ResourceKeyField, Value: strings.ToLower(resourceID)}}
+	}
+
+	result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []history.Entry{}
+	for _, item := range result.Items {
+		entry := history.Entry{}
+		if err := item.As(&entry); err != nil {
+			return nil, err
+		}
+
+		entry.ResourceKey = ""
+		entry.Timestamp = 0
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].StartTime.After(entries[j].StartTime)
+	})
+
+	items := make([]any, len(entries))
+	for i := range entries {
+		items[i] = entries[i]
+	}
+
+	nextLink, err := nextLinkWithResourceID(ctrl.GetNextLinkURL(ctx, req, result.PaginationToken), resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{
+		Value:    items,
+		NextLink: nextLink,
+	}), nil
+}
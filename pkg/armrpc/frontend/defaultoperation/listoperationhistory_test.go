@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationHistoryRun(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+	recorder := history.NewRecorder(databaseClient, v1.LocationGlobal, time.Hour)
+
+	operations := []struct {
+		resourceID string
+		method     string
+		startTime  time.Time
+	}{
+		{"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0", "PUT", time.Now().Add(-2 * time.Minute)},
+		{"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c1", "PUT", time.Now().Add(-time.Minute)},
+		{"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0", "DELETE", time.Now()},
+	}
+
+	names := []string{}
+	for _, op := range operations {
+		sCtx := &v1.ARMRequestContext{
+			ResourceID:          resources.MustParse(op.resourceID),
+			OperationID:         uuid.New(),
+			OperationType:       v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationMethod(op.method)},
+			APIVersion:          "2023-10-01-preview",
+			ClientPrincipalName: "user@contoso.com",
+		}
+		require.NoError(t, recorder.RecordQueued(ctx, sCtx, op.startTime))
+		names = append(names, sCtx.OperationID.String())
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "all operations",
+			expected: []string{names[2], names[1], names[0]},
+		},
+		{
+			name:     "operations of resource",
+			query:    "&resourceId=/planes/radius/local/resourcegroups/test-rg/providers/applications.core/containers/c0",
+			expected: []string{names[2], names[0]},
+		},
+		{
+			name:     "no operations of resource",
+			query:    "&resourceId=/planes/radius/local/resourcegroups/test-rg/providers/applications.core/containers/c2",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operations?api-version=2023-10-01-preview" + tt.query
+			req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+			w := httptest.NewRecorder()
+
+			ctl, err := NewListOperationHistory(ctrl.Options{
+				DatabaseClient: databaseClient,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actual := struct {
+				Value []history.Entry `json:"value"`
+			}{}
+			err = json.Unmarshal(w.Body.Bytes(), &actual)
+			require.NoError(t, err)
+
+			actualNames := []string{}
+			for _, entry := range actual.Value {
+				require.Empty(t, entry.ResourceKey)
+				require.Equal(t, "user@contoso.com", entry.Caller.PrincipalName)
+				actualNames = append(actualNames, entry.Name)
+			}
+			require.Equal(t, tt.expected, actualNames)
+		})
+	}
+}
+
+func TestListOperationHistoryRun_Pagination(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+	recorder := history.NewRecorder(databaseClient, v1.LocationGlobal, time.Hour)
+
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0"
+	otherResourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c1"
+	expected := []string{}
+	for i := 0; i < 8; i++ {
+		linked := resourceID
+		if i == 2 {
+			linked = otherResourceID
+		}
+		sCtx := &v1.ARMRequestContext{
+			ResourceID:    resources.MustParse(linked),
+			OperationID:   uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i)),
+			OperationType: v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut},
+		}
+		require.NoError(t, recorder.RecordQueued(ctx, sCtx, time.Now()))
+
+		if linked == resourceID {
+			expected = append(expected, sCtx.OperationID.String())
+		}
+	}
+
+	ctl, err := NewListOperationHistory(ctrl.Options{
+		DatabaseClient: databaseClient,
+	})
+	require.NoError(t, err)
+
+	names := []string{}
+	next := "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operations?api-version=2023-10-01-preview&top=5&resourceId=" + strings.ToLower(resourceID)
+	pages := 0
+	for next != "" {
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, next, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+		w := httptest.NewRecorder()
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := struct {
+			Value    []history.Entry `json:"value"`
+			NextLink string          `json:"nextLink"`
+		}{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(actual.Value), 5)
+
+		for _, entry := range actual.Value {
+			names = append(names, entry.Name)
+		}
+		next = actual.NextLink
+		pages++
+	}
+
+	require.ElementsMatch(t, expected, names)
+	require.Equal(t, 2, pages)
+}
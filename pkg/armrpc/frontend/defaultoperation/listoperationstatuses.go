@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// ResourceIDQueryParam is the query parameter used to filter the operation statuses by the id of their resource.
+	ResourceIDQueryParam = "resourceId"
+)
+
+var _ ctrl.Controller = (*ListOperationStatuses)(nil)
+
+// ListOperationStatuses is the controller implementation to list the async operation statuses of a location.
+type ListOperationStatuses struct {
+	ctrl.BaseController
+}
+
+// NewListOperationStatuses creates a new ListOperationStatuses.
+func NewListOperationStatuses(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationStatuses{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns a page of the async operation statuses of the location. When the resourceId query parameter is set, only
+// the statuses of the operations on that resource are returned.
+func (e *ListOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+	}
+
+	// Resource ids are case-insensitive.
+	resourceID := req.URL.Query().Get(ResourceIDQueryParam)
+	if resourceID != "" {
+		query.Filters = append(query.Filters, database.QueryFilter{
+			Field:    "resourceID",
+			Operator: database.FilterOperatorEqualsIgnoreCase,
+			Value:    resourceID,
+		})
+	}
+
+	result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []any{}
+	for _, item := range result.Items {
+		os := &manager.Status{}
+		if err := item.As(os); err != nil {
+			return nil, err
+		}
+
+		items = append(items, os.AsyncOperationStatus)
+	}
+
+	nextLink, err := nextLinkWithResourceID(ctrl.GetNextLinkURL(ctx, req, result.PaginationToken), resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{
+		Value:    items,
+		NextLink: nextLink,
+	}), nil
+}
+
+// nextLinkWithResourceID adds the resourceId query parameter to the next link, so that the next page uses the same
+// filter.
+func nextLinkWithResourceID(nextLink string, resourceID string) (string, error) {
+	if nextLink == "" || resourceID == "" {
+		return nextLink, nil
+	}
+
+	u, err := url.Parse(nextLink)
+	if err != nil {
+		return "", err
+	}
+
+	qps := u.Query()
+	qps.Set(ResourceIDQueryParam, resourceID)
+	u.RawQuery = qps.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationStatusesRun(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	statuses := []*manager.Status{
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000001",
+				Name:   "00000000-0000-0000-0000-000000000001",
+				Status: v1.ProvisioningStateUpdating,
+			},
+			LinkedResourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0",
+		},
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000002",
+				Name:   "00000000-0000-0000-0000-000000000002",
+				Status: v1.ProvisioningStateSucceeded,
+			},
+			LinkedResourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c1",
+		},
+	}
+	for _, status := range statuses {
+		err := databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: status.ID}, Data: status})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "all operations",
+			expected: []string{statuses[0].Name, statuses[1].Name},
+		},
+		{
+			name:     "operations of resource",
+			query:    "&resourceId=/planes/radius/local/resourcegroups/test-rg/providers/applications.core/containers/c0",
+			expected: []string{statuses[0].Name},
+		},
+		{
+			name:     "no operations of resource",
+			query:    "&resourceId=/planes/radius/local/resourcegroups/test-rg/providers/applications.core/containers/c2",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses?api-version=2023-10-01-preview" + tt.query
+			req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+			w := httptest.NewRecorder()
+
+			ctl, err := NewListOperationStatuses(ctrl.Options{
+				DatabaseClient: databaseClient,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actual := struct {
+				Value []v1.AsyncOperationStatus `json:"value"`
+			}{}
+			err = json.Unmarshal(w.Body.Bytes(), &actual)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, status := range actual.Value {
+				names = append(names, status.Name)
+			}
+			require.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func TestListOperationStatusesRun_Pagination(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c0"
+	expected := []string{}
+	otherResourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/c1"
+	for i := 0; i < 8; i++ {
+		linked := resourceID
+		if i == 2 {
+			linked = otherResourceID
+		}
+		name := fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i)
+		status := &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/" + name,
+				Name:   name,
+				Status: v1.ProvisioningStateSucceeded,
+			},
+			LinkedResourceID: linked,
+		}
+		err := databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: status.ID}, Data: status})
+		require.NoError(t, err)
+
+		if linked == resourceID {
+			expected = append(expected, name)
+		}
+	}
+
+	ctl, err := NewListOperationStatuses(ctrl.Options{
+		DatabaseClient: databaseClient,
+	})
+	require.NoError(t, err)
+
+	names := []string{}
+	next := "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses?api-version=2023-10-01-preview&top=5&resourceId=" + strings.ToLower(resourceID)
+	pages := 0
+	for next != "" {
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, next, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+		w := httptest.NewRecorder()
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := struct {
+			Value    []v1.AsyncOperationStatus `json:"value"`
+			NextLink string                    `json:"nextLink"`
+		}{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(actual.Value), 5)
+
+		for _, status := range actual.Value {
+			names = append(names, status.Name)
+		}
+		next = actual.NextLink
+		pages++
+	}
+
+	require.Equal(t, expected, names)
+	require.Equal(t, 2, pages)
+}
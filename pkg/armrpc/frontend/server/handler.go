@@ -251,6 +251,39 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, providerNamespace),
+		ResourceType:      statusRT,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operations", rootScopePath, providerNamespace),
+		ResourceType:      providerNamespace + "/operationhistory",
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationHistory,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
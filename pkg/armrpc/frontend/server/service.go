@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
@@ -69,7 +70,13 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.OperationStatusManager = manager.New(databaseClient, reqQueueClient, s.Options.Config.Env.RoleLocation)
+
+	historyRecorder, err := history.FromOptions(databaseClient, s.Options.Config.Env.RoleLocation, s.Options.Config.OperationHistory)
+	if err != nil {
+		return err
+	}
+
+	s.OperationStatusManager = manager.New(databaseClient, reqQueueClient, s.Options.Config.Env.RoleLocation, manager.WithHistory(historyRecorder))
 	s.KubeClient, err = kubeutil.NewRuntimeClient(s.Options.K8sConfig)
 	if err != nil {
 		return err
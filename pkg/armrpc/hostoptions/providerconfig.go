@@ -46,6 +46,10 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	OpenTofu         OpenTofuOptions                      `yaml:"opentofu,omitempty"`
+	Pulumi           PulumiOptions                        `yaml:"pulumi,omitempty"`
+	OperationHistory OperationHistoryOptions              `yaml:"operationHistory,omitempty"`
+	DriftDetection   DriftDetectionOptions                `yaml:"driftDetection,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -84,6 +88,63 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// FairnessScope is the scope at which async request operations share the concurrency fairly: "resourceGroup" (default), "plane" or "none".
+	FairnessScope string `yaml:"fairnessScope,omitempty"`
+	// MaxOperationConcurrencyPerScope is the maximum concurrency to process async request operations of a single fairness scope.
+	MaxOperationConcurrencyPerScope *int `yaml:"maxOperationConcurrencyPerScope,omitempty"`
+	// MaxPendingOperations is the maximum number of async request operations dequeued ahead of processing to schedule them.
+	MaxPendingOperations *int `yaml:"maxPendingOperations,omitempty"`
+	// OperationPriorities maps operation methods (for example "DELETE") to their priority. Operations with a higher priority are processed first.
+	OperationPriorities map[string]int `yaml:"operationPriorities,omitempty"`
+}
+
+// OperationHistoryOptions includes the options for the durable history of async operations.
+type OperationHistoryOptions struct {
+	// Enabled enables recording of the operation history. Defaults to true.
+	Enabled *bool `yaml:"enabled,omitempty"`
+	// Retention is how long the history of an operation is kept after it started, for example "720h". Defaults to 30 days.
+	Retention string `yaml:"retention,omitempty"`
+}
+
+// DriftDetectionOptions includes the options for the periodic detection of drift in the infrastructure deployed by recipes.
+type DriftDetectionOptions struct {
+	// Enabled enables the detection of drift. Defaults to false.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Interval is how often the resources deployed by recipes are checked for drift, for example "1h". Defaults to 1 hour.
+	Interval string `yaml:"interval,omitempty"`
+}
+
+// ReencryptionOptions includes the options for the re-encryption of sensitive fields after a key rotation.
+type ReencryptionOptions struct {
+	// Enabled enables the re-encryption of sensitive fields. Defaults to true.
+	Enabled *bool `yaml:"enabled,omitempty"`
+	// Interval is how often the current encryption key version is checked, for example "5m". Defaults to 5 minutes.
+	Interval string `yaml:"interval,omitempty"`
+}
+
+// ClientIdentityOptions includes the options for authenticating the client identity of the requests proxied by UCP to
+// the resource providers. UCP signs the identity with the key, and the resource provider only trusts signed identities.
+type ClientIdentityOptions struct {
+	// SigningKeyFile is the path of the file holding the key signing client identities. The same key must be configured
+	// for UCP and the resource provider. Client identities are not trusted by the resource provider when empty.
+	SigningKeyFile string `yaml:"signingKeyFile,omitempty"`
+}
+
+// SensitiveDataAccessOptions includes the options controlling which callers can read the sensitive fields of
+// resources with the listSecrets action. Access is denied when no rule allows it.
+type SensitiveDataAccessOptions struct {
+	// Rules are the rules granting access to sensitive fields.
+	Rules []SensitiveDataAccessRule `yaml:"rules,omitempty"`
+}
+
+// SensitiveDataAccessRule grants callers access to the sensitive fields of resources.
+type SensitiveDataAccessRule struct {
+	// Callers are the principal names of the allowed callers: the Kubernetes users authenticated by the Kubernetes API server
+	// for the requests to the UCP API service. "*" allows all authenticated callers.
+	Callers []string `yaml:"callers,omitempty"`
+	// ResourceTypes are the resource types the rule applies to, for example "Radius.Data/mySqlDatabases". The rule applies to all
+	// resource types when empty or "*".
+	ResourceTypes []string `yaml:"resourceTypes,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
@@ -101,4 +162,48 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution (ERROR, DEBUG, etc.).
 	LogLevel string `yaml:"logLevel,omitempty"`
+
+	// Cache configures the cache of Terraform modules and provider plugins shared by recipe executions.
+	Cache TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes the options of the cache of Terraform modules and provider plugins.
+type TerraformCacheOptions struct {
+	// Path is the path to the cache directory. The cache is disabled if empty.
+	Path string `yaml:"path,omitempty"`
+
+	// MaxSize is the maximum size of the cache as a Kubernetes quantity (e.g. 10Gi). Default: 10Gi.
+	MaxSize string `yaml:"maxSize,omitempty"`
+
+	// Offline disables downloads of modules and provider plugins. The cache must be pre-seeded.
+	Offline bool `yaml:"offline,omitempty"`
+}
+
+// OpenTofuOptions includes options required for OpenTofu execution. OpenTofu recipes share the
+// path, log level and cache of the Terraform options.
+type OpenTofuOptions struct {
+	// ExecPath is the path or name of the tofu binary. Default: tofu, looked up in PATH.
+	ExecPath string `yaml:"execPath,omitempty"`
+}
+
+// PulumiOptions includes options required for Pulumi execution.
+type PulumiOptions struct {
+	// Path is the path to the directory mounted to the container where Pulumi programs are executed.
+	// Default: the Terraform path.
+	Path string `yaml:"path,omitempty"`
+
+	// ExecPath is the path or name of the pulumi binary. Default: pulumi, looked up in PATH.
+	ExecPath string `yaml:"execPath,omitempty"`
+
+	// BackendURL is the URL of the durable state backend storing the stacks of Pulumi recipes, for example
+	// s3://<bucket> or file://<directory of a persistent volume>. Pulumi recipes fail if empty.
+	BackendURL string `yaml:"backendUrl,omitempty"`
+
+	// PassphraseFile is the path of the file holding the passphrase encrypting the secrets of the Pulumi state.
+	// Default: the PULUMI_CONFIG_PASSPHRASE or PULUMI_CONFIG_PASSPHRASE_FILE environment variable.
+	PassphraseFile string `yaml:"passphraseFile,omitempty"`
+
+	// Env are the names of the environment variables of the resource provider passed to the Pulumi CLI in addition
+	// to the defaults, for example the credentials of the state backend.
+	Env []string `yaml:"env,omitempty"`
 }
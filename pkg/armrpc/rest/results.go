@@ -582,6 +582,46 @@ func (r *NotFoundResponse) Apply(ctx context.Context, w http.ResponseWriter, req
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+//
+// This is used when the caller is not authorized to perform the operation.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with resource id and a message.
+func NewForbiddenResponse(id resources.ID, message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+				Target:  id.String(),
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // ConflictResponse represents an HTTP 409 with an ARM error payload.
 //
 // This is used for delete operations.
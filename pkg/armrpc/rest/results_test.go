@@ -147,6 +147,27 @@ func Test_OKResponse_WithBody(t *testing.T) {
 	require.Equal(t, payload, body)
 }
 
+func Test_ForbiddenResponse(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Test.Resource/testResources/test")
+	response := NewForbiddenResponse(id, "access denied")
+
+	req := httptest.NewRequest("POST", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeAuthorizationFailed, body.Error.Code)
+	require.Equal(t, "access denied", body.Error.Message)
+	require.Equal(t, id.String(), body.Error.Target)
+}
+
 func TestGetAsyncLocationPath(t *testing.T) {
 	operationID := uuid.New()
 
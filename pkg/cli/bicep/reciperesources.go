@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// parameterExpression matches a template expression that references a single parameter, eg: "[parameters('name')]".
+	parameterExpression = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+	// nonRecipeNamespaces are the resource type namespaces whose resources are not deployed with a recipe.
+	nonRecipeNamespaces = []string{"applications.core", "radius.core"}
+
+	// recipeResourceTypes are the resource types of the namespaces above that are deployed with a recipe.
+	recipeResourceTypes = []string{"applications.core/extenders"}
+
+	// nonRadiusPrefixes are the prefixes of the resource types that are not managed by Radius.
+	nonRadiusPrefixes = []string{"microsoft.", "aws."}
+)
+
+// RecipeResource describes a resource of a compiled Radius Bicep template that is deployed with a recipe.
+type RecipeResource struct {
+	// SymbolicName is the symbolic name of the resource in the template.
+	SymbolicName string
+
+	// Type is the resource type, without the API version.
+	Type string
+
+	// Name is the name of the resource.
+	Name string
+
+	// ApplicationID is the application of the resource, if known.
+	ApplicationID string
+
+	// RecipeName is the name of the recipe used by the resource, if set.
+	RecipeName string
+
+	// RecipeParameters are the parameters passed to the recipe by the resource.
+	RecipeParameters map[string]any
+
+	// Properties are the properties of the resource.
+	Properties map[string]any
+}
+
+// FindRecipeResources returns the resources of the compiled Radius Bicep template that are deployed with a
+// recipe, sorted by symbolic name. These are the Radius resources outside of the Applications.Core and Radius.Core
+// namespaces, and Applications.Core extenders, unless their resource provisioning is manual.
+//
+// Values of the template that reference a parameter are resolved using the deployment parameters or the default
+// values of the template. Values computed by other expressions are only known during the deployment and are left
+// out. Resources whose name cannot be resolved are skipped.
+func FindRecipeResources(template map[string]any, parameters map[string]map[string]any) []RecipeResource {
+	if template == nil {
+		return nil
+	}
+
+	resources, ok := template["resources"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	formalParams, _ := ExtractParameters(template)
+	resolver := &parameterResolver{formal: formalParams, actual: parameters}
+
+	result := []RecipeResource{}
+	for symbolicName, value := range resources {
+		resource, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		resourceType, ok := resource["type"].(string)
+		if !ok || !isRecipeResourceType(resourceType) {
+			continue
+		}
+
+		if existing, _ := resource["existing"].(bool); existing {
+			continue
+		}
+
+		nameValue, body := resourceNameAndProperties(resource)
+		name, ok := resolver.resolve(nameValue).(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		properties, _ := resolver.resolve(body).(map[string]any)
+		if properties == nil {
+			properties = map[string]any{}
+		}
+
+		if provisioning, _ := properties["resourceProvisioning"].(string); strings.EqualFold(provisioning, "manual") {
+			continue
+		}
+
+		recipeResource := RecipeResource{
+			SymbolicName: symbolicName,
+			Type:         strings.Split(resourceType, "@")[0],
+			Name:         name,
+			Properties:   properties,
+		}
+		recipeResource.ApplicationID, _ = properties["application"].(string)
+		if recipe, ok := properties["recipe"].(map[string]any); ok {
+			recipeResource.RecipeName, _ = recipe["name"].(string)
+			recipeResource.RecipeParameters, _ = recipe["parameters"].(map[string]any)
+		}
+
+		result = append(result, recipeResource)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SymbolicName < result[j].SymbolicName
+	})
+
+	return result
+}
+
+// isRecipeResourceType returns true if resources of the given type, with or without API version, are deployed
+// with a recipe.
+func isRecipeResourceType(resourceType string) bool {
+	resourceType = strings.ToLower(strings.Split(resourceType, "@")[0])
+	for _, prefix := range nonRadiusPrefixes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return false
+		}
+	}
+
+	namespace, _, found := strings.Cut(resourceType, "/")
+	if !found || !strings.Contains(namespace, ".") {
+		// Kubernetes resources, eg: "core/Secret".
+		return false
+	}
+
+	for _, recipeType := range recipeResourceTypes {
+		if resourceType == recipeType {
+			return true
+		}
+	}
+
+	for _, nonRecipe := range nonRecipeNamespaces {
+		if namespace == nonRecipe {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceNameAndProperties returns the name and properties of a template resource. Resources of an extension
+// are nested in the template: {"type": ..., "properties": {"name": ..., "properties": {...}}}.
+func resourceNameAndProperties(resource map[string]any) (any, any) {
+	if name, ok := resource["name"]; ok {
+		return name, resource["properties"]
+	}
+
+	body, ok := resource["properties"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	return body["name"], body["properties"]
+}
+
+// parameterResolver resolves the parameter references of template values.
+type parameterResolver struct {
+	formal map[string]any
+	actual map[string]map[string]any
+}
+
+// resolve returns the value with its parameter references replaced by the value of the parameter. Expressions
+// that cannot be resolved are replaced by nil.
+func (r *parameterResolver) resolve(value any) any {
+	switch v := value.(type) {
+	case string:
+		if !strings.HasPrefix(v, "[") || strings.HasPrefix(v, "[[") {
+			return v
+		}
+
+		matches := parameterExpression.FindStringSubmatch(v)
+		if matches == nil {
+			return nil
+		}
+
+		return r.parameter(matches[1])
+	case map[string]any:
+		resolved := map[string]any{}
+		for key, item := range v {
+			if item = r.resolve(item); item != nil {
+				resolved[key] = item
+			}
+		}
+		return resolved
+	case []any:
+		resolved := make([]any, len(v))
+		for i, item := range v {
+			resolved[i] = r.resolve(item)
+		}
+		return resolved
+	default:
+		return v
+	}
+}
+
+// parameter returns the value of the parameter with the given name. Parameter names are case-insensitive.
+func (r *parameterResolver) parameter(name string) any {
+	for key, param := range r.actual {
+		if strings.EqualFold(key, name) {
+			return param["value"]
+		}
+	}
+
+	for key, param := range r.formal {
+		if strings.EqualFold(key, name) {
+			value, _ := DefaultValue(param)
+			return value
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindRecipeResources(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"application": map[string]any{"type": "string"},
+			"size":        map[string]any{"type": "string", "defaultValue": "small"},
+		},
+		"resources": map[string]any{
+			"redis": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "redis",
+					"properties": map[string]any{
+						"application": "[parameters('application')]",
+						"recipe": map[string]any{
+							"name": "custom",
+							"parameters": map[string]any{
+								"size":   "[parameters('size')]",
+								"region": "[reference('other').location]",
+							},
+						},
+					},
+				},
+			},
+			"db": map[string]any{
+				"import": "Radius",
+				"type":   "Radius.Data/postgreSqlDatabases@2025-08-01-preview",
+				"properties": map[string]any{
+					"name":       "db",
+					"properties": map[string]any{"size": "S"},
+				},
+			},
+			"extender": map[string]any{
+				"type":       "Applications.Core/extenders@2023-10-01-preview",
+				"name":       "extender",
+				"properties": map[string]any{},
+			},
+			"manual": map[string]any{
+				"type": "Applications.Datastores/sqlDatabases@2023-10-01-preview",
+				"name": "manual",
+				"properties": map[string]any{
+					"resourceProvisioning": "manual",
+				},
+			},
+			"existing": map[string]any{
+				"type":     "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+				"name":     "existing",
+				"existing": true,
+			},
+			"computed": map[string]any{
+				"type": "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+				"name": "[format('{0}-db', parameters('application'))]",
+			},
+			"container": map[string]any{
+				"type": "Applications.Core/containers@2023-10-01-preview",
+				"name": "container",
+			},
+			"environment": map[string]any{
+				"type": "Radius.Core/environments@2025-08-01-preview",
+				"name": "environment",
+			},
+			"storage": map[string]any{
+				"type": "Microsoft.Storage/storageAccounts@2022-09-01",
+				"name": "storage",
+			},
+			"secret": map[string]any{
+				"import": "Kubernetes",
+				"type":   "core/Secret@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "secret"},
+				},
+			},
+		},
+	}
+	parameters := map[string]map[string]any{
+		"Application": {"value": "/planes/radius/local/resourceGroups/test/providers/Applications.Core/applications/app"},
+	}
+
+	resources := FindRecipeResources(template, parameters)
+	require.Equal(t, []RecipeResource{
+		{
+			SymbolicName: "db",
+			Type:         "Radius.Data/postgreSqlDatabases",
+			Name:         "db",
+			Properties:   map[string]any{"size": "S"},
+		},
+		{
+			SymbolicName: "extender",
+			Type:         "Applications.Core/extenders",
+			Name:         "extender",
+			Properties:   map[string]any{},
+		},
+		{
+			SymbolicName:     "redis",
+			Type:             "Applications.Datastores/redisCaches",
+			Name:             "redis",
+			ApplicationID:    "/planes/radius/local/resourceGroups/test/providers/Applications.Core/applications/app",
+			RecipeName:       "custom",
+			RecipeParameters: map[string]any{"size": "small"},
+			Properties: map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test/providers/Applications.Core/applications/app",
+				"recipe": map[string]any{
+					"name":       "custom",
+					"parameters": map[string]any{"size": "small"},
+				},
+			},
+		},
+	}, resources)
+}
+
+func Test_FindRecipeResources_NoResources(t *testing.T) {
+	require.Nil(t, FindRecipeResources(nil, nil))
+	require.Nil(t, FindRecipeResources(map[string]any{}, nil))
+	require.Empty(t, FindRecipeResources(map[string]any{"resources": map[string]any{}}, nil))
+}
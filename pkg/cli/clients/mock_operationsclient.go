@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/cli/clients (interfaces: OperationsClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_operationsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients OperationsClient
+//
+
+// Package clients is a generated GoMock package.
+package clients
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	history "github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOperationsClient is a mock of OperationsClient interface.
+type MockOperationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationsClientMockRecorder
+	isgomock struct{}
+}
+
+// MockOperationsClientMockRecorder is the mock recorder for MockOperationsClient.
+type MockOperationsClientMockRecorder struct {
+	mock *MockOperationsClient
+}
+
+// NewMockOperationsClient creates a new mock instance.
+func NewMockOperationsClient(ctrl *gomock.Controller) *MockOperationsClient {
+	mock := &MockOperationsClient{ctrl: ctrl}
+	mock.recorder = &MockOperationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationsClient) EXPECT() *MockOperationsClientMockRecorder {
+	return m.recorder
+}
+
+// CancelOperation mocks base method.
+func (m *MockOperationsClient) CancelOperation(ctx context.Context, operationStatusID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", ctx, operationStatusID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockOperationsClientMockRecorder) CancelOperation(ctx, operationStatusID any) *MockOperationsClientCancelOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockOperationsClient)(nil).CancelOperation), ctx, operationStatusID)
+	return &MockOperationsClientCancelOperationCall{Call: call}
+}
+
+// MockOperationsClientCancelOperationCall wrap *gomock.Call
+type MockOperationsClientCancelOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOperationsClientCancelOperationCall) Return(arg0 error) *MockOperationsClientCancelOperationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOperationsClientCancelOperationCall) Do(f func(context.Context, string) error) *MockOperationsClientCancelOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOperationsClientCancelOperationCall) DoAndReturn(f func(context.Context, string) error) *MockOperationsClientCancelOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOperationHistory mocks base method.
+func (m *MockOperationsClient) ListOperationHistory(ctx context.Context, resourceID string) ([]history.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperationHistory", ctx, resourceID)
+	ret0, _ := ret[0].([]history.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperationHistory indicates an expected call of ListOperationHistory.
+func (mr *MockOperationsClientMockRecorder) ListOperationHistory(ctx, resourceID any) *MockOperationsClientListOperationHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperationHistory", reflect.TypeOf((*MockOperationsClient)(nil).ListOperationHistory), ctx, resourceID)
+	return &MockOperationsClientListOperationHistoryCall{Call: call}
+}
+
+// MockOperationsClientListOperationHistoryCall wrap *gomock.Call
+type MockOperationsClientListOperationHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOperationsClientListOperationHistoryCall) Return(arg0 []history.Entry, arg1 error) *MockOperationsClientListOperationHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOperationsClientListOperationHistoryCall) Do(f func(context.Context, string) ([]history.Entry, error)) *MockOperationsClientListOperationHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOperationsClientListOperationHistoryCall) DoAndReturn(f func(context.Context, string) ([]history.Entry, error)) *MockOperationsClientListOperationHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOperationStatuses mocks base method.
+func (m *MockOperationsClient) ListOperationStatuses(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperationStatuses", ctx, resourceID)
+	ret0, _ := ret[0].([]v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperationStatuses indicates an expected call of ListOperationStatuses.
+func (mr *MockOperationsClientMockRecorder) ListOperationStatuses(ctx, resourceID any) *MockOperationsClientListOperationStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperationStatuses", reflect.TypeOf((*MockOperationsClient)(nil).ListOperationStatuses), ctx, resourceID)
+	return &MockOperationsClientListOperationStatusesCall{Call: call}
+}
+
+// MockOperationsClientListOperationStatusesCall wrap *gomock.Call
+type MockOperationsClientListOperationStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOperationsClientListOperationStatusesCall) Return(arg0 []v1.AsyncOperationStatus, arg1 error) *MockOperationsClientListOperationStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOperationsClientListOperationStatusesCall) Do(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockOperationsClientListOperationStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOperationsClientListOperationStatusesCall) DoAndReturn(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockOperationsClientListOperationStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
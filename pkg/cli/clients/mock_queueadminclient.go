@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/cli/clients (interfaces: QueueAdminClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_queueadminclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients QueueAdminClient
+//
+
+// Package clients is a generated GoMock package.
+package clients
+
+import (
+	context "context"
+	reflect "reflect"
+
+	admin "github.com/radius-project/radius/pkg/ucp/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQueueAdminClient is a mock of QueueAdminClient interface.
+type MockQueueAdminClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueAdminClientMockRecorder
+	isgomock struct{}
+}
+
+// MockQueueAdminClientMockRecorder is the mock recorder for MockQueueAdminClient.
+type MockQueueAdminClientMockRecorder struct {
+	mock *MockQueueAdminClient
+}
+
+// NewMockQueueAdminClient creates a new mock instance.
+func NewMockQueueAdminClient(ctrl *gomock.Controller) *MockQueueAdminClient {
+	mock := &MockQueueAdminClient{ctrl: ctrl}
+	mock.recorder = &MockQueueAdminClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueueAdminClient) EXPECT() *MockQueueAdminClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteDeadLetterMessage mocks base method.
+func (m *MockQueueAdminClient) DeleteDeadLetterMessage(ctx context.Context, queueName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadLetterMessage", ctx, queueName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadLetterMessage indicates an expected call of DeleteDeadLetterMessage.
+func (mr *MockQueueAdminClientMockRecorder) DeleteDeadLetterMessage(ctx, queueName, id any) *MockQueueAdminClientDeleteDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetterMessage", reflect.TypeOf((*MockQueueAdminClient)(nil).DeleteDeadLetterMessage), ctx, queueName, id)
+	return &MockQueueAdminClientDeleteDeadLetterMessageCall{Call: call}
+}
+
+// MockQueueAdminClientDeleteDeadLetterMessageCall wrap *gomock.Call
+type MockQueueAdminClientDeleteDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueueAdminClientDeleteDeadLetterMessageCall) Return(arg0 error) *MockQueueAdminClientDeleteDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueueAdminClientDeleteDeadLetterMessageCall) Do(f func(context.Context, string, string) error) *MockQueueAdminClientDeleteDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueueAdminClientDeleteDeadLetterMessageCall) DoAndReturn(f func(context.Context, string, string) error) *MockQueueAdminClientDeleteDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeadLetterMessages mocks base method.
+func (m *MockQueueAdminClient) ListDeadLetterMessages(ctx context.Context, queueName string) ([]admin.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterMessages", ctx, queueName)
+	ret0, _ := ret[0].([]admin.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterMessages indicates an expected call of ListDeadLetterMessages.
+func (mr *MockQueueAdminClientMockRecorder) ListDeadLetterMessages(ctx, queueName any) *MockQueueAdminClientListDeadLetterMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterMessages", reflect.TypeOf((*MockQueueAdminClient)(nil).ListDeadLetterMessages), ctx, queueName)
+	return &MockQueueAdminClientListDeadLetterMessagesCall{Call: call}
+}
+
+// MockQueueAdminClientListDeadLetterMessagesCall wrap *gomock.Call
+type MockQueueAdminClientListDeadLetterMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueueAdminClientListDeadLetterMessagesCall) Return(arg0 []admin.DeadLetterMessage, arg1 error) *MockQueueAdminClientListDeadLetterMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueueAdminClientListDeadLetterMessagesCall) Do(f func(context.Context, string) ([]admin.DeadLetterMessage, error)) *MockQueueAdminClientListDeadLetterMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueueAdminClientListDeadLetterMessagesCall) DoAndReturn(f func(context.Context, string) ([]admin.DeadLetterMessage, error)) *MockQueueAdminClientListDeadLetterMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListQueues mocks base method.
+func (m *MockQueueAdminClient) ListQueues(ctx context.Context) ([]admin.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues", ctx)
+	ret0, _ := ret[0].([]admin.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockQueueAdminClientMockRecorder) ListQueues(ctx any) *MockQueueAdminClientListQueuesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockQueueAdminClient)(nil).ListQueues), ctx)
+	return &MockQueueAdminClientListQueuesCall{Call: call}
+}
+
+// MockQueueAdminClientListQueuesCall wrap *gomock.Call
+type MockQueueAdminClientListQueuesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueueAdminClientListQueuesCall) Return(arg0 []admin.Queue, arg1 error) *MockQueueAdminClientListQueuesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueueAdminClientListQueuesCall) Do(f func(context.Context) ([]admin.Queue, error)) *MockQueueAdminClientListQueuesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueueAdminClientListQueuesCall) DoAndReturn(f func(context.Context) ([]admin.Queue, error)) *MockQueueAdminClientListQueuesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockQueueAdminClient) PurgeDeadLetterMessages(ctx context.Context, queueName string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", ctx, queueName)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockQueueAdminClientMockRecorder) PurgeDeadLetterMessages(ctx, queueName any) *MockQueueAdminClientPurgeDeadLetterMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockQueueAdminClient)(nil).PurgeDeadLetterMessages), ctx, queueName)
+	return &MockQueueAdminClientPurgeDeadLetterMessagesCall{Call: call}
+}
+
+// MockQueueAdminClientPurgeDeadLetterMessagesCall wrap *gomock.Call
+type MockQueueAdminClientPurgeDeadLetterMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueueAdminClientPurgeDeadLetterMessagesCall) Return(arg0 int, arg1 error) *MockQueueAdminClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueueAdminClientPurgeDeadLetterMessagesCall) Do(f func(context.Context, string) (int, error)) *MockQueueAdminClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueueAdminClientPurgeDeadLetterMessagesCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockQueueAdminClientPurgeDeadLetterMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequeueDeadLetterMessage mocks base method.
+func (m *MockQueueAdminClient) RequeueDeadLetterMessage(ctx context.Context, queueName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetterMessage", ctx, queueName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadLetterMessage indicates an expected call of RequeueDeadLetterMessage.
+func (mr *MockQueueAdminClientMockRecorder) RequeueDeadLetterMessage(ctx, queueName, id any) *MockQueueAdminClientRequeueDeadLetterMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterMessage", reflect.TypeOf((*MockQueueAdminClient)(nil).RequeueDeadLetterMessage), ctx, queueName, id)
+	return &MockQueueAdminClientRequeueDeadLetterMessageCall{Call: call}
+}
+
+// MockQueueAdminClientRequeueDeadLetterMessageCall wrap *gomock.Call
+type MockQueueAdminClientRequeueDeadLetterMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueueAdminClientRequeueDeadLetterMessageCall) Return(arg0 error) *MockQueueAdminClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueueAdminClientRequeueDeadLetterMessageCall) Do(f func(context.Context, string, string) error) *MockQueueAdminClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueueAdminClientRequeueDeadLetterMessageCall) DoAndReturn(f func(context.Context, string, string) error) *MockQueueAdminClientRequeueDeadLetterMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
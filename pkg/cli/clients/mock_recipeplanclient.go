@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/cli/clients (interfaces: RecipePlanClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_recipeplanclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients RecipePlanClient
+//
+
+// Package clients is a generated GoMock package.
+package clients
+
+import (
+	context "context"
+	reflect "reflect"
+
+	datamodel "github.com/radius-project/radius/pkg/corerp/datamodel"
+	recipes "github.com/radius-project/radius/pkg/recipes"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecipePlanClient is a mock of RecipePlanClient interface.
+type MockRecipePlanClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecipePlanClientMockRecorder
+	isgomock struct{}
+}
+
+// MockRecipePlanClientMockRecorder is the mock recorder for MockRecipePlanClient.
+type MockRecipePlanClientMockRecorder struct {
+	mock *MockRecipePlanClient
+}
+
+// NewMockRecipePlanClient creates a new mock instance.
+func NewMockRecipePlanClient(ctrl *gomock.Controller) *MockRecipePlanClient {
+	mock := &MockRecipePlanClient{ctrl: ctrl}
+	mock.recorder = &MockRecipePlanClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecipePlanClient) EXPECT() *MockRecipePlanClientMockRecorder {
+	return m.recorder
+}
+
+// PlanRecipe mocks base method.
+func (m *MockRecipePlanClient) PlanRecipe(ctx context.Context, environmentID string, request datamodel.RecipePlanRequest) (*recipes.PlanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", ctx, environmentID, request)
+	ret0, _ := ret[0].(*recipes.PlanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockRecipePlanClientMockRecorder) PlanRecipe(ctx, environmentID, request any) *MockRecipePlanClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockRecipePlanClient)(nil).PlanRecipe), ctx, environmentID, request)
+	return &MockRecipePlanClientPlanRecipeCall{Call: call}
+}
+
+// MockRecipePlanClientPlanRecipeCall wrap *gomock.Call
+type MockRecipePlanClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecipePlanClientPlanRecipeCall) Return(arg0 *recipes.PlanOutput, arg1 error) *MockRecipePlanClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecipePlanClientPlanRecipeCall) Do(f func(context.Context, string, datamodel.RecipePlanRequest) (*recipes.PlanOutput, error)) *MockRecipePlanClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecipePlanClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, datamodel.RecipePlanRequest) (*recipes.PlanOutput, error)) *MockRecipePlanClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
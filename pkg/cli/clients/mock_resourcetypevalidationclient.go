@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/cli/clients (interfaces: ResourceTypeValidationClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_resourcetypevalidationclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients ResourceTypeValidationClient
+//
+
+// Package clients is a generated GoMock package.
+package clients
+
+import (
+	context "context"
+	reflect "reflect"
+
+	datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceTypeValidationClient is a mock of ResourceTypeValidationClient interface.
+type MockResourceTypeValidationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceTypeValidationClientMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceTypeValidationClientMockRecorder is the mock recorder for MockResourceTypeValidationClient.
+type MockResourceTypeValidationClientMockRecorder struct {
+	mock *MockResourceTypeValidationClient
+}
+
+// NewMockResourceTypeValidationClient creates a new mock instance.
+func NewMockResourceTypeValidationClient(ctrl *gomock.Controller) *MockResourceTypeValidationClient {
+	mock := &MockResourceTypeValidationClient{ctrl: ctrl}
+	mock.recorder = &MockResourceTypeValidationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceTypeValidationClient) EXPECT() *MockResourceTypeValidationClientMockRecorder {
+	return m.recorder
+}
+
+// ValidateInstances mocks base method.
+func (m *MockResourceTypeValidationClient) ValidateInstances(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, schema map[string]any) (*datamodel.ValidateInstancesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateInstances", ctx, planeName, resourceProviderName, resourceTypeName, apiVersionName, schema)
+	ret0, _ := ret[0].(*datamodel.ValidateInstancesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateInstances indicates an expected call of ValidateInstances.
+func (mr *MockResourceTypeValidationClientMockRecorder) ValidateInstances(ctx, planeName, resourceProviderName, resourceTypeName, apiVersionName, schema any) *MockResourceTypeValidationClientValidateInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateInstances", reflect.TypeOf((*MockResourceTypeValidationClient)(nil).ValidateInstances), ctx, planeName, resourceProviderName, resourceTypeName, apiVersionName, schema)
+	return &MockResourceTypeValidationClientValidateInstancesCall{Call: call}
+}
+
+// MockResourceTypeValidationClientValidateInstancesCall wrap *gomock.Call
+type MockResourceTypeValidationClientValidateInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceTypeValidationClientValidateInstancesCall) Return(arg0 *datamodel.ValidateInstancesResult, arg1 error) *MockResourceTypeValidationClientValidateInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceTypeValidationClientValidateInstancesCall) Do(f func(context.Context, string, string, string, string, map[string]any) (*datamodel.ValidateInstancesResult, error)) *MockResourceTypeValidationClientValidateInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceTypeValidationClientValidateInstancesCall) DoAndReturn(f func(context.Context, string, string, string, string, map[string]any) (*datamodel.ValidateInstancesResult, error)) *MockResourceTypeValidationClientValidateInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// operationsAPIVersion is the api-version used for the operation status and history endpoints. They are
+	// implemented by every resource provider and do not depend on the api-version of the resource type.
+	operationsAPIVersion = "2023-10-01-preview"
+)
+
+//go:generate mockgen -typed -destination=./mock_operationsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients OperationsClient
+
+// OperationsClient is used to inspect, cancel and audit the async operations of Radius resources.
+type OperationsClient interface {
+	// ListOperationStatuses lists the statuses of the async operations on the resource with the given id.
+	ListOperationStatuses(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error)
+
+	// CancelOperation requests cancellation of the async operation with the given operation status id.
+	CancelOperation(ctx context.Context, operationStatusID string) error
+
+	// ListOperationHistory lists the operation history of the resource with the given id, most recent operations first.
+	ListOperationHistory(ctx context.Context, resourceID string) ([]history.Entry, error)
+}
+
+var _ OperationsClient = (*UCPOperationsClient)(nil)
+
+// UCPOperationsClient implements OperationsClient using the operation status endpoints of the resource providers.
+type UCPOperationsClient struct {
+	// Connection is the connection to UCP.
+	Connection sdk.Connection
+}
+
+// ListOperationStatuses lists the statuses of the async operations on the resource with the given id.
+func (c *UCPOperationsClient) ListOperationStatuses(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses", id.PlaneScope(), id.ProviderNamespace(), v1.LocationGlobal)
+	query := url.Values{}
+	query.Set("api-version", operationsAPIVersion)
+	query.Set("resourceId", id.String())
+
+	return listAll[v1.AsyncOperationStatus](ctx, c, path, query)
+}
+
+// CancelOperation requests cancellation of the async operation with the given operation status id.
+func (c *UCPOperationsClient) CancelOperation(ctx context.Context, operationStatusID string) error {
+	return c.do(ctx, http.MethodPost, strings.TrimSuffix(operationStatusID, "/")+"/cancel?api-version="+operationsAPIVersion, nil)
+}
+
+// ListOperationHistory lists the operation history of the resource with the given id, most recent operations first.
+func (c *UCPOperationsClient) ListOperationHistory(ctx context.Context, resourceID string) ([]history.Entry, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/providers/%s/locations/%s/operations", id.PlaneScope(), id.ProviderNamespace(), v1.LocationGlobal)
+	query := url.Values{}
+	query.Set("api-version", operationsAPIVersion)
+	query.Set("resourceId", id.String())
+
+	entries, err := listAll[history.Entry](ctx, c, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each page is sorted by the server, but the pages are not.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].StartTime.After(entries[j].StartTime)
+	})
+
+	return entries, nil
+}
+
+// listAll lists every page of a collection. The skip token of each next link is sent to the connection's endpoint,
+// since the host of the next link is the one seen by the resource provider.
+func listAll[T any](ctx context.Context, c *UCPOperationsClient, path string, query url.Values) ([]T, error) {
+	items := []T{}
+	for {
+		page := struct {
+			Value    []T    `json:"value"`
+			NextLink string `json:"nextLink"`
+		}{}
+		err := c.do(ctx, http.MethodGet, path+"?"+query.Encode(), &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Value...)
+
+		if page.NextLink == "" {
+			return items, nil
+		}
+
+		next, err := url.Parse(page.NextLink)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next link %q: %w", page.NextLink, err)
+		}
+
+		token := next.Query().Get(v1.SkipTokenParameterName)
+		if token == "" {
+			return items, nil
+		}
+		query.Set(v1.SkipTokenParameterName, token)
+	}
+}
+
+// do sends a request to UCP and decodes the response body into result if it is not nil. Error responses are returned
+// as *azcore.ResponseError so they can be checked with Is404Error.
+func (c *UCPOperationsClient) do(ctx context.Context, method string, path string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.Connection.Endpoint()+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
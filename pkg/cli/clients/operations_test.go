@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID        = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"
+	testOperationStatusID = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000"
+)
+
+func newTestOperationsClient(t *testing.T, handler http.HandlerFunc) *UCPOperationsClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	return &UCPOperationsClient{Connection: connection}
+}
+
+func Test_UCPOperationsClient_ListOperationStatuses(t *testing.T) {
+	client := newTestOperationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses", r.URL.Path)
+		require.Equal(t, testResourceID, r.URL.Query().Get("resourceId"))
+		require.Equal(t, operationsAPIVersion, r.URL.Query().Get("api-version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"value": []v1.AsyncOperationStatus{{ID: testOperationStatusID, Status: v1.ProvisioningStateUpdating}},
+		})
+	})
+
+	statuses, err := client.ListOperationStatuses(context.Background(), testResourceID)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, testOperationStatusID, statuses[0].ID)
+	require.Equal(t, v1.ProvisioningStateUpdating, statuses[0].Status)
+}
+
+func Test_UCPOperationsClient_CancelOperation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client := newTestOperationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, testOperationStatusID+"/cancel", r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		err := client.CancelOperation(context.Background(), testOperationStatusID)
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestOperationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		err := client.CancelOperation(context.Background(), testOperationStatusID)
+		require.True(t, Is404Error(err))
+	})
+}
+
+func Test_UCPOperationsClient_ListOperationHistory(t *testing.T) {
+	now := time.Now()
+	client := newTestOperationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/planes/radius/local/providers/Applications.Core/locations/global/operations", r.URL.Path)
+		require.Equal(t, testResourceID, r.URL.Query().Get("resourceId"))
+		require.Equal(t, operationsAPIVersion, r.URL.Query().Get("api-version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("skipToken") == "" {
+			// The host of the next link is the one seen by the resource provider.
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"value":    []history.Entry{{Name: "00000000-0000-0000-0000-000000000000", OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT", Status: v1.ProvisioningStateSucceeded, StartTime: now.Add(-time.Minute)}},
+				"nextLink": "http://applications-rp:5443" + r.URL.Path + "?api-version=" + operationsAPIVersion + "&skipToken=page2&top=1",
+			})
+			return
+		}
+
+		require.Equal(t, "page2", r.URL.Query().Get("skipToken"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"value": []history.Entry{{Name: "00000000-0000-0000-0000-000000000001", OperationType: "APPLICATIONS.CORE/CONTAINERS|DELETE", Status: v1.ProvisioningStateAccepted, StartTime: now}},
+		})
+	})
+
+	entries, err := client.ListOperationHistory(context.Background(), testResourceID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// Most recent operations first.
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|DELETE", entries[0].OperationType)
+	require.Equal(t, v1.ProvisioningStateAccepted, entries[0].Status)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", entries[1].OperationType)
+	require.Equal(t, v1.ProvisioningStateSucceeded, entries[1].Status)
+}
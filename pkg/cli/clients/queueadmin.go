@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/admin"
+)
+
+//go:generate mockgen -typed -destination=./mock_queueadminclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients QueueAdminClient
+
+// QueueAdminClient is used to inspect and repair the async operation queues of a Radius installation.
+type QueueAdminClient interface {
+	// ListQueues lists the async operation queues.
+	ListQueues(ctx context.Context) ([]admin.Queue, error)
+
+	// ListDeadLetterMessages lists the dead-lettered messages of a queue.
+	ListDeadLetterMessages(ctx context.Context, queueName string) ([]admin.DeadLetterMessage, error)
+
+	// RequeueDeadLetterMessage moves a dead-lettered message back to its queue.
+	RequeueDeadLetterMessage(ctx context.Context, queueName string, id string) error
+
+	// DeleteDeadLetterMessage deletes a dead-lettered message.
+	DeleteDeadLetterMessage(ctx context.Context, queueName string, id string) error
+
+	// PurgeDeadLetterMessages deletes all dead-lettered messages of a queue and returns the number deleted.
+	PurgeDeadLetterMessages(ctx context.Context, queueName string) (int, error)
+}
+
+var _ QueueAdminClient = (*UCPQueueAdminClient)(nil)
+
+// UCPQueueAdminClient implements QueueAdminClient using UCP's administrative API.
+type UCPQueueAdminClient struct {
+	// Connection is the connection to UCP.
+	Connection sdk.Connection
+}
+
+// ListQueues lists the async operation queues.
+func (c *UCPQueueAdminClient) ListQueues(ctx context.Context) ([]admin.Queue, error) {
+	result := admin.QueueList{}
+	err := c.do(ctx, http.MethodGet, admin.QueuesPath, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// ListDeadLetterMessages lists the dead-lettered messages of a queue.
+func (c *UCPQueueAdminClient) ListDeadLetterMessages(ctx context.Context, queueName string) ([]admin.DeadLetterMessage, error) {
+	result := admin.DeadLetterMessageList{}
+	err := c.do(ctx, http.MethodGet, deadLetterPath(queueName), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// RequeueDeadLetterMessage moves a dead-lettered message back to its queue.
+func (c *UCPQueueAdminClient) RequeueDeadLetterMessage(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodPost, deadLetterPath(queueName)+"/"+url.PathEscape(id)+"/"+admin.RequeuePathSegment, nil)
+}
+
+// DeleteDeadLetterMessage deletes a dead-lettered message.
+func (c *UCPQueueAdminClient) DeleteDeadLetterMessage(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodDelete, deadLetterPath(queueName)+"/"+url.PathEscape(id), nil)
+}
+
+// PurgeDeadLetterMessages deletes all dead-lettered messages of a queue and returns the number deleted.
+func (c *UCPQueueAdminClient) PurgeDeadLetterMessages(ctx context.Context, queueName string) (int, error) {
+	result := admin.PurgeResult{}
+	err := c.do(ctx, http.MethodDelete, deadLetterPath(queueName), &result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Deleted, nil
+}
+
+func deadLetterPath(queueName string) string {
+	return admin.QueuesPath + "/" + url.PathEscape(queueName) + "/" + admin.DeadLetterPathSegment
+}
+
+// do sends a request to the administrative API and decodes the response body into result if it is not nil. Error
+// responses are returned as *azcore.ResponseError so they can be checked with Is404Error.
+func (c *UCPQueueAdminClient) do(ctx context.Context, method string, path string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.Connection.Endpoint()+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// planPollFrequency is the interval between two polls of the async operation of a recipe plan.
+	planPollFrequency = 2 * time.Second
+)
+
+//go:generate mockgen -typed -destination=./mock_recipeplanclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients RecipePlanClient
+
+// RecipePlanClient is used to plan the recipes of resources before they are deployed.
+type RecipePlanClient interface {
+	// PlanRecipe returns the changes that deploying the resource described by the request would make to its
+	// infrastructure, using the recipes of the environment.
+	PlanRecipe(ctx context.Context, environmentID string, request datamodel.RecipePlanRequest) (*recipes.PlanOutput, error)
+}
+
+var _ RecipePlanClient = (*UCPRecipePlanClient)(nil)
+
+// UCPRecipePlanClient implements RecipePlanClient using the planRecipe action of environments.
+type UCPRecipePlanClient struct {
+	// Connection is the connection to UCP.
+	Connection sdk.Connection
+}
+
+// PlanRecipe returns the changes that deploying the resource described by the request would make to its
+// infrastructure, using the recipes of the environment. The plan runs as an async operation, which is polled until it
+// completes.
+func (c *UCPRecipePlanClient) PlanRecipe(ctx context.Context, environmentID string, request datamodel.RecipePlanRequest) (*recipes.PlanOutput, error) {
+	id, err := resources.ParseResource(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := corerpv20250801preview.Version
+	if strings.EqualFold(id.ProviderNamespace(), resources_radius.NamespaceApplicationsCore) {
+		apiVersion = corerpv20231001preview.Version
+	}
+
+	client, err := arm.NewClient("github.com/radius-project/radius/pkg/cli/clients", "v0.1.0", &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.Endpoint(), id.String(), datamodel.RecipePlanActionName))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, request); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	poller, err := runtime.NewPoller[recipes.PlanOutput](resp, client.Pipeline(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: planPollFrequency})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
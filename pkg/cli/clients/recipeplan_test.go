@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UCPRecipePlanClient_PlanRecipe(t *testing.T) {
+	const (
+		environmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+		operationPath = "/planes/radius/local/providers/Applications.Core/locations/global"
+	)
+	plan := recipes.PlanOutput{
+		Driver:  recipes.TemplateKindTerraform,
+		Kind:    recipes.PlanKindWhatIf,
+		Changes: []recipes.ResourceChange{{Address: "aws_s3_bucket.bucket", Type: "aws_s3_bucket", Action: recipes.PlanActionCreate}},
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case environmentID + "/" + datamodel.RecipePlanActionName:
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, corerpv20231001preview.Version, r.URL.Query().Get("api-version"))
+
+			request := datamodel.RecipePlanRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Equal(t, testResourceID, request.ResourceID)
+
+			w.Header().Set("Azure-AsyncOperation", server.URL+operationPath+"/operationStatuses/op?api-version="+corerpv20231001preview.Version)
+			w.Header().Set("Location", server.URL+operationPath+"/operationResults/op?api-version="+corerpv20231001preview.Version)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte("{}"))
+
+		case operationPath + "/operationStatuses/op":
+			_ = json.NewEncoder(w).Encode(v1.AsyncOperationStatus{Name: "op", Status: v1.ProvisioningStateSucceeded})
+
+		case operationPath + "/operationResults/op":
+			_ = json.NewEncoder(w).Encode(plan)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPRecipePlanClient{Connection: connection}
+
+	actual, err := client.PlanRecipe(context.Background(), environmentID, datamodel.RecipePlanRequest{ResourceID: testResourceID})
+	require.NoError(t, err)
+	require.Equal(t, &plan, actual)
+}
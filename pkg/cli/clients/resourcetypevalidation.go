@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+//go:generate mockgen -typed -destination=./mock_resourcetypevalidationclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients ResourceTypeValidationClient
+
+// ResourceTypeValidationClient is used to check the stored resources of a resource type against a schema.
+type ResourceTypeValidationClient interface {
+	// ValidateInstances validates the stored resources of a resource type against the proposed schema of one of its
+	// API versions, without changing the schema. The stored schema of the API version is used when schema is nil.
+	ValidateInstances(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, schema map[string]any) (*datamodel.ValidateInstancesResult, error)
+}
+
+var _ ResourceTypeValidationClient = (*UCPResourceTypeValidationClient)(nil)
+
+// UCPResourceTypeValidationClient implements ResourceTypeValidationClient using the validateInstances action of UCP.
+type UCPResourceTypeValidationClient struct {
+	// Connection is the connection to UCP.
+	Connection sdk.Connection
+}
+
+// ValidateInstances validates the stored resources of a resource type against the proposed schema of one of its
+// API versions, without changing the schema. The stored schema of the API version is used when schema is nil.
+func (c *UCPResourceTypeValidationClient) ValidateInstances(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, schema map[string]any) (*datamodel.ValidateInstancesResult, error) {
+	path := fmt.Sprintf("/planes/radius/%s/providers/System.Resources/resourceproviders/%s/resourcetypes/%s/apiversions/%s/%s?api-version=%s",
+		url.PathEscape(planeName),
+		url.PathEscape(resourceProviderName),
+		url.PathEscape(resourceTypeName),
+		url.PathEscape(apiVersionName),
+		datamodel.ValidateInstancesActionName,
+		v20231001preview.Version)
+
+	body, err := json.Marshal(datamodel.ValidateInstancesRequest{Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Connection.Endpoint()+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := &datamodel.ValidateInstancesResult{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import "github.com/radius-project/radius/pkg/cli/output"
+
+// GetQueueTableFormat returns the fields to output from a queue object.
+func GetQueueTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "QUEUE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "DEAD-LETTER SUPPORTED",
+				JSONPath: "{ .DeadLetterSupported }",
+			},
+			{
+				Heading:  "DEAD-LETTERED",
+				JSONPath: "{ .DeadLetterCount }",
+			},
+		},
+	}
+}
+
+// GetDeadLetterMessageTableFormat returns the fields to output from a dead-lettered message object.
+func GetDeadLetterMessageTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .DequeueCount }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+		},
+	}
+}
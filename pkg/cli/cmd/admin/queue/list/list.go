@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/admin/queue/common"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad admin queue list` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list [queue]",
+		Short: "List async operation queues or dead-lettered operations",
+		Long: `List async operation queues or dead-lettered operations.
+
+Without arguments, lists the async operation queues and the number of dead-lettered operations in each queue.
+
+With a queue name, lists the operations in the dead-letter queue of that queue. Operations are dead-lettered when
+they fail repeatedly, for example because the resource provider crashes while processing them.`,
+		Example: `
+# List async operation queues
+rad admin queue list
+
+# List dead-lettered operations of the 'radius' queue
+rad admin queue list radius
+
+# List dead-lettered operations of the 'radius' queue in JSON format
+rad admin queue list radius --output json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad admin queue list` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	QueueName string
+}
+
+// NewRunner creates an instance of the runner for the `rad admin queue list` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad admin queue list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	if len(args) > 0 {
+		r.QueueName = args[0]
+	}
+
+	return nil
+}
+
+// Run runs the `rad admin queue list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateQueueAdminClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if r.QueueName == "" {
+		queues, err := client.ListQueues(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.Output.WriteFormatted(r.Format, queues, common.GetQueueTableFormat())
+	}
+
+	messages, err := client.ListDeadLetterMessages(ctx, r.QueueName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The queue %q was not found.", r.QueueName)
+	} else if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, messages, common.GetDeadLetterMessageTableFormat())
+}
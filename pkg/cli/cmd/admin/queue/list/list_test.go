@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/admin/queue/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/admin"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: no queue",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: queue",
+			Input:         []string{"radius"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: queue with json output",
+			Input:         []string{"radius", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"radius", "dynamic-rp"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	t.Run("Success: List queues", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		queues := []admin.Queue{
+			{Name: "radius", DeadLetterSupported: true, DeadLetterCount: 2},
+			{Name: "dynamic-rp", DeadLetterSupported: true},
+		}
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			ListQueues(gomock.Any()).
+			Return(queues, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     queues,
+				Options: common.GetQueueTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: List dead-lettered messages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		messages := []admin.DeadLetterMessage{
+			{
+				ID:            "radius.1.abc",
+				DequeueCount:  6,
+				EnqueueAt:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				Reason:        "exceeded max retry count",
+				ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test",
+				OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+			},
+		}
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			ListDeadLetterMessages(gomock.Any(), "radius").
+			Return(messages, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			QueueName:         "radius",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     messages,
+				Options: common.GetDeadLetterMessageTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Queue not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			ListDeadLetterMessages(gomock.Any(), "unknown").
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            &output.MockOutput{},
+			QueueName:         "unknown",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The queue %q was not found.", "unknown"), err)
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	purgeAllConfirmation = "Are you sure you want to delete all dead-lettered messages of queue %q? The operations will not be retried."
+	purgeConfirmation    = "Are you sure you want to delete dead-lettered message %q of queue %q? The operation will not be retried."
+)
+
+// NewCommand creates an instance of the `rad admin queue purge` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [queue] [message id]",
+		Short: "Delete dead-lettered operations",
+		Long: `Delete dead-lettered operations.
+
+Deletes operations from the dead-letter queue so that they are never retried. Without a message id, deletes all
+dead-lettered operations of the queue.
+
+Use 'rad admin queue list [queue]' to find the ids of dead-lettered operations.`,
+		Example: `
+# Delete a dead-lettered operation of the 'radius' queue
+rad admin queue purge radius radius.1712345678.8f0c2a6b9e3d4f5a8b7c6d5e4f3a2b1c
+
+# Delete all dead-lettered operations of the 'radius' queue (bypass confirmation)
+rad admin queue purge radius --yes`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddConfirmationFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad admin queue purge` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	InputPrompter     prompt.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	Confirm   bool
+	QueueName string
+	MessageID string
+}
+
+// NewRunner creates an instance of the runner for the `rad admin queue purge` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		InputPrompter:     factory.GetPrompter(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad admin queue purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.QueueName = args[0]
+	if len(args) > 1 {
+		r.MessageID = args[1]
+	}
+
+	return nil
+}
+
+// Run runs the `rad admin queue purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateQueueAdminClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if !r.Confirm {
+		message := fmt.Sprintf(purgeAllConfirmation, r.QueueName)
+		if r.MessageID != "" {
+			message = fmt.Sprintf(purgeConfirmation, r.MessageID, r.QueueName)
+		}
+
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	if r.MessageID != "" {
+		err := client.DeleteDeadLetterMessage(ctx, r.QueueName, r.MessageID)
+		if clients.Is404Error(err) {
+			return clierrors.Message("The dead-lettered message %q was not found in queue %q.", r.MessageID, r.QueueName)
+		} else if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Deleted message %q.", r.MessageID)
+		return nil
+	}
+
+	deleted, err := client.PurgeDeadLetterMessages(ctx, r.QueueName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The queue %q was not found.", r.QueueName)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Deleted %d message(s) from queue %q.", deleted, r.QueueName)
+	return nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: queue",
+			Input:         []string{"radius"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: message id with confirmation",
+			Input:         []string{"radius", "radius.1.abc", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: not enough arguments",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"radius", "radius.1.abc", "radius.2.def"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	t.Run("Success: Purge queue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			PurgeDeadLetterMessages(gomock.Any(), "radius").
+			Return(3, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Output:            outputSink,
+			QueueName:         "radius",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Deleted %d message(s) from queue %q.",
+				Params: []any{3, "radius"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: Delete message with prompt confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			DeleteDeadLetterMessage(gomock.Any(), "radius", "radius.1.abc").
+			Return(nil).
+			Times(1)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeConfirmation, "radius.1.abc", "radius")).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			InputPrompter:     promptMock,
+			Workspace:         workspace,
+			Output:            outputSink,
+			QueueName:         "radius",
+			MessageID:         "radius.1.abc",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Deleted message %q.",
+				Params: []any{"radius.1.abc"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: Prompt cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeAllConfirmation, "radius")).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			InputPrompter:     promptMock,
+			Workspace:         workspace,
+			Output:            outputSink,
+			QueueName:         "radius",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Error: Queue not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			PurgeDeadLetterMessages(gomock.Any(), "unknown").
+			Return(0, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Output:            &output.MockOutput{},
+			QueueName:         "unknown",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The queue %q was not found.", "unknown"), err)
+	})
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import "github.com/spf13/cobra"
+
+// NewCommand returns a new cobra command for `rad admin queue`.
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queue",
+		Short: "Manage async operation queues",
+		Long: `Manage async operation queues.
+
+Radius processes long-running operations (such as deploying a resource) asynchronously using a queue. Operations that
+fail repeatedly are moved to the dead-letter queue instead of being retried forever. Use these commands to list the
+dead-lettered operations, and to requeue or delete them.`,
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeue
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad admin queue requeue` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "requeue [queue] [message id]",
+		Short: "Requeue dead-lettered operations",
+		Long: `Requeue dead-lettered operations.
+
+Moves an operation from the dead-letter queue back to its queue so that it is processed again. The operation is
+retried as if it was newly queued. Requeue an operation after fixing the problem that caused it to fail.
+
+Use 'rad admin queue list [queue]' to find the ids of dead-lettered operations.`,
+		Example: `
+# Requeue a dead-lettered operation of the 'radius' queue
+rad admin queue requeue radius radius.1712345678.8f0c2a6b9e3d4f5a8b7c6d5e4f3a2b1c
+
+# Requeue all dead-lettered operations of the 'radius' queue
+rad admin queue requeue radius --all`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	cmd.Flags().Bool("all", false, "Requeue all dead-lettered operations of the queue")
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad admin queue requeue` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	QueueName string
+	MessageID string
+	All       bool
+}
+
+// NewRunner creates an instance of the runner for the `rad admin queue requeue` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad admin queue requeue` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.All, err = cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	r.QueueName = args[0]
+	if len(args) > 1 {
+		r.MessageID = args[1]
+	}
+
+	if r.All && r.MessageID != "" {
+		return clierrors.Message("The --all flag cannot be used with a message id.")
+	} else if !r.All && r.MessageID == "" {
+		return clierrors.Message("Specify the id of the message to requeue, or use --all to requeue all dead-lettered messages.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad admin queue requeue` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateQueueAdminClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{r.MessageID}
+	if r.All {
+		messages, err := client.ListDeadLetterMessages(ctx, r.QueueName)
+		if clients.Is404Error(err) {
+			return clierrors.Message("The queue %q was not found.", r.QueueName)
+		} else if err != nil {
+			return err
+		}
+
+		ids = []string{}
+		for _, msg := range messages {
+			ids = append(ids, msg.ID)
+		}
+	}
+
+	for _, id := range ids {
+		err := client.RequeueDeadLetterMessage(ctx, r.QueueName, id)
+		if clients.Is404Error(err) {
+			return clierrors.Message("The dead-lettered message %q was not found in queue %q.", id, r.QueueName)
+		} else if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Requeued message %q.", id)
+	}
+
+	if r.All {
+		r.Output.LogInfo("Requeued %d message(s) from queue %q.", len(ids), r.QueueName)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/admin"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: message id",
+			Input:         []string{"radius", "radius.1.abc"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: all",
+			Input:         []string{"radius", "--all"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: message id and all",
+			Input:         []string{"radius", "radius.1.abc", "--all"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: no message id",
+			Input:         []string{"radius"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: not enough arguments",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"radius", "radius.1.abc", "radius.2.def"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	t.Run("Success: Requeue message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			RequeueDeadLetterMessage(gomock.Any(), "radius", "radius.1.abc").
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Output:            outputSink,
+			QueueName:         "radius",
+			MessageID:         "radius.1.abc",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Requeued message %q.",
+				Params: []any{"radius.1.abc"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: Requeue all messages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			ListDeadLetterMessages(gomock.Any(), "radius").
+			Return([]admin.DeadLetterMessage{{ID: "radius.1.abc"}, {ID: "radius.2.def"}}, nil).
+			Times(1)
+		client.EXPECT().
+			RequeueDeadLetterMessage(gomock.Any(), "radius", "radius.1.abc").
+			Return(nil).
+			Times(1)
+		client.EXPECT().
+			RequeueDeadLetterMessage(gomock.Any(), "radius", "radius.2.def").
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Output:            outputSink,
+			QueueName:         "radius",
+			All:               true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Requeued message %q.",
+				Params: []any{"radius.1.abc"},
+			},
+			output.LogOutput{
+				Format: "Requeued message %q.",
+				Params: []any{"radius.2.def"},
+			},
+			output.LogOutput{
+				Format: "Requeued %d message(s) from queue %q.",
+				Params: []any{2, "radius"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Message not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockQueueAdminClient(ctrl)
+		client.EXPECT().
+			RequeueDeadLetterMessage(gomock.Any(), "radius", "radius.1.abc").
+			Return(&azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{QueueAdminClient: client},
+			Workspace:         workspace,
+			Output:            &output.MockOutput{},
+			QueueName:         "radius",
+			MessageID:         "radius.1.abc",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The dead-lettered message %q was not found in queue %q.", "radius.1.abc", "radius"), err)
+	})
+}
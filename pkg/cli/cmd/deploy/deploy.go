@@ -79,6 +79,9 @@ When passing multiple parameters in a single file, use the format described here
 
 You can specify parameters using multiple sources. Parameters can be overridden based on the 
 order they are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+Use the '--what-if' flag to preview the infrastructure changes that the recipes of the resources in the template
+would make, without deploying the template. This requires an existing environment.
 `,
 		Example: `
 # deploy a Bicep template
@@ -114,6 +117,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the infrastructure changes made by recipes without deploying
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -124,6 +130,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Preview the infrastructure changes made by recipes without deploying the template")
 
 	return cmd, runner
 }
@@ -146,6 +153,7 @@ type Runner struct {
 	Workspace                *workspaces.Workspace
 	Providers                *clients.Providers
 	EnvResult                *EnvironmentCheckResult
+	WhatIf                   bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -218,6 +226,18 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.EnvironmentNameOrID = ""
 	}
 
+	// rad run shares this validation but does not define the what-if flag.
+	if cmd.Flags().Lookup("what-if") != nil {
+		r.WhatIf, err = cmd.Flags().GetBool("what-if")
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.WhatIf && r.EnvironmentNameOrID == "" {
+		return clierrors.Message("The --what-if flag requires an existing environment. Use --environment to specify the environment name.")
+	}
+
 	// This might be empty, and that's fine!
 	r.ApplicationName, err = cli.ReadApplicationName(cmd, *workspace)
 	if err != nil {
@@ -277,6 +297,11 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Previewing the changes doesn't deploy anything, including the application.
+	if r.WhatIf {
+		return r.whatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	// Note: This only applies when the environment already exists. If the template is creating the environment,
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+// planActionSymbols are the symbols used to display the actions of the recipe plans.
+var planActionSymbols = map[string]string{
+	recipes.PlanActionCreate:  "+",
+	recipes.PlanActionUpdate:  "~",
+	recipes.PlanActionReplace: "-/+",
+	recipes.PlanActionDelete:  "-",
+}
+
+// whatIf previews the infrastructure changes that the recipes of the resources in the template would make,
+// without deploying the template.
+func (r *Runner) whatIf(ctx context.Context, template map[string]any) error {
+	resources := bicep.FindRecipeResources(template, r.Parameters)
+	if len(resources) == 0 {
+		r.Output.LogInfo("The template %q does not contain any resources deployed with a recipe.", r.FilePath)
+		return nil
+	}
+
+	client, err := r.ConnectionFactory.CreateRecipePlanClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Previewing the changes made by recipes for template '%v' in environment '%v' from workspace '%v'...\n", r.FilePath, r.EnvironmentNameOrID, r.Workspace.Name)
+
+	total := map[string]int{}
+	listed := 0
+	failures := []string{}
+	for _, resource := range resources {
+		applicationID := resource.ApplicationID
+		if applicationID == "" {
+			applicationID = r.Providers.Radius.ApplicationID
+		}
+
+		plan, err := client.PlanRecipe(ctx, r.Providers.Radius.EnvironmentID, datamodel.RecipePlanRequest{
+			ResourceID:    r.Workspace.Scope + "/providers/" + resource.Type + "/" + resource.Name,
+			ApplicationID: applicationID,
+			RecipeName:    resource.RecipeName,
+			Parameters:    resource.RecipeParameters,
+			Properties:    resource.Properties,
+		})
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("  - %s (%s): %v", resource.SymbolicName, resource.Type, err))
+			continue
+		}
+
+		r.Output.LogInfo("%s (%s) using %s:", resource.SymbolicName, resource.Type, plan.Driver)
+
+		// The driver cannot preview the changes: the plan only lists the resources declared by the recipe.
+		if plan.Kind == recipes.PlanKindResourceList {
+			for _, change := range plan.Changes {
+				r.Output.LogInfo("  * %s", change.Address)
+			}
+			listed += len(plan.Changes)
+
+			for _, message := range plan.Messages {
+				r.Output.LogInfo("  %s", message)
+			}
+			r.Output.LogInfo("")
+			continue
+		}
+
+		changed := false
+		for _, change := range plan.Changes {
+			symbol, ok := planActionSymbols[change.Action]
+			if !ok {
+				continue
+			}
+
+			r.Output.LogInfo("  %s %s", symbol, change.Address)
+			total[change.Action]++
+			changed = true
+		}
+
+		if !changed {
+			r.Output.LogInfo("  No changes.")
+		}
+
+		for _, message := range plan.Messages {
+			r.Output.LogInfo("  %s", message)
+		}
+		r.Output.LogInfo("")
+	}
+
+	if listed > 0 {
+		r.Output.LogInfo("%d resources are listed without a preview of their changes.", listed)
+	}
+	r.Output.LogInfo("Plan: %d to create, %d to update, %d to replace, %d to delete.",
+		total[recipes.PlanActionCreate],
+		total[recipes.PlanActionUpdate],
+		total[recipes.PlanActionReplace],
+		total[recipes.PlanActionDelete])
+
+	if len(failures) > 0 {
+		return clierrors.Message("The changes of the following resources could not be previewed:\n\n%v", strings.Join(failures, "\n"))
+	}
+
+	return nil
+}
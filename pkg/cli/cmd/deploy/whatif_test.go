@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Run_WhatIf(t *testing.T) {
+	environmentID := fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Applications.Core/environments/%s", radcli.TestEnvironmentName, radcli.TestEnvironmentName)
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	template := map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{},
+		},
+		"resources": map[string]any{
+			"redis": map[string]any{
+				"type": "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"name": "redis",
+				"properties": map[string]any{
+					"environment": "[parameters('environment')]",
+					"recipe": map[string]any{
+						"name": "custom",
+					},
+				},
+			},
+			"container": map[string]any{
+				"type": "Applications.Core/containers@2023-10-01-preview",
+				"name": "container",
+			},
+		},
+	}
+	expectedRequest := datamodel.RecipePlanRequest{
+		ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+		RecipeName: "custom",
+		Properties: map[string]any{
+			"environment": environmentID,
+			"recipe": map[string]any{
+				"name": "custom",
+			},
+		},
+	}
+
+	newRunner := func(ctrl *gomock.Controller, planClient clients.RecipePlanClient, outputSink *output.MockOutput) *Runner {
+		return &Runner{
+			Bicep:               bicep.NewMockInterface(ctrl),
+			ConnectionFactory:   &connections.MockFactory{RecipePlanClient: planClient},
+			Deploy:              deploy.NewMockInterface(ctrl),
+			Output:              outputSink,
+			Providers:           &clients.Providers{Radius: &clients.RadiusProvider{EnvironmentID: environmentID}},
+			EnvironmentNameOrID: radcli.TestEnvironmentName,
+			FilePath:            "app.bicep",
+			Parameters:          map[string]map[string]any{},
+			Workspace:           workspace,
+			Template:            template,
+			WhatIf:              true,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		planClient := clients.NewMockRecipePlanClient(ctrl)
+		planClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, expectedRequest).
+			Return(&recipes.PlanOutput{
+				Driver: "terraform",
+				Kind:   recipes.PlanKindWhatIf,
+				Changes: []recipes.ResourceChange{
+					{Address: "azurerm_redis_cache.cache", Action: recipes.PlanActionCreate},
+					{Address: "azurerm_resource_group.rg", Action: recipes.PlanActionNoOp},
+					{Address: "random_password.password", Action: recipes.PlanActionReplace},
+				},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := newRunner(ctrl, planClient, outputSink)
+
+		// No deployment is expected.
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "%s (%s) using %s:", Params: []any{"redis", "Applications.Datastores/redisCaches", "terraform"}})
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "  %s %s", Params: []any{"+", "azurerm_redis_cache.cache"}})
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "  %s %s", Params: []any{"-/+", "random_password.password"}})
+		require.NotContains(t, outputSink.Writes, output.LogOutput{Format: "  %s %s", Params: []any{"", "azurerm_resource_group.rg"}})
+		require.Equal(t, output.LogOutput{
+			Format: "Plan: %d to create, %d to update, %d to replace, %d to delete.",
+			Params: []any{1, 0, 1, 0},
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Resource list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		planClient := clients.NewMockRecipePlanClient(ctrl)
+		planClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, expectedRequest).
+			Return(&recipes.PlanOutput{
+				Driver: "bicep",
+				Kind:   recipes.PlanKindResourceList,
+				Changes: []recipes.ResourceChange{
+					{Address: "cache", Type: "Microsoft.Cache/redis"},
+				},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := newRunner(ctrl, planClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "  * %s", Params: []any{"cache"}})
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "%d resources are listed without a preview of their changes.", Params: []any{1}})
+		require.Equal(t, output.LogOutput{
+			Format: "Plan: %d to create, %d to update, %d to replace, %d to delete.",
+			Params: []any{0, 0, 0, 0},
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Plan failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		planClient := clients.NewMockRecipePlanClient(ctrl)
+		planClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, expectedRequest).
+			Return(nil, errors.New("recipe not found")).
+			Times(1)
+
+		runner := newRunner(ctrl, planClient, &output.MockOutput{})
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Equal(t, "The changes of the following resources could not be previewed:\n\n  - redis (Applications.Datastores/redisCaches): recipe not found", err.Error())
+	})
+
+	t.Run("No recipe resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		outputSink := &output.MockOutput{}
+		runner := newRunner(ctrl, nil, outputSink)
+		runner.Template = map[string]any{}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{Format: "The template %q does not contain any resources deployed with a recipe.", Params: []any{"app.bicep"}},
+		}, outputSink.Writes)
+	})
+}
@@ -130,6 +130,21 @@ func (r *Runner) Run(ctx context.Context) error {
 					TemplateKind:    *c.TemplateKind,
 					TemplateVersion: *c.TemplateVersion,
 				}
+			case *corerp.OpenTofuRecipeProperties:
+				recipe = types.EnvironmentRecipe{
+					Name:            recipeName,
+					ResourceType:    resourceType,
+					TemplatePath:    *c.TemplatePath,
+					TemplateKind:    *c.TemplateKind,
+					TemplateVersion: *c.TemplateVersion,
+				}
+			case *corerp.PulumiRecipeProperties:
+				recipe = types.EnvironmentRecipe{
+					Name:         recipeName,
+					ResourceType: resourceType,
+					TemplatePath: *c.TemplatePath,
+					TemplateKind: *c.TemplateKind,
+				}
 			case *corerp.BicepRecipeProperties:
 				recipe = types.EnvironmentRecipe{
 					Name:         recipeName,
@@ -70,7 +70,7 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().String("template-kind", "", "specify the kind for the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-kind")
-	cmd.Flags().String("template-version", "", "specify the version for the terraform module.")
+	cmd.Flags().String("template-version", "", "specify the version for the terraform or opentofu module.")
 	cmd.Flags().String("template-path", "", "specify the path to the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
@@ -193,6 +193,19 @@ func (r *Runner) Run(ctx context.Context) error {
 			TemplateVersion: &r.TemplateVersion,
 			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
 		}
+	case recipes.TemplateKindOpenTofu:
+		properties = &corerp.OpenTofuRecipeProperties{
+			TemplateKind:    &r.TemplateKind,
+			TemplatePath:    &r.TemplatePath,
+			TemplateVersion: &r.TemplateVersion,
+			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	case recipes.TemplateKindPulumi:
+		properties = &corerp.PulumiRecipeProperties{
+			TemplateKind: &r.TemplateKind,
+			TemplatePath: &r.TemplatePath,
+			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+		}
 	case recipes.TemplateKindBicep:
 		properties = &corerp.BicepRecipeProperties{
 			TemplateKind: &r.TemplateKind,
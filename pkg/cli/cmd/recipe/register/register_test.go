@@ -456,4 +456,78 @@ func Test_Run(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expectedOutput, outputSink.Writes)
 	})
+
+	t.Run("Register OpenTofu and Pulumi recipes", func(t *testing.T) {
+		tests := []struct {
+			templateKind string
+			templatePath string
+			expected     v20231001preview.RecipePropertiesClassification
+		}{
+			{
+				templateKind: recipes.TemplateKindOpenTofu,
+				templatePath: "Azure/redis/azurerm",
+				expected: &v20231001preview.OpenTofuRecipeProperties{
+					TemplateKind:    new(recipes.TemplateKindOpenTofu),
+					TemplatePath:    new("Azure/redis/azurerm"),
+					TemplateVersion: new("1.1.0"),
+					Parameters:      map[string]any{},
+				},
+			},
+			{
+				templateKind: recipes.TemplateKindPulumi,
+				templatePath: "git::https://github.com/radius-project/recipes.git//pulumi/redis",
+				expected: &v20231001preview.PulumiRecipeProperties{
+					TemplateKind: new(recipes.TemplateKindPulumi),
+					TemplatePath: new("git::https://github.com/radius-project/recipes.git//pulumi/redis"),
+					Parameters:   map[string]any{},
+				},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.templateKind, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+
+				envResource := v20231001preview.EnvironmentResource{
+					ID:       new("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+					Name:     new("kind-kind"),
+					Type:     new("applications.core/environments"),
+					Location: to.Ptr(v1.LocationGlobal),
+					Properties: &v20231001preview.EnvironmentProperties{
+						Compute: &v20231001preview.KubernetesCompute{
+							Namespace: new("default"),
+						},
+					},
+				}
+
+				appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+				appManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), gomock.Any()).
+					Return(envResource, nil).Times(1)
+
+				var registered v20231001preview.RecipePropertiesClassification
+				appManagementClient.EXPECT().
+					CreateOrUpdateEnvironment(context.Background(), "kind-kind", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, env *v20231001preview.EnvironmentResource) error {
+						registered = env.Properties.Recipes[ds_ctrl.RedisCachesResourceType]["redis"]
+						return nil
+					}).Times(1)
+
+				runner := &Runner{
+					ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+					Output:            &output.MockOutput{},
+					Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+					TemplateKind:      tc.templateKind,
+					TemplatePath:      tc.templatePath,
+					TemplateVersion:   "1.1.0",
+					ResourceType:      ds_ctrl.RedisCachesResourceType,
+					RecipeName:        "redis",
+				}
+
+				err := runner.Run(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, registered)
+			})
+		}
+	})
 }
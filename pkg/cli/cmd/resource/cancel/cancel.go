@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	cancelConfirmation = "Are you sure you want to cancel the operation in progress on resource '%v' of type %v? The resource may be left partially provisioned."
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource cancel` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel [resourceType] [resourceName]",
+		Short: "Cancel the operation in progress on a Radius resource",
+		Long: `Cancel the operation in progress on a Radius resource.
+
+Requests cancellation of the deployment or deletion that is in progress on the resource, for example a recipe that is
+stuck. Cancellation is asynchronous: the operation is stopped by Radius shortly after it is requested, and the
+provisioning state of the resource is set to 'Canceled'. The resource may be left partially provisioned; deploy or
+delete it again once the operation is canceled.`,
+		Example: `
+# Cancel the operation in progress on a container named orders
+rad resource cancel Applications.Core/containers orders
+
+# Cancel the operation in progress on a container named orders without prompting for confirmation
+rad resource cancel Applications.Core/containers orders --yes`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource cancel` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	InputPrompter                  prompt.Interface
+	Confirm                        bool
+}
+
+// NewRunner creates a new instance of the `rad resource cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad resource cancel` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	r.Confirm = yes
+
+	return nil
+}
+
+// Run runs the `rad resource cancel` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Use the id returned by the resource provider, so that it matches the id recorded in the operation status.
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	} else if err != nil {
+		return err
+	}
+
+	operationsClient, err := r.ConnectionFactory.CreateOperationsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := operationsClient.ListOperationStatuses(ctx, to.String(resource.ID))
+	if err != nil {
+		return err
+	}
+
+	inProgress := []string{}
+	for _, status := range statuses {
+		if !status.Status.IsTerminal() {
+			inProgress = append(inProgress, status.ID)
+		}
+	}
+
+	if len(inProgress) == 0 {
+		r.Output.LogInfo("Resource %q of type %q has no operation in progress.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	}
+
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(cancelConfirmation, r.ResourceName, r.FullyQualifiedResourceTypeName), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			r.Output.LogInfo("Operation on resource %q of type %q NOT canceled", r.ResourceName, r.FullyQualifiedResourceTypeName)
+			return nil
+		}
+	}
+
+	canceled := 0
+	for _, id := range inProgress {
+		err := operationsClient.CancelOperation(ctx, id)
+		if clients.Is404Error(err) || isConflictError(err) {
+			// The operation completed or expired since it was listed.
+			continue
+		} else if err != nil {
+			return err
+		}
+		canceled++
+	}
+
+	if canceled == 0 {
+		r.Output.LogInfo("Resource %q of type %q has no operation in progress.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	}
+
+	r.Output.LogInfo("Cancellation requested for the operation in progress on resource %q of type %q. Use 'rad resource show' to check its provisioning state.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	return nil
+}
+
+// isConflictError returns true if the error is a 409 response, which is returned when the operation has already completed.
+func isConflictError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && responseError.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceType = "Applications.Core/containers"
+	testResourceName = "test-container"
+	testResourceID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"
+	testOperationID  = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Cancel Command",
+			Input:         []string{testResourceType, "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Cancel Command with fallback workspace",
+			Input:         []string{testResourceType, "foo", "-g", "my-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Cancel Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Cancel Command with insufficient args",
+			Input:         []string{testResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	setup := func(t *testing.T) (*clients.MockApplicationsManagementClient, *clients.MockOperationsClient, *output.MockOutput, *Runner) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		operationsClient := clients.NewMockOperationsClient(ctrl)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				OperationsClient:             operationsClient,
+			},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Confirm:                        true,
+		}
+
+		return appManagementClient, operationsClient, outputSink, runner
+	}
+
+	t.Run("Success: operation canceled", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationStatuses(gomock.Any(), testResourceID).
+			Return([]v1.AsyncOperationStatus{
+				{ID: testOperationID + "-done", Status: v1.ProvisioningStateSucceeded},
+				{ID: testOperationID, Status: v1.ProvisioningStateUpdating},
+			}, nil)
+		operationsClient.EXPECT().
+			CancelOperation(gomock.Any(), testOperationID).
+			Return(nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Cancellation requested for the operation in progress on resource %q of type %q. Use 'rad resource show' to check its provisioning state.",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: prompt confirmed", func(t *testing.T) {
+		appManagementClient, operationsClient, _, runner := setup(t)
+		promptMock := prompt.NewMockInterface(gomock.NewController(t))
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(cancelConfirmation, testResourceName, testResourceType)).
+			Return(prompt.ConfirmYes, nil)
+		runner.InputPrompter = promptMock
+		runner.Confirm = false
+
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationStatuses(gomock.Any(), testResourceID).
+			Return([]v1.AsyncOperationStatus{{ID: testOperationID, Status: v1.ProvisioningStateAccepted}}, nil)
+		operationsClient.EXPECT().
+			CancelOperation(gomock.Any(), testOperationID).
+			Return(nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Success: prompt denied", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		promptMock := prompt.NewMockInterface(gomock.NewController(t))
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(cancelConfirmation, testResourceName, testResourceType)).
+			Return(prompt.ConfirmNo, nil)
+		runner.InputPrompter = promptMock
+		runner.Confirm = false
+
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationStatuses(gomock.Any(), testResourceID).
+			Return([]v1.AsyncOperationStatus{{ID: testOperationID, Status: v1.ProvisioningStateUpdating}}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation on resource %q of type %q NOT canceled",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: no operation in progress", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationStatuses(gomock.Any(), testResourceID).
+			Return([]v1.AsyncOperationStatus{{ID: testOperationID, Status: v1.ProvisioningStateSucceeded}}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource %q of type %q has no operation in progress.",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: operation completed before cancellation", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationStatuses(gomock.Any(), testResourceID).
+			Return([]v1.AsyncOperationStatus{{ID: testOperationID, Status: v1.ProvisioningStateUpdating}}, nil)
+		operationsClient.EXPECT().
+			CancelOperation(gomock.Any(), testOperationID).
+			Return(&azcore.ResponseError{StatusCode: http.StatusConflict})
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource %q of type %q has no operation in progress.",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Failure: resource not found", func(t *testing.T) {
+		appManagementClient, _, _, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q was not found.", testResourceName, testResourceType), err)
+	})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource drift` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "drift [resourceType] [resourceName]",
+		Short: "Show the drift of the infrastructure deployed by the recipe of a Radius resource",
+		Long: `Show the drift of the infrastructure deployed by the recipe of a Radius resource.
+
+Radius periodically compares the infrastructure deployed by recipes with its actual state when drift detection is
+enabled. This command shows the result of the latest check: whether the infrastructure is in sync, and the
+resources that were modified or deleted outside of Radius. Redeploying the resource restores the infrastructure
+and clears the drift.`,
+		Example: `
+# Show the drift of a Redis cache named cache
+rad resource drift Applications.Datastores/redisCaches cache
+
+# Show the drift of a Redis cache named cache in JSON format
+rad resource drift Applications.Datastores/redisCaches cache --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource drift` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource drift` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource drift` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource drift` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	status, err := driftStatus(resource.Properties)
+	if err != nil {
+		return err
+	}
+
+	if status == nil && r.Format != output.FormatJson {
+		r.Output.LogInfo("Drift has not been checked yet for resource %q of type %q. Drift is checked periodically for resources deployed by a recipe when drift detection is enabled.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	}
+
+	err = r.Output.WriteFormatted(r.Format, status, driftStatusFormat())
+	if err != nil {
+		return err
+	}
+
+	// The drifted resources are part of the JSON output already.
+	if r.Format == output.FormatJson || len(status.DriftedResources) == 0 {
+		return nil
+	}
+
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(r.Format, status.DriftedResources, driftedResourcesFormat())
+}
+
+// driftStatus returns the drift status of a resource from its properties, or nil if drift was not checked.
+func driftStatus(properties map[string]any) (*rpv1.DriftStatus, error) {
+	status, ok := properties["status"].(map[string]any)
+	if !ok || status["driftStatus"] == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(status["driftStatus"])
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpv1.DriftStatus{}
+	if err := json.Unmarshal(b, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
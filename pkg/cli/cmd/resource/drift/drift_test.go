@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceType = "Applications.Datastores/redisCaches"
+	testResourceName = "test-cache"
+	testScope        = "/planes/radius/local/resourceGroups/test-group"
+	testResourceID   = testScope + "/providers/Applications.Datastores/redisCaches/test-cache"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Drift Command",
+			Input:         []string{testResourceType, "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with json output",
+			Input:         []string{testResourceType, "foo", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with insufficient args",
+			Input:         []string{testResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	setup := func(t *testing.T, format string) (*clients.MockApplicationsManagementClient, *output.MockOutput, *Runner) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+			},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{Scope: testScope},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Format:                         format,
+		}
+
+		return appManagementClient, outputSink, runner
+	}
+
+	checked := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	driftedResource := generated.GenericResource{
+		ID: to.Ptr(testResourceID),
+		Properties: map[string]any{
+			"status": map[string]any{
+				"driftStatus": map[string]any{
+					"state":           "Drifted",
+					"lastCheckedTime": checked.Format(time.RFC3339),
+					"driftedResources": []any{
+						map[string]any{"address": "aws_memorydb_cluster.cache", "type": "aws_memorydb_cluster", "name": "cache", "change": "Modified"},
+					},
+				},
+			},
+		},
+	}
+	expectedStatus := &rpv1.DriftStatus{
+		State:           rpv1.DriftStateDrifted,
+		LastCheckedTime: checked,
+		DriftedResources: []rpv1.DriftedResource{
+			{Address: "aws_memorydb_cluster.cache", Type: "aws_memorydb_cluster", Name: "cache", Change: rpv1.DriftChangeModified},
+		},
+	}
+
+	t.Run("Success: drifted", func(t *testing.T) {
+		appManagementClient, outputSink, runner := setup(t, output.FormatTable)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(driftedResource, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatTable,
+				Obj:     expectedStatus,
+				Options: driftStatusFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  output.FormatTable,
+				Obj:     expectedStatus.DriftedResources,
+				Options: driftedResourcesFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: drifted in JSON format", func(t *testing.T) {
+		appManagementClient, outputSink, runner := setup(t, output.FormatJson)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(driftedResource, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     expectedStatus,
+				Options: driftStatusFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: not checked", func(t *testing.T) {
+		appManagementClient, outputSink, runner := setup(t, output.FormatTable)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID), Properties: map[string]any{}}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Drift has not been checked yet for resource %q of type %q. Drift is checked periodically for resources deployed by a recipe when drift detection is enabled.",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import "github.com/radius-project/radius/pkg/cli/output"
+
+// driftStatusFormat returns the columns to output from the drift status of a resource.
+func driftStatusFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "STATE",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "LAST CHECKED",
+				JSONPath: "{ .LastCheckedTime }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
+
+// driftedResourcesFormat returns the columns to output from the resources changed outside of Radius.
+func driftedResourcesFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "ADDRESS",
+				JSONPath: "{ .Address }",
+			},
+		},
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource history` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "history [resourceType] [resourceName]",
+		Short: "Show the operation history of a Radius resource",
+		Long: `Show the operation history of a Radius resource.
+
+Lists the deployments and deletions of the resource, most recent first, with the caller who started them, the api
+version of the request and their result. The history is kept for the retention configured for Radius (30 days by
+default), including after the resource is deleted.`,
+		Example: `
+# Show the operation history of a container named orders
+rad resource history Applications.Core/containers orders
+
+# Show the operation history of a container named orders, including error details, in JSON format
+rad resource history Applications.Core/containers orders --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource history` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource history` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource history` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	resourceID := r.Workspace.Scope + "/providers/" + r.FullyQualifiedResourceTypeName + "/" + r.ResourceName
+
+	// The history is kept after the resource is deleted. When the resource exists, use the id returned by the
+	// resource provider, so that it matches the id recorded in the history.
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if err == nil {
+		resourceID = to.String(resource.ID)
+	} else if !clients.Is404Error(err) {
+		return err
+	}
+
+	operationsClient, err := r.ConnectionFactory.CreateOperationsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	entries, err := operationsClient.ListOperationHistory(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 && r.Format != output.FormatJson {
+		r.Output.LogInfo("No operation history found for resource %q of type %q.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, entries, historyFormat())
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	operationhistory "github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceType = "Applications.Core/containers"
+	testResourceName = "test-container"
+	testScope        = "/planes/radius/local/resourceGroups/test-group"
+	testResourceID   = testScope + "/providers/Applications.Core/containers/test-container"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid History Command",
+			Input:         []string{testResourceType, "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with json output",
+			Input:         []string{testResourceType, "foo", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with fallback workspace",
+			Input:         []string{testResourceType, "foo", "-g", "my-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "History Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with insufficient args",
+			Input:         []string{testResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	setup := func(t *testing.T) (*clients.MockApplicationsManagementClient, *clients.MockOperationsClient, *output.MockOutput, *Runner) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		operationsClient := clients.NewMockOperationsClient(ctrl)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				OperationsClient:             operationsClient,
+			},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{Scope: testScope},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Format:                         output.FormatTable,
+		}
+
+		return appManagementClient, operationsClient, outputSink, runner
+	}
+
+	entries := []operationhistory.Entry{
+		{
+			Name:          "00000000-0000-0000-0000-000000000002",
+			OperationType: "APPLICATIONS.CORE/CONTAINERS|DELETE",
+			Status:        v1.ProvisioningStateFailed,
+			StartTime:     time.Now(),
+			Error:         &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"},
+		},
+		{
+			Name:          "00000000-0000-0000-0000-000000000001",
+			OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+			Status:        v1.ProvisioningStateSucceeded,
+			StartTime:     time.Now().Add(-time.Hour),
+		},
+	}
+
+	t.Run("Success: resource exists", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationHistory(gomock.Any(), testResourceID).
+			Return(entries, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatTable,
+				Obj:     entries,
+				Options: historyFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: resource deleted", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+		operationsClient.EXPECT().
+			ListOperationHistory(gomock.Any(), testResourceID).
+			Return(entries, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Len(t, outputSink.Writes, 1)
+	})
+
+	t.Run("Success: no history", func(t *testing.T) {
+		appManagementClient, operationsClient, outputSink, runner := setup(t)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{ID: to.Ptr(testResourceID)}, nil)
+		operationsClient.EXPECT().
+			ListOperationHistory(gomock.Any(), testResourceID).
+			Return([]operationhistory.Entry{}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No operation history found for resource %q of type %q.",
+				Params: []any{testResourceName, testResourceType},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
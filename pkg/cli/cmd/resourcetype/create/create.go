@@ -18,6 +18,8 @@ package create
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
@@ -37,6 +39,11 @@ const (
 	msgAllResourceTypesCreated    = "All resource types in the manifest created successfully"
 )
 
+const (
+	flagCheckBreakingChanges = "check-breaking-changes"
+	flagForce                = "force"
+)
+
 // NewCommand creates an instance of the `rad resource-type create` command and runner.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
@@ -53,6 +60,8 @@ Input can be passed in using a JSON or YAML file using the --from-file option.
 The resource type name argument is optional. If specified, only the specified type is created/updated. If not specified, all resource types in the referenced file are created/updated.
 
 The resource type name argument is the simple name (e.g., 'testResources') not the fully qualified name.
+
+Use --check-breaking-changes to compare the schemas in the file with the schemas already stored in Radius before creating anything. An API version that already exists is compared with its stored schema, and a new API version is compared with the latest stored API version. Breaking changes, such as a removed property, a narrowed enum or a new required property, can invalidate existing resources, so the command refuses to apply them unless --force is also specified.
 `,
 		Example: `
 # Create a specific resource type from a YAML file
@@ -66,6 +75,12 @@ rad resource-type create --from-file /path/to/input.yaml
  
 # Create all resource types from a JSON file
 rad resource-type create --from-file /path/to/input.json
+
+# Create a resource type, refusing breaking changes to the stored schemas
+rad resource-type create myType --from-file /path/to/input.yaml --check-breaking-changes
+
+# Create a resource type even if the schema changes are breaking
+rad resource-type create myType --from-file /path/to/input.yaml --check-breaking-changes --force
 `,
 		Args: cobra.MaximumNArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -74,6 +89,8 @@ rad resource-type create --from-file /path/to/input.json
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
+	cmd.Flags().BoolVar(&runner.CheckBreakingChanges, flagCheckBreakingChanges, false, "Compare the schemas with the stored schemas and refuse breaking changes")
+	cmd.Flags().BoolVar(&runner.Force, flagForce, false, "Apply breaking schema changes detected by --check-breaking-changes")
 
 	return cmd, runner
 }
@@ -89,6 +106,8 @@ type Runner struct {
 	ResourceProviderManifestFilePath string
 	ResourceProvider                 *manifest.ResourceProvider
 	ResourceTypeName                 string
+	CheckBreakingChanges             bool
+	Force                            bool
 	Logger                           func(format string, args ...any)
 }
 
@@ -120,6 +139,10 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	if r.Force && !r.CheckBreakingChanges {
+		return clierrors.Message("The --%s flag can only be used with --%s.", flagForce, flagCheckBreakingChanges)
+	}
+
 	r.ResourceProvider, err = manifest.ValidateManifest(cmd.Context(), r.ResourceProviderManifestFilePath)
 	if err != nil {
 		return err
@@ -148,6 +171,17 @@ func (r *Runner) Run(ctx context.Context) error {
 		r.UCPClientFactory = clientFactory
 	}
 
+	if r.CheckBreakingChanges {
+		typeNames := []string{r.ResourceTypeName}
+		if r.ResourceTypeName == "" {
+			typeNames = slices.Sorted(maps.Keys(r.ResourceProvider.Types))
+		}
+
+		if err := r.checkBreakingChanges(ctx, typeNames); err != nil {
+			return err
+		}
+	}
+
 	if r.ResourceTypeName == "" {
 		r.Output.LogInfo(msgNoResourceTypeNameProvided)
 		return r.registerTypes(ctx, nil) // Register all types
@@ -156,6 +190,42 @@ func (r *Runner) Run(ctx context.Context) error {
 	return r.registerTypes(ctx, []string{r.ResourceTypeName}) // Register single type
 }
 
+// checkBreakingChanges compares the schemas of the specified resource types with the stored schemas, and returns an
+// error if there are breaking changes and --force is not specified.
+func (r *Runner) checkBreakingChanges(ctx context.Context, typeNames []string) error {
+	breaking := 0
+	for _, typeName := range typeNames {
+		comparisons, err := manifest.CompareWithStoredSchemas(ctx, r.UCPClientFactory, defaultPlaneName, *r.ResourceProvider, typeName)
+		if err != nil {
+			return err
+		}
+
+		for _, comparison := range comparisons {
+			if len(comparison.Diff.Changes) == 0 {
+				r.Output.LogInfo("No schema changes in %s@%s compared with stored API version %s.", comparison.ResourceType, comparison.APIVersion, comparison.BaselineAPIVersion)
+				continue
+			}
+
+			r.Output.LogInfo("Schema changes in %s@%s compared with stored API version %s:", comparison.ResourceType, comparison.APIVersion, comparison.BaselineAPIVersion)
+			for _, change := range comparison.Diff.Changes {
+				r.Output.LogInfo("  %s", change.String())
+			}
+			breaking += len(comparison.Diff.BreakingChanges())
+		}
+	}
+
+	if breaking == 0 {
+		return nil
+	}
+
+	if r.Force {
+		r.Output.LogInfo("Applying %d breaking schema change(s) because --%s was specified.", breaking, flagForce)
+		return nil
+	}
+
+	return clierrors.Message("Found %d breaking schema change(s). Existing resources may no longer be valid. Use --%s to apply the changes anyway.", breaking, flagForce)
+}
+
 // registerTypes registers the specified resource types (or all types if typeNames is nil)
 func (r *Runner) registerTypes(ctx context.Context, typeNames []string) error {
 	// Always ensure the resource provider exists first
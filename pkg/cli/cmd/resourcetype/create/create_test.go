@@ -51,6 +51,18 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: true,
 			ConfigHolder:  framework.ConfigHolder{Config: config},
 		},
+		{
+			Name:          "Valid: check breaking changes with force",
+			Input:         []string{"testResources", "--from-file", "testdata/valid.yaml", "--check-breaking-changes", "--force"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: force without check breaking changes",
+			Input:         []string{"testResources", "--from-file", "testdata/valid.yaml", "--force"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
 		{
 			Name:          "Invalid: resource type not present in manifest",
 			Input:         []string{"myResources", "--from-file", "testdata/valid.yaml"},
@@ -209,4 +221,78 @@ func Test_Run(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "unexpected status code 500.")
 	})
+
+	t.Run("Check breaking changes", func(t *testing.T) {
+		storedSchema := map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"application": map[string]any{"type": "string"},
+				"environment": map[string]any{"type": "string"},
+				"database":    map[string]any{"type": "string", "readOnly": true},
+				"region":      map[string]any{"type": "string"},
+			},
+			"required": []any{"environment"},
+		}
+
+		testcases := []struct {
+			name          string
+			force         bool
+			expectedError string
+		}{
+			{
+				name:          "breaking changes are refused",
+				expectedError: "Found 1 breaking schema change(s). Existing resources may no longer be valid. Use --force to apply the changes anyway.",
+			},
+			{
+				name:  "breaking changes are applied with force",
+				force: true,
+			},
+		}
+
+		for _, tc := range testcases {
+			t.Run(tc.name, func(t *testing.T) {
+				resourceProviderData, err := manifest.ReadFile("testdata/valid.yaml")
+				require.NoError(t, err)
+
+				clientFactory, err := manifest.NewTestClientFactoryWithAPIVersions(
+					manifest.WithResourceProviderServerNoError,
+					manifest.WithAPIVersionServerStoredSchemas(map[string]map[string]any{"2023-10-01-preview": storedSchema}))
+				require.NoError(t, err)
+
+				var logBuffer bytes.Buffer
+				logger := func(format string, args ...any) {
+					fmt.Fprintf(&logBuffer, format+"\n", args...)
+				}
+
+				outputSink := &output.MockOutput{}
+				runner := &Runner{
+					UCPClientFactory:                 clientFactory,
+					Output:                           outputSink,
+					Workspace:                        &workspaces.Workspace{},
+					ResourceProvider:                 resourceProviderData,
+					Format:                           "table",
+					Logger:                           logger,
+					ResourceProviderManifestFilePath: "testdata/valid.yaml",
+					ResourceTypeName:                 "testResources",
+					CheckBreakingChanges:             true,
+					Force:                            tc.force,
+				}
+
+				err = runner.Run(context.Background())
+				require.Contains(t, outputSink.Writes, output.LogOutput{
+					Format: "  %s",
+					Params: []any{`Breaking: "region": property was removed`},
+				})
+
+				if tc.expectedError != "" {
+					require.EqualError(t, err, tc.expectedError)
+					require.Empty(t, logBuffer.String(), "Expected nothing to be created")
+					return
+				}
+
+				require.NoError(t, err)
+				require.Contains(t, logBuffer.String(), "Resource type MyCompany.Resources4/testResources created successfully")
+			})
+		}
+	})
 }
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FormatText writes one line per problem, in the form "<file>:<line>:<column>: <message> [<rule>]".
+	FormatText = "text"
+
+	// FormatJSON writes the problems as a JSON array.
+	FormatJSON = "json"
+
+	// FormatSARIF writes the problems as a SARIF 2.1.0 log, for code scanning tools.
+	FormatSARIF = "sarif"
+)
+
+var supportedFormats = []string{FormatText, FormatJSON, FormatSARIF}
+
+// NewCommand creates an instance of the `rad resource-type lint` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "lint <manifest-file>",
+		Short: "Check a resource type definition file for problems",
+		Long: `Check a resource type definition file for problems without contacting Radius.
+
+All the checks run by 'rad resource-type create' are run at once: the format of the manifest, the names of the namespace, resource types, API versions and capabilities, the OpenAPI schemas and their Radius constraints, and the conversion rules between API versions. Every problem is reported with its line and column in the file.
+
+The command exits with a non-zero exit code when problems are found, so it can be used in CI pipelines. Use '--format sarif' to upload the results to code scanning tools.
+`,
+		Example: `
+# Check a resource type definition file
+rad resource-type lint /path/to/input.yaml
+
+# Check a resource type definition file and write the problems as JSON
+rad resource-type lint /path/to/input.yaml --format json
+
+# Check a resource type definition file and write the problems as a SARIF log
+rad resource-type lint /path/to/input.yaml --format sarif > results.sarif
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	cmd.Flags().StringVar(&runner.Format, "format", FormatText, "The format of the problems. One of: "+strings.Join(supportedFormats, ", "))
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type lint` command.
+type Runner struct {
+	Output output.Interface
+
+	Format                           string
+	ResourceProviderManifestFilePath string
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type lint` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output: factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type lint` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	r.ResourceProviderManifestFilePath = args[0]
+
+	if !slices.Contains(supportedFormats, r.Format) {
+		return clierrors.Message("Unsupported format %q. Supported formats are: %s.", r.Format, strings.Join(supportedFormats, ", "))
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource-type lint` command.
+func (r *Runner) Run(ctx context.Context) error {
+	diagnostics, err := manifest.LintFile(ctx, r.ResourceProviderManifestFilePath)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to read manifest %q.", r.ResourceProviderManifestFilePath)
+	}
+
+	switch r.Format {
+	case FormatJSON:
+		err = r.Output.WriteFormatted(output.FormatJson, diagnostics, output.FormatterOptions{})
+	case FormatSARIF:
+		err = r.Output.WriteFormatted(output.FormatJson, newSARIFLog(r.ResourceProviderManifestFilePath, diagnostics), output.FormatterOptions{})
+	default:
+		for _, diagnostic := range diagnostics {
+			r.Output.LogInfo("%s:%s", r.ResourceProviderManifestFilePath, diagnostic.String())
+		}
+		if len(diagnostics) == 0 {
+			r.Output.LogInfo("No problems found in %s", r.ResourceProviderManifestFilePath)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(diagnostics) > 0 {
+		return clierrors.Message("Found %d problem(s) in %s.", len(diagnostics), r.ResourceProviderManifestFilePath)
+	}
+
+	return nil
+}
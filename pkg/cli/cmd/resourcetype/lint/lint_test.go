@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	// The lint command runs offline, so it does not require a workspace.
+	config := radcli.LoadEmptyConfig(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: sarif format",
+			Input:         []string{"testdata/valid.yaml", "--format", "sarif"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: unsupported format",
+			Input:         []string{"testdata/valid.yaml", "--format", "table"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: no manifest",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("valid manifest", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           FormatText,
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{Format: "No problems found in %s", Params: []any{"testdata/valid.yaml"}},
+		}, outputSink.Writes)
+	})
+
+	t.Run("text format", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           FormatText,
+			ResourceProviderManifestFilePath: "testdata/invalid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "Found 2 problem(s) in testdata/invalid.yaml.")
+		require.Len(t, outputSink.Writes, 2)
+
+		first := outputSink.Writes[0].(output.LogOutput)
+		require.Equal(t, "testdata/invalid.yaml", first.Params[0])
+		require.Contains(t, first.Params[1], "3:3: types[Bad_Type] must be a valid resource type.")
+		require.Contains(t, first.Params[1], "[manifest-field]")
+
+		second := outputSink.Writes[1].(output.LogOutput)
+		require.Contains(t, second.Params[1], "11:13: x-radius-sensitive annotation is only supported on string and object types, got 'integer' [schema-constraint]")
+	})
+
+	t.Run("json format", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           FormatJSON,
+			ResourceProviderManifestFilePath: "testdata/invalid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Len(t, outputSink.Writes, 1)
+
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		require.Equal(t, output.FormatJson, formatted.Format)
+		diagnostics := formatted.Obj.([]manifest.Diagnostic)
+		require.Len(t, diagnostics, 2)
+		require.Equal(t, manifest.RuleManifestField, diagnostics[0].Rule)
+		require.Equal(t, manifest.RuleSchemaConstraint, diagnostics[1].Rule)
+	})
+
+	t.Run("sarif format", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           FormatSARIF,
+			ResourceProviderManifestFilePath: "testdata/invalid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Len(t, outputSink.Writes, 1)
+
+		log := outputSink.Writes[0].(output.FormattedOutput).Obj.(*sarifLog)
+		require.Equal(t, "2.1.0", log.Version)
+		require.Len(t, log.Runs, 1)
+		require.Equal(t, []sarifRule{{ID: manifest.RuleManifestField}, {ID: manifest.RuleSchemaConstraint}}, log.Runs[0].Tool.Driver.Rules)
+		require.Len(t, log.Runs[0].Results, 2)
+
+		result := log.Runs[0].Results[1]
+		require.Equal(t, manifest.RuleSchemaConstraint, result.RuleID)
+		require.Equal(t, "error", result.Level)
+		require.Equal(t, "testdata/invalid.yaml", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		require.Equal(t, &sarifRegion{StartLine: 11, StartColumn: 13}, result.Locations[0].PhysicalLocation.Region)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		runner := &Runner{
+			Output:                           &output.MockOutput{},
+			Format:                           FormatText,
+			ResourceProviderManifestFilePath: "testdata/does-not-exist.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/version"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// sarifLog is the subset of the SARIF 2.1.0 format written by `rad resource-type lint`.
+//
+// See: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// newSARIFLog creates a SARIF log of the problems found in a manifest file.
+func newSARIFLog(filePath string, diagnostics []manifest.Diagnostic) *sarifLog {
+	rules := []sarifRule{}
+	results := []sarifResult{}
+	for _, diagnostic := range diagnostics {
+		if !slices.ContainsFunc(rules, func(rule sarifRule) bool { return rule.ID == diagnostic.Rule }) {
+			rules = append(rules, sarifRule{ID: diagnostic.Rule})
+		}
+
+		location := sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(filePath)},
+		}
+		if diagnostic.Line > 0 {
+			location.Region = &sarifRegion{StartLine: diagnostic.Line, StartColumn: diagnostic.Column}
+		}
+
+		results = append(results, sarifResult{
+			RuleID:    diagnostic.Rule,
+			Level:     "error",
+			Message:   sarifMessage{Text: diagnostic.Message},
+			Locations: []sarifLocation{{PhysicalLocation: location}},
+		})
+	}
+
+	return &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "rad resource-type lint",
+						Version:        version.Version(),
+						InformationURI: "https://docs.radapp.io",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
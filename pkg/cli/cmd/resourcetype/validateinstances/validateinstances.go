@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validateinstances
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultPlaneName = "local"
+	flagAPIVersion   = "api-version"
+)
+
+// NewCommand creates an instance of the `rad resource-type validate-instances` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "validate-instances [resource type]",
+		Short: "Validate existing resources against a resource type schema",
+		Long: `Validate existing resources against a resource type schema.
+
+Checks every stored resource of the resource type against the schema of one of its API versions and reports the resources that do not satisfy it. This is a dry-run: the stored schema is not changed.
+
+Use --from-file to validate against the schema in a resource type definition file, before updating the resource type with 'rad resource-type create'. Without --from-file the resources are validated against the stored schema.
+
+The API version is specified with --api-version. When it is omitted, the default API version of the resource type in the file is used, or the only API version in the file.
+
+Resources stored with another API version are converted to the validated API version using the conversion rules of the resource type. Resources that cannot be converted are reported as skipped.
+
+The command fails if any resource does not satisfy the schema.`,
+		Example: `
+# Validate the existing resources against the schema in a resource type definition file
+rad resource-type validate-instances MyCompany.Resources/testResources --from-file /path/to/input.yaml
+
+# Validate the existing resources against the schema of a specific API version in the file
+rad resource-type validate-instances MyCompany.Resources/testResources --from-file /path/to/input.yaml --api-version 2025-01-01
+
+# Validate the existing resources against the stored schema of an API version
+rad resource-type validate-instances MyCompany.Resources/testResources --api-version 2025-01-01`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
+	cmd.Flags().StringVar(&runner.APIVersion, flagAPIVersion, "", "The API version whose schema the resources are validated against")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type validate-instances` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	ResourceProviderManifestFilePath string
+	ResourceProviderNamespace        string
+	ResourceTypeSuffix               string
+	APIVersion                       string
+
+	// Schema is the proposed schema. It is nil when the resources are validated against the stored schema.
+	Schema map[string]any
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type validate-instances` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type validate-instances` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.ResourceProviderNamespace, r.ResourceTypeSuffix, err = cli.RequireFullyQualifiedResourceType(args)
+	if err != nil {
+		return err
+	}
+
+	if r.ResourceProviderManifestFilePath == "" {
+		if r.APIVersion == "" {
+			return clierrors.Message("The --%s flag is required when --from-file is not specified.", flagAPIVersion)
+		}
+		return nil
+	}
+
+	resourceProvider, err := manifest.ValidateManifest(cmd.Context(), r.ResourceProviderManifestFilePath)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(resourceProvider.Namespace, r.ResourceProviderNamespace) {
+		return clierrors.Message("The file defines resource types in namespace %q, not %q.", resourceProvider.Namespace, r.ResourceProviderNamespace)
+	}
+
+	resourceType, ok := resourceProvider.Types[r.ResourceTypeSuffix]
+	if !ok {
+		return clierrors.Message("Resource type %q not found in the manifest.", r.ResourceTypeSuffix)
+	}
+
+	if r.APIVersion == "" {
+		switch {
+		case resourceType.DefaultAPIVersion != nil && *resourceType.DefaultAPIVersion != "":
+			r.APIVersion = *resourceType.DefaultAPIVersion
+		case len(resourceType.APIVersions) == 1:
+			r.APIVersion = slices.Collect(maps.Keys(resourceType.APIVersions))[0]
+		default:
+			return clierrors.Message("Resource type %q has more than one API version. Use --%s to specify one of: %s.", r.ResourceTypeSuffix, flagAPIVersion, strings.Join(slices.Sorted(maps.Keys(resourceType.APIVersions)), ", "))
+		}
+	}
+
+	apiVersion, ok := resourceType.APIVersions[r.APIVersion]
+	if !ok {
+		return clierrors.Message("API version %q of resource type %q not found in the manifest.", r.APIVersion, r.ResourceTypeSuffix)
+	}
+
+	r.Schema, ok = apiVersion.Schema.(map[string]any)
+	if !ok {
+		return clierrors.Message("The schema of API version %q of resource type %q must be an object.", r.APIVersion, r.ResourceTypeSuffix)
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource-type validate-instances` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateResourceTypeValidationClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resourceTypeName := r.ResourceProviderNamespace + "/" + r.ResourceTypeSuffix
+	result, err := client.ValidateInstances(ctx, defaultPlaneName, r.ResourceProviderNamespace, r.ResourceTypeSuffix, r.APIVersion, r.Schema)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The API version %q of resource type %q was not found.", r.APIVersion, resourceTypeName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		if err := r.Output.WriteFormatted(r.Format, result, output.FormatterOptions{}); err != nil {
+			return err
+		}
+	} else {
+		r.Output.LogInfo("Validated %d resource(s) of type %q against API version %q: %d invalid, %d skipped.", result.Validated, resourceTypeName, r.APIVersion, len(result.Invalid), len(result.Skipped))
+
+		rows := instanceRows(result)
+		if len(rows) > 0 {
+			if err := r.Output.WriteFormatted(r.Format, rows, instanceFormat()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(result.Invalid) > 0 {
+		return clierrors.Message("Found %d resource(s) that do not satisfy the schema of %s@%s.", len(result.Invalid), resourceTypeName, r.APIVersion)
+	}
+
+	return nil
+}
+
+// instanceRow is a row of the table of resources that are invalid or were skipped.
+type instanceRow struct {
+	ID      string
+	Status  string
+	Message string
+}
+
+func instanceRows(result *datamodel.ValidateInstancesResult) []instanceRow {
+	rows := []instanceRow{}
+	for _, invalid := range result.Invalid {
+		rows = append(rows, instanceRow{ID: invalid.ID, Status: "Invalid", Message: invalid.Message})
+	}
+	for _, skipped := range result.Skipped {
+		rows = append(rows, instanceRow{ID: skipped.ID, Status: "Skipped", Message: skipped.Message})
+	}
+	return rows
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validateinstances
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: default API version from the file",
+			Input:         []string{"MyCompany.Resources/testResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "2025-01-01", r.APIVersion)
+				require.Contains(t, r.Schema["properties"], "port")
+			},
+		},
+		{
+			Name:          "Valid: API version from the flag",
+			Input:         []string{"MyCompany.Resources/otherResources", "--from-file", "testdata/valid.yaml", "--api-version", "2024-01-01"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: stored schema",
+			Input:         []string{"MyCompany.Resources/testResources", "--api-version", "2025-01-01"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Nil(t, runner.(*Runner).Schema)
+			},
+		},
+		{
+			Name:          "Invalid: stored schema without API version",
+			Input:         []string{"MyCompany.Resources/testResources"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: more than one API version and no default",
+			Input:         []string{"MyCompany.Resources/otherResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: API version not in the file",
+			Input:         []string{"MyCompany.Resources/testResources", "--from-file", "testdata/valid.yaml", "--api-version", "2030-01-01"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: namespace does not match the file",
+			Input:         []string{"Other.Resources/testResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: resource type not in the file",
+			Input:         []string{"MyCompany.Resources/missingResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: resource type not fully-qualified",
+			Input:         []string{"testResources", "--api-version", "2025-01-01"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	schema := map[string]any{"type": "object"}
+
+	setup := func(t *testing.T, format string) (*clients.MockResourceTypeValidationClient, *output.MockOutput, *Runner) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockResourceTypeValidationClient(ctrl)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ResourceTypeValidationClient: client},
+			Output:                    outputSink,
+			Workspace:                 &workspaces.Workspace{},
+			Format:                    format,
+			ResourceProviderNamespace: "MyCompany.Resources",
+			ResourceTypeSuffix:        "testResources",
+			APIVersion:                "2025-01-01",
+			Schema:                    schema,
+		}
+
+		return client, outputSink, runner
+	}
+
+	t.Run("Success: all resources valid", func(t *testing.T) {
+		client, outputSink, runner := setup(t, output.FormatTable)
+		client.EXPECT().
+			ValidateInstances(gomock.Any(), "local", "MyCompany.Resources", "testResources", "2025-01-01", schema).
+			Return(&datamodel.ValidateInstancesResult{Validated: 2}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Validated %d resource(s) of type %q against API version %q: %d invalid, %d skipped.",
+				Params: []any{2, "MyCompany.Resources/testResources", "2025-01-01", 0, 0},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Failure: invalid resources", func(t *testing.T) {
+		client, outputSink, runner := setup(t, output.FormatTable)
+		client.EXPECT().
+			ValidateInstances(gomock.Any(), "local", "MyCompany.Resources", "testResources", "2025-01-01", schema).
+			Return(&datamodel.ValidateInstancesResult{
+				Validated: 2,
+				Invalid:   []datamodel.InvalidInstance{{ID: "invalid-id", Message: "missing port"}},
+				Skipped:   []datamodel.SkippedInstance{{ID: "skipped-id", APIVersion: "2020-01-01", Message: "cannot convert"}},
+			}, nil)
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Found 1 resource(s) that do not satisfy the schema")
+
+		require.Len(t, outputSink.Writes, 2)
+		require.Equal(t, output.FormattedOutput{
+			Format: output.FormatTable,
+			Obj: []instanceRow{
+				{ID: "invalid-id", Status: "Invalid", Message: "missing port"},
+				{ID: "skipped-id", Status: "Skipped", Message: "cannot convert"},
+			},
+			Options: instanceFormat(),
+		}, outputSink.Writes[1])
+	})
+
+	t.Run("Success: JSON output", func(t *testing.T) {
+		client, outputSink, runner := setup(t, output.FormatJson)
+		result := &datamodel.ValidateInstancesResult{Validated: 1}
+		client.EXPECT().
+			ValidateInstances(gomock.Any(), "local", "MyCompany.Resources", "testResources", "2025-01-01", schema).
+			Return(result, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     result,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Failure: API version not found", func(t *testing.T) {
+		client, _, runner := setup(t, output.FormatTable)
+		client.EXPECT().
+			ValidateInstances(gomock.Any(), "local", "MyCompany.Resources", "testResources", "2025-01-01", schema).
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "was not found")
+	})
+}
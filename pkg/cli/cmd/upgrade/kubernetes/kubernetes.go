@@ -19,6 +19,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
@@ -30,6 +31,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// migrateDataSetArgs are the chart values that enable data migrations in the pre-upgrade job.
+var migrateDataSetArgs = []string{
+	"preupgrade.enabled=true",
+	"preupgrade.checks.migrations=true",
+	"preupgrade.migrations.apply=true",
+}
+
 // NewCommand creates an instance of the `rad upgrade kubernetes` command and runner.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
@@ -49,6 +57,10 @@ Preflight checks include:
 - Cluster resource availability
 - Custom configuration parameter validation
 
+Use --migrate-data to migrate the resources stored by Radius to the target version as part of the upgrade.
+The migrations run in the pre-upgrade job of the target version, and the upgrade fails if any stored resource
+cannot be migrated. Resources that are not migrated during the upgrade are migrated when they are next read.
+
 Radius is installed in the 'radius-system' namespace. For more information visit https://docs.radapp.io/concepts/technical/architecture/.
 `,
 		Example: `# Upgrade Radius in the cluster of the active workspace
@@ -98,6 +110,9 @@ rad upgrade kubernetes --skip-preflight
 # Run only preflight checks without upgrading
 rad upgrade kubernetes --preflight-only
 
+# Migrate stored resources to the target version during the upgrade
+rad upgrade kubernetes --migrate-data
+
 # Upgrade Radius using a Helm chart from specified file path
 rad upgrade kubernetes --chart /root/radius/deploy/Chart
 `,
@@ -112,6 +127,7 @@ rad upgrade kubernetes --chart /root/radius/deploy/Chart
 	cmd.Flags().StringArrayVar(&runner.SetFile, "set-file", []string{}, "Set values from files on the command line (can specify multiple or separate files with commas: key1=filename1,key2=filename2)")
 	cmd.Flags().BoolVar(&runner.SkipPreflight, "skip-preflight", false, "Skip preflight checks before upgrade (not recommended)")
 	cmd.Flags().BoolVar(&runner.PreflightOnly, "preflight-only", false, "Run only preflight checks without performing the upgrade")
+	cmd.Flags().BoolVar(&runner.MigrateData, "migrate-data", false, "Migrate stored resources to the target version in the pre-upgrade job")
 
 	return cmd, runner
 }
@@ -128,6 +144,7 @@ type Runner struct {
 	SetFile       []string
 	SkipPreflight bool
 	PreflightOnly bool
+	MigrateData   bool
 }
 
 // NewRunner creates an instance of the runner for the `rad upgrade kubernetes` command.
@@ -143,6 +160,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if r.SkipPreflight && r.PreflightOnly {
 		return fmt.Errorf("cannot specify both --skip-preflight and --preflight-only")
 	}
+	if r.MigrateData && r.PreflightOnly {
+		return fmt.Errorf("cannot specify both --migrate-data and --preflight-only")
+	}
 	return nil
 }
 
@@ -188,11 +208,17 @@ func (r *Runner) Run(ctx context.Context) error {
 	// Perform the upgrade
 	r.Output.LogInfo("Upgrading Radius from version %s to %s...", currentVersion, targetVersion)
 
+	setArgs := r.Set
+	if r.MigrateData {
+		// Data migrations run in the pre-upgrade job so that they use the migrations of the target version.
+		setArgs = append(slices.Clone(r.Set), migrateDataSetArgs...)
+	}
+
 	cliOptions := helm.CLIClusterOptions{
 		Radius: helm.ChartOptions{
 			ChartPath:    r.Chart,
 			ChartVersion: targetVersion,
-			SetArgs:      r.Set,
+			SetArgs:      setArgs,
 			SetFileArgs:  r.SetFile,
 		},
 	}
@@ -65,11 +65,21 @@ func Test_Validate(t *testing.T) {
 			Input:         []string{"--preflight-only"},
 			ExpectedValid: true,
 		},
+		{
+			Name:          "valid - migrate data",
+			Input:         []string{"--migrate-data"},
+			ExpectedValid: true,
+		},
 		{
 			Name:          "invalid - conflicting flags",
 			Input:         []string{"--skip-preflight", "--preflight-only"},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "invalid - migrate data with preflight only",
+			Input:         []string{"--migrate-data", "--preflight-only"},
+			ExpectedValid: false,
+		},
 		{
 			Name:          "invalid - too many args",
 			Input:         []string{"extra-arg"},
@@ -105,6 +115,7 @@ func TestRunner_Validate(t *testing.T) {
 		name          string
 		skipPreflight bool
 		preflightOnly bool
+		migrateData   bool
 		expectedError string
 	}{
 		{
@@ -131,6 +142,12 @@ func TestRunner_Validate(t *testing.T) {
 			preflightOnly: true,
 			expectedError: "cannot specify both --skip-preflight and --preflight-only",
 		},
+		{
+			name:          "migrate data with preflight only",
+			preflightOnly: true,
+			migrateData:   true,
+			expectedError: "cannot specify both --migrate-data and --preflight-only",
+		},
 	}
 
 	for _, tt := range tests {
@@ -139,6 +156,7 @@ func TestRunner_Validate(t *testing.T) {
 			runner := &Runner{
 				SkipPreflight: tt.skipPreflight,
 				PreflightOnly: tt.preflightOnly,
+				MigrateData:   tt.migrateData,
 			}
 
 			err := runner.Validate(nil, nil)
@@ -161,6 +179,7 @@ func TestRunner_Run(t *testing.T) {
 		version       string
 		skipPreflight bool
 		preflightOnly bool
+		migrateData   bool
 		set           []string
 		setFile       []string
 		expectError   bool
@@ -237,6 +256,34 @@ func TestRunner_Run(t *testing.T) {
 			setFile:       []string{"global.rootCA.cert=/path/to/cert.crt"},
 			expectError:   false,
 		},
+		{
+			name: "successful upgrade with --migrate-data",
+			setupMock: func(mockHelm *helm.MockInterface, mockOutput *output.MockInterface) {
+				installState := helm.InstallState{
+					RadiusInstalled: true,
+					RadiusVersion:   "v0.46.0",
+				}
+				mockHelm.EXPECT().CheckRadiusInstall("").Return(installState, nil)
+				mockHelm.EXPECT().UpgradeRadius(gomock.Any(), gomock.Any(), "").
+					DoAndReturn(func(ctx context.Context, clusterOptions helm.ClusterOptions, kubeContext string) error {
+						expectedSetArgs := []string{
+							"key=value",
+							"preupgrade.enabled=true",
+							"preupgrade.checks.migrations=true",
+							"preupgrade.migrations.apply=true",
+						}
+						assert.Equal(t, expectedSetArgs, clusterOptions.Radius.SetArgs)
+						return nil
+					})
+				mockOutput.EXPECT().LogInfo(gomock.Any(), gomock.Any()).AnyTimes()
+				mockOutput.EXPECT().LogInfo(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			version:       "0.47.0",
+			skipPreflight: true,
+			migrateData:   true,
+			set:           []string{"key=value"},
+			expectError:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -256,6 +303,7 @@ func TestRunner_Run(t *testing.T) {
 				Version:       tt.version,
 				SkipPreflight: tt.skipPreflight,
 				PreflightOnly: tt.preflightOnly,
+				MigrateData:   tt.migrateData,
 				Set:           tt.set,
 				SetFile:       tt.setFile,
 			}
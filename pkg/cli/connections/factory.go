@@ -43,6 +43,10 @@ type Factory interface {
 	CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error)
 	CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error)
 	CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error)
+	CreateQueueAdminClient(ctx context.Context, workspace workspaces.Workspace) (clients.QueueAdminClient, error)
+	CreateOperationsClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationsClient, error)
+	CreateResourceTypeValidationClient(ctx context.Context, workspace workspaces.Workspace) (clients.ResourceTypeValidationClient, error)
+	CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error)
 }
 
 var _ Factory = (*impl)(nil)
@@ -201,3 +205,44 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 
 	return cpClient, nil
 }
+
+// CreateQueueAdminClient connects to the workspace and returns a client for UCP's administrative queue API.
+func (*impl) CreateQueueAdminClient(ctx context.Context, workspace workspaces.Workspace) (clients.QueueAdminClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPQueueAdminClient{Connection: connection}, nil
+}
+
+// CreateOperationsClient connects to the workspace and returns a client for the async operations of resources.
+func (*impl) CreateOperationsClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationsClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPOperationsClient{Connection: connection}, nil
+}
+
+// CreateResourceTypeValidationClient connects to the workspace and returns a client for validating the stored
+// resources of a resource type against a schema.
+func (*impl) CreateResourceTypeValidationClient(ctx context.Context, workspace workspaces.Workspace) (clients.ResourceTypeValidationClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPResourceTypeValidationClient{Connection: connection}, nil
+}
+
+// CreateRecipePlanClient connects to the workspace and returns a client for planning the recipes of resources.
+func (*impl) CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPRecipePlanClient{Connection: connection}, nil
+}
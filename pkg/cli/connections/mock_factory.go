@@ -30,6 +30,10 @@ type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
 	CredentialManagementClient   cli_credential.CredentialManagementClient
 	DiagnosticsClient            clients.DiagnosticsClient
+	QueueAdminClient             clients.QueueAdminClient
+	OperationsClient             clients.OperationsClient
+	ResourceTypeValidationClient clients.ResourceTypeValidationClient
+	RecipePlanClient             clients.RecipePlanClient
 }
 
 // CreateDeploymentClient function takes in a context and a workspace and returns a DeploymentClient and an error, if any.
@@ -52,3 +56,23 @@ func (f *MockFactory) CreateApplicationsManagementClient(ctx context.Context, wo
 func (f *MockFactory) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	return f.CredentialManagementClient, nil
 }
+
+// CreateQueueAdminClient function takes in a context and a workspace and returns a QueueAdminClient and does not return an error.
+func (f *MockFactory) CreateQueueAdminClient(ctx context.Context, workspace workspaces.Workspace) (clients.QueueAdminClient, error) {
+	return f.QueueAdminClient, nil
+}
+
+// CreateOperationsClient function takes in a context and a workspace and returns an OperationsClient and does not return an error.
+func (f *MockFactory) CreateOperationsClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationsClient, error) {
+	return f.OperationsClient, nil
+}
+
+// CreateResourceTypeValidationClient function takes in a context and a workspace and returns a ResourceTypeValidationClient and does not return an error.
+func (f *MockFactory) CreateResourceTypeValidationClient(ctx context.Context, workspace workspaces.Workspace) (clients.ResourceTypeValidationClient, error) {
+	return f.ResourceTypeValidationClient, nil
+}
+
+// CreateRecipePlanClient function takes in a context and a workspace and returns a RecipePlanClient and does not return an error.
+func (f *MockFactory) CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error) {
+	return f.RecipePlanClient, nil
+}
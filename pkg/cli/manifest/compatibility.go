@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// SchemaComparison is the result of comparing the schema of an API version in a manifest with a schema stored in
+// Radius.
+type SchemaComparison struct {
+	// ResourceType is the fully-qualified resource type, e.g. "MyCompany.Resources/testResources".
+	ResourceType string
+
+	// APIVersion is the API version in the manifest.
+	APIVersion string
+
+	// BaselineAPIVersion is the stored API version the manifest schema was compared with. It is the same as
+	// APIVersion when the API version is being updated, or the latest stored API version when a new API version is
+	// being added.
+	BaselineAPIVersion string
+
+	// Diff lists the schema changes.
+	Diff *schema.SchemaDiff
+}
+
+// CompareWithStoredSchemas compares the schema of each API version of a resource type in the manifest with the
+// schemas stored in Radius. An API version that is already stored is compared with its stored schema, and a new API
+// version is compared with the latest stored API version. Returns no comparisons when the resource type is not
+// stored yet. The comparisons are sorted by API version.
+func CompareWithStoredSchemas(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProvider ResourceProvider, typeName string) ([]SchemaComparison, error) {
+	resourceType, ok := resourceProvider.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in manifest", typeName)
+	}
+
+	stored := map[string]map[string]any{}
+	pager := clientFactory.NewAPIVersionsClient().NewListPager(planeName, resourceProvider.Namespace, typeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if clients.Is404Error(err) {
+			// The resource provider or resource type does not exist yet, so there is nothing to compare with.
+			return []SchemaComparison{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, apiVersion := range page.Value {
+			if apiVersion == nil || apiVersion.Name == nil || apiVersion.Properties == nil {
+				continue
+			}
+			stored[*apiVersion.Name] = apiVersion.Properties.Schema
+		}
+	}
+
+	comparisons := []SchemaComparison{}
+	if len(stored) == 0 {
+		return comparisons, nil
+	}
+
+	latest := slices.MaxFunc(slices.Collect(maps.Keys(stored)), compareAPIVersions)
+
+	for _, apiVersionName := range slices.SortedFunc(maps.Keys(resourceType.APIVersions), compareAPIVersions) {
+		baseline := apiVersionName
+		if _, ok := stored[apiVersionName]; !ok {
+			baseline = latest
+		}
+
+		diff, err := schema.DiffSchemas(stored[baseline], resourceType.APIVersions[apiVersionName].Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare schema of %s/%s@%s with stored API version %s: %w", resourceProvider.Namespace, typeName, apiVersionName, baseline, err)
+		}
+
+		comparisons = append(comparisons, SchemaComparison{
+			ResourceType:       resourceProvider.Namespace + "/" + typeName,
+			APIVersion:         apiVersionName,
+			BaselineAPIVersion: baseline,
+			Diff:               diff,
+		})
+	}
+
+	return comparisons, nil
+}
+
+// compareAPIVersions compares two API versions by release order. API versions are dates with an optional suffix, such
+// as "2025-01-01-preview". They are ordered by date, and a preview ranks below the stable API version of the same date.
+func compareAPIVersions(a string, b string) int {
+	dateA, suffixA := splitAPIVersion(a)
+	dateB, suffixB := splitAPIVersion(b)
+	if c := strings.Compare(dateA, dateB); c != 0 {
+		return c
+	}
+
+	switch {
+	case suffixA == suffixB:
+		return 0
+	case suffixA == "":
+		return 1
+	case suffixB == "":
+		return -1
+	default:
+		return strings.Compare(suffixA, suffixB)
+	}
+}
+
+// splitAPIVersion splits an API version into its date prefix and suffix, e.g. "2025-01-01" and "preview".
+func splitAPIVersion(apiVersion string) (string, string) {
+	const dateLength = len("2006-01-02")
+	if len(apiVersion) <= dateLength {
+		return apiVersion, ""
+	}
+
+	return apiVersion[:dateLength], strings.TrimPrefix(apiVersion[dateLength:], "-")
+}
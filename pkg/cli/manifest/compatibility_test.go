@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func testStoredSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type": "string",
+				"enum": []any{"S", "M"},
+			},
+		},
+	}
+}
+
+func testCompatibilityProvider(apiVersions map[string]map[string]any) ResourceProvider {
+	resourceType := &ResourceType{APIVersions: map[string]*ResourceTypeAPIVersion{}}
+	for name, schema := range apiVersions {
+		resourceType.APIVersions[name] = &ResourceTypeAPIVersion{Schema: schema}
+	}
+	return ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types:     map[string]*ResourceType{"testResources": resourceType},
+	}
+}
+
+func TestCompareWithStoredSchemas(t *testing.T) {
+	narrowed := testStoredSchema()
+	narrowed["properties"].(map[string]any)["size"].(map[string]any)["enum"] = []any{"S"}
+
+	widened := testStoredSchema()
+	widened["properties"].(map[string]any)["name"] = map[string]any{"type": "string"}
+
+	t.Run("existing API version is compared with its stored schema", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactoryWithAPIVersions(WithResourceProviderServerNoError, WithAPIVersionServerStoredSchemas(map[string]map[string]any{
+			"2025-01-01": testStoredSchema(),
+			"2025-06-01": widened,
+		}))
+		require.NoError(t, err)
+
+		comparisons, err := CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(map[string]map[string]any{"2025-01-01": narrowed}), "testResources")
+		require.NoError(t, err)
+		require.Len(t, comparisons, 1)
+		require.Equal(t, "MyCompany.Resources/testResources", comparisons[0].ResourceType)
+		require.Equal(t, "2025-01-01", comparisons[0].APIVersion)
+		require.Equal(t, "2025-01-01", comparisons[0].BaselineAPIVersion)
+		require.Equal(t, []schema.SchemaChange{
+			{Kind: schema.ChangeKindBreaking, Path: "size", Message: "enum values were removed: M"},
+		}, comparisons[0].Diff.Changes)
+	})
+
+	t.Run("new API version is compared with the latest stored API version", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactoryWithAPIVersions(WithResourceProviderServerNoError, WithAPIVersionServerStoredSchemas(map[string]map[string]any{
+			"2025-01-01": testStoredSchema(),
+			"2025-06-01": widened,
+		}))
+		require.NoError(t, err)
+
+		comparisons, err := CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(map[string]map[string]any{"2026-01-01": testStoredSchema()}), "testResources")
+		require.NoError(t, err)
+		require.Len(t, comparisons, 1)
+		require.Equal(t, "2025-06-01", comparisons[0].BaselineAPIVersion)
+		require.Equal(t, []schema.SchemaChange{
+			{Kind: schema.ChangeKindBreaking, Path: "name", Message: "property was removed"},
+		}, comparisons[0].Diff.Changes)
+	})
+
+	t.Run("stable API version is later than the preview of the same date", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactoryWithAPIVersions(WithResourceProviderServerNoError, WithAPIVersionServerStoredSchemas(map[string]map[string]any{
+			"2025-01-01":         testStoredSchema(),
+			"2025-06-01":         widened,
+			"2025-06-01-preview": testStoredSchema(),
+		}))
+		require.NoError(t, err)
+
+		comparisons, err := CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(map[string]map[string]any{"2026-01-01": widened}), "testResources")
+		require.NoError(t, err)
+		require.Len(t, comparisons, 1)
+		require.Equal(t, "2025-06-01", comparisons[0].BaselineAPIVersion)
+		require.Empty(t, comparisons[0].Diff.Changes)
+	})
+
+	t.Run("preview API version is later than an older stable API version", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactoryWithAPIVersions(WithResourceProviderServerNoError, WithAPIVersionServerStoredSchemas(map[string]map[string]any{
+			"2025-01-01":         testStoredSchema(),
+			"2025-06-01-preview": widened,
+		}))
+		require.NoError(t, err)
+
+		comparisons, err := CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(map[string]map[string]any{"2026-01-01": widened}), "testResources")
+		require.NoError(t, err)
+		require.Len(t, comparisons, 1)
+		require.Equal(t, "2025-06-01-preview", comparisons[0].BaselineAPIVersion)
+	})
+
+	t.Run("resource type is not stored", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactoryWithAPIVersions(WithResourceProviderServerNoError, func() ucpfake.APIVersionsServer {
+			server := WithAPIVersionServerNoError()
+			server.NewListPager = func(string, string, string, *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+				resp.AddResponseError(http.StatusNotFound, "NotFound")
+				return
+			}
+			return server
+		})
+		require.NoError(t, err)
+
+		comparisons, err := CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(map[string]map[string]any{"2025-01-01": narrowed}), "testResources")
+		require.NoError(t, err)
+		require.Empty(t, comparisons)
+	})
+
+	t.Run("type not in manifest", func(t *testing.T) {
+		clientFactory, err := NewTestClientFactory(WithResourceProviderServerNoError)
+		require.NoError(t, err)
+
+		_, err = CompareWithStoredSchemas(context.Background(), clientFactory, "local", testCompatibilityProvider(nil), "otherResources")
+		require.ErrorContains(t, err, "type otherResources not found in manifest")
+	})
+}
+
+func TestCompareAPIVersions(t *testing.T) {
+	ordered := []string{"2024-12-01", "2025-01-01-alpha", "2025-01-01-preview", "2025-01-01", "2025-06-01-preview", "2025-06-01"}
+	for i := range ordered {
+		for j := range ordered {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			require.Equal(t, expected, compareAPIVersions(ordered[i], ordered[j]), "%s vs %s", ordered[i], ordered[j])
+		}
+	}
+}
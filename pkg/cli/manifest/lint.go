@@ -0,0 +1,480 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	yaml "github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// Rules reported by Lint.
+const (
+	// RuleYAMLSyntax is reported when the manifest is not valid YAML or JSON.
+	RuleYAMLSyntax = "yaml-syntax"
+
+	// RuleUnknownField is reported for fields that are not part of the manifest format.
+	RuleUnknownField = "unknown-field"
+
+	// RuleDuplicateKey is reported for keys that appear more than once in the same map.
+	RuleDuplicateKey = "duplicate-key"
+
+	// RuleInvalidValue is reported for values that cannot be read as the type of their field.
+	RuleInvalidValue = "invalid-value"
+
+	// RuleManifestField is reported for manifest fields that are missing or have an invalid format.
+	RuleManifestField = "manifest-field"
+
+	// RuleDefaultAPIVersion is reported when the default API version of a resource type is not one of its API versions.
+	RuleDefaultAPIVersion = "default-api-version"
+
+	// RuleSchema is reported for schemas that are not valid OpenAPI schemas.
+	RuleSchema = "schema"
+
+	// RuleSchemaConstraint is reported for schemas that violate Radius constraints.
+	RuleSchemaConstraint = "schema-constraint"
+
+	// RuleSchemaFormat is reported for schemas with invalid formats.
+	RuleSchemaFormat = "schema-format"
+
+	// RuleConversion is reported for invalid conversion rules between API versions.
+	RuleConversion = "conversion"
+)
+
+// Diagnostic describes a violation of a manifest or schema rule found by Lint.
+type Diagnostic struct {
+	// Rule identifies the rule that was violated, for example "schema-constraint".
+	Rule string `json:"rule"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+
+	// Path is the location of the violation in the manifest, as keys separated by '.'.
+	// Example: types.myType.apiVersions.2025-01-01.schema.properties.port
+	Path string `json:"path,omitempty"`
+
+	// Line is the 1-based line of the violation in the manifest, or 0 if unknown.
+	Line int `json:"line,omitempty"`
+
+	// Column is the 1-based column of the violation in the manifest, or 0 if unknown.
+	Column int `json:"column,omitempty"`
+}
+
+// String returns the diagnostic formatted as "line:column: message [rule]".
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s [%s]", d.Line, d.Column, d.Message, d.Rule)
+}
+
+// LintFile runs all manifest and schema rules on a resource provider manifest file, without contacting Radius.
+// It returns every violation found, sorted by position. An error is returned only if the file cannot be read.
+func LintFile(ctx context.Context, filePath string) ([]Diagnostic, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return LintBytes(ctx, data), nil
+}
+
+// LintBytes runs all manifest and schema rules on a resource provider manifest, and returns every violation found,
+// sorted by position.
+//
+// Unlike ReadBytes, which stops at the first error, LintBytes continues after a violation whenever the rest of the
+// manifest can still be checked.
+func LintBytes(ctx context.Context, data []byte) []Diagnostic {
+	l := &linter{diagnostics: []Diagnostic{}}
+	l.lint(ctx, data)
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return l.diagnostics
+}
+
+// linter collects the diagnostics of a manifest.
+type linter struct {
+	root        ast.Node
+	diagnostics []Diagnostic
+}
+
+func (l *linter) lint(ctx context.Context, data []byte) {
+	// Duplicate keys are reported by checkKeys, so that all of them are found.
+	file, err := parser.ParseBytes(data, 0, parser.AllowDuplicateMapKey())
+	if err != nil {
+		l.addYAMLError(RuleYAMLSyntax, err)
+		return
+	}
+	if len(file.Docs) > 0 {
+		l.root = file.Docs[0].Body
+	}
+
+	l.checkKeys(l.root, reflect.TypeOf(ResourceProvider{}), nil)
+
+	provider := ResourceProvider{}
+	if err := yaml.UnmarshalWithOptions(data, &provider, yaml.AllowDuplicateMapKey()); err != nil {
+		l.addYAMLError(RuleInvalidValue, err)
+		return
+	}
+
+	l.checkStruct(&provider)
+	l.checkDefaultAPIVersions(&provider)
+	l.checkSchemas(ctx, &provider)
+}
+
+// add records a diagnostic at the position of the path in the manifest.
+func (l *linter) add(rule string, message string, path []string) {
+	diagnostic := Diagnostic{Rule: rule, Message: message, Path: strings.Join(path, ".")}
+	diagnostic.Line, diagnostic.Column = l.position(path)
+	l.diagnostics = append(l.diagnostics, diagnostic)
+}
+
+// addAtKey records a diagnostic at the position of the key of a key/value pair.
+func (l *linter) addAtKey(rule string, message string, path []string, value *ast.MappingValueNode) {
+	diagnostic := Diagnostic{Rule: rule, Message: message, Path: strings.Join(path, ".")}
+	if token := value.Key.GetToken(); token != nil && token.Position != nil {
+		diagnostic.Line = token.Position.Line
+		diagnostic.Column = token.Position.Column
+	}
+	l.diagnostics = append(l.diagnostics, diagnostic)
+}
+
+// addYAMLError records a diagnostic for an error returned by the YAML parser or decoder.
+func (l *linter) addYAMLError(rule string, err error) {
+	diagnostic := Diagnostic{Rule: rule, Message: err.Error()}
+
+	var yamlErr yaml.Error
+	if errors.As(err, &yamlErr) {
+		diagnostic.Message = yamlErr.GetMessage()
+		if token := yamlErr.GetToken(); token != nil && token.Position != nil {
+			diagnostic.Line = token.Position.Line
+			diagnostic.Column = token.Position.Column
+		}
+	}
+
+	l.diagnostics = append(l.diagnostics, diagnostic)
+}
+
+// checkKeys reports unknown fields and duplicate keys in the node, which is decoded as a value of type typ.
+func (l *linter) checkKeys(node ast.Node, typ reflect.Type, path []string) {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == nil || typ.Kind() == reflect.Interface:
+		// Free-form values, such as schemas, can only have duplicate keys.
+		l.checkDuplicateKeys(node, path)
+		return
+	case typ.Kind() != reflect.Struct && typ.Kind() != reflect.Map:
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, value := range mappingValues(node) {
+		key := keyName(value)
+		keyPath := appendPath(path, key)
+		if seen[key] {
+			l.addAtKey(RuleDuplicateKey, fmt.Sprintf("duplicate key %q", key), keyPath, value)
+			continue
+		}
+		seen[key] = true
+
+		if typ.Kind() == reflect.Map {
+			l.checkKeys(value.Value, typ.Elem(), keyPath)
+			continue
+		}
+
+		field, ok := fieldByYAMLName(typ, key)
+		if !ok {
+			l.addAtKey(RuleUnknownField, fmt.Sprintf("unknown field %q", key), keyPath, value)
+			continue
+		}
+		l.checkKeys(value.Value, field.Type, keyPath)
+	}
+}
+
+// checkDuplicateKeys reports duplicate keys in the node and all of its descendants.
+func (l *linter) checkDuplicateKeys(node ast.Node, path []string) {
+	if sequence, ok := unwrap(node).(*ast.SequenceNode); ok {
+		for i, value := range sequence.Values {
+			l.checkDuplicateKeys(value, appendPath(path, fmt.Sprintf("%d", i)))
+		}
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, value := range mappingValues(node) {
+		key := keyName(value)
+		keyPath := appendPath(path, key)
+		if seen[key] {
+			l.addAtKey(RuleDuplicateKey, fmt.Sprintf("duplicate key %q", key), keyPath, value)
+			continue
+		}
+		seen[key] = true
+		l.checkDuplicateKeys(value.Value, keyPath)
+	}
+}
+
+// checkStruct reports the violations of the "validate" tags of the manifest types.
+func (l *linter) checkStruct(provider *ResourceProvider) {
+	v, translator := newStructValidator()
+	err := v.Struct(provider)
+	if err == nil {
+		return
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		l.add(RuleManifestField, err.Error(), nil)
+		return
+	}
+
+	for _, fieldError := range validationErrors {
+		l.add(RuleManifestField, fieldError.Translate(translator), namespacePath(fieldError.Namespace()))
+	}
+}
+
+// checkDefaultAPIVersions reports default API versions that are not API versions of their resource type.
+func (l *linter) checkDefaultAPIVersions(provider *ResourceProvider) {
+	for name, resourceType := range provider.Types {
+		if resourceType == nil || resourceType.DefaultAPIVersion == nil {
+			continue
+		}
+
+		if _, ok := resourceType.APIVersions[*resourceType.DefaultAPIVersion]; !ok {
+			l.add(RuleDefaultAPIVersion,
+				fmt.Sprintf("defaultApiVersion %q is not one of the API versions of resource type %q", *resourceType.DefaultAPIVersion, name),
+				[]string{"types", name, "defaultApiVersion"})
+		}
+	}
+}
+
+// checkSchemas reports the violations of the schema rules, at the position of the schema property they apply to.
+func (l *linter) checkSchemas(ctx context.Context, provider *ResourceProvider) {
+	for _, violation := range checkManifestSchemas(ctx, provider) {
+		schemaPath := []string{"types", violation.resourceType, "apiVersions", violation.apiVersion, "schema"}
+		if violation.conversion {
+			l.add(RuleConversion, violation.err.Error(), appendPath(schemaPath, "x-radius-conversions"))
+			continue
+		}
+
+		var valErrs *schema.ValidationErrors
+		var valErr *schema.ValidationError
+		switch {
+		case errors.As(violation.err, &valErrs):
+			for _, ve := range valErrs.Errors {
+				l.addSchemaError(schemaPath, ve)
+			}
+		case errors.As(violation.err, &valErr):
+			l.addSchemaError(schemaPath, valErr)
+		default:
+			l.add(RuleSchema, violation.err.Error(), schemaPath)
+		}
+	}
+}
+
+// addSchemaError records a diagnostic for a schema validation error.
+func (l *linter) addSchemaError(schemaPath []string, err *schema.ValidationError) {
+	rule := RuleSchema
+	switch err.Type {
+	case schema.ErrorTypeConstraint:
+		rule = RuleSchemaConstraint
+	case schema.ErrorTypeFormat:
+		rule = RuleSchemaFormat
+	}
+
+	l.add(rule, err.Message, l.resolveSchemaField(schemaPath, err.Field))
+}
+
+// resolveSchemaField returns the path in the manifest of a property path relative to a schema. Each segment of the
+// property path is a property of the schema, or a keyword such as "items" or "additionalProperties". Resolution
+// stops at the last segment found in the manifest.
+func (l *linter) resolveSchemaField(schemaPath []string, field string) []string {
+	path := schemaPath
+	if field == "" {
+		return path
+	}
+
+	for _, segment := range strings.Split(field, ".") {
+		if property := appendPath(path, "properties", segment); l.find(property) != nil {
+			path = property
+		} else if keyword := appendPath(path, segment); l.find(keyword) != nil {
+			path = keyword
+		} else {
+			break
+		}
+	}
+
+	return path
+}
+
+// position returns the line and column of the deepest key of the path found in the manifest.
+func (l *linter) position(path []string) (int, int) {
+	node := l.root
+	var found ast.Node = node
+	for _, key := range path {
+		value := lookup(node, key)
+		if value == nil {
+			break
+		}
+		found = value.Key
+		node = value.Value
+	}
+
+	if found == nil {
+		return 0, 0
+	}
+	token := found.GetToken()
+	if token == nil || token.Position == nil {
+		return 0, 0
+	}
+
+	return token.Position.Line, token.Position.Column
+}
+
+// find returns the node at the path in the manifest, or nil if it does not exist.
+func (l *linter) find(path []string) ast.Node {
+	node := l.root
+	for _, key := range path {
+		value := lookup(node, key)
+		if value == nil {
+			return nil
+		}
+		node = value.Value
+	}
+
+	return node
+}
+
+// lookup returns the first value of the key in a mapping node.
+func lookup(node ast.Node, key string) *ast.MappingValueNode {
+	for _, value := range mappingValues(node) {
+		if keyName(value) == key {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// mappingValues returns the key/value pairs of a mapping node. A mapping with a single key is parsed as a
+// MappingValueNode.
+func mappingValues(node ast.Node) []*ast.MappingValueNode {
+	switch n := unwrap(node).(type) {
+	case *ast.MappingNode:
+		return n.Values
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}
+	}
+
+	return nil
+}
+
+// unwrap returns the value of anchor and tag nodes.
+func unwrap(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.AnchorNode:
+			node = n.Value
+		case *ast.TagNode:
+			node = n.Value
+		default:
+			return node
+		}
+	}
+}
+
+// keyName returns the key of a key/value pair, without quotes.
+func keyName(value *ast.MappingValueNode) string {
+	if token := value.Key.GetToken(); token != nil {
+		return token.Value
+	}
+
+	return value.Key.String()
+}
+
+// fieldByYAMLName returns the field of a struct type with the yaml tag name.
+func fieldByYAMLName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if strings.SplitN(field.Tag.Get("yaml"), ",", 2)[0] == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// namespaceSegmentRegex matches a segment of a validator namespace, such as "types[myType]".
+var namespaceSegmentRegex = regexp.MustCompile(`^([^\[]+)(?:\[(.*)\])?$`)
+
+// namespacePath converts the namespace of a validator field error to a manifest path.
+//
+// Example: "ResourceProvider.types[myType].apiVersions[2025-01-01].schema" -> types.myType.apiVersions.2025-01-01.schema
+func namespacePath(namespace string) []string {
+	segments := strings.Split(namespace, ".")
+	if len(segments) > 0 {
+		// Skip the name of the root type.
+		segments = segments[1:]
+	}
+
+	// Map keys may contain '.', so rejoin segments split inside brackets.
+	path := []string{}
+	for i := 0; i < len(segments); i++ {
+		segment := segments[i]
+		for strings.Count(segment, "[") > strings.Count(segment, "]") && i+1 < len(segments) {
+			i++
+			segment += "." + segments[i]
+		}
+
+		match := namespaceSegmentRegex.FindStringSubmatch(segment)
+		if match == nil {
+			path = append(path, segment)
+			continue
+		}
+
+		path = append(path, match[1])
+		if match[2] != "" {
+			path = append(path, match[2])
+		}
+	}
+
+	return path
+}
+
+// appendPath returns a new path with the keys appended.
+func appendPath(path []string, keys ...string) []string {
+	result := make([]string, 0, len(path)+len(keys))
+	result = append(result, path...)
+	return append(result, keys...)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintFile(t *testing.T) {
+	t.Run("valid manifest", func(t *testing.T) {
+		diagnostics, err := LintFile(context.Background(), "testdata/valid.yaml")
+		require.NoError(t, err)
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("reports all problems with their position", func(t *testing.T) {
+		diagnostics, err := LintFile(context.Background(), "testdata/lint-errors.yaml")
+		require.NoError(t, err)
+
+		type problem struct {
+			rule   string
+			path   string
+			line   int
+			column int
+		}
+		actual := []problem{}
+		for _, diagnostic := range diagnostics {
+			actual = append(actual, problem{diagnostic.Rule, diagnostic.Path, diagnostic.Line, diagnostic.Column})
+		}
+
+		require.Equal(t, []problem{
+			{RuleUnknownField, "unknownTopLevel", 2, 1},
+			{RuleDefaultAPIVersion, "types.testResources.defaultApiVersion", 5, 5},
+			{RuleSchemaConstraint, "types.testResources.apiVersions.2025-01-01.schema.properties.password", 14, 13},
+			{RuleSchemaConstraint, "types.testResources.apiVersions.2025-01-01.schema.properties.items.items", 19, 15},
+			{RuleManifestField, "types.testResources.apiVersions.bad-version", 23, 7},
+			{RuleManifestField, "types.Bad_Type", 29, 3},
+			{RuleManifestField, "types.Bad_Type.capabilities.0", 30, 5},
+			{RuleDuplicateKey, "types.Bad_Type.apiVersions.2025-01-01.schema.properties.port", 40, 13},
+		}, actual)
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		diagnostics, err := LintFile(context.Background(), "testdata/invalid-yaml.yaml")
+		require.NoError(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, RuleYAMLSyntax, diagnostics[0].Rule)
+		require.NotZero(t, diagnostics[0].Line)
+	})
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		diagnostics, err := LintFile(context.Background(), "testdata/duplicate-key.yaml")
+		require.NoError(t, err)
+		require.Contains(t, diagnostics, Diagnostic{Rule: RuleDuplicateKey, Message: `duplicate key "types"`, Path: "types", Line: 8, Column: 1})
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LintFile(context.Background(), "testdata/does-not-exist.yaml")
+		require.Error(t, err)
+	})
+}
+
+func TestLintBytes_InvalidValue(t *testing.T) {
+	data := []byte(`namespace: MyCompany.Resources
+types:
+  testResources:
+    capabilities: "NotAList"
+    apiVersions:
+      '2025-01-01':
+        schema: {}
+`)
+
+	diagnostics := LintBytes(context.Background(), data)
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, RuleInvalidValue, diagnostics[0].Rule)
+	require.Equal(t, 4, diagnostics[0].Line)
+}
+
+func TestLintBytes_Conversion(t *testing.T) {
+	data := []byte(`namespace: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01':
+        schema:
+          type: object
+          properties:
+            environment:
+              type: string
+          x-radius-conversions:
+            '2024-01-01':
+              - rename: port
+`)
+
+	diagnostics := LintBytes(context.Background(), data)
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, RuleConversion, diagnostics[0].Rule)
+	require.Equal(t, "types.testResources.apiVersions.2025-01-01.schema.x-radius-conversions", diagnostics[0].Path)
+	require.Equal(t, 11, diagnostics[0].Line)
+	require.Contains(t, diagnostics[0].Message, `rename of "port" must set 'to' to a property name`)
+}
+
+func TestNamespacePath(t *testing.T) {
+	require.Equal(t, []string{"types", "myType", "apiVersions", "2025-01-01", "schema"}, namespacePath("ResourceProvider.types[myType].apiVersions[2025-01-01].schema"))
+	require.Equal(t, []string{"namespace"}, namespacePath("ResourceProvider.namespace"))
+	require.Equal(t, []string{"types", "a.b"}, namespacePath("ResourceProvider.types[a.b]"))
+}
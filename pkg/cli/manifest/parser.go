@@ -61,6 +61,13 @@ func ReadBytes(data []byte) (*ResourceProvider, error) {
 }
 
 func createValidator() yaml.StructValidator {
+	v, translator := newStructValidator()
+	return &errorTranslator{inner: v, translator: translator}
+}
+
+// newStructValidator creates a validator for the "validate" tags of the manifest types, and the translator
+// for its error messages.
+func newStructValidator() (*validator.Validate, ut.Translator) {
 	// This is the boilerplate required to create a validator that will support struct tags
 	// like `validate:"required"` AND provide reasonable error messages.
 	//
@@ -124,7 +131,7 @@ func createValidator() yaml.StructValidator {
 		return name
 	})
 
-	return &errorTranslator{inner: v, translator: translator}
+	return v, translator
 }
 
 // errorTranslator is a wrapper around the validator.Validate type that will
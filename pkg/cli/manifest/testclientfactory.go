@@ -24,16 +24,22 @@ import (
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
 )
 
 // NewTestClientFactory creates a new client factory for testing purposes.
 func NewTestClientFactory(resourceProvidersServer func() ucpfake.ResourceProvidersServer) (*v20231001preview.ClientFactory, error) {
+	return NewTestClientFactoryWithAPIVersions(resourceProvidersServer, WithAPIVersionServerNoError)
+}
+
+// NewTestClientFactoryWithAPIVersions creates a new client factory for testing purposes with a custom API versions server.
+func NewTestClientFactoryWithAPIVersions(resourceProvidersServer func() ucpfake.ResourceProvidersServer, apiVersionsServer func() ucpfake.APIVersionsServer) (*v20231001preview.ClientFactory, error) {
 	serverFactory := ucpfake.ServerFactory{
 		ResourceProvidersServer: resourceProvidersServer(),
 		ResourceTypesServer:     WithResourceTypeServerNoError(),
-		APIVersionsServer:       WithAPIVersionServerNoError(),
+		APIVersionsServer:       apiVersionsServer(),
 		LocationsServer:         WithLocationServerNoError(),
 	}
 
@@ -193,6 +199,33 @@ func WithAPIVersionServerNoError() ucpfake.APIVersionsServer {
 	return apiVersionsServer
 }
 
+// WithAPIVersionServerStoredSchemas returns an API versions server that succeeds on create and lists the given stored
+// schemas, keyed by API version name.
+func WithAPIVersionServerStoredSchemas(schemas map[string]map[string]any) func() ucpfake.APIVersionsServer {
+	return func() ucpfake.APIVersionsServer {
+		apiVersionsServer := WithAPIVersionServerNoError()
+		apiVersionsServer.NewListPager = func(
+			planeName string,
+			resourceProviderName string,
+			resourceTypeName string,
+			options *v20231001preview.APIVersionsClientListOptions,
+		) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			result := v20231001preview.APIVersionsClientListResponse{}
+			for name, schema := range schemas {
+				result.Value = append(result.Value, &v20231001preview.APIVersionResource{
+					Name: to.Ptr(name),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schema,
+					},
+				})
+			}
+			resp.AddPage(http.StatusOK, result, nil)
+			return
+		}
+		return apiVersionsServer
+	}
+}
+
 func WithLocationServerNoError() ucpfake.LocationsServer {
 	locationsServer := ucpfake.LocationsServer{
 		BeginCreateOrUpdate: func(
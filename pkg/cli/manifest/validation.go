@@ -19,7 +19,9 @@ package manifest
 import (
 	"context"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/radius-project/radius/pkg/schema"
@@ -63,39 +65,97 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 		return fmt.Errorf("provider is nil")
 	}
 
+	errs := &schema.ValidationErrors{}
+	for _, violation := range checkManifestSchemas(ctx, provider) {
+		schemaPath := fmt.Sprintf("%s/%s@%s", provider.Namespace, violation.resourceType, violation.apiVersion)
+		if valErr, ok := violation.err.(*schema.ValidationError); ok {
+			valErr.Field = schemaPath + "." + valErr.Field
+			errs.Add(valErr)
+		} else {
+			errs.Add(schema.NewSchemaError(schemaPath, violation.err.Error()))
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+
+	return nil
+}
+
+// schemaViolation is a violation of the schema rules by an API version of a resource type.
+type schemaViolation struct {
+	resourceType string
+	apiVersion   string
+
+	// conversion is true if the violation is in the conversion rules of the schema.
+	conversion bool
+
+	// err describes the violation. Errors returned by schema.Validator may contain several violations, with fields
+	// relative to the schema.
+	err error
+}
+
+// checkManifestSchemas returns the violations of the schema rules by the schemas of a ResourceProvider, sorted by
+// resource type and API version.
+func checkManifestSchemas(ctx context.Context, provider *ResourceProvider) []schemaViolation {
 	validator := schema.NewValidator()
-	errors := &schema.ValidationErrors{}
+	violations := []schemaViolation{}
 
 	// Iterate through resource types in the provider
-	for resourceTypeName, resourceType := range provider.Types {
+	for _, resourceTypeName := range slices.Sorted(maps.Keys(provider.Types)) {
+		resourceType := provider.Types[resourceTypeName]
+		if resourceType == nil {
+			continue
+		}
+
 		// Check each API version
-		for apiVersion, versionInfo := range resourceType.APIVersions {
-			if versionInfo.Schema != nil {
-				schemaPath := fmt.Sprintf("%s/%s@%s", provider.Namespace, resourceTypeName, apiVersion)
+		for _, apiVersion := range slices.Sorted(maps.Keys(resourceType.APIVersions)) {
+			versionInfo := resourceType.APIVersions[apiVersion]
+			if versionInfo == nil || versionInfo.Schema == nil {
+				continue
+			}
+
+			add := func(err error, conversion bool) {
+				violations = append(violations, schemaViolation{
+					resourceType: resourceTypeName,
+					apiVersion:   apiVersion,
+					conversion:   conversion,
+					err:          err,
+				})
+			}
+
+			// Convert schema to OpenAPI schema
+			openAPISchema, err := schema.ConvertToOpenAPISchema(versionInfo.Schema)
+			if err != nil {
+				add(fmt.Errorf("failed to parse schema: %w", err), false)
+				continue
+			}
+
+			// Validate the schema
+			if err := validator.ValidateSchema(ctx, openAPISchema); err != nil {
+				add(err, false)
+			}
 
-				// Convert schema to OpenAPI schema
-				openAPISchema, err := schema.ConvertToOpenAPISchema(versionInfo.Schema)
+			// Validate the conversion rules between API versions and the validation webhook
+			if schemaMap, ok := versionInfo.Schema.(map[string]any); ok {
+				conversions, err := schema.ExtractConversionRules(schemaMap)
 				if err != nil {
-					errors.Add(schema.NewSchemaError(schemaPath, fmt.Sprintf("failed to parse schema: %v", err)))
-					continue
+					add(err, true)
 				}
-
-				// Validate the schema
-				if err := validator.ValidateSchema(ctx, openAPISchema); err != nil {
-					if valErr, ok := err.(*schema.ValidationError); ok {
-						valErr.Field = schemaPath + "." + valErr.Field
-						errors.Add(valErr)
-					} else {
-						errors.Add(schema.NewSchemaError(schemaPath, err.Error()))
+				for _, from := range slices.Sorted(maps.Keys(conversions)) {
+					if _, ok := resourceType.APIVersions[from]; !ok || from == apiVersion {
+						add(fmt.Errorf("conversion rules must convert from another API version of the resource type, got %q", from), true)
 					}
 				}
+
+				// Validate the admission webhook settings
+				if _, err := schema.ExtractValidationWebhook(schemaMap); err != nil {
+					add(err, false)
+				}
 			}
 		}
 	}
 
-	if errors.HasErrors() {
-		return errors
-	}
-
-	return nil
+	return violations
 }
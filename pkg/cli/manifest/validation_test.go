@@ -459,6 +459,143 @@ func TestValidateManifestSchemas(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("provider with valid conversion rules", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"host":        map[string]any{"type": "string"},
+									"environment": map[string]any{"type": "string"},
+								},
+							},
+						},
+						"2023-11-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"hostname":    map[string]any{"type": "string"},
+									"environment": map[string]any{"type": "string"},
+								},
+								"x-radius-conversions": map[string]any{
+									"2023-10-01": []any{
+										map[string]any{"rename": "host", "to": "hostname"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.NoError(t, err)
+	})
+
+	t.Run("provider with conversion rules from an unknown API version", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-11-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"x-radius-conversions": map[string]any{
+									"2023-10-01": []any{
+										map[string]any{"rename": "host", "to": "hostname"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "conversion rules must convert from another API version of the resource type, got \"2023-10-01\"")
+	})
+
+	t.Run("provider with a validation webhook", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"x-radius-validation-webhook": map[string]any{
+									"url":            "https://policy.example.com/validate",
+									"timeoutSeconds": 5,
+									"failurePolicy":  "Ignore",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.NoError(t, err)
+	})
+
+	t.Run("provider with an invalid validation webhook", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"x-radius-validation-webhook": map[string]any{
+									"url": "policy.example.com",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "x-radius-validation-webhook: url must be an absolute URL")
+	})
+
+	t.Run("provider with invalid conversion rules", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{"type": "object"},
+						},
+						"2023-11-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"x-radius-conversions": map[string]any{
+									"2023-10-01": []any{
+										map[string]any{"move": "port"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "move of \"port\" must set 'to' to a property path")
+	})
+
 	t.Run("provider with multiple errors", func(t *testing.T) {
 		provider := &ResourceProvider{
 			Namespace: "Test.Provider",
@@ -44,7 +44,9 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -59,9 +61,20 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// indexedFields are the properties that are labeled so that equality filters on them can be pushed down to the
+// API Server. These are the properties that controllers filter on.
+var indexedFields = []string{
+	"resourceID",
+	"resourceKey",
+	"properties.compute.kubernetes.namespace",
+	"properties.providers.kubernetes.namespace",
+	"properties.status.compute.kubernetes.namespace",
+}
+
 const (
 	// LabelKind is used to determine whether an object holds scopes or resources. Conflicts are not possible due to the way we do naming.
 	// Each Kubernetes object holds only scopes or only resources.
@@ -77,17 +90,34 @@ const (
 	// hash collision.
 	LabelValueMultiple = "m_u_l_t_i_p_l_e"
 
+	// LabelFilterFormat is used to format a label that indexes a property used in query filters. The placeholder is
+	// replaced by the property path (eg: resourceKey).
+	LabelFilterFormat = "ucp.dev/filter-%s"
+
+	// LabelFilterIndex is used as the key of a label recording the version of the property index applied to the
+	// object. Objects written before the index existed, or with a different set of indexed properties, are not
+	// labeled with the current version.
+	LabelFilterIndex = "ucp.dev/filter-index"
+
+	// FilterIndexVersion is the current value of the LabelFilterIndex label. It must be changed whenever
+	// indexedFields changes.
+	FilterIndexVersion = "1"
+
 	// RetryCount is the number of retries we will make on optimistic concurrency failures. The need for retries is **rare** because
 	// it only happens on concurrent operations to the same UCP resource or on a hash collision.
 	RetryCount = 10
 )
 
 // NewAPIServerClient creates a new APIServerClient object which is used to interact with the API server.
+//
+// The client must implement runtimeclient.WithWatch to support Watch.
 func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServerClient {
 	return &APIServerClient{client: client, namespace: namespace}
 }
 
 var _ database.Client = (*APIServerClient)(nil)
+var _ database.Watcher = (*APIServerClient)(nil)
+var _ database.Transactor = (*APIServerClient)(nil)
 
 type APIServerClient struct {
 	client    runtimeclient.Client
@@ -101,6 +131,12 @@ type APIServerClient struct {
 }
 
 // Query searches for objects in the store that match the given query and returns them.
+//
+// Scopes and resource types are pushed down to the API Server as label selectors. Equality filters (equals,
+// ieq and in) on the indexed properties are pushed down as well, by matching the hash of the value stored in a
+// label when the object was saved. Objects saved before the current index version are listed separately so they
+// are still found. The filters are always evaluated against each entry while the list is processed, since labels
+// are only a hint: one object can hold several entries and hashes are case-insensitive.
 func (c *APIServerClient) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
 	if ctx == nil {
 		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -115,13 +151,26 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 		return nil, err
 	}
 
-	rs := ucpv1alpha1.ResourceList{}
-	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	selectors, err := createFilterSelectors(selector, query.Filters)
 	if err != nil {
 		return nil, err
 	}
 
-	results := database.ObjectQueryResult{}
+	rs := ucpv1alpha1.ResourceList{}
+	for _, selector := range selectors {
+		list := ucpv1alpha1.ResourceList{}
+		err = c.client.List(ctx, &list, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+
+		rs.Items = append(rs.Items, list.Items...)
+	}
+
+	config := database.NewQueryConfig(options...)
+
+	// The API Server does not support ordering, so we read all of the matching entries and paginate them here.
+	items := []database.Object{}
 	for _, resource := range rs.Items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
@@ -146,12 +195,12 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 					continue
 				}
 
-				results.Items = append(results.Items, *converted)
+				items = append(items, *converted)
 			}
 		}
 	}
 
-	return &results, nil
+	return databaseutil.Paginate(items, config)
 }
 
 // Get retrieves an object from the store given its ID, or returns an error if the object does not exist or if an error occurs.
@@ -252,7 +301,7 @@ func (c *APIServerClient) Delete(ctx context.Context, id string, options ...data
 			// If there was more than one resource we need to update. There's no need to explicitly
 			// pass the options here as OCC is implicit.
 			resource.Entries = append(resource.Entries[:*index], resource.Entries[*index+1:]...)
-			resource.Labels = assignLabels(&resource)
+			resource.Labels = labels.Merge(assignLabels(&resource), assignFilterLabels(&resource))
 
 			err := c.client.Update(ctx, &resource)
 			if err != nil && apierrors.IsNotFound(err) {
@@ -325,7 +374,7 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 			resource.Entries[*index] = *converted
 		}
 
-		resource.Labels = assignLabels(&resource)
+		resource.Labels = labels.Merge(assignLabels(&resource), assignFilterLabels(&resource))
 
 		c.synchronize()
 
@@ -353,6 +402,262 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 	return err
 }
 
+// ExecuteTransaction implements database.Transactor.
+//
+// ExecuteTransaction is NOT atomic. The API Server cannot update multiple objects atomically, so the operations
+// are applied one at a time and a failure is handled with a best-effort revert. The preconditions of every
+// operation are checked before any change is made, and if an operation fails then the operations that were
+// already applied are reverted. Concurrent readers may observe the intermediate state, and the revert itself can
+// fail (leaving partial state behind) if another writer modifies the same resources at the same time or the API
+// Server becomes unavailable.
+func (c *APIServerClient) ExecuteTransaction(ctx context.Context, operations []database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := database.ValidateOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	// Capture the current state of each resource so we can check preconditions and revert.
+	previous := make([]*database.Object, len(operations))
+	for i, operation := range operations {
+		obj, err := c.Get(ctx, operation.ResourceID())
+		if errors.Is(err, &database.ErrNotFound{}) && operation.ETag != "" {
+			return &database.ErrConcurrency{}
+		} else if errors.Is(err, &database.ErrNotFound{}) && operation.Type == database.OperationDelete {
+			return &database.ErrNotFound{ID: operation.ID}
+		} else if errors.Is(err, &database.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return err
+		} else if operation.ETag != "" && operation.ETag != obj.ETag {
+			return &database.ErrConcurrency{}
+		}
+
+		previous[i] = obj
+	}
+
+	// Save updates the ETag of the object, so we need to restore the ETags if the transaction fails.
+	etags := make([]string, len(operations))
+	for i, operation := range operations {
+		if operation.Type == database.OperationSave {
+			etags[i] = operation.Object.ETag
+		}
+	}
+
+	for i, operation := range operations {
+		// Use the ETag we read as the precondition so that we detect concurrent changes made since we checked.
+		options := []database.MutatingOptions{}
+		if previous[i] != nil {
+			options = append(options, database.WithETag(previous[i].ETag))
+		}
+
+		switch operation.Type {
+		case database.OperationSave:
+			saveOptions := []database.SaveOptions{}
+			for _, option := range options {
+				saveOptions = append(saveOptions, option)
+			}
+			err = c.Save(ctx, operation.Object, saveOptions...)
+		case database.OperationDelete:
+			deleteOptions := []database.DeleteOptions{}
+			for _, option := range options {
+				deleteOptions = append(deleteOptions, option)
+			}
+			err = c.Delete(ctx, operation.ID, deleteOptions...)
+		}
+
+		if err != nil {
+			revertErr := c.revert(ctx, operations[:i], previous[:i])
+			if revertErr != nil {
+				logger := ucplog.FromContextOrDiscard(ctx)
+				logger.Error(revertErr, "failed to revert transaction")
+			}
+
+			for j := range operations[:i+1] {
+				if operations[j].Type == database.OperationSave {
+					operations[j].Object.ETag = etags[j]
+				}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revert restores the previous state of the resources targeted by the applied operations, in reverse order.
+func (c *APIServerClient) revert(ctx context.Context, applied []database.Operation, previous []*database.Object) error {
+	var err error
+	for i := len(applied) - 1; i >= 0; i-- {
+		if previous[i] == nil {
+			// The resource was created by the transaction.
+			deleteErr := c.Delete(ctx, applied[i].ResourceID())
+			if deleteErr != nil && !errors.Is(deleteErr, &database.ErrNotFound{}) {
+				err = errors.Join(err, deleteErr)
+			}
+			continue
+		}
+
+		restored := *previous[i]
+		err = errors.Join(err, c.Save(ctx, &restored))
+	}
+
+	return err
+}
+
+// Watch implements database.Watcher using a Kubernetes watch over the objects that match the query's labels.
+//
+// Each Kubernetes object can hold multiple UCP resources, so events are computed by comparing the entries of
+// each object with the last known state of that object.
+func (c *APIServerClient) Watch(ctx context.Context, query database.Query) (<-chan database.Event, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	watcher, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("watch is not supported: the Kubernetes client does not support watches")
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// List first so we know the current state and can start watching from the same resource version.
+	rs := ucpv1alpha1.ResourceList{}
+	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]map[string]ucpv1alpha1.ResourceEntry{}
+	for i := range rs.Items {
+		known[rs.Items[i].Name] = entriesByID(&rs.Items[i])
+	}
+
+	w, err := watcher.Watch(
+		ctx,
+		&ucpv1alpha1.ResourceList{},
+		runtimeclient.InNamespace(c.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector},
+		&runtimeclient.ListOptions{Raw: &v1.ListOptions{ResourceVersion: rs.ResourceVersion}})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan database.Event)
+	go func() {
+		defer close(events)
+		defer w.Stop()
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for {
+			var change watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case change, ok = <-w.ResultChan():
+				if !ok {
+					return
+				}
+			}
+
+			if change.Type == watch.Error {
+				logger.Info("watch of resources was terminated", "error", apierrors.FromObject(change.Object).Error())
+				return
+			}
+
+			resource, ok := change.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				// Bookmarks and other notifications don't carry resources.
+				continue
+			}
+
+			previous := known[resource.Name]
+			current := map[string]ucpv1alpha1.ResourceEntry{}
+			if change.Type == watch.Deleted {
+				delete(known, resource.Name)
+			} else {
+				current = entriesByID(resource)
+				known[resource.Name] = current
+			}
+
+			for _, event := range diffEntries(previous, current) {
+				match, err := databaseutil.EventMatchesQuery(event, query)
+				if err != nil {
+					logger.Error(err, "found an invalid resource id as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+					continue
+				} else if !match {
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// entriesByID returns the entries of the Kubernetes object keyed by their case-insensitive id.
+func entriesByID(resource *ucpv1alpha1.Resource) map[string]ucpv1alpha1.ResourceEntry {
+	entries := map[string]ucpv1alpha1.ResourceEntry{}
+	for _, entry := range resource.Entries {
+		entries[strings.ToLower(entry.ID)] = entry
+	}
+
+	return entries
+}
+
+// diffEntries computes the events that describe the change from the previous entries to the current entries.
+func diffEntries(previous map[string]ucpv1alpha1.ResourceEntry, current map[string]ucpv1alpha1.ResourceEntry) []database.Event {
+	events := []database.Event{}
+	for key, entry := range current {
+		old, ok := previous[key]
+		if ok && old.ETag == entry.ETag {
+			continue
+		}
+
+		obj, err := readEntry(&entry)
+		if err != nil {
+			continue
+		}
+
+		eventType := database.EventUpdated
+		if !ok {
+			eventType = database.EventCreated
+		}
+		events = append(events, database.Event{Type: eventType, Object: *obj})
+	}
+
+	for key, entry := range previous {
+		if _, ok := current[key]; ok {
+			continue
+		}
+
+		obj, err := readEntry(&entry)
+		if err != nil {
+			continue
+		}
+		events = append(events, database.Event{Type: database.EventDeleted, Object: *obj})
+	}
+
+	return events
+}
+
 func (c *APIServerClient) doWithRetry(action func() (bool, error)) error {
 	for range RetryCount {
 		retryable, err := action()
@@ -513,6 +818,124 @@ func createLabelSelector(query database.Query) (labels.Selector, error) {
 	return selector, nil
 }
 
+// assignFilterLabels labels the resource with the hash of each indexed property, so that equality filters can be
+// pushed down by createFilterSelectors.
+func assignFilterLabels(resource *ucpv1alpha1.Resource) labels.Set {
+	set := labels.Set{}
+	for _, entry := range resource.Entries {
+		obj, err := readEntry(&entry)
+		if err != nil {
+			// An entry we can't read can't be indexed, so leave the object unindexed. It will be listed by every
+			// filtered query.
+			return labels.Set{}
+		}
+
+		for _, field := range indexedFields {
+			property, ok := stringProperty(obj.Data, field)
+			if !ok {
+				continue
+			}
+
+			key := fmt.Sprintf(LabelFilterFormat, field)
+			value := filterLabelValue(property)
+			existing, ok := set[key]
+			if ok && existing != value {
+				value = LabelValueMultiple
+			}
+
+			set[key] = value
+		}
+	}
+
+	set[LabelFilterIndex] = FilterIndexVersion
+	return set
+}
+
+// createFilterSelectors returns the selectors used to list the objects that can match the filters. When none of the
+// filters can be pushed down this is the base selector. Otherwise it is one selector for the objects labeled with the
+// current index version, restricted by the filters, and one for every other object.
+func createFilterSelectors(selector labels.Selector, filters []database.QueryFilter) ([]labels.Selector, error) {
+	requirements := []labels.Requirement{}
+	for _, filter := range filters {
+		if !slices.Contains(indexedFields, filter.Field) {
+			continue
+		}
+
+		var values []string
+		switch filter.Operator {
+		case database.FilterOperatorEquals, database.FilterOperatorEqualsIgnoreCase:
+			values = []string{filterLabelValue(filter.Value)}
+		case database.FilterOperatorIn:
+			for _, value := range filter.Values {
+				values = append(values, filterLabelValue(value))
+			}
+		default:
+			continue
+		}
+
+		requirement, err := labels.NewRequirement(fmt.Sprintf(LabelFilterFormat, filter.Field), selection.In, append(values, LabelValueMultiple))
+		if err != nil {
+			return nil, err
+		}
+
+		requirements = append(requirements, *requirement)
+	}
+
+	if len(requirements) == 0 {
+		return []labels.Selector{selector}, nil
+	}
+
+	indexed, err := labels.NewRequirement(LabelFilterIndex, selection.Equals, []string{FilterIndexVersion})
+	if err != nil {
+		return nil, err
+	}
+
+	// NotIn also matches objects without the label.
+	unindexed, err := labels.NewRequirement(LabelFilterIndex, selection.NotIn, []string{FilterIndexVersion})
+	if err != nil {
+		return nil, err
+	}
+
+	return []labels.Selector{selector.Add(*indexed).Add(requirements...), selector.Add(*unindexed)}, nil
+}
+
+// filterLabelValue returns the label value for an indexed property. Property values are often longer than the
+// 63 characters allowed in a label value and may contain invalid characters, so we use the hash of the value.
+// The value is lowercased first so that the label also serves case-insensitive filters.
+func filterLabelValue(value string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(toLowerASCII(value))))
+}
+
+// toLowerASCII lowercases the ASCII letters of value, the same folding that is used for case-insensitive filters.
+func toLowerASCII(value string) string {
+	b := []byte(value)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + ('a' - 'A')
+		}
+	}
+
+	return string(b)
+}
+
+// stringProperty returns the string at the dot-separated property path of data.
+func stringProperty(data any, field string) (string, bool) {
+	for _, segment := range strings.Split(field, ".") {
+		properties, ok := data.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		data, ok = properties[segment]
+		if !ok {
+			return "", false
+		}
+	}
+
+	value, ok := data.(string)
+	return value, ok
+}
+
 func findIndex(resource *ucpv1alpha1.Resource, id resources.ID) *int {
 	for i, entry := range resource.Entries {
 		if strings.EqualFold(entry.ID, id.String()) {
@@ -17,6 +17,7 @@ limitations under the License.
 package apiserverstore
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/radius-project/radius/pkg/components/database"
@@ -103,6 +105,35 @@ func Test_ResourceName_Normalize(t *testing.T) {
 	}
 }
 
+func Test_diffEntries(t *testing.T) {
+	entry := func(id string, value string) ucpv1alpha1.ResourceEntry {
+		raw := []byte(`{"value":"` + value + `"}`)
+		return ucpv1alpha1.ResourceEntry{ID: id, ETag: etag.New(raw), Data: &runtime.RawExtension{Raw: raw}}
+	}
+
+	previous := map[string]ucpv1alpha1.ResourceEntry{
+		"a": entry("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a", "1"),
+		"b": entry("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/b", "1"),
+		"c": entry("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/c", "1"),
+	}
+	current := map[string]ucpv1alpha1.ResourceEntry{
+		"a": previous["a"],
+		"b": entry("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/b", "2"),
+		"d": entry("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/d", "1"),
+	}
+
+	actual := map[string]database.EventType{}
+	for _, event := range diffEntries(previous, current) {
+		actual[event.Object.ID[len(event.Object.ID)-1:]] = event.Type
+	}
+
+	require.Equal(t, map[string]database.EventType{
+		"b": database.EventUpdated,
+		"c": database.EventDeleted,
+		"d": database.EventCreated,
+	}, actual)
+}
+
 func Test_APIServer_Client(t *testing.T) {
 	ctx, cancel := testcontext.NewWithCancel(t)
 	t.Cleanup(cancel)
@@ -130,6 +161,8 @@ func Test_APIServer_Client(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
 
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
@@ -857,3 +890,103 @@ func Test_CreateLabelSelector_ScopeQuery(t *testing.T) {
 	set = assignLabels(&resource)
 	require.True(t, selector.Matches(set))
 }
+
+func Test_AssignFilterLabels_IndexedFields(t *testing.T) {
+	for _, field := range indexedFields {
+		key := fmt.Sprintf(LabelFilterFormat, field)
+		_, err := labels.NewRequirement(key, selection.Equals, []string{filterLabelValue(strings.Repeat("a", 200))})
+		require.NoError(t, err, "field %q does not produce a valid label", field)
+	}
+}
+
+func Test_AssignFilterLabels_MultipleEntries(t *testing.T) {
+	resource := ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"resourceKey": "a", "resourceID": "/planes/radius/local/resourceGroups/cool-group"}`)},
+			},
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/frontend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"resourceKey": "b", "properties": {"compute": {"kubernetes": {"namespace": 3}}}}`)},
+			},
+		},
+	}
+
+	expected := labels.Set{
+		LabelFilterIndex: FilterIndexVersion,
+		fmt.Sprintf(LabelFilterFormat, "resourceKey"): LabelValueMultiple,
+		fmt.Sprintf(LabelFilterFormat, "resourceID"):  filterLabelValue("/planes/radius/local/resourcegroups/cool-group"),
+	}
+	require.Equal(t, expected, assignFilterLabels(&resource))
+}
+
+func Test_CreateFilterSelectors_NoPushDown(t *testing.T) {
+	selector, err := createLabelSelector(database.Query{RootScope: "/planes/radius/local/resourceGroups/cool-group"})
+	require.NoError(t, err)
+
+	filters := []database.QueryFilter{
+		{Field: "properties.application", Value: "cool-app"},
+		{Field: "resourceKey", Operator: database.FilterOperatorPrefix, Value: "cool"},
+	}
+	selectors, err := createFilterSelectors(selector, filters)
+	require.NoError(t, err)
+	require.Equal(t, []labels.Selector{selector}, selectors)
+}
+
+func Test_CreateFilterSelectors_PushDown(t *testing.T) {
+	query := database.Query{
+		RootScope:    "/planes/radius/local/resourceGroups/cool-group",
+		ResourceType: "Applications.Core/containers",
+		Filters: []database.QueryFilter{
+			{Field: "resourceID", Operator: database.FilterOperatorEqualsIgnoreCase, Value: "/PLANES/radius/local/resourceGroups/cool-group"},
+			{Field: "resourceKey", Operator: database.FilterOperatorIn, Values: []string{"a", "b"}},
+		},
+	}
+
+	selector, err := createLabelSelector(query)
+	require.NoError(t, err)
+
+	selectors, err := createFilterSelectors(selector, query.Filters)
+	require.NoError(t, err)
+	require.Len(t, selectors, 2)
+
+	matches := func(data string) []bool {
+		resource := ucpv1alpha1.Resource{
+			Entries: []ucpv1alpha1.ResourceEntry{
+				{
+					ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+					Data: &runtime.RawExtension{Raw: []byte(data)},
+				},
+			},
+		}
+		set := labels.Merge(assignLabels(&resource), assignFilterLabels(&resource))
+		return []bool{selectors[0].Matches(set), selectors[1].Matches(set)}
+	}
+
+	// Match!
+	require.Equal(t, []bool{true, false}, matches(`{"resourceKey": "b", "resourceID": "/planes/radius/local/resourceGroups/cool-group"}`))
+
+	// Value not in the filter
+	require.Equal(t, []bool{false, false}, matches(`{"resourceKey": "c", "resourceID": "/planes/radius/local/resourceGroups/cool-group"}`))
+
+	// Missing property
+	require.Equal(t, []bool{false, false}, matches(`{"resourceKey": "a"}`))
+
+	// Objects saved before the index existed are always listed.
+	resource := ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				ID: "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+			},
+		},
+	}
+	set := assignLabels(&resource)
+	require.False(t, selectors[0].Matches(set))
+	require.True(t, selectors[1].Matches(set))
+
+	// Objects indexed with another version are always listed.
+	set[LabelFilterIndex] = "0"
+	require.False(t, selectors[0].Matches(set))
+	require.True(t, selectors[1].Matches(set))
+}
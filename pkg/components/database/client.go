@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 )
 
 // jsonPropertyPattern is the pattern for a valid JSON property name.
@@ -54,6 +55,18 @@ type Client interface {
 	// Query executes a query against the data store and returns the results.
 	//
 	// Queries must provide a root scope and a resource type. Other fields are optional.
+	//
+	// Results are ordered by resource id, compared byte-wise after normalization (see databaseutil.SortKey).
+	// When WithMaxQueryItemCount is used, at most that many results are returned and PaginationToken is set
+	// if and only if more results exist. Passing the token to WithPaginationToken resumes the query after the
+	// last returned result. Since the token records a position in the ordering rather than an offset, resources
+	// created or deleted between pages never cause a resource that exists for the whole query to be skipped or
+	// returned twice. Query will return ErrInvalid if the token is malformed.
+	//
+	// Filters are evaluated by the data store where the implementation supports it. The Kubernetes API Server
+	// implementation pushes down equality filters (equals, ieq and in) on a fixed set of indexed properties as
+	// label selectors. Other filters are evaluated in memory against every object matching the scope and
+	// resource type.
 	Query(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error)
 
 	// Get retrieves a single resource from the data store by its resource id.
@@ -124,9 +137,13 @@ type Query struct {
 	// 	set RootScope to /planes/radius/local and ScopeRecursive = True and IsScopeQuery to False.
 	IsScopeQuery bool
 
-	// TODO: Revisit filter design
-
-	// Filters is an query filter to filter the specific property value.
+	// Filters is an query filter to filter the specific property value. All filters must match
+	// for a resource to be returned.
+	//
+	// Implementations should evaluate filters in the underlying data store when possible. Pagination limits
+	// always apply to the filtered result, but a store that evaluates filters in memory (see Client.Query)
+	// still reads every resource matching the scope and resource type. Object.MatchesFilters is the reference
+	// implementation of the filter semantics.
 	Filters []QueryFilter
 }
 
@@ -146,7 +163,7 @@ func (q Query) Validate() error {
 	}
 
 	for _, filter := range q.Filters {
-		err = errors.Join(filter.Validate())
+		err = errors.Join(err, filter.Validate())
 	}
 
 	return err
@@ -162,9 +179,18 @@ type QueryFilter struct {
 	//	- "properties.application"
 	Field string
 
+	// Operator specifies the comparison to perform. The zero value is FilterOperatorEquals
+	// so existing filters that only set Field and Value keep their exact-match behavior.
+	Operator FilterOperator
+
 	// Value specifies the value to filter. The value must be a string and will be
-	// compared case-insentively with the property value.
+	// compared with the property value according to the Operator.
+	//
+	// For numeric operators the value must be parseable as a number.
 	Value string
+
+	// Values specifies the set of values to match for FilterOperatorIn.
+	Values []string
 }
 
 // Validate validates the QueryFilter.
@@ -178,7 +204,28 @@ func (f QueryFilter) Validate() error {
 		err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Field is invalid in filter: %+v", f)})
 	}
 
-	// Value can be blank. If it is blank, the filter will match the empty string in the target property.
+	switch f.Operator {
+	case FilterOperatorEquals, FilterOperatorEqualsIgnoreCase, FilterOperatorNotEquals, FilterOperatorPrefix, FilterOperatorContains:
+		// Value can be blank. If it is blank, the filter will match the empty string in the target property.
+	case FilterOperatorExists, FilterOperatorNotExists:
+		if f.Value != "" || len(f.Values) > 0 {
+			err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Value must not be set for operator %q in filter: %+v", f.Operator, f)})
+		}
+	case FilterOperatorIn:
+		if len(f.Values) == 0 {
+			err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Values is required for operator %q in filter: %+v", f.Operator, f)})
+		}
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual, FilterOperatorLessThan, FilterOperatorLessThanOrEqual:
+		if _, parseErr := strconv.ParseFloat(f.Value, 64); parseErr != nil {
+			err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Value must be a number for operator %q in filter: %+v", f.Operator, f)})
+		}
+	default:
+		err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Operator is invalid in filter: %+v", f)})
+	}
+
+	if f.Operator != FilterOperatorIn && len(f.Values) > 0 {
+		err = errors.Join(err, &ErrInvalid{Message: fmt.Sprintf("Values is only supported for operator %q in filter: %+v", FilterOperatorIn, f)})
+	}
 
 	return err
 }
@@ -106,6 +106,41 @@ func TestQueryFilter_Validate(t *testing.T) {
 			filter:  QueryFilter{Field: "properties.application.some.other.thing", Value: "some value"},
 			wantErr: false,
 		},
+		{
+			name:    "Operator is invalid",
+			filter:  QueryFilter{Field: "location", Operator: "asdf", Value: "some value"},
+			wantErr: true,
+		},
+		{
+			name:    "In without values",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorIn},
+			wantErr: true,
+		},
+		{
+			name:    "In with values",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorIn, Values: []string{"east", "west"}},
+			wantErr: false,
+		},
+		{
+			name:    "Values without In",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorPrefix, Values: []string{"east"}},
+			wantErr: true,
+		},
+		{
+			name:    "Exists with value",
+			filter:  QueryFilter{Field: "location", Operator: FilterOperatorExists, Value: "some value"},
+			wantErr: true,
+		},
+		{
+			name:    "Numeric with non-numeric value",
+			filter:  QueryFilter{Field: "properties.replicas", Operator: FilterOperatorGreaterThan, Value: "three"},
+			wantErr: true,
+		},
+		{
+			name:    "Numeric with numeric value",
+			filter:  QueryFilter{Field: "properties.replicas", Operator: FilterOperatorLessThanOrEqual, Value: "3.5"},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
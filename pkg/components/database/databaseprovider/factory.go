@@ -28,6 +28,7 @@ import (
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/components/database/postgres"
+	"github.com/radius-project/radius/pkg/components/database/sqlite"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -41,6 +42,7 @@ var databaseClientFactory = map[DatabaseProviderType]databaseClientFactoryFunc{
 	TypeAPIServer:  initAPIServerClient,
 	TypeInMemory:   initInMemoryClient,
 	TypePostgreSQL: initPostgreSQLClient,
+	TypeSQLite:     initSQLiteClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error) {
@@ -70,7 +72,7 @@ func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error)
 		Scheme: scheme,
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -103,5 +105,33 @@ func initPostgreSQLClient(ctx context.Context, opt Options) (store.Client, error
 		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
 	}
 
+	// Existing databases may have been created by an older version of deploy/init-db/db.sql.txt. Features such as
+	// Watch depend on the schema objects created by the migrations, so they must be applied before the client is used.
+	err = postgres.MigrateSchema(ctx, pool)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
 	return postgres.NewPostgresClient(pool), nil
 }
+
+// initSQLiteClient creates a new SQLite store client.
+func initSQLiteClient(ctx context.Context, opt Options) (store.Client, error) {
+	if opt.SQLite.Path == "" {
+		return nil, errors.New("failed to initialize SQLite client: path is required")
+	}
+
+	db, err := sqlite.Open(opt.SQLite.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize SQLite client: %w", err)
+	}
+
+	client, err := sqlite.NewClient(ctx, db)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize SQLite client: %w", err)
+	}
+
+	return client, nil
+}
@@ -29,6 +29,9 @@ type Options struct {
 
 	// PostgreSQL configures options for connecting to a PostgreSQL database. Will be ignored if another store is configured.
 	PostgreSQL PostgreSQLOptions `yaml:"postgresql,omitempty"`
+
+	// SQLite configures options for the embedded SQLite database. Will be ignored if another store is configured.
+	SQLite SQLiteOptions `yaml:"sqlite,omitempty"`
 }
 
 // APIServerOptions represents options for the configuring the Kubernetes APIServer store.
@@ -57,3 +60,11 @@ type PostgreSQLOptions struct {
 	// 	${ENV_VAR_NAME}
 	URL string `yaml:"url"`
 }
+
+// SQLiteOptions represents options for the SQLite store.
+type SQLiteOptions struct {
+	// Path is the path of the SQLite database file. The file and its directory are created if they do not exist.
+	//
+	// The file can be shared with the SQLite queue provider and with other processes on the same node.
+	Path string `yaml:"path"`
+}
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/migration"
 )
 
 // DatabaseProvider acts as a factory for database clients.
@@ -131,6 +132,11 @@ func (p *DatabaseProvider) initialize(ctx context.Context) result {
 		return p.result
 	}
 
+	// Stored objects are migrated lazily when they are read, see the migration package.
+	if !migration.Default.IsEmpty() {
+		client = migration.NewClient(client, migration.Default)
+	}
+
 	p.result = result{client, nil}
 	return p.result
 }
@@ -19,6 +19,7 @@ package databaseprovider
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/components/database"
@@ -37,6 +38,18 @@ func Test_FromOptions(t *testing.T) {
 	require.NotNil(t, client)
 }
 
+func Test_FromOptions_SQLite(t *testing.T) {
+	options := Options{Provider: TypeSQLite, SQLite: SQLiteOptions{Path: filepath.Join(t.TempDir(), "radius.db")}}
+	provider := FromOptions(options)
+
+	client, err := provider.GetClient(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	_, err = FromOptions(Options{Provider: TypeSQLite}).GetClient(context.Background())
+	require.EqualError(t, err, "failed to initialize database client: failed to initialize SQLite client: path is required")
+}
+
 func Test_FromMemory(t *testing.T) {
 	provider := FromMemory()
 
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// SortKey returns the key used to order query results for the given resource id. This is the normalized
+// form of the resource id with scopes converted to resources. See ConvertScopeIDToResourceID.
+//
+// Keys are compared byte-wise.
+func SortKey(id string) (string, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	converted, err := ConvertScopeIDToResourceID(parsed)
+	if err != nil {
+		return "", err
+	}
+
+	return NormalizePart(converted.String()), nil
+}
+
+// Paginate sorts the objects by their sort key and applies the pagination options to them. This can be used
+// by implementations of database.Client that cannot paginate in the underlying data store.
+//
+// The objects are sorted in place.
+func Paginate(objs []database.Object, config database.DatabaseOptions) (*database.ObjectQueryResult, error) {
+	type keyed struct {
+		key string
+		obj database.Object
+	}
+
+	items := make([]keyed, 0, len(objs))
+	for _, obj := range objs {
+		key, err := SortKey(obj.ID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, keyed{key: key, obj: obj})
+	}
+
+	slices.SortFunc(items, func(a, b keyed) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	if config.PaginationToken != "" {
+		after, err := database.ParsePaginationToken(config.PaginationToken)
+		if err != nil {
+			return nil, err
+		}
+
+		start, _ := slices.BinarySearchFunc(items, after, func(item keyed, target string) int {
+			if item.key <= target {
+				return -1
+			}
+			return 1
+		})
+		items = items[start:]
+	}
+
+	result := &database.ObjectQueryResult{}
+	if config.MaxQueryItemCount > 0 && len(items) > config.MaxQueryItemCount {
+		items = items[:config.MaxQueryItemCount]
+		result.PaginationToken = database.NewPaginationToken(items[len(items)-1].key)
+	}
+
+	for _, item := range items {
+		result.Items = append(result.Items, item.obj)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SortKey(t *testing.T) {
+	key, err := SortKey("/planes/radius/local/resourceGroups/My-RG")
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/providers/system.resources/resourcegroups/my-rg/", key)
+}
+
+func Test_Paginate(t *testing.T) {
+	objs := []database.Object{
+		{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/c"}},
+		{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/A"}},
+		{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/b"}},
+	}
+
+	ids := func(result *database.ObjectQueryResult) []string {
+		out := []string{}
+		for _, obj := range result.Items {
+			out = append(out, obj.Metadata.ID[len(obj.Metadata.ID)-1:])
+		}
+		return out
+	}
+
+	result, err := Paginate(objs, database.DatabaseOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A", "b", "c"}, ids(result))
+	require.Empty(t, result.PaginationToken)
+
+	result, err = Paginate(objs, database.DatabaseOptions{MaxQueryItemCount: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"A", "b"}, ids(result))
+	require.NotEmpty(t, result.PaginationToken)
+
+	result, err = Paginate(objs, database.DatabaseOptions{MaxQueryItemCount: 2, PaginationToken: result.PaginationToken})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, ids(result))
+	require.Empty(t, result.PaginationToken)
+
+	_, err = Paginate(objs, database.DatabaseOptions{PaginationToken: "invalid!"})
+	require.ErrorIs(t, err, &database.ErrInvalid{})
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// EventMatchesQuery checks if the given event matches the given query. See database.Watcher for the
+// semantics of filters on deleted objects.
+func EventMatchesQuery(event database.Event, query database.Query) (bool, error) {
+	id, err := resources.Parse(event.Object.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if !IDMatchesQuery(id, query) {
+		return false, nil
+	}
+
+	if event.Type == database.EventDeleted && event.Object.Data == nil {
+		// The last state of the object is unknown so filters can't be applied.
+		return true, nil
+	}
+
+	return event.Object.MatchesFilters(query.Filters)
+}
+
+// Subscription delivers events for a single watch. Events are buffered without limit so that publishing
+// never blocks the producer, which is typically holding a lock on the data store.
+type Subscription struct {
+	query database.Query
+
+	mutex   sync.Mutex
+	pending []database.Event
+	signal  chan struct{}
+
+	events chan database.Event
+	done   chan struct{}
+}
+
+// NewSubscription creates a new Subscription for the given query. The subscription is closed when the
+// context is canceled.
+func NewSubscription(ctx context.Context, query database.Query) *Subscription {
+	s := &Subscription{
+		query:  query,
+		signal: make(chan struct{}, 1),
+		events: make(chan database.Event),
+		done:   make(chan struct{}),
+	}
+
+	go s.run(ctx)
+	return s
+}
+
+// Events returns the channel of events. The channel is closed when the subscription is closed.
+func (s *Subscription) Events() <-chan database.Event {
+	return s.events
+}
+
+// Done returns a channel that is closed when the subscription is closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Publish queues the event for delivery if it matches the subscription's query.
+func (s *Subscription) Publish(event database.Event) error {
+	match, err := EventMatchesQuery(event, s.query)
+	if err != nil {
+		return err
+	} else if !match {
+		return nil
+	}
+
+	s.mutex.Lock()
+	s.pending = append(s.pending, event)
+	s.mutex.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+		// Already signaled.
+	}
+
+	return nil
+}
+
+func (s *Subscription) run(ctx context.Context) {
+	defer close(s.events)
+	defer close(s.done)
+
+	for {
+		s.mutex.Lock()
+		pending := s.pending
+		s.pending = nil
+		s.mutex.Unlock()
+
+		for _, event := range pending {
+			select {
+			case s.events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-s.signal:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -18,10 +18,60 @@ package database
 
 import (
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 )
 
+// FilterOperator is the comparison performed by a QueryFilter.
+type FilterOperator string
+
+const (
+	// FilterOperatorEquals matches when the property is a string equal to the filter value. This is the default.
+	FilterOperatorEquals FilterOperator = ""
+
+	// FilterOperatorEqualsIgnoreCase matches when the property is a string equal to the filter value, ignoring case.
+	// This is useful for properties that store resource ids, since resource ids are case-insensitive.
+	//
+	// Only the ASCII letters are compared case-insensitively, so that every client gives the same result regardless
+	// of the locale of the database. Other characters must match exactly.
+	FilterOperatorEqualsIgnoreCase FilterOperator = "ieq"
+
+	// FilterOperatorNotEquals matches when the property is missing or is not a string equal to the filter value.
+	FilterOperatorNotEquals FilterOperator = "ne"
+
+	// FilterOperatorIn matches when the property is a string equal to one of the filter values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorPrefix matches when the property is a string that starts with the filter value.
+	FilterOperatorPrefix FilterOperator = "prefix"
+
+	// FilterOperatorExists matches when the property is present. The property value may be null.
+	FilterOperatorExists FilterOperator = "exists"
+
+	// FilterOperatorNotExists matches when the property is not present.
+	FilterOperatorNotExists FilterOperator = "notexists"
+
+	// FilterOperatorGreaterThan matches when the property is a number greater than the filter value.
+	FilterOperatorGreaterThan FilterOperator = "gt"
+
+	// FilterOperatorGreaterThanOrEqual matches when the property is a number greater than or equal to the filter value.
+	FilterOperatorGreaterThanOrEqual FilterOperator = "ge"
+
+	// FilterOperatorLessThan matches when the property is a number less than the filter value.
+	FilterOperatorLessThan FilterOperator = "lt"
+
+	// FilterOperatorLessThanOrEqual matches when the property is a number less than or equal to the filter value.
+	FilterOperatorLessThanOrEqual FilterOperator = "le"
+
+	// FilterOperatorContains matches when the property is an array containing a string equal to the filter value.
+	FilterOperatorContains FilterOperator = "contains"
+)
+
 // MatchesFilters checks if the object's data matches the given filters and returns a boolean and an error.
+//
+// This is the reference implementation of the filter semantics. Database implementations that evaluate
+// filters natively must produce the same results.
 func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	if len(filters) == 0 {
 		// Skip expensive work if there is nothing to filter-by.
@@ -44,37 +94,156 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	}
 
 	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if !value.IsValid() {
-				// Field doesn't exist, no match
-				return false, nil
-			}
+		value, found := lookupField(reflect.ValueOf(data), filter.Field)
+		if !filter.matches(value, found) {
+			return false, nil
+		}
+	}
 
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
-			}
+	return true, nil
+}
+
+// lookupField walks the '.' separated field path and returns the value of the property. The boolean
+// return value is false if the property does not exist.
+func lookupField(value reflect.Value, field string) (reflect.Value, bool) {
+	for _, segment := range strings.Split(field, ".") {
+		value = unwrap(value)
+		if !value.IsValid() || value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+			// Not an object, so the nested field can't exist.
+			return reflect.Value{}, false
 		}
-		comparator := reflect.ValueOf(filter.Value)
 
-		if value.Type().Kind() == reflect.Interface {
-			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+		value = value.MapIndex(reflect.ValueOf(segment).Convert(value.Type().Key()))
+		if !value.IsValid() {
+			// Field doesn't exist, no match
+			return reflect.Value{}, false
 		}
+	}
 
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
-			return false, nil
+	return unwrap(value), true
+}
+
+// unwrap removes any interface{} or pointer wrapping from the value.
+func unwrap(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer) {
+		if value.IsNil() {
+			return reflect.Value{}
 		}
+		value = value.Elem()
+	}
 
-		if value.String() != comparator.String() {
-			// not the same value!
-			return false, nil
+	return value
+}
+
+// matches evaluates the filter against the property value. found is false if the property does not exist.
+func (f QueryFilter) matches(value reflect.Value, found bool) bool {
+	switch f.Operator {
+	case FilterOperatorExists:
+		return found
+	case FilterOperatorNotExists:
+		return !found
+	case FilterOperatorNotEquals:
+		s, ok := stringValue(value)
+		return !ok || s != f.Value
+	}
+
+	if !found {
+		return false
+	}
+
+	switch f.Operator {
+	case FilterOperatorEquals:
+		s, ok := stringValue(value)
+		return ok && s == f.Value
+	case FilterOperatorEqualsIgnoreCase:
+		s, ok := stringValue(value)
+		return ok && equalFoldASCII(s, f.Value)
+	case FilterOperatorIn:
+		s, ok := stringValue(value)
+		return ok && slices.Contains(f.Values, s)
+	case FilterOperatorPrefix:
+		s, ok := stringValue(value)
+		return ok && strings.HasPrefix(s, f.Value)
+	case FilterOperatorContains:
+		if !value.IsValid() || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+			return false
+		}
+		for i := 0; i < value.Len(); i++ {
+			if s, ok := stringValue(unwrap(value.Index(i))); ok && s == f.Value {
+				return true
+			}
+		}
+		return false
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual, FilterOperatorLessThan, FilterOperatorLessThanOrEqual:
+		n, ok := numberValue(value)
+		if !ok {
+			return false
+		}
+		comparator, err := strconv.ParseFloat(f.Value, 64)
+		if err != nil {
+			return false
+		}
+		switch f.Operator {
+		case FilterOperatorGreaterThan:
+			return n > comparator
+		case FilterOperatorGreaterThanOrEqual:
+			return n >= comparator
+		case FilterOperatorLessThan:
+			return n < comparator
+		default:
+			return n <= comparator
 		}
 	}
 
-	return true, nil
+	return false
+}
+
+// stringValue returns the value as a string if it is a string.
+func stringValue(value reflect.Value) (string, bool) {
+	if !value.IsValid() || value.Kind() != reflect.String {
+		// not a string, can't compare!
+		return "", false
+	}
+
+	return value.String(), true
+}
+
+// equalFoldASCII reports whether a and b are equal, ignoring the case of ASCII letters.
+func equalFoldASCII(a string, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := 0; i < len(a); i++ {
+		if toLowerASCII(a[i]) != toLowerASCII(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toLowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
+
+// numberValue returns the value as a float64 if it is numeric.
+func numberValue(value reflect.Value) (float64, bool) {
+	if !value.IsValid() {
+		return 0, false
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+
+	return 0, false
 }
@@ -119,6 +119,140 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "value", Value: "hot"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "nested_field_of_non_object",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value.nested", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+
+		// Operators
+		{
+			Description:   "not_equals_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "hot"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "not_equals_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "not_equals_missing_field",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "other", Operator: FilterOperatorNotEquals, Value: "cool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_match",
+			Obj:           &Object{Data: map[string]any{"location": "west"}},
+			Filters:       []QueryFilter{{Field: "location", Operator: FilterOperatorIn, Values: []string{"east", "west"}}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_not_match",
+			Obj:           &Object{Data: map[string]any{"location": "north"}},
+			Filters:       []QueryFilter{{Field: "location", Operator: FilterOperatorIn, Values: []string{"east", "west"}}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "equals_ignore_case_match",
+			Obj:           &Object{Data: map[string]any{"resourceID": "/planes/radius/local/resourceGroups/RG/providers/Applications.Core/containers/c"}},
+			Filters:       []QueryFilter{{Field: "resourceID", Operator: FilterOperatorEqualsIgnoreCase, Value: "/planes/radius/local/resourcegroups/rg/providers/applications.core/containers/c"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "equals_ignore_case_not_match",
+			Obj:           &Object{Data: map[string]any{"resourceID": "/planes/radius/local/resourceGroups/RG/providers/Applications.Core/containers/c"}},
+			Filters:       []QueryFilter{{Field: "resourceID", Operator: FilterOperatorEqualsIgnoreCase, Value: "/planes/radius/local/resourcegroups/rg/providers/applications.core/containers/other"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "prefix_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"application": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"}}},
+			Filters:       []QueryFilter{{Field: "properties.application", Operator: FilterOperatorPrefix, Value: "/planes/radius/local/resourceGroups/rg/"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "prefix_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"application": "/planes/radius/local/resourceGroups/other"}}},
+			Filters:       []QueryFilter{{Field: "properties.application", Operator: FilterOperatorPrefix, Value: "/planes/radius/local/resourceGroups/rg/"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"status": nil}}},
+			Filters:       []QueryFilter{{Field: "properties.status", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_not_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.status", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "not_exists_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{}}},
+			Filters:       []QueryFilter{{Field: "properties.status", Operator: FilterOperatorNotExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "greater_than_match",
+			Obj:           &Object{Data: map[string]any{"replicas": float64(3)}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThan, Value: "2"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "greater_than_not_match_int",
+			Obj:           &Object{Data: map[string]any{"replicas": 2}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThan, Value: "2"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "greater_than_or_equal_match",
+			Obj:           &Object{Data: map[string]any{"replicas": 2}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThanOrEqual, Value: "2"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "less_than_match",
+			Obj:           &Object{Data: map[string]any{"replicas": 1.5}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorLessThan, Value: "2"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "less_than_or_equal_not_match",
+			Obj:           &Object{Data: map[string]any{"replicas": 3}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorLessThanOrEqual, Value: "2"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "numeric_wrong_type",
+			Obj:           &Object{Data: map[string]any{"replicas": "3"}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThan, Value: "2"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_match",
+			Obj:           &Object{Data: map[string]any{"tags": []any{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "b"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "contains_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": []string{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "c"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_not_array",
+			Obj:           &Object{Data: map[string]any{"tags": "b"}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "b"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
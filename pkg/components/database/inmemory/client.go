@@ -31,6 +31,8 @@ import (
 )
 
 var _ database.Client = (*Client)(nil)
+var _ database.Watcher = (*Client)(nil)
+var _ database.Transactor = (*Client)(nil)
 
 // Client is an in-memory implementation of database.Client.
 type Client struct {
@@ -44,6 +46,10 @@ type Client struct {
 	//
 	// The Query method will iterate over all entries in the map to find the matching ones.
 	resources map[string]entry
+
+	// subscriptions is the set of active watches. Changes are published to each subscription while
+	// holding the mutex so that events are delivered in the order they are applied.
+	subscriptions map[*databaseutil.Subscription]struct{}
 }
 
 // entry stores the commonly-used fields (extracted from the resource ID) for comparison in queries.
@@ -82,8 +88,9 @@ type entry struct {
 // NewClient creates a new in-memory store client.
 func NewClient() *Client {
 	return &Client{
-		mutex:     sync.Mutex{},
-		resources: map[string]entry{},
+		mutex:         sync.Mutex{},
+		resources:     map[string]entry{},
+		subscriptions: map[*databaseutil.Subscription]struct{}{},
 	}
 }
 
@@ -162,7 +169,7 @@ func (c *Client) Delete(ctx context.Context, id string, options ...database.Dele
 
 	delete(c.resources, strings.ToLower(converted.String()))
 
-	return nil
+	return c.publish(database.EventDeleted, &entry.obj)
 }
 
 // Query implements database.Client.
@@ -176,10 +183,12 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
 	}
 
+	config := database.NewQueryConfig(options...)
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	result := &database.ObjectQueryResult{}
+	items := []database.Object{}
 	for _, entry := range c.resources {
 		// Check root scope.
 		if query.ScopeRecursive && !strings.HasPrefix(entry.rootScope, databaseutil.NormalizePart(query.RootScope)) {
@@ -217,10 +226,10 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 			return nil, err
 		}
 
-		result.Items = append(result.Items, *copy)
+		items = append(items, *copy)
 	}
 
-	return result, nil
+	return databaseutil.Paginate(items, config)
 }
 
 // Save implements database.Client.
@@ -277,6 +286,159 @@ func (c *Client) Save(ctx context.Context, obj *database.Object, options ...data
 
 	c.resources[strings.ToLower(converted.String())] = entry
 
+	eventType := database.EventUpdated
+	if !ok {
+		eventType = database.EventCreated
+	}
+
+	return c.publish(eventType, &entry.obj)
+}
+
+// ExecuteTransaction implements database.Transactor.
+func (c *Client) ExecuteTransaction(ctx context.Context, operations []database.Operation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := database.ValidateOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	// change is a validated operation that is ready to apply.
+	type change struct {
+		key       string
+		entry     entry
+		eventType database.EventType
+		etag      string
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Validate every operation before applying any of them.
+	changes := []change{}
+	for _, operation := range operations {
+		parsed, err := resources.Parse(operation.ResourceID())
+		if err != nil {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		if parsed.IsEmpty() {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+		}
+		if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		}
+
+		converted, err := databaseutil.ConvertScopeIDToResourceID(parsed)
+		if err != nil {
+			return err
+		}
+
+		key := strings.ToLower(converted.String())
+		existing, ok := c.resources[key]
+		if !ok && operation.ETag != "" {
+			return &database.ErrConcurrency{}
+		} else if ok && operation.ETag != "" && operation.ETag != existing.obj.ETag {
+			return &database.ErrConcurrency{}
+		}
+
+		if operation.Type == database.OperationDelete {
+			if !ok {
+				return &database.ErrNotFound{ID: operation.ID}
+			}
+
+			changes = append(changes, change{key: key, entry: existing, eventType: database.EventDeleted})
+			continue
+		}
+
+		raw, err := json.Marshal(operation.Object.Data)
+		if err != nil {
+			return err
+		}
+
+		// Make a defensive copy so users can't modify the data in the store.
+		copy, err := operation.Object.DeepCopy()
+		if err != nil {
+			return err
+		}
+		copy.ETag = etag.New(raw)
+
+		updated := existing
+		updated.obj = *copy
+		eventType := database.EventUpdated
+		if !ok {
+			updated.rootScope = databaseutil.NormalizePart(converted.RootScope())
+			updated.resourceType = databaseutil.NormalizePart(converted.Type())
+			updated.routingScope = databaseutil.NormalizePart(converted.RoutingScope())
+			eventType = database.EventCreated
+		}
+
+		changes = append(changes, change{key: key, entry: updated, eventType: eventType, etag: copy.ETag})
+	}
+
+	for i, change := range changes {
+		if change.eventType == database.EventDeleted {
+			delete(c.resources, change.key)
+		} else {
+			c.resources[change.key] = change.entry
+
+			// Callers are allowed to read the ETag after the transaction.
+			operations[i].Object.ETag = change.etag
+		}
+
+		err = c.publish(change.eventType, &change.entry.obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch implements database.Watcher.
+func (c *Client) Watch(ctx context.Context, query database.Query) (<-chan database.Event, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	subscription := databaseutil.NewSubscription(ctx, query)
+
+	c.mutex.Lock()
+	c.subscriptions[subscription] = struct{}{}
+	c.mutex.Unlock()
+
+	go func() {
+		<-subscription.Done()
+
+		c.mutex.Lock()
+		delete(c.subscriptions, subscription)
+		c.mutex.Unlock()
+	}()
+
+	return subscription.Events(), nil
+}
+
+// publish sends an event to each active watch. The caller must hold the mutex.
+func (c *Client) publish(eventType database.EventType, obj *database.Object) error {
+	for subscription := range c.subscriptions {
+		// Make a defensive copy so users can't modify the data in the store.
+		copy, err := obj.DeepCopy()
+		if err != nil {
+			return err
+		}
+
+		err = subscription.Publish(database.Event{Type: eventType, Object: *copy})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
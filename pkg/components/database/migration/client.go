@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+var _ database.Client = (*Client)(nil)
+var _ database.Watcher = (*Client)(nil)
+var _ database.Transactor = (*Client)(nil)
+
+// Client is a database.Client that applies migrations lazily.
+//
+// Objects returned by Get, Query and Watch are migrated to the current version in memory. Objects written by Save
+// and ExecuteTransaction are stamped with the current version. Stored objects are only rewritten when they are
+// saved, use a Runner to migrate all stored objects eagerly.
+type Client struct {
+	inner    database.Client
+	registry *Registry
+}
+
+// NewClient creates a new Client that wraps the inner client and applies the migrations in the registry.
+func NewClient(inner database.Client, registry *Registry) *Client {
+	return &Client{inner: inner, registry: registry}
+}
+
+// Query implements database.Client.
+func (c *Client) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	result, err := c.inner.Query(ctx, query, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Items {
+		_, err := c.registry.Migrate(&result.Items[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Get implements database.Client.
+func (c *Client) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	obj, err := c.inner.Get(ctx, id, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.registry.Migrate(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete implements database.Client.
+func (c *Client) Delete(ctx context.Context, id string, options ...database.DeleteOptions) error {
+	return c.inner.Delete(ctx, id, options...)
+}
+
+// Save implements database.Client.
+func (c *Client) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if obj == nil {
+		return c.inner.Save(ctx, obj, options...)
+	}
+
+	stamped, err := c.registry.stamp(obj)
+	if err != nil {
+		return err
+	}
+
+	err = c.inner.Save(ctx, stamped, options...)
+	if err != nil {
+		return err
+	}
+
+	// The inner client updates the ETag of the copy.
+	obj.ETag = stamped.ETag
+	return nil
+}
+
+// Watch implements database.Watcher. Watch returns ErrInvalid if the inner client does not support watches.
+func (c *Client) Watch(ctx context.Context, query database.Query) (<-chan database.Event, error) {
+	watcher, ok := c.inner.(database.Watcher)
+	if !ok {
+		return nil, &database.ErrInvalid{Message: "watch is not supported by the database client"}
+	}
+
+	events, err := watcher.Watch(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := make(chan database.Event)
+	go func() {
+		defer close(migrated)
+		for event := range events {
+			if event.Type != database.EventDeleted {
+				// Deleted objects may only carry an ID, and are never written back, so they are not migrated.
+				_, err := c.registry.Migrate(&event.Object)
+				if err != nil {
+					// Closing the channel tells the caller to re-read the current state, which will surface the error.
+					return
+				}
+			}
+
+			select {
+			case migrated <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return migrated, nil
+}
+
+// ExecuteTransaction implements database.Transactor.
+func (c *Client) ExecuteTransaction(ctx context.Context, operations []database.Operation) error {
+	stamped := make([]database.Operation, len(operations))
+	for i, operation := range operations {
+		stamped[i] = operation
+		if operation.Type == database.OperationSave && operation.Object != nil {
+			obj, err := c.registry.stamp(operation.Object)
+			if err != nil {
+				return err
+			}
+			stamped[i].Object = obj
+		}
+	}
+
+	err := database.ExecuteTransaction(ctx, c.inner, stamped)
+
+	// The inner client updates the ETags of the copies, and may do so for a partially applied transaction.
+	for i, operation := range operations {
+		if operation.Type == database.OperationSave && operation.Object != nil {
+			operation.Object.ETag = stamped[i].Object.ETag
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_MigratesOnRead(t *testing.T) {
+	ctx := context.Background()
+	inner := inmemory.NewClient()
+	client := NewClient(inner, testRegistry(t))
+
+	// Written by a client that doesn't know about migrations.
+	err := inner.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{"a": "value"}})
+	require.NoError(t, err)
+
+	obj, err := client.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: 2}, obj.Data)
+
+	result, err := client.Query(ctx, database.Query{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: testResourceType})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: 2}, result.Items[0].Data)
+
+	// Reading doesn't modify the stored object.
+	stored, err := inner.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": "value"}, stored.Data)
+
+	// Saving the migrated object with the ETag from the read writes the new version.
+	err = client.Save(ctx, obj, database.WithETag(obj.ETag))
+	require.NoError(t, err)
+
+	stored, err = inner.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: float64(2)}, stored.Data)
+	require.Equal(t, stored.ETag, obj.ETag)
+}
+
+func Test_Client_StampsOnSave(t *testing.T) {
+	type payload struct {
+		C string `json:"c"`
+	}
+
+	ctx := context.Background()
+	inner := inmemory.NewClient()
+	client := NewClient(inner, testRegistry(t))
+
+	data := &payload{C: "value"}
+	obj := &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: data}
+	err := client.Save(ctx, obj)
+	require.NoError(t, err)
+	require.NotEmpty(t, obj.ETag)
+
+	// The caller's object is not modified, other than the ETag.
+	require.Same(t, data, obj.Data)
+
+	stored, err := inner.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: float64(2)}, stored.Data)
+
+	// Stamped objects are not migrated again.
+	obj, err = client.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: float64(2)}, obj.Data)
+}
+
+func Test_Client_ExecuteTransaction(t *testing.T) {
+	ctx := context.Background()
+	inner := inmemory.NewClient()
+	client := NewClient(inner, testRegistry(t))
+
+	obj := &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{"c": "value"}}
+	err := database.ExecuteTransaction(ctx, client, []database.Operation{database.SaveOperation(obj)})
+	require.NoError(t, err)
+	require.NotEmpty(t, obj.ETag)
+	require.Equal(t, map[string]any{"c": "value"}, obj.Data)
+
+	stored, err := inner.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: float64(2)}, stored.Data)
+	require.Equal(t, stored.ETag, obj.ETag)
+}
+
+func Test_Client_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inner := inmemory.NewClient()
+	client := NewClient(inner, testRegistry(t))
+
+	events, err := client.Watch(ctx, database.Query{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: testResourceType})
+	require.NoError(t, err)
+
+	err = inner.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{"a": "value"}})
+	require.NoError(t, err)
+
+	event := <-events
+	require.Equal(t, database.EventCreated, event.Type)
+	require.Equal(t, map[string]any{"c": "value", VersionProperty: 2}, event.Object.Data)
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration upgrades the payloads of stored database objects when the shape of a datamodel changes.
+//
+// Each stored object records the version of its payload in the VersionProperty field. Objects written before a
+// resource type had any migrations have no version and are treated as version 0. A Migration upgrades the payload
+// from Version-1 to Version, and migrations for a resource type must be registered with consecutive versions
+// starting at 1.
+//
+// Migrations are applied lazily when objects are read through the Client returned by NewClient, and can be applied
+// eagerly to all stored objects with a Runner (for example, from the pre-upgrade job).
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// VersionProperty is the name of the property in the object payload that records the version of the payload.
+	VersionProperty = "storageVersion"
+)
+
+// Default is the registry used by the database provider and the pre-upgrade job. Datamodel packages should
+// register their migrations with Default using MustRegister.
+var Default = NewRegistry()
+
+// Migration upgrades the stored payload of a resource type by one version.
+type Migration struct {
+	// ResourceType is the resource type of the objects to migrate.
+	//
+	// For resources this is the fully-qualified resource type, eg: "Applications.Core/containers". For scopes
+	// (Scope is true) this is the scope type used by scope queries, eg: "resourceGroups" or "radius".
+	ResourceType string
+
+	// Scope is true when ResourceType is a scope type.
+	Scope bool
+
+	// Version is the version of the payload after the migration is applied.
+	Version int
+
+	// Description is a human-readable description of the migration.
+	Description string
+
+	// Migrate upgrades the payload in place from Version-1 to Version. Migrate must not modify VersionProperty.
+	//
+	// Migrations should be idempotent, since objects written by a client that does not stamp the version will
+	// be migrated again.
+	Migrate func(data map[string]any) error
+}
+
+// Target identifies a resource type with registered migrations.
+type Target struct {
+	// ResourceType is the resource type. See Migration.ResourceType.
+	ResourceType string
+
+	// Scope is true when ResourceType is a scope type.
+	Scope bool
+}
+
+func (t Target) key() Target {
+	return Target{ResourceType: strings.ToLower(t.ResourceType), Scope: t.Scope}
+}
+
+// Registry is the set of registered migrations. Registry is safe for concurrent use.
+type Registry struct {
+	mutex      sync.RWMutex
+	migrations map[Target][]Migration
+	targets    []Target
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{migrations: map[Target][]Migration{}}
+}
+
+// Register adds migrations to the registry. Migrations for each resource type must be registered in order and
+// with consecutive versions starting at 1.
+func (r *Registry) Register(migrations ...Migration) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, migration := range migrations {
+		if migration.ResourceType == "" {
+			return fmt.Errorf("migration %q: ResourceType is required", migration.Description)
+		}
+		if migration.Migrate == nil {
+			return fmt.Errorf("migration %q for %s: Migrate is required", migration.Description, migration.ResourceType)
+		}
+
+		target := Target{ResourceType: migration.ResourceType, Scope: migration.Scope}
+		existing := r.migrations[target.key()]
+		if migration.Version != len(existing)+1 {
+			return fmt.Errorf("migration %q for %s: expected version %d, got %d", migration.Description, migration.ResourceType, len(existing)+1, migration.Version)
+		}
+
+		if len(existing) == 0 {
+			r.targets = append(r.targets, target)
+		}
+		r.migrations[target.key()] = append(existing, migration)
+	}
+
+	return nil
+}
+
+// MustRegister is like Register but panics if the migrations are invalid. This is intended for use in init functions.
+func (r *Registry) MustRegister(migrations ...Migration) {
+	err := r.Register(migrations...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsEmpty returns true if no migrations are registered.
+func (r *Registry) IsEmpty() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return len(r.targets) == 0
+}
+
+// Targets returns the resource types that have registered migrations, sorted by resource type.
+func (r *Registry) Targets() []Target {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	targets := append([]Target{}, r.targets...)
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Scope != targets[j].Scope {
+			return targets[i].Scope
+		}
+		return strings.ToLower(targets[i].ResourceType) < strings.ToLower(targets[j].ResourceType)
+	})
+	return targets
+}
+
+// CurrentVersion returns the latest version for the target, or 0 if the target has no migrations.
+func (r *Registry) CurrentVersion(target Target) int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return len(r.migrations[target.key()])
+}
+
+// Migrate upgrades the payload of the object to the current version for its resource type, and returns true if
+// the payload was changed. Payloads that are not JSON objects, or that are already at (or beyond) the current
+// version, are left unchanged.
+//
+// When the object is migrated its Data is replaced with a map[string]any, so callers should decode it with
+// Object.As rather than type-asserting the original type.
+func (r *Registry) Migrate(obj *database.Object) (bool, error) {
+	target, err := targetForID(obj.ID)
+	if err != nil {
+		return false, err
+	}
+
+	r.mutex.RLock()
+	migrations := r.migrations[target.key()]
+	r.mutex.RUnlock()
+
+	if len(migrations) == 0 {
+		return false, nil
+	}
+
+	data, ok, err := toMap(obj.Data)
+	if err != nil {
+		return false, fmt.Errorf("failed to migrate %s: %w", obj.ID, err)
+	} else if !ok {
+		return false, nil
+	}
+
+	version, err := readVersion(data)
+	if err != nil {
+		return false, fmt.Errorf("failed to migrate %s: %w", obj.ID, err)
+	}
+
+	if version >= len(migrations) {
+		return false, nil
+	}
+
+	for _, migration := range migrations[version:] {
+		err := migration.Migrate(data)
+		if err != nil {
+			return false, fmt.Errorf("failed to migrate %s to version %d (%s): %w", obj.ID, migration.Version, migration.Description, err)
+		}
+	}
+
+	data[VersionProperty] = len(migrations)
+	obj.Data = data
+	return true, nil
+}
+
+// stamp returns a copy of the object with VersionProperty set to the current version for its resource type. The
+// object is returned unchanged when its resource type has no migrations.
+func (r *Registry) stamp(obj *database.Object) (*database.Object, error) {
+	target, err := targetForID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	version := r.CurrentVersion(target)
+	if version == 0 {
+		return obj, nil
+	}
+
+	data, ok, err := toMap(obj.Data)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return obj, nil
+	}
+
+	// toMap may return the caller's map, so copy it before adding the version.
+	stamped := make(map[string]any, len(data)+1)
+	for k, v := range data {
+		stamped[k] = v
+	}
+	stamped[VersionProperty] = version
+
+	return &database.Object{Metadata: obj.Metadata, Data: stamped}, nil
+}
+
+// targetForID returns the migration target for the resource or scope id.
+func targetForID(id string) (Target, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return Target{}, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. 'id' must be a valid resource id: %s", err.Error())}
+	}
+
+	prefix, _, _, resourceType := databaseutil.ExtractStorageParts(parsed)
+	return Target{ResourceType: resourceType, Scope: prefix == databaseutil.ScopePrefix}, nil
+}
+
+// toMap converts the payload to a JSON object. The second return value is false if the payload is not a JSON object.
+func toMap(data any) (map[string]any, bool, error) {
+	if data == nil {
+		return nil, false, nil
+	}
+
+	if m, ok := data.(map[string]any); ok {
+		return m, true, nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var m map[string]any
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		// The payload is valid JSON but not an object.
+		return nil, false, nil
+	}
+
+	return m, m != nil, nil
+}
+
+// readVersion reads VersionProperty from the payload. A missing version is treated as version 0.
+func readVersion(data map[string]any) (int, error) {
+	value, ok := data[VersionProperty]
+	if !ok || value == nil {
+		return 0, nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q", VersionProperty, v)
+		}
+		return int(i), nil
+	default:
+		return 0, fmt.Errorf("invalid %s %v", VersionProperty, value)
+	}
+}
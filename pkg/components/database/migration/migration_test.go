@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceType = "Applications.Test/testType"
+	testResourceID   = "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"
+)
+
+// renameProperty returns a migration function that renames a property.
+func renameProperty(from string, to string) func(data map[string]any) error {
+	return func(data map[string]any) error {
+		if value, ok := data[from]; ok {
+			data[to] = value
+			delete(data, from)
+		}
+		return nil
+	}
+}
+
+func testRegistry(t *testing.T) *Registry {
+	registry := NewRegistry()
+	err := registry.Register(
+		Migration{ResourceType: testResourceType, Version: 1, Description: "rename a to b", Migrate: renameProperty("a", "b")},
+		Migration{ResourceType: testResourceType, Version: 2, Description: "rename b to c", Migrate: renameProperty("b", "c")},
+	)
+	require.NoError(t, err)
+	return registry
+}
+
+func Test_Registry_Register(t *testing.T) {
+	noop := func(data map[string]any) error { return nil }
+
+	tests := []struct {
+		name       string
+		migrations []Migration
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			migrations: []Migration{{ResourceType: testResourceType, Version: 1, Migrate: noop}, {ResourceType: "resourceGroups", Scope: true, Version: 1, Migrate: noop}},
+		},
+		{
+			name:       "missing resource type",
+			migrations: []Migration{{Version: 1, Migrate: noop}},
+			wantErr:    "ResourceType is required",
+		},
+		{
+			name:       "missing migrate",
+			migrations: []Migration{{ResourceType: testResourceType, Version: 1}},
+			wantErr:    "Migrate is required",
+		},
+		{
+			name:       "version gap",
+			migrations: []Migration{{ResourceType: testResourceType, Version: 1, Migrate: noop}, {ResourceType: testResourceType, Version: 3, Migrate: noop}},
+			wantErr:    "expected version 2, got 3",
+		},
+		{
+			name:       "duplicate version (case-insensitive type)",
+			migrations: []Migration{{ResourceType: testResourceType, Version: 1, Migrate: noop}, {ResourceType: "applications.test/TESTTYPE", Version: 1, Migrate: noop}},
+			wantErr:    "expected version 2, got 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRegistry().Register(tt.migrations...)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_Registry_Targets(t *testing.T) {
+	registry := testRegistry(t)
+	require.NoError(t, registry.Register(Migration{ResourceType: "resourceGroups", Scope: true, Version: 1, Migrate: renameProperty("x", "y")}))
+
+	require.False(t, registry.IsEmpty())
+	require.True(t, NewRegistry().IsEmpty())
+	require.Equal(t, []Target{{ResourceType: "resourceGroups", Scope: true}, {ResourceType: testResourceType}}, registry.Targets())
+	require.Equal(t, 2, registry.CurrentVersion(Target{ResourceType: "applications.test/testtype"}))
+	require.Equal(t, 1, registry.CurrentVersion(Target{ResourceType: "resourceGroups", Scope: true}))
+	require.Equal(t, 0, registry.CurrentVersion(Target{ResourceType: "resourceGroups"}))
+}
+
+func Test_Registry_Migrate(t *testing.T) {
+	type payload struct {
+		A string `json:"a"`
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		data         any
+		wantMigrated bool
+		wantData     any
+	}{
+		{
+			name:         "unversioned map",
+			id:           testResourceID,
+			data:         map[string]any{"a": "value"},
+			wantMigrated: true,
+			wantData:     map[string]any{"c": "value", VersionProperty: 2},
+		},
+		{
+			name:         "unversioned struct",
+			id:           testResourceID,
+			data:         &payload{A: "value"},
+			wantMigrated: true,
+			wantData:     map[string]any{"c": "value", VersionProperty: 2},
+		},
+		{
+			name:         "partially migrated (version read from JSON)",
+			id:           testResourceID,
+			data:         map[string]any{"b": "value", VersionProperty: float64(1)},
+			wantMigrated: true,
+			wantData:     map[string]any{"c": "value", VersionProperty: 2},
+		},
+		{
+			name:     "current version",
+			id:       testResourceID,
+			data:     map[string]any{"b": "value", VersionProperty: float64(2)},
+			wantData: map[string]any{"b": "value", VersionProperty: float64(2)},
+		},
+		{
+			name:     "newer version",
+			id:       testResourceID,
+			data:     map[string]any{"a": "value", VersionProperty: float64(3)},
+			wantData: map[string]any{"a": "value", VersionProperty: float64(3)},
+		},
+		{
+			name:     "no migrations for type",
+			id:       "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/otherType/a",
+			data:     map[string]any{"a": "value"},
+			wantData: map[string]any{"a": "value"},
+		},
+		{
+			name:     "not an object",
+			id:       testResourceID,
+			data:     "value",
+			wantData: "value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &database.Object{Metadata: database.Metadata{ID: tt.id}, Data: tt.data}
+			migrated, err := testRegistry(t).Migrate(obj)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantMigrated, migrated)
+			require.Equal(t, tt.wantData, obj.Data)
+		})
+	}
+}
+
+func Test_Registry_Migrate_Scope(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(Migration{ResourceType: "resourceGroups", Scope: true, Version: 1, Migrate: renameProperty("a", "b")}))
+
+	obj := &database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg"}, Data: map[string]any{"a": "value"}}
+	migrated, err := registry.Migrate(obj)
+	require.NoError(t, err)
+	require.True(t, migrated)
+	require.Equal(t, map[string]any{"b": "value", VersionProperty: 1}, obj.Data)
+}
+
+func Test_Registry_Migrate_Error(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(Migration{
+		ResourceType: testResourceType,
+		Version:      1,
+		Description:  "always fails",
+		Migrate:      func(data map[string]any) error { return errors.New("oops") },
+	}))
+
+	obj := &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{"a": "value"}}
+	migrated, err := registry.Migrate(obj)
+	require.EqualError(t, err, "failed to migrate "+testResourceID+" to version 1 (always fails): oops")
+	require.False(t, migrated)
+
+	obj = &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{VersionProperty: "one"}}
+	_, err = registry.Migrate(obj)
+	require.ErrorContains(t, err, "invalid storageVersion")
+}
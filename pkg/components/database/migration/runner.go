@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// defaultPageSize is the number of objects read per query when migrating.
+	defaultPageSize = 100
+)
+
+// Runner migrates all stored objects to the current version.
+type Runner struct {
+	// Client is the database client. If Client is a migration Client then the client it wraps is used, so that
+	// stored objects are read without being migrated.
+	Client database.Client
+
+	// Registry is the set of migrations to apply.
+	Registry *Registry
+
+	// DryRun reports the objects that need to be migrated without saving them. The migrations are still run
+	// in memory so that failures are reported.
+	DryRun bool
+}
+
+// Result is the result of a Runner.
+type Result struct {
+	// Scanned is the number of objects read.
+	Scanned int
+
+	// Migrated is the number of objects that were migrated (or would be migrated for a dry run).
+	Migrated int
+
+	// Conflicts is the ids of objects that were modified concurrently and were not saved. These objects will be
+	// migrated when they are next read through a migration Client, or by running the Runner again.
+	Conflicts []string
+}
+
+// Run migrates all stored objects of the resource types in the registry. Run stops at the first migration that
+// fails, and returns the result so far along with the error.
+func (r *Runner) Run(ctx context.Context) (*Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	result := &Result{}
+
+	client := r.Client
+	if wrapped, ok := client.(*Client); ok {
+		client = wrapped.inner
+	}
+
+	for _, target := range r.Registry.Targets() {
+		query := database.Query{
+			RootScope:      "/",
+			ScopeRecursive: true,
+			ResourceType:   target.ResourceType,
+			IsScopeQuery:   target.Scope,
+		}
+
+		token := ""
+		for {
+			// Stop between pages when the context is done, the stores don't all check it.
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			page, err := client.Query(ctx, query, database.WithMaxQueryItemCount(defaultPageSize), database.WithPaginationToken(token))
+			if err != nil {
+				return result, fmt.Errorf("failed to query %s: %w", target.ResourceType, err)
+			}
+
+			for i := range page.Items {
+				obj := &page.Items[i]
+				result.Scanned++
+
+				migrated, err := r.Registry.Migrate(obj)
+				if err != nil {
+					return result, err
+				} else if !migrated {
+					continue
+				}
+
+				result.Migrated++
+				if r.DryRun {
+					logger.Info("Resource requires migration", "id", obj.ID, "version", r.Registry.CurrentVersion(target))
+					continue
+				}
+
+				err = client.Save(ctx, obj, database.WithETag(obj.ETag))
+				if errors.Is(err, &database.ErrConcurrency{}) {
+					logger.Info("Resource was modified during migration, skipping", "id", obj.ID)
+					result.Migrated--
+					result.Conflicts = append(result.Conflicts, obj.ID)
+					continue
+				} else if err != nil {
+					return result, fmt.Errorf("failed to save migrated resource %s: %w", obj.ID, err)
+				}
+
+				logger.Info("Migrated resource", "id", obj.ID, "version", r.Registry.CurrentVersion(target))
+			}
+
+			if page.PaginationToken == "" {
+				break
+			}
+			token = page.PaginationToken
+		}
+	}
+
+	return result, nil
+}
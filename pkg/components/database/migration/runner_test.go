@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Runner(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*inmemory.Client, *Registry) {
+		client := inmemory.NewClient()
+		registry := testRegistry(t)
+		require.NoError(t, registry.Register(Migration{ResourceType: "resourceGroups", Scope: true, Version: 1, Migrate: renameProperty("a", "b")}))
+
+		// Enough resources to span multiple pages.
+		for i := range defaultPageSize + 5 {
+			id := fmt.Sprintf("/planes/radius/local/resourceGroups/rg%d/providers/Applications.Test/testType/a%d", i%3, i)
+			require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"a": "value"}}))
+		}
+
+		// Already migrated.
+		require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testResourceID}, Data: map[string]any{"c": "value", VersionProperty: 2}}))
+
+		// Scope.
+		require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/rg"}, Data: map[string]any{"a": "value"}}))
+
+		return client, registry
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		client, registry := setup(t)
+
+		runner := &Runner{Client: client, Registry: registry, DryRun: true}
+		result, err := runner.Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, &Result{Scanned: defaultPageSize + 7, Migrated: defaultPageSize + 6}, result)
+
+		stored, err := client.Get(ctx, "/planes/radius/local/resourceGroups/rg")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"a": "value"}, stored.Data)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		client, registry := setup(t)
+
+		runner := &Runner{Client: client, Registry: registry}
+		result, err := runner.Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, &Result{Scanned: defaultPageSize + 7, Migrated: defaultPageSize + 6}, result)
+
+		stored, err := client.Get(ctx, "/planes/radius/local/resourceGroups/rg")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"b": "value", VersionProperty: float64(1)}, stored.Data)
+
+		stored, err = client.Get(ctx, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testType/a1")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"c": "value", VersionProperty: float64(2)}, stored.Data)
+
+		// Running again is a no-op, even through a migration client.
+		runner.Client = NewClient(client, registry)
+		result, err = runner.Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, &Result{Scanned: defaultPageSize + 7}, result)
+	})
+}
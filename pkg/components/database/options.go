@@ -64,10 +64,10 @@ type (
 
 // DatabaseOptions represents the configurations of the underlying database APIs.
 type DatabaseOptions struct {
-	// PaginationToken represents pagination token such as continuation token.
+	// PaginationToken represents pagination token such as continuation token. The token is opaque to callers.
 	PaginationToken string
 
-	// MaxQueryItemCount represents max items in query result.
+	// MaxQueryItemCount represents max items in query result. Zero means no limit.
 	MaxQueryItemCount int
 
 	// ETag represents the entity tag for optimistic consistency control.
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// paginationTokenPrefix is used to version the format of pagination tokens.
+const paginationTokenPrefix = "v1:"
+
+// NewPaginationToken creates an opaque pagination token that resumes a query after the object with the
+// given key. The key is the normalized resource id used to order query results.
+//
+// This is intended for use by implementations of Client. Callers of Query must treat the token as opaque.
+func NewPaginationToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(paginationTokenPrefix + key))
+}
+
+// ParsePaginationToken parses a pagination token created by NewPaginationToken and returns the key
+// of the last object that was returned. ErrInvalid is returned if the token is malformed.
+//
+// This is intended for use by implementations of Client.
+func ParsePaginationToken(token string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", &ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+	}
+
+	key, ok := strings.CutPrefix(string(data), paginationTokenPrefix)
+	if !ok || key == "" {
+		return "", &ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+	}
+
+	return key, nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PaginationToken_RoundTrip(t *testing.T) {
+	key := "/planes/radius/local/resourcegroups/rg/providers/applications.core/applications/app/"
+
+	token := NewPaginationToken(key)
+	require.NotContains(t, token, "/")
+
+	parsed, err := ParsePaginationToken(token)
+	require.NoError(t, err)
+	require.Equal(t, key, parsed)
+}
+
+func Test_ParsePaginationToken_Invalid(t *testing.T) {
+	for _, token := range []string{"not a token!", NewPaginationToken("")[:2], "djI6YWJj"} {
+		_, err := ParsePaginationToken(token)
+		require.ErrorIs(t, err, &ErrInvalid{}, token)
+	}
+}
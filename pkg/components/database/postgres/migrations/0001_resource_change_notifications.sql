@@ -0,0 +1,21 @@
+-- notify_resource_change sends a notification on the 'resource_changes' channel when a resource is created,
+-- updated, or deleted. This is used to implement watches.
+--
+-- The payload is limited to 8000 bytes, so we only send the id and etag. Listeners read the resource data
+-- separately.
+CREATE OR REPLACE FUNCTION notify_resource_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('resource_changes', json_build_object('operation', TG_OP, 'id', OLD.original_id, 'etag', OLD.etag)::text);
+        RETURN OLD;
+    END IF;
+
+    PERFORM pg_notify('resource_changes', json_build_object('operation', TG_OP, 'id', NEW.original_id, 'etag', NEW.etag)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS resource_changes ON resources;
+
+CREATE TRIGGER resource_changes AFTER INSERT OR UPDATE OR DELETE ON resources
+    FOR EACH ROW EXECUTE FUNCTION notify_resource_change();
@@ -0,0 +1,7 @@
+-- idx_resource_id is an index for the ordering of queries.
+--
+-- Queries are ordered by id compared with the "C" collation, so that the ordering is byte-wise and matches the
+-- other database implementations, and resume after the last id of the previous page. The primary key uses the
+-- default collation of the database, so it cannot be used for either: this index avoids a sequential scan and a
+-- sort for every page.
+CREATE INDEX IF NOT EXISTS idx_resource_id ON resources (id COLLATE "C");
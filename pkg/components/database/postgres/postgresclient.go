@@ -22,13 +22,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/database/databaseutil"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
 )
 
@@ -44,12 +47,38 @@ type PostgresAPI interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
 
+// PostgresListenerAPI defines the API surface from pgx that we use for LISTEN/NOTIFY. This is optional and
+// is only required for Watch.
+//
+// Keep these definitions in sync with pgxpool.Pool.
+type PostgresListenerAPI interface {
+	// Acquire returns a dedicated connection from the pool.
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// PostgresTransactionAPI defines the API surface from pgx that we use for transactions. This is optional and
+// is only required for ExecuteTransaction.
+//
+// Keep these definitions in sync with pgxpool.Pool and pgx.Conn.
+type PostgresTransactionAPI interface {
+	// Begin starts a transaction.
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ChangeNotificationChannel is the channel used by the resources table trigger to notify listeners of changes. The
+// trigger has the same name.
+//
+// See migrations/0001_resource_change_notifications.sql for the definition of the trigger.
+const ChangeNotificationChannel = "resource_changes"
+
 // NewPostgresClient creates a new PostgresClient.
 func NewPostgresClient(api PostgresAPI) *PostgresClient {
 	return &PostgresClient{api: api}
 }
 
 var _ database.Client = (*PostgresClient)(nil)
+var _ database.Watcher = (*PostgresClient)(nil)
+var _ database.Transactor = (*PostgresClient)(nil)
 
 // PostgresClient is a database client that uses Postgres as the backend.
 type PostgresClient struct {
@@ -199,18 +228,19 @@ func (p *PostgresClient) Query(ctx context.Context, query database.Query, option
 		routingScopePrefixFilter = new(databaseutil.NormalizePart(query.RoutingScopePrefix))
 	}
 
-	var timestampFilter *string
-	if config.PaginationToken != "" {
-		ts, err := p.parsePaginationToken(config.PaginationToken)
+	var afterFilter *string
+	if config.PaginationToken != "" && !isLegacyPaginationToken(config.PaginationToken) {
+		after, err := database.ParsePaginationToken(config.PaginationToken)
 		if err != nil {
-			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
+			return nil, err
 		}
-		timestampFilter = &ts
+		afterFilter = &after
 	}
 
 	var limitFilter *int
 	if config.MaxQueryItemCount > 0 {
-		limitFilter = &config.MaxQueryItemCount
+		// Read one extra row so we know whether there is another page.
+		limitFilter = new(config.MaxQueryItemCount + 1)
 	}
 
 	// For a scope query, we need to perform the same normalization as we do for other operations on scopes.
@@ -225,16 +255,6 @@ func (p *PostgresClient) Query(ctx context.Context, query database.Query, option
 	// NOTE: building SQL by concatenating strings is hard to do safely and should be avoided.
 	// If you need to work on this code MAKE SURE you use SQL parameters
 	// for any user input.
-	sql := `
-SELECT original_id, etag, resource_data, created_at 
-FROM resources
-WHERE ((root_scope = $1) OR ($2 AND (root_scope LIKE $1 || '%'))) AND 
-	resource_type = $3 AND 
-	((routing_scope LIKE $4 || '%') OR $4 IS NULL) AND 
-	(created_at > $5::TIMESTAMP OR $5 IS NULL)
-ORDER BY created_at ASC
-LIMIT $6`
-
 	args := []any{
 		// If ScopeRecursive is false, the RootScope must match exactly.
 		// If ScopeRecursive is true, the RootScope must be a prefix of the stored RootScope.
@@ -242,38 +262,47 @@ LIMIT $6`
 		query.ScopeRecursive,
 		resourceType,
 		routingScopePrefixFilter, // RoutingScopePrefix is optional and always treated as as prefix.
-		timestampFilter,          // Optional for pagination.
+		afterFilter,              // Optional for pagination.
 		limitFilter,              // NOTE: Postgres allows LIMIT to be set with a NULL value to mean no limit.
 	}
 
+	// Filters are evaluated by Postgres so that LIMIT applies to the filtered results.
+	filterSQL := ""
+	for _, filter := range query.Filters {
+		var clause string
+		clause, args = buildFilterClause(filter, args)
+		filterSQL += " AND\n\t" + clause
+	}
+
+	sql := `
+SELECT id, original_id, etag, resource_data
+FROM resources
+WHERE ((root_scope = $1) OR ($2 AND (root_scope LIKE $1 || '%'))) AND 
+	resource_type = $3 AND 
+	((routing_scope LIKE $4 || '%') OR $4 IS NULL) AND 
+	(id COLLATE "C" > $5 OR $5 IS NULL)` + filterSQL + `
+ORDER BY id COLLATE "C" ASC
+LIMIT $6`
+
 	rows, err := p.api.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	// Capture the last timestamp so we can use it for pagination.
-	var timestamp *time.Time
+	// Capture the id of each row so we can use it for pagination.
+	keys := []string{}
 
 	result := database.ObjectQueryResult{}
 	for rows.Next() {
+		key := ""
 		obj := database.Object{}
-		err := rows.Scan(&obj.ID, &obj.ETag, &obj.Data, &timestamp)
-		if err != nil {
-			return nil, err
-		}
-
-		// We could improve this by moving the filter logic to the SQL query.
-		//
-		// The problem is that the current filter logic is not well documented or tested, and
-		// we want to stay compatible with the existing implementation for now.
-		match, err := obj.MatchesFilters(query.Filters)
+		err := rows.Scan(&key, &obj.ID, &obj.ETag, &obj.Data)
 		if err != nil {
 			return nil, err
-		} else if !match {
-			continue
 		}
 
+		keys = append(keys, key)
 		result.Items = append(result.Items, obj)
 	}
 
@@ -282,18 +311,10 @@ LIMIT $6`
 		return nil, err
 	}
 
-	if len(result.Items) < config.MaxQueryItemCount && config.MaxQueryItemCount > 0 {
-		// No more rows, so no need for pagination.
-		return &result, nil
-	}
-
-	if timestamp != nil {
-		// Will be empty if there were no rows.
-		token, err := p.createPaginationToken(*timestamp)
-		if err != nil {
-			return nil, err
-		}
-		result.PaginationToken = token
+	if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+		// There is at least one more row, so trim the extra row and return a token.
+		result.Items = result.Items[:config.MaxQueryItemCount]
+		result.PaginationToken = database.NewPaginationToken(keys[config.MaxQueryItemCount-1])
 	}
 
 	return &result, nil
@@ -344,7 +365,7 @@ WITH updated AS (
 	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
 	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	ON CONFLICT (id) 
-	DO UPDATE SET resource_data = $7
+	DO UPDATE SET resource_data = $7, etag = $6
 	RETURNING id
 )
 SELECT
@@ -369,7 +390,7 @@ END AS result;`
 		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
 		sql = `
 WITH updated AS (
-	UPDATE resources SET resource_data = $2
+	UPDATE resources SET resource_data = $2, etag = $4
 	WHERE id = $1 AND etag = $3
 	RETURNING id
 )
@@ -380,7 +401,7 @@ CASE
 	ELSE 'ErrConcurrency'
 END AS result;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag}
+		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag, obj.ETag}
 	}
 
 	result := ""
@@ -396,26 +417,277 @@ END AS result;`
 	return nil
 }
 
-// createPaginationToken converts a timestamp to a base64 encoded string.
+// ExecuteTransaction implements database.Transactor.
 //
-// We use ISO8601/RFC3339 format which postgres understands and can be used for comparison.
-// We also add microseconds to the timestamp to ensure uniqueness.
-func (p *PostgresClient) createPaginationToken(timestamp time.Time) (string, error) {
-	return base64.StdEncoding.EncodeToString([]byte(timestamp.UTC().Format(time.RFC3339Nano))), nil
+// ExecuteTransaction requires the PostgresAPI to implement PostgresTransactionAPI. The operations use the same
+// SQL as Save and Delete, executed as part of a single Postgres transaction.
+func (p *PostgresClient) ExecuteTransaction(ctx context.Context, operations []database.Operation) (err error) {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err = database.ValidateOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	transactor, ok := p.api.(PostgresTransactionAPI)
+	if !ok {
+		return errors.New("transactions are not supported: the PostgreSQL connection does not support transactions")
+	}
+
+	tx, err := transactor.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Save updates the ETag of the object, so we need to restore the ETags if the transaction fails.
+	etags := make([]string, len(operations))
+	for i, operation := range operations {
+		if operation.Type == database.OperationSave {
+			etags[i] = operation.Object.ETag
+		}
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		_ = tx.Rollback(ctx)
+		for i, operation := range operations {
+			if operation.Type == database.OperationSave {
+				operation.Object.ETag = etags[i]
+			}
+		}
+	}()
+
+	client := NewPostgresClient(tx)
+	for _, operation := range operations {
+		switch operation.Type {
+		case database.OperationSave:
+			err = client.Save(ctx, operation.Object, saveOptions(operation.ETag)...)
+		case database.OperationDelete:
+			err = client.Delete(ctx, operation.ID, deleteOptions(operation.ETag)...)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
 }
 
-// parsePaginationToken converts a base64 encoded string to a timestamp.
-func (p *PostgresClient) parsePaginationToken(token string) (string, error) {
-	data, err := base64.StdEncoding.DecodeString(token)
+// saveOptions converts an optional ETag to SaveOptions.
+func saveOptions(etag string) []database.SaveOptions {
+	if etag == "" {
+		return nil
+	}
+
+	return []database.SaveOptions{database.WithETag(etag)}
+}
+
+// deleteOptions converts an optional ETag to DeleteOptions.
+func deleteOptions(etag string) []database.DeleteOptions {
+	if etag == "" {
+		return nil
+	}
+
+	return []database.DeleteOptions{database.WithETag(etag)}
+}
+
+// Watch implements database.Watcher.
+//
+// Watch requires the PostgresAPI to implement PostgresListenerAPI. Each watch holds a dedicated connection
+// that listens for notifications sent by the trigger on the resources table.
+func (p *PostgresClient) Watch(ctx context.Context, query database.Query) (<-chan database.Event, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	listener, ok := p.api.(PostgresListenerAPI)
+	if !ok {
+		return nil, errors.New("watch is not supported: the PostgreSQL connection does not support LISTEN")
+	}
+
+	// Without the trigger LISTEN succeeds but no notifications are ever sent.
+	exists, err := hasChangeNotificationTrigger(ctx, p.api)
 	if err != nil {
-		return "", err
+		return nil, err
+	} else if !exists {
+		return nil, errors.New("watch is not supported: the resources table is missing the change notification trigger. Apply the PostgreSQL schema migrations")
 	}
 
-	// Roundtripping to ensure that we understand the data.
-	parsed, err := time.Parse(time.RFC3339Nano, string(data))
+	conn, err := listener.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = conn.Exec(ctx, "LISTEN "+ChangeNotificationChannel)
+	if err != nil {
+		conn.Release()
+		return nil, err
+	}
+
+	events := make(chan database.Event)
+	go func() {
+		defer close(events)
+		defer func() {
+			// Closing the connection ensures it won't be reused while still listening.
+			_ = conn.Conn().Close(context.Background())
+			conn.Release()
+		}()
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for {
+			notification, err := conn.Conn().WaitForNotification(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error(err, "failed to wait for resource change notification")
+				}
+				return
+			}
+
+			event, err := p.readNotification(ctx, notification.Payload)
+			if err != nil {
+				logger.Error(err, "failed to process resource change notification", "payload", notification.Payload)
+				continue
+			} else if event == nil {
+				continue
+			}
+
+			match, err := databaseutil.EventMatchesQuery(*event, query)
+			if err != nil {
+				logger.Error(err, "failed to match resource change notification", "payload", notification.Payload)
+				continue
+			} else if !match {
+				continue
+			}
+
+			select {
+			case events <- *event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// changeNotification is the payload sent by the trigger on the resources table.
+type changeNotification struct {
+	// Operation is the SQL operation: INSERT, UPDATE, or DELETE.
+	Operation string `json:"operation"`
+
+	// ID is the original resource id.
+	ID string `json:"id"`
+
+	// ETag is the etag of the row after the operation (or before the operation for DELETE).
+	ETag string `json:"etag"`
+}
+
+// readNotification converts a notification payload to an event. The resource data is not included in the payload
+// because of the size limit on notifications, so it is read from the database. Returns nil if the resource no longer
+// exists, in which case a later notification will report the deletion.
+func (p *PostgresClient) readNotification(ctx context.Context, payload string) (*database.Event, error) {
+	notification := changeNotification{}
+	err := json.Unmarshal([]byte(payload), &notification)
+	if err != nil {
+		return nil, err
+	}
+
+	switch notification.Operation {
+	case "DELETE":
+		return &database.Event{
+			Type:   database.EventDeleted,
+			Object: database.Object{Metadata: database.Metadata{ID: notification.ID, ETag: notification.ETag}},
+		}, nil
+	case "INSERT", "UPDATE":
+		obj, err := p.Get(ctx, notification.ID)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		eventType := database.EventUpdated
+		if notification.Operation == "INSERT" {
+			eventType = database.EventCreated
+		}
+
+		return &database.Event{Type: eventType, Object: *obj}, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", notification.Operation)
+	}
+}
+
+// buildFilterClause converts a query filter to a SQL predicate over the resource_data JSONB column.
+// The filter's field path and values are appended to args as SQL parameters and the updated args are returned.
+//
+// The semantics must match database.Object.MatchesFilters.
+func buildFilterClause(filter database.QueryFilter, args []any) (string, []any) {
+	args = append(args, strings.Split(filter.Field, "."))
+	property := fmt.Sprintf("(resource_data #> $%d::text[])", len(args))
+
+	switch filter.Operator {
+	case database.FilterOperatorExists:
+		return property + " IS NOT NULL", args
+	case database.FilterOperatorNotExists:
+		return property + " IS NULL", args
+	case database.FilterOperatorIn:
+		args = append(args, filter.Values)
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'string' AND %[1]s #>> '{}' = ANY($%[2]d::text[]))", property, len(args)), args
+	}
+
+	args = append(args, filter.Value)
+	param := len(args)
+
+	switch filter.Operator {
+	case database.FilterOperatorEqualsIgnoreCase:
+		// lower() only folds ASCII letters under the "C" collation, matching database.Object.MatchesFilters. Under
+		// other collations the result would depend on the locale of the database.
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'string' AND lower((%[1]s #>> '{}') COLLATE \"C\") = lower($%[2]d::text COLLATE \"C\"))", property, param), args
+	case database.FilterOperatorNotEquals:
+		return fmt.Sprintf("(%s = to_jsonb($%d::text)) IS NOT TRUE", property, param), args
+	case database.FilterOperatorPrefix:
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'string' AND starts_with(%[1]s #>> '{}', $%[2]d::text))", property, param), args
+	case database.FilterOperatorContains:
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'array' AND %[1]s @> jsonb_build_array($%[2]d::text))", property, param), args
+	case database.FilterOperatorGreaterThan, database.FilterOperatorGreaterThanOrEqual, database.FilterOperatorLessThan, database.FilterOperatorLessThanOrEqual:
+		// CASE guarantees the cast is only evaluated for numbers.
+		return fmt.Sprintf("(CASE WHEN jsonb_typeof(%[1]s) = 'number' THEN (%[1]s)::numeric %[2]s $%[3]d::numeric ELSE false END)", property, comparisonOperators[filter.Operator], param), args
+	default:
+		return fmt.Sprintf("%s = to_jsonb($%d::text)", property, param), args
+	}
+}
+
+// comparisonOperators maps numeric filter operators to their SQL equivalents.
+var comparisonOperators = map[database.FilterOperator]string{
+	database.FilterOperatorGreaterThan:        ">",
+	database.FilterOperatorGreaterThanOrEqual: ">=",
+	database.FilterOperatorLessThan:           "<",
+	database.FilterOperatorLessThanOrEqual:    "<=",
+}
+
+// isLegacyPaginationToken returns true if the token was created by an older version of the client, which
+// paginated by the creation time of the resources rather than by their id.
+//
+// A legacy token records a position in a different ordering, so it cannot be converted. The query is restarted
+// from the beginning instead of failing: callers that were listing resources during an upgrade will see some
+// resources again, but will not miss any.
+func isLegacyPaginationToken(token string) bool {
+	data, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
-		return "", err
+		return false
 	}
 
-	return parsed.UTC().Format(time.RFC3339Nano), nil
+	_, err = time.Parse(time.RFC3339Nano, string(data))
+	return err == nil
 }
@@ -18,8 +18,10 @@ package postgres
 
 import (
 	"context"
+	"encoding/base64"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -27,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/test/testcontext"
 	shared "github.com/radius-project/radius/test/ucp/storetest"
 )
@@ -46,6 +49,11 @@ func Test_PostgresClient(t *testing.T) {
 	require.NoError(t, err)
 
 	logger := postgresLogger{t: t, pool: pool}
+
+	// Migrations are idempotent, so they can be applied to the database created by make db-init.
+	err = MigrateSchema(ctx, &logger)
+	require.NoError(t, err)
+
 	client := NewPostgresClient(&logger)
 
 	clear := func(t *testing.T) {
@@ -56,15 +64,30 @@ func Test_PostgresClient(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
 }
 
 var _ PostgresAPI = (*postgresLogger)(nil)
+var _ PostgresListenerAPI = (*postgresLogger)(nil)
+var _ PostgresTransactionAPI = (*postgresLogger)(nil)
 
 type postgresLogger struct {
 	t    *testing.T
 	pool *pgxpool.Pool
 }
 
+// Acquire implements PostgresListenerAPI.
+func (l *postgresLogger) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	return l.pool.Acquire(ctx)
+}
+
+// Begin implements PostgresTransactionAPI.
+func (l *postgresLogger) Begin(ctx context.Context) (pgx.Tx, error) {
+	l.t.Logf("Beginning transaction")
+	return l.pool.Begin(ctx)
+}
+
 // Exec implements PostgresAPI.
 func (l *postgresLogger) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
 	l.t.Logf("Executing: %s", sql)
@@ -85,3 +108,79 @@ func (l *postgresLogger) QueryRow(ctx context.Context, sql string, args ...any)
 	l.t.Logf("Args:\n%s", spew.Sdump(args...))
 	return l.pool.QueryRow(ctx, sql, args...)
 }
+
+func Test_schemaMigrations(t *testing.T) {
+	migrations, err := schemaMigrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	require.Equal(t, "migrations/0001_resource_change_notifications.sql", migrations[0].Name)
+	require.Contains(t, migrations[0].SQL, "CREATE OR REPLACE FUNCTION notify_resource_change()")
+	require.Contains(t, migrations[0].SQL, "DROP TRIGGER IF EXISTS resource_changes ON resources;")
+
+	require.Equal(t, "migrations/0002_resource_id_collation_index.sql", migrations[1].Name)
+	require.Contains(t, migrations[1].SQL, `CREATE INDEX IF NOT EXISTS idx_resource_id ON resources (id COLLATE "C");`)
+}
+
+func Test_isLegacyPaginationToken(t *testing.T) {
+	legacy := base64.StdEncoding.EncodeToString([]byte(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC).Format(time.RFC3339Nano)))
+	require.True(t, isLegacyPaginationToken(legacy))
+
+	require.False(t, isLegacyPaginationToken(database.NewPaginationToken("/planes/radius/local/resourcegroups/rg1/")))
+	require.False(t, isLegacyPaginationToken("not a token"))
+	require.False(t, isLegacyPaginationToken(base64.StdEncoding.EncodeToString([]byte("not a timestamp"))))
+}
+
+func Test_buildFilterClause(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   database.QueryFilter
+		expected string
+		args     []any
+	}{
+		{
+			name:     "equals",
+			filter:   database.QueryFilter{Field: "properties.application", Value: "app"},
+			expected: "(resource_data #> $1::text[]) = to_jsonb($2::text)",
+			args:     []any{[]string{"properties", "application"}, "app"},
+		},
+		{
+			name:     "not equals",
+			filter:   database.QueryFilter{Field: "value", Operator: database.FilterOperatorNotEquals, Value: "app"},
+			expected: "((resource_data #> $1::text[]) = to_jsonb($2::text)) IS NOT TRUE",
+			args:     []any{[]string{"value"}, "app"},
+		},
+		{
+			name:     "equals ignore case",
+			filter:   database.QueryFilter{Field: "value", Operator: database.FilterOperatorEqualsIgnoreCase, Value: "App"},
+			expected: `(jsonb_typeof((resource_data #> $1::text[])) = 'string' AND lower(((resource_data #> $1::text[]) #>> '{}') COLLATE "C") = lower($2::text COLLATE "C"))`,
+			args:     []any{[]string{"value"}, "App"},
+		},
+		{
+			name:     "in",
+			filter:   database.QueryFilter{Field: "value", Operator: database.FilterOperatorIn, Values: []string{"a", "b"}},
+			expected: "(jsonb_typeof((resource_data #> $1::text[])) = 'string' AND (resource_data #> $1::text[]) #>> '{}' = ANY($2::text[]))",
+			args:     []any{[]string{"value"}, []string{"a", "b"}},
+		},
+		{
+			name:     "exists",
+			filter:   database.QueryFilter{Field: "value", Operator: database.FilterOperatorExists},
+			expected: "(resource_data #> $1::text[]) IS NOT NULL",
+			args:     []any{[]string{"value"}},
+		},
+		{
+			name:     "greater than",
+			filter:   database.QueryFilter{Field: "value", Operator: database.FilterOperatorGreaterThan, Value: "3"},
+			expected: "(CASE WHEN jsonb_typeof((resource_data #> $1::text[])) = 'number' THEN ((resource_data #> $1::text[]))::numeric > $2::numeric ELSE false END)",
+			args:     []any{[]string{"value"}, "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, args := buildFilterClause(tt.filter, nil)
+			require.Equal(t, tt.expected, clause)
+			require.Equal(t, tt.args, args)
+		})
+	}
+}
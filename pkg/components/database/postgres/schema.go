@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+// migrationFiles contains the schema migrations applied to existing databases.
+//
+// deploy/init-db/db.sql.txt creates the initial schema. Changes to the schema after the initial release are only
+// added here: the database initialization scripts (deploy/init-db/init-db.sh and build/scripts/start-radius.sh)
+// apply these files to new databases, and MigrateSchema upgrades existing ones. Each migration must be idempotent,
+// since migrations are applied every time the client starts.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// schemaMigration is a single schema migration.
+type schemaMigration struct {
+	// Name is the file name of the migration.
+	Name string
+
+	// SQL is the content of the migration.
+	SQL string
+}
+
+// schemaMigrations returns the schema migrations in the order they should be applied.
+func schemaMigrations() ([]schemaMigration, error) {
+	names, err := fs.Glob(migrationFiles, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	migrations := []schemaMigration{}
+	for _, name := range names {
+		content, err := migrationFiles.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, schemaMigration{Name: name, SQL: string(content)})
+	}
+
+	return migrations, nil
+}
+
+// MigrateSchema applies the schema migrations to the database. The resources table must already exist.
+func MigrateSchema(ctx context.Context, api PostgresAPI) error {
+	migrations, err := schemaMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		// Without arguments the statements are sent with the simple protocol, which runs all the statements of a
+		// migration in a single implicit transaction.
+		_, err := api.Exec(ctx, migration.SQL)
+		if err != nil {
+			return fmt.Errorf("failed to apply schema migration %q: %w", migration.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// hasChangeNotificationTrigger returns true if the trigger that sends change notifications exists on the resources
+// table.
+func hasChangeNotificationTrigger(ctx context.Context, api PostgresAPI) (bool, error) {
+	exists := false
+	err := api.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = $1 AND tgrelid = 'resources'::regclass)", ChangeNotificationChannel).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
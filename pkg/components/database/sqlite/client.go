@@ -0,0 +1,398 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+// schema creates the resources table. The columns match the PostgreSQL schema in deploy/init-db/db.sql.txt.
+const schema = `
+CREATE TABLE IF NOT EXISTS resources (
+	id TEXT PRIMARY KEY NOT NULL,
+	original_id TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	root_scope TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	etag TEXT NOT NULL,
+	resource_data TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_resource_query ON resources (resource_type, root_scope);`
+
+// SQLiteAPI defines the API surface from database/sql that we use. This is satisfied by *sql.DB and *sql.Tx.
+type SQLiteAPI interface {
+	// ExecContext executes a query without returning any rows.
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	// QueryContext executes a query that returns rows.
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	// QueryRowContext executes a query that is expected to return at most one row.
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+var _ database.Client = (*Client)(nil)
+var _ database.Transactor = (*Client)(nil)
+
+// Client is a database client that uses an embedded SQLite database as the backend.
+//
+// Client does not implement database.Watcher, since changes made by other processes sharing the database file
+// cannot be observed.
+type Client struct {
+	api SQLiteAPI
+}
+
+// NewClient creates a new Client and creates the resources table if it does not exist.
+func NewClient(ctx context.Context, db *sql.DB) (*Client, error) {
+	_, err := db.ExecContext(ctx, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resources table: %w", err)
+	}
+
+	return &Client{api: db}, nil
+}
+
+// parseID validates the id and returns its normalized form, which is the primary key of the resources table.
+func parseID(id string, name string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. '%s' must be a valid resource id", name)}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. '%s' must not be empty", name)}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. '%s' must refer to a named resource, not a collection", name)}
+	}
+
+	return databaseutil.ConvertScopeIDToResourceID(parsed)
+}
+
+// Delete implements database.Client.
+func (c *Client) Delete(ctx context.Context, id string, options ...database.DeleteOptions) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	converted, err := parseID(id, "id")
+	if err != nil {
+		return err
+	}
+
+	config := database.NewDeleteConfig(options...)
+
+	var result sql.Result
+	if config.ETag == "" {
+		result, err = c.api.ExecContext(ctx, "DELETE FROM resources WHERE id = ?", databaseutil.NormalizePart(converted.String()))
+	} else {
+		result, err = c.api.ExecContext(ctx, "DELETE FROM resources WHERE id = ? AND etag = ?", databaseutil.NormalizePart(converted.String()), config.ETag)
+	}
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if count == 0 && config.ETag != "" {
+		// NOTE: we want to report ErrConcurrency for all failure cases when an etag is provided.
+		return &database.ErrConcurrency{}
+	} else if count == 0 {
+		return &database.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// Get implements database.Client.
+func (c *Client) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	converted, err := parseID(id, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	obj := database.Object{}
+	data := ""
+	err = c.api.QueryRowContext(
+		ctx,
+		"SELECT original_id, etag, resource_data FROM resources WHERE id = ?",
+		databaseutil.NormalizePart(converted.String())).Scan(&obj.ID, &obj.ETag, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &database.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(data), &obj.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// Query implements database.Client.
+//
+// Filters are evaluated as rows are read, so that the page size applies to the filtered results.
+func (c *Client) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err := query.Validate()
+	if err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	config := database.NewQueryConfig(options...)
+
+	// For a scope query, we need to perform the same normalization as we do for other operations on scopes.
+	resourceType := query.ResourceType
+	if query.IsScopeQuery {
+		resourceType, err = databaseutil.ConvertScopeTypeToResourceType(query.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var routingScopePrefix *string
+	if query.RoutingScopePrefix != "" {
+		routingScopePrefix = new(databaseutil.NormalizePart(query.RoutingScopePrefix))
+	}
+
+	var after *string
+	if config.PaginationToken != "" {
+		key, err := database.ParsePaginationToken(config.PaginationToken)
+		if err != nil {
+			return nil, err
+		}
+		after = &key
+	}
+
+	// substr is used for prefix matching rather than LIKE, since LIKE is case-insensitive and treats '_' as a wildcard.
+	// The default BINARY collation orders ids byte-wise, which matches databaseutil.SortKey.
+	sql := `
+SELECT id, original_id, etag, resource_data
+FROM resources
+WHERE resource_type = ?1 AND
+	((root_scope = ?2) OR (?3 AND substr(root_scope, 1, length(?2)) = ?2)) AND
+	(?4 IS NULL OR substr(routing_scope, 1, length(?4)) = ?4) AND
+	(?5 IS NULL OR id > ?5)
+ORDER BY id ASC`
+
+	rows, err := c.api.QueryContext(
+		ctx,
+		sql,
+		databaseutil.NormalizePart(resourceType),
+		databaseutil.NormalizePart(query.RootScope),
+		query.ScopeRecursive,
+		routingScopePrefix,
+		after)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Capture the id of each row so we can use it for pagination.
+	keys := []string{}
+
+	result := database.ObjectQueryResult{}
+	for rows.Next() {
+		key := ""
+		data := ""
+		obj := database.Object{}
+		err := rows.Scan(&key, &obj.ID, &obj.ETag, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(data), &obj.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		match, err := obj.MatchesFilters(query.Filters)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		keys = append(keys, key)
+		result.Items = append(result.Items, obj)
+
+		// Read one extra row so we know whether there is another page.
+		if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+			break
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.MaxQueryItemCount > 0 && len(result.Items) > config.MaxQueryItemCount {
+		// There is at least one more row, so trim the extra row and return a token.
+		result.Items = result.Items[:config.MaxQueryItemCount]
+		result.PaginationToken = database.NewPaginationToken(keys[config.MaxQueryItemCount-1])
+	}
+
+	return &result, nil
+}
+
+// Save implements database.Client.
+func (c *Client) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	converted, err := parseID(obj.ID, "obj.ID")
+	if err != nil {
+		return err
+	}
+
+	config := database.NewSaveConfig(options...)
+
+	// Compute ETag for the current state of the object.
+	raw, err := json.Marshal(obj.Data)
+	if err != nil {
+		return err
+	}
+
+	newETag := etag.New(raw)
+
+	var result sql.Result
+	if config.ETag == "" {
+		result, err = c.api.ExecContext(ctx, `
+INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET resource_data = excluded.resource_data, etag = excluded.etag`,
+			databaseutil.NormalizePart(converted.String()),
+			obj.ID, // MUST NOT BE NORMALIZED. Preserve the original casing and format.
+			databaseutil.NormalizePart(converted.Type()),
+			databaseutil.NormalizePart(converted.RootScope()),
+			databaseutil.NormalizePart(converted.RoutingScope()),
+			newETag,
+			string(raw))
+	} else {
+		// When an etag is provided we should not perform inserts, only updates.
+		result, err = c.api.ExecContext(ctx,
+			"UPDATE resources SET resource_data = ?, etag = ? WHERE id = ? AND etag = ?",
+			string(raw),
+			newETag,
+			databaseutil.NormalizePart(converted.String()),
+			config.ETag)
+	}
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if count == 0 {
+		// NOTE: we want to report ErrConcurrency for all failure cases when an etag is provided.
+		return &database.ErrConcurrency{}
+	}
+
+	obj.ETag = newETag
+	return nil
+}
+
+// ExecuteTransaction implements database.Transactor.
+//
+// The operations use the same SQL as Save and Delete, executed as part of a single SQLite transaction.
+func (c *Client) ExecuteTransaction(ctx context.Context, operations []database.Operation) (err error) {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	err = database.ValidateOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	db, ok := c.api.(*sql.DB)
+	if !ok {
+		return errors.New("transactions are not supported: the client is already part of a transaction")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Save updates the ETag of the object, so we need to restore the ETags if the transaction fails.
+	etags := make([]string, len(operations))
+	for i, operation := range operations {
+		if operation.Type == database.OperationSave {
+			etags[i] = operation.Object.ETag
+		}
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		_ = tx.Rollback()
+		for i, operation := range operations {
+			if operation.Type == database.OperationSave {
+				operation.Object.ETag = etags[i]
+			}
+		}
+	}()
+
+	client := &Client{api: tx}
+	for _, operation := range operations {
+		switch operation.Type {
+		case database.OperationSave:
+			options := []database.SaveOptions{}
+			if operation.ETag != "" {
+				options = append(options, database.WithETag(operation.ETag))
+			}
+			err = client.Save(ctx, operation.Object, options...)
+		case database.OperationDelete:
+			options := []database.DeleteOptions{}
+			if operation.ETag != "" {
+				options = append(options, database.WithETag(operation.ETag))
+			}
+			err = client.Delete(ctx, operation.ID, options...)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SQLiteClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	db, err := Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client, err := NewClient(ctx, db)
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM resources")
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+	shared.RunTransactionTest(t, client, clear)
+}
+
+func Test_SQLiteClient_Persistence(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	path := filepath.Join(t.TempDir(), "radius.db")
+	id := "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"
+
+	db, err := Open(path)
+	require.NoError(t, err)
+
+	client, err := NewClient(ctx, db)
+	require.NoError(t, err)
+
+	obj := &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"value": "a"}}
+	require.NoError(t, client.Save(ctx, obj))
+	require.NoError(t, db.Close())
+
+	// Reopening the file (eg: after a restart) returns the saved data.
+	db, err = Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client, err = NewClient(ctx, db)
+	require.NoError(t, err)
+
+	saved, err := client.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, obj, saved)
+}
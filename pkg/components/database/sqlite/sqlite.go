@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlite implements database.Client using an embedded SQLite database. This is intended for single-node
+// installations and local development, where data should survive restarts without running a database server.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	// Registers the pure-Go "sqlite" driver.
+	_ "modernc.org/sqlite"
+)
+
+const (
+	// busyTimeoutMilliseconds is how long a connection waits for a lock held by another connection or process.
+	busyTimeoutMilliseconds = 10000
+)
+
+// Open opens the SQLite database file at path, creating it if it does not exist.
+//
+// The database uses write-ahead logging so that readers do not block writers, and transactions take the write
+// lock when they start. The same file can be opened by multiple processes on the same node.
+func Open(path string) (*sql.DB, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory for SQLite database: %w", err)
+	}
+
+	query := url.Values{}
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMilliseconds))
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "synchronous(NORMAL)")
+	query.Set("_txlock", "immediate")
+
+	dsn := (&url.URL{Scheme: "file", Path: path, RawQuery: query.Encode()}).String()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.PingContext(context.Background())
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to open SQLite database %q: %w", path, err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// OperationType is the type of an Operation.
+type OperationType string
+
+const (
+	// OperationSave saves an object. See Client.Save.
+	OperationSave OperationType = "Save"
+
+	// OperationDelete deletes an object. See Client.Delete.
+	OperationDelete OperationType = "Delete"
+)
+
+// Operation is a single Save or Delete as part of a transaction. Use SaveOperation or DeleteOperation to
+// create an Operation.
+type Operation struct {
+	// Type is the type of the operation.
+	Type OperationType
+
+	// Object is the object to save for OperationSave. The ETag field of the object is read-only and will be
+	// updated when the transaction succeeds.
+	Object *Object
+
+	// ID is the resource id to delete for OperationDelete.
+	ID string
+
+	// ETag is the optional ETag used to enforce optimistic concurrency control. This has the same semantics
+	// as WithETag for Save and Delete.
+	ETag ETag
+}
+
+// SaveOperation creates an Operation that saves the object.
+func SaveOperation(obj *Object, options ...SaveOptions) Operation {
+	config := NewSaveConfig(options...)
+	return Operation{Type: OperationSave, Object: obj, ETag: config.ETag}
+}
+
+// DeleteOperation creates an Operation that deletes the resource with the given id.
+func DeleteOperation(id string, options ...DeleteOptions) Operation {
+	config := NewDeleteConfig(options...)
+	return Operation{Type: OperationDelete, ID: id, ETag: config.ETag}
+}
+
+// ResourceID returns the resource id targeted by the operation.
+func (o Operation) ResourceID() string {
+	if o.Type == OperationSave && o.Object != nil {
+		return o.Object.ID
+	}
+
+	return o.ID
+}
+
+// ValidateOperations validates a set of operations for a transaction. Each resource may only be targeted
+// by a single operation.
+func ValidateOperations(operations []Operation) error {
+	seen := map[string]bool{}
+	for _, operation := range operations {
+		switch operation.Type {
+		case OperationSave:
+			if operation.Object == nil {
+				return &ErrInvalid{Message: "invalid argument. 'Object' is required for a save operation"}
+			}
+		case OperationDelete:
+			if operation.ID == "" {
+				return &ErrInvalid{Message: "invalid argument. 'ID' is required for a delete operation"}
+			}
+		default:
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. operation type %q is not supported", operation.Type)}
+		}
+
+		key := strings.ToLower(operation.ResourceID())
+		if seen[key] {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. resource %q is targeted by more than one operation", operation.ResourceID())}
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Transactor is implemented by a Client that can apply multiple operations as a unit.
+//
+// Callers should use ExecuteTransaction rather than type-asserting a Client to Transactor.
+type Transactor interface {
+	// ExecuteTransaction applies all of the operations or none of them. The operations are applied in order.
+	//
+	// ExecuteTransaction returns the same errors as Save and Delete for the first operation that fails. When
+	// an error is returned none of the operations have been applied, unless the implementation documents that
+	// it only provides best-effort atomicity.
+	ExecuteTransaction(ctx context.Context, operations []Operation) error
+}
+
+// ExecuteTransaction applies the operations using the client's Transactor implementation when it is available.
+// Otherwise the operations are applied in order with Save and Delete, and earlier operations are not rolled back
+// when a later operation fails.
+func ExecuteTransaction(ctx context.Context, client Client, operations []Operation) error {
+	err := ValidateOperations(operations)
+	if err != nil {
+		return err
+	}
+
+	if transactor, ok := client.(Transactor); ok {
+		return transactor.ExecuteTransaction(ctx, operations)
+	}
+
+	for _, operation := range operations {
+		switch operation.Type {
+		case OperationSave:
+			options := []SaveOptions{}
+			if operation.ETag != "" {
+				options = append(options, WithETag(operation.ETag))
+			}
+			err = client.Save(ctx, operation.Object, options...)
+		case OperationDelete:
+			options := []DeleteOptions{}
+			if operation.ETag != "" {
+				options = append(options, WithETag(operation.ETag))
+			}
+			err = client.Delete(ctx, operation.ID, options...)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateOperations(t *testing.T) {
+	obj := &Object{Metadata: Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"}}
+
+	tests := []struct {
+		name       string
+		operations []Operation
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			operations: []Operation{SaveOperation(obj), DeleteOperation("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/b")},
+		},
+		{
+			name:       "duplicate (case-insensitive)",
+			operations: []Operation{SaveOperation(obj), DeleteOperation("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/A")},
+			wantErr:    true,
+		},
+		{
+			name:       "save without object",
+			operations: []Operation{{Type: OperationSave}},
+			wantErr:    true,
+		},
+		{
+			name:       "delete without id",
+			operations: []Operation{{Type: OperationDelete}},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown type",
+			operations: []Operation{{Type: "Patch", ID: obj.ID}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOperations(tt.operations)
+			if tt.wantErr {
+				require.ErrorIs(t, err, &ErrInvalid{})
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ExecuteTransaction_Fallback(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := NewMockClient(ctrl)
+
+	obj := &Object{Metadata: Metadata{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"}}
+	deleteID := "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/b"
+
+	gomock.InOrder(
+		client.EXPECT().Save(gomock.Any(), obj, gomock.Len(1)).Return(nil),
+		client.EXPECT().Delete(gomock.Any(), deleteID).Return(&ErrNotFound{ID: deleteID}),
+	)
+
+	err := ExecuteTransaction(ctx, client, []Operation{
+		SaveOperation(obj, WithETag("abc")),
+		DeleteOperation(deleteID),
+	})
+	require.ErrorIs(t, err, &ErrNotFound{ID: deleteID})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+)
+
+// EventType is the type of change reported by a Watcher.
+type EventType string
+
+const (
+	// EventCreated indicates that an object was created.
+	EventCreated EventType = "Created"
+
+	// EventUpdated indicates that an existing object was updated.
+	EventUpdated EventType = "Updated"
+
+	// EventDeleted indicates that an object was deleted.
+	EventDeleted EventType = "Deleted"
+)
+
+// Event describes a change to an object in the data store.
+type Event struct {
+	// Type is the type of change.
+	Type EventType
+
+	// Object is the state of the object after the change. For EventDeleted the object contains the
+	// last known state when the implementation has it, otherwise only the ID and ETag are set.
+	Object Object
+}
+
+// Watcher is implemented by a Client that can stream changes to objects.
+//
+// Callers should type-assert a Client to Watcher to check whether the capability is available.
+type Watcher interface {
+	// Watch starts watching for changes to objects that match the query and returns a channel of events.
+	//
+	// Only changes made after Watch returns are reported. Events for a single object are delivered in order.
+	// The channel is closed when the context is canceled or when the implementation can no longer deliver
+	// events, in which case callers should re-read the current state with Query and start a new watch.
+	//
+	// Query filters are applied to the state of the object after the change. Implementations that do not know
+	// the last state of a deleted object report EventDeleted for any deleted object whose id matches the query.
+	// Pagination options do not apply to watches.
+	Watch(ctx context.Context, query Query) (<-chan Event, error)
+}
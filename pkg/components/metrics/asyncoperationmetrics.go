@@ -40,18 +40,30 @@ const (
 	// ExtendedAsyncOperationCount is the metric name for extended async operation count.
 	ExtendedAsyncOperationCount = "asyncoperation.extended.operation"
 
+	// DeadLetteredAsyncOperationCount is the metric name for async operations moved to the dead-letter queue.
+	DeadLetteredAsyncOperationCount = "asyncoperation.deadlettered.operation"
+
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// PendingAsyncOperationCount is the metric name for the number of async operations dequeued by the worker and
+	// waiting to be scheduled.
+	PendingAsyncOperationCount = "asyncoperation.pending.operation"
+
+	// AsyncOperationSchedulingDelay is the metric name for the time async operations wait to be scheduled by the worker.
+	AsyncOperationSchedulingDelay = "asyncoperation.scheduling.delay"
 )
 
 type asyncOperationMetrics struct {
 	counters       map[string]metric.Int64Counter
+	upDownCounters map[string]metric.Int64UpDownCounter
 	valueRecorders map[string]metric.Float64Histogram
 }
 
 func newAsyncOperationMetrics() *asyncOperationMetrics {
 	return &asyncOperationMetrics{
 		counters:       make(map[string]metric.Int64Counter),
+		upDownCounters: make(map[string]metric.Int64UpDownCounter),
 		valueRecorders: make(map[string]metric.Float64Histogram),
 	}
 }
@@ -77,11 +89,26 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.counters[DeadLetteredAsyncOperationCount], err = meter.Int64Counter(DeadLetteredAsyncOperationCount)
+	if err != nil {
+		return err
+	}
+
 	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration)
 	if err != nil {
 		return err
 	}
 
+	a.upDownCounters[PendingAsyncOperationCount], err = meter.Int64UpDownCounter(PendingAsyncOperationCount)
+	if err != nil {
+		return err
+	}
+
+	a.valueRecorders[AsyncOperationSchedulingDelay], err = meter.Float64Histogram(AsyncOperationSchedulingDelay)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -115,6 +142,14 @@ func (a *asyncOperationMetrics) RecordExtendedAsyncOperation(ctx context.Context
 	}
 }
 
+// RecordDeadLetteredAsyncOperation increments the DeadLetteredAsyncOperationCount metric for the given request. It
+// should be called when an async operation message is moved to the dead-letter queue.
+func (a *asyncOperationMetrics) RecordDeadLetteredAsyncOperation(ctx context.Context, req *ctrl.Request) {
+	if a.counters[DeadLetteredAsyncOperationCount] != nil {
+		a.counters[DeadLetteredAsyncOperationCount].Add(ctx, 1, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
 // RecordAsyncOperationDuration records the duration of an asynchronous operation in milliseconds.
 func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context, req *ctrl.Request, startTime time.Time) {
 	if a.valueRecorders[AsnycOperationDuration] != nil {
@@ -123,6 +158,23 @@ func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context
 	}
 }
 
+// RecordPendingAsyncOperation adds delta to the number of async operations waiting to be scheduled by the worker.
+// It should be called with 1 when the worker dequeues an operation and with -1 when the operation is scheduled.
+func (a *asyncOperationMetrics) RecordPendingAsyncOperation(ctx context.Context, req *ctrl.Request, delta int64) {
+	if a.upDownCounters[PendingAsyncOperationCount] != nil {
+		a.upDownCounters[PendingAsyncOperationCount].Add(ctx, delta, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
+// RecordAsyncOperationSchedulingDelay records the time in milliseconds an async operation waited to be scheduled by
+// the worker since it was dequeued.
+func (a *asyncOperationMetrics) RecordAsyncOperationSchedulingDelay(ctx context.Context, req *ctrl.Request, queuedAt time.Time) {
+	if a.valueRecorders[AsyncOperationSchedulingDelay] != nil {
+		elapsedTime := float64(time.Since(queuedAt)) / float64(time.Millisecond)
+		a.valueRecorders[AsyncOperationSchedulingDelay].Record(ctx, elapsedTime, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
 func newAsyncOperationCommonAttributes(req *ctrl.Request, res *ctrl.Result) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
 
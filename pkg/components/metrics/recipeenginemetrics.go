@@ -46,12 +46,25 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// recipeDriftCheckCount is the metric name for the number of drift detections of recipe deployments.
+	recipeDriftCheckCount = "recipe.drift.check.count"
+
+	// recipeDriftedResourceCount is the metric name for the number of resources found changed outside of Radius by
+	// drift detections.
+	recipeDriftedResourceCount = "recipe.drift.resource.count"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
+	// RecipeEngineOperationDetectDrift represents the DetectDrift operation of the Recipe Engine.
+	RecipeEngineOperationDetectDrift = "detect.drift"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -96,6 +109,16 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeDriftCheckCount], err = meter.Int64Counter(recipeDriftCheckCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeDriftedResourceCount], err = meter.Int64Counter(recipeDriftedResourceCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -148,6 +171,37 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordDriftCheck records a drift detection of a recipe deployment, and the number of resources it found changed
+// outside of Radius, with the given attributes.
+func (m *recipeEngineMetrics) RecordDriftCheck(ctx context.Context, driftedResources int, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftCheckCount] != nil {
+		m.counters[recipeDriftCheckCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if m.counters[recipeDriftedResourceCount] != nil && driftedResources > 0 {
+		m.counters[recipeDriftedResourceCount].Add(ctx, int64(driftedResources), metric.WithAttributes(attrs...))
+	}
+}
+
+// NewDriftAttributes generates the attributes of a drift detection of a recipe deployment.
+func NewDriftAttributes(resourceType, driver, driftState string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0)
+
+	if resourceType != "" {
+		attrs = append(attrs, resourceTypeAttrKey.String(strings.ToLower(resourceType)))
+	}
+
+	if driver != "" {
+		attrs = append(attrs, recipeDriverAttrKey.String(strings.ToLower(driver)))
+	}
+
+	if driftState != "" {
+		attrs = append(attrs, driftStateAttrKey.String(strings.ToLower(driftState)))
+	}
+
+	return attrs
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
@@ -42,6 +42,9 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// driftStateAttrKey is the attribute name for the drift state of a recipe deployment.
+	driftStateAttrKey = attribute.Key("drift_state")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
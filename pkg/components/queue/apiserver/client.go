@@ -49,6 +49,11 @@ limitations under the License.
 // and checks if its dequeue count matches the dequeue count of Message Client A currently have. We are using DequeueCount as a
 // revision number of message here. If it is mismatched, it means that Client B already leased the message. In this case,
 // ExtendMessage returns ErrDequeuedMessage to prevent Client A from extending lock.
+//
+// Dead-letter sub-queue - DeadLetterMessage moves a message to the dead-letter sub-queue by changing its `ucp.dev/queuename`
+// label to the name of the sub-queue (for example, `applications.core.deadletter`), so Dequeue no longer finds it. The reason
+// is stored in the `ucp.dev/deadletterreason` annotation. RequeueDeadLetterMessage moves the message back by restoring the
+// label and resetting DequeueCount.
 
 package apiserver
 
@@ -57,11 +62,13 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
 	v1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/queue"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -75,12 +82,15 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// AnnotationDeadLetterReason is the annotation representing the reason the message was dead-lettered.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
 )
 
 var _ queue.Client = (*Client)(nil)
+var _ queue.DeadLetterClient = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -118,11 +128,12 @@ func getTimeFromString(s string) time.Time {
 
 func copyMessage(msg *queue.Message, queueMessage *v1alpha1.QueueMessage) {
 	msg.Metadata = queue.Metadata{
-		ID:            queueMessage.Name,
-		DequeueCount:  queueMessage.Spec.DequeueCount,
-		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
-		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
-		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		ID:               queueMessage.Name,
+		DequeueCount:     queueMessage.Spec.DequeueCount,
+		EnqueueAt:        queueMessage.Spec.EnqueueAt.Time,
+		ExpireAt:         queueMessage.Spec.ExpireAt.Time,
+		NextVisibleAt:    getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		DeadLetterReason: queueMessage.Annotations[AnnotationDeadLetterReason],
 	}
 	msg.ContentType = queue.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
@@ -355,3 +366,126 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+// DeadLetterMessage moves the message to the dead-letter sub-queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(getErr) {
+			return queue.ErrInvalidMessage
+		} else if getErr != nil {
+			return getErr
+		}
+
+		if result.Labels[LabelQueueName] != c.opts.Name {
+			return queue.ErrInvalidMessage
+		}
+
+		// Ensure that it doesn't dead-letter the message that another client leased.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return queue.ErrDequeuedMessage
+		}
+
+		result.Labels[LabelQueueName] = queue.DeadLetterQueueName(c.opts.Name)
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Annotations[AnnotationDeadLetterReason] = reason
+
+		return c.client.Update(ctx, result)
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	msg.DeadLetterReason = reason
+	return nil
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter sub-queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*queue.Message, error) {
+	ql := &v1alpha1.QueueMessageList{}
+	err := c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabels{LabelQueueName: queue.DeadLetterQueueName(c.opts.Name)})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*queue.Message{}
+	for i := range ql.Items {
+		msg := &queue.Message{}
+		copyMessage(msg, &ql.Items[i])
+		result = append(result, msg)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].EnqueueAt.Before(result[j].EnqueueAt)
+	})
+
+	return result, nil
+}
+
+// RequeueDeadLetterMessage moves the dead-lettered message back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.getDeadLetterMessage(ctx, id, result)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		result.Labels[LabelQueueName] = c.opts.Name
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		result.Spec.DequeueCount = 0
+		result.Spec.EnqueueAt = metav1.Time{Time: now.UTC()}
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// DeleteDeadLetterMessage deletes the dead-lettered message.
+func (c *Client) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.getDeadLetterMessage(ctx, id, result)
+		if err != nil {
+			return err
+		}
+
+		options := &runtimeclient.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID:             &result.UID,
+				ResourceVersion: &result.ResourceVersion,
+			},
+		}
+		return c.client.Delete(ctx, result, options)
+	})
+}
+
+// getDeadLetterMessage fetches the message with the given id, returning ErrMessageNotFound if the message does not
+// exist or is not in the dead-letter sub-queue of this queue.
+func (c *Client) getDeadLetterMessage(ctx context.Context, id string, result *v1alpha1.QueueMessage) error {
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return queue.ErrMessageNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if result.Labels[LabelQueueName] != queue.DeadLetterQueueName(c.opts.Name) {
+		return queue.ErrMessageNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+)
+
+const (
+	// DeadLetterQueueSuffix is appended to the name of a queue to form the name of its dead-letter sub-queue.
+	DeadLetterQueueSuffix = ".deadletter"
+)
+
+// DeadLetterQueueName returns the name of the dead-letter sub-queue for the queue with the given name.
+func DeadLetterQueueName(name string) string {
+	return name + DeadLetterQueueSuffix
+}
+
+// DeadLetterClient is implemented by queue clients that support a dead-letter sub-queue.
+//
+// Messages that cannot be processed (for example, because they exceeded the maximum number of retries) are moved to
+// the dead-letter sub-queue instead of being deleted. Dead-lettered messages are never dequeued and do not expire,
+// so that an operator can inspect them and either requeue or delete them.
+type DeadLetterClient interface {
+	// DeadLetterMessage moves a leased message to the dead-letter sub-queue, recording the reason it could not be
+	// processed. DeadLetterMessage returns ErrDequeuedMessage if the message has been leased by another client, and
+	// ErrInvalidMessage if the message has been deleted or dead-lettered already.
+	DeadLetterMessage(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetterMessages lists the messages in the dead-letter sub-queue, ordered by the time they were enqueued.
+	ListDeadLetterMessages(ctx context.Context) ([]*Message, error)
+
+	// RequeueDeadLetterMessage moves the dead-lettered message with the given id back to the queue. The message is
+	// treated as newly enqueued: its dequeue count is reset and its expiry is renewed. RequeueDeadLetterMessage
+	// returns ErrMessageNotFound if there is no dead-lettered message with the given id.
+	RequeueDeadLetterMessage(ctx context.Context, id string) error
+
+	// DeleteDeadLetterMessage deletes the dead-lettered message with the given id. DeleteDeadLetterMessage returns
+	// ErrMessageNotFound if there is no dead-lettered message with the given id.
+	DeleteDeadLetterMessage(ctx context.Context, id string) error
+}
+
+// DeadLetterMessage moves the message to the dead-letter sub-queue if the client supports it. Otherwise the message
+// is finished so that it is not processed again.
+func DeadLetterMessage(ctx context.Context, cli Client, msg *Message, reason string) error {
+	if dlc, ok := cli.(DeadLetterClient); ok {
+		return dlc.DeadLetterMessage(ctx, msg, reason)
+	}
+
+	return cli.FinishMessage(ctx, msg)
+}
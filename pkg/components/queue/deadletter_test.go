@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type testDeadLetterClient struct {
+	*MockClient
+
+	reason string
+}
+
+func (c *testDeadLetterClient) DeadLetterMessage(ctx context.Context, msg *Message, reason string) error {
+	c.reason = reason
+	return nil
+}
+
+func (c *testDeadLetterClient) ListDeadLetterMessages(ctx context.Context) ([]*Message, error) {
+	return nil, nil
+}
+
+func (c *testDeadLetterClient) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *testDeadLetterClient) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	return nil
+}
+
+func TestDeadLetterQueueName(t *testing.T) {
+	require.Equal(t, "applications.core.deadletter", DeadLetterQueueName("applications.core"))
+}
+
+func TestDeadLetterMessage(t *testing.T) {
+	msg := &Message{Metadata: Metadata{ID: "testID", DequeueCount: 4}}
+
+	t.Run("dead-letter client", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		cli := &testDeadLetterClient{MockClient: NewMockClient(mctrl)}
+
+		err := DeadLetterMessage(context.Background(), cli, msg, "too many retries")
+		require.NoError(t, err)
+		require.Equal(t, "too many retries", cli.reason)
+	})
+
+	t.Run("client without dead-letter support finishes the message", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		cli := NewMockClient(mctrl)
+		cli.EXPECT().FinishMessage(gomock.Any(), msg).Return(nil).Times(1)
+
+		err := DeadLetterMessage(context.Background(), cli, msg, "too many retries")
+		require.NoError(t, err)
+	})
+}
@@ -25,6 +25,7 @@ import (
 
 var namedQueue = &sync.Map{}
 var _ queue.Client = (*Client)(nil)
+var _ queue.DeadLetterClient = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -89,3 +90,27 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the message to the dead-letter sub-queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, reason)
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter sub-queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*queue.Message, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// RequeueDeadLetterMessage moves the dead-lettered message back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return c.queue.Requeue(id)
+}
+
+// DeleteDeadLetterMessage deletes the dead-lettered message.
+func (c *Client) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	return c.queue.DeleteDeadLetter(id)
+}
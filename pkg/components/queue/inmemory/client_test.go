@@ -52,4 +52,5 @@ func TestClient(t *testing.T) {
 	}
 
 	sharedtest.RunTest(t, cli, clean)
+	sharedtest.RunDeadLetterTest(t, cli, clean)
 }
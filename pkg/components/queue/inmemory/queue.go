@@ -18,6 +18,7 @@ package inmemory
 
 import (
 	"container/list"
+	"slices"
 	"sync"
 	"time"
 
@@ -36,6 +37,9 @@ type element struct {
 	val *queue.Message
 
 	visible bool
+
+	// deadLetter is true when the message is in the dead-letter sub-queue.
+	deadLetter bool
 }
 
 // InmemQueue implements in-memory queue for dev/test
@@ -53,10 +57,28 @@ func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	}
 }
 
+// Len returns the number of messages in the queue, excluding dead-lettered messages.
 func (q *InmemQueue) Len() int {
-	q.vMu.Lock()
-	defer q.vMu.Unlock()
-	return q.v.Len()
+	count := 0
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if !elem.deadLetter {
+			count++
+		}
+		return false
+	})
+	return count
+}
+
+// DeadLetterLen returns the number of messages in the dead-letter sub-queue.
+func (q *InmemQueue) DeadLetterLen() int {
+	count := 0
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.deadLetter {
+			count++
+		}
+		return false
+	})
+	return count
 }
 
 func (q *InmemQueue) DeleteAll() {
@@ -85,7 +107,7 @@ func (q *InmemQueue) Dequeue() *queue.Message {
 	var found *queue.Message
 
 	q.elementRange(func(e *list.Element, elem *element) bool {
-		if elem.visible {
+		if elem.visible && !elem.deadLetter {
 			elem.val.DequeueCount++
 			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
 			elem.visible = false
@@ -101,7 +123,7 @@ func (q *InmemQueue) Dequeue() *queue.Message {
 func (q *InmemQueue) Complete(msg *queue.Message) error {
 	found := false
 	q.elementRange(func(e *list.Element, elem *element) bool {
-		if elem.val.ID == msg.ID {
+		if elem.val.ID == msg.ID && !elem.deadLetter {
 			found = true
 			q.v.Remove(e)
 			return true
@@ -120,7 +142,7 @@ func (q *InmemQueue) Extend(msg *queue.Message) error {
 	found := false
 	now := time.Now()
 	q.elementRange(func(e *list.Element, elem *element) bool {
-		if elem.val.ID == msg.ID {
+		if elem.val.ID == msg.ID && !elem.deadLetter {
 			if elem.val.NextVisibleAt.UnixNano() < now.UnixNano() || elem.val.DequeueCount != msg.DequeueCount {
 				elem.visible = false
 				return false
@@ -141,10 +163,97 @@ func (q *InmemQueue) Extend(msg *queue.Message) error {
 	return nil
 }
 
+// DeadLetter moves the leased message to the dead-letter sub-queue.
+func (q *InmemQueue) DeadLetter(msg *queue.Message, reason string) error {
+	var err error = queue.ErrInvalidMessage
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID != msg.ID || elem.deadLetter {
+			return false
+		}
+
+		if elem.val.DequeueCount != msg.DequeueCount {
+			err = queue.ErrDequeuedMessage
+			return true
+		}
+
+		elem.deadLetter = true
+		elem.visible = false
+		elem.val.DeadLetterReason = reason
+		msg.DeadLetterReason = reason
+		err = nil
+		return true
+	})
+
+	return err
+}
+
+// DeadLetters returns copies of the messages in the dead-letter sub-queue.
+func (q *InmemQueue) DeadLetters() []*queue.Message {
+	result := []*queue.Message{}
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.deadLetter {
+			copied := *elem.val
+			copied.Data = slices.Clone(elem.val.Data)
+			result = append(result, &copied)
+		}
+		return false
+	})
+
+	return result
+}
+
+// Requeue moves the message with the given id from the dead-letter sub-queue back to the queue.
+func (q *InmemQueue) Requeue(id string) error {
+	found := false
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == id && elem.deadLetter {
+			found = true
+			now := time.Now().UTC()
+			elem.deadLetter = false
+			elem.visible = true
+			elem.val.DequeueCount = 0
+			elem.val.DeadLetterReason = ""
+			elem.val.EnqueueAt = now
+			elem.val.ExpireAt = now.Add(messageExpireDuration)
+			elem.val.NextVisibleAt = time.Time{}
+			return true
+		}
+		return false
+	})
+
+	if !found {
+		return queue.ErrMessageNotFound
+	}
+
+	return nil
+}
+
+// DeleteDeadLetter deletes the message with the given id from the dead-letter sub-queue.
+func (q *InmemQueue) DeleteDeadLetter(id string) error {
+	found := false
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == id && elem.deadLetter {
+			found = true
+			q.v.Remove(e)
+			return true
+		}
+		return false
+	})
+
+	if !found {
+		return queue.ErrMessageNotFound
+	}
+
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
-		if elem.val.ExpireAt.UnixNano() < now.UnixNano() {
+		if elem.deadLetter {
+			// Dead-lettered messages do not expire and are never visible.
+			return false
+		} else if elem.val.ExpireAt.UnixNano() < now.UnixNano() {
 			q.v.Remove(e)
 		} else if elem.val.NextVisibleAt.UnixNano() < now.UnixNano() {
 			elem.visible = true
@@ -46,6 +46,8 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// DeadLetterReason represents the reason the message was moved to the dead-letter sub-queue.
+	DeadLetterReason string
 }
 
 // NewMessage creates Message.
@@ -22,9 +22,11 @@ import (
 	"fmt"
 
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	databasesqlite "github.com/radius-project/radius/pkg/components/database/sqlite"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/components/queue/apiserver"
 	qinmem "github.com/radius-project/radius/pkg/components/queue/inmemory"
+	qsqlite "github.com/radius-project/radius/pkg/components/queue/sqlite"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -36,6 +38,7 @@ type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, erro
 var clientFactory = map[QueueProviderType]factoryFunc{
 	TypeInmemory:  initInMemory,
 	TypeAPIServer: initAPIServer,
+	TypeSQLite:    initSQLite,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -79,3 +82,22 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initSQLite(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.SQLite.Path == "" {
+		return nil, errors.New("failed to initialize SQLite client: path is required")
+	}
+
+	db, err := databasesqlite.Open(opt.SQLite.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize SQLite client: %w", err)
+	}
+
+	client, err := qsqlite.New(ctx, db, qsqlite.Options{Name: opt.Name})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize SQLite client: %w", err)
+	}
+
+	return client, nil
+}
@@ -29,6 +29,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// SQLite configures options for the embedded SQLite queue. (Optional)
+	SQLite SQLiteOptions `yaml:"sqlite,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +47,11 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// SQLiteOptions represents options for the SQLite queue.
+type SQLiteOptions struct {
+	// Path is the path of the SQLite database file. The file and its directory are created if they do not exist.
+	//
+	// The file can be shared with the SQLite database provider and with other processes on the same node.
+	Path string `yaml:"path"`
+}
@@ -18,6 +18,7 @@ package queueprovider
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,18 @@ func TestGetClient_ValidQueue(t *testing.T) {
 	require.Equal(t, oldcli, newcli)
 }
 
+func TestGetClient_SQLite(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeSQLite,
+		SQLite:   SQLiteOptions{Path: filepath.Join(t.TempDir(), "radius.db")},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, cli)
+}
+
 func TestGetClient_InvalidQueue(t *testing.T) {
 	p := New(QueueProviderOptions{
 		Name:     "Applications.Core",
@@ -25,4 +25,7 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeSQLite represents the embedded SQLite queue provider.
+	TypeSQLite QueueProviderType = "sqlite"
 )
@@ -0,0 +1,347 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlite is an embedded SQLite based queue implementation for single-node installations.
+//
+// Messages are stored in the queue_messages table. Like the apiserver queue, a message is leased by moving its
+// next_visible_at timestamp into the future and incrementing its dequeue count. SQLite serializes writes, so
+// Dequeue selects and leases the next visible message in a single UPDATE statement and two clients (or processes
+// sharing the database file) can never lease the same message. The dequeue count is used as the revision number
+// of the message, so a client cannot extend a message that has since been leased by another client.
+//
+// Dead-lettered messages stay in the same table, but their queue_name is changed to the name of the dead-letter
+// sub-queue so they are no longer dequeued or expired.
+package sqlite
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+// schema creates the queue_messages table.
+const schema = `
+CREATE TABLE IF NOT EXISTS queue_messages (
+	id TEXT PRIMARY KEY NOT NULL,
+	queue_name TEXT NOT NULL,
+	dequeue_count INTEGER NOT NULL,
+	enqueue_at INTEGER NOT NULL,
+	expire_at INTEGER NOT NULL,
+	next_visible_at INTEGER NOT NULL,
+	content_type TEXT NOT NULL,
+	data BLOB NOT NULL,
+	dead_letter_reason TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_queue_messages_visible ON queue_messages (queue_name, next_visible_at);`
+
+// messageColumns is the list of columns read by scanMessage.
+const messageColumns = "id, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data, dead_letter_reason"
+
+var _ queue.Client = (*Client)(nil)
+var _ queue.DeadLetterClient = (*Client)(nil)
+
+// Client is the queue client backed by an SQLite database.
+type Client struct {
+	db *sql.DB
+
+	opts Options
+}
+
+// Options is the options to create the SQLite queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by the SQLite database and creates the queue_messages table if it does not exist.
+// name is unique name for each service which will consume the queue.
+func New(ctx context.Context, db *sql.DB, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	_, err := db.ExecContext(ctx, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue_messages table: %w", err)
+	}
+
+	return &Client{db: db, opts: options}, nil
+}
+
+func (c *Client) generateID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.%10d.%32x", c.opts.Name, time.Now().Unix(), b), nil
+}
+
+// scanMessage reads a message from the columns in messageColumns.
+func scanMessage(row interface{ Scan(dest ...any) error }) (*queue.Message, error) {
+	var enqueueAt, expireAt, nextVisibleAt int64
+	msg := &queue.Message{}
+	err := row.Scan(&msg.ID, &msg.DequeueCount, &enqueueAt, &expireAt, &nextVisibleAt, &msg.ContentType, &msg.Data, &msg.DeadLetterReason)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.EnqueueAt = time.Unix(0, enqueueAt).UTC()
+	msg.ExpireAt = time.Unix(0, expireAt).UTC()
+	msg.NextVisibleAt = time.Unix(0, nextVisibleAt)
+	return msg, nil
+}
+
+// Enqueue enqueues message to the SQLite queue.
+func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return queue.ErrEmptyMessage
+	}
+
+	if msg.ContentType != queue.JSONContentType {
+		return queue.ErrUnsupportedContentType
+	}
+
+	now := time.Now()
+	id, err := c.generateID()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, `
+INSERT INTO queue_messages (id, queue_name, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data)
+VALUES (?, ?, 0, ?, ?, ?, ?, ?)`,
+		id,
+		c.opts.Name,
+		now.UnixNano(),
+		now.Add(c.opts.ExpiryDuration).UnixNano(),
+		now.UnixNano(),
+		msg.ContentType,
+		msg.Data)
+	return err
+}
+
+// Dequeue leases the oldest visible message in the queue. Expired messages are deleted.
+func (c *Client) Dequeue(ctx context.Context, opts queue.QueueClientConfig) (*queue.Message, error) {
+	now := time.Now()
+
+	_, err := c.db.ExecContext(ctx, "DELETE FROM queue_messages WHERE queue_name = ? AND expire_at < ?", c.opts.Name, now.UnixNano())
+	if err != nil {
+		return nil, err
+	}
+
+	row := c.db.QueryRowContext(ctx, `
+UPDATE queue_messages
+SET dequeue_count = dequeue_count + 1, next_visible_at = ?1
+WHERE id = (
+	SELECT id FROM queue_messages
+	WHERE queue_name = ?2 AND next_visible_at < ?3
+	ORDER BY next_visible_at ASC, enqueue_at ASC
+	LIMIT 1
+)
+RETURNING `+messageColumns,
+		now.Add(c.opts.MessageLockDuration).UnixNano(),
+		c.opts.Name,
+		now.UnixNano())
+
+	msg, err := scanMessage(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, queue.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FinishMessage deletes the message from the queue. FinishMessage returns ErrInvalidMessage if the message
+// has already been deleted.
+func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	result, err := c.db.ExecContext(ctx, "DELETE FROM queue_messages WHERE id = ? AND queue_name = ?", msg.ID, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return queue.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// ExtendMessage extends the message lock. ExtendMessage returns ErrDequeuedMessage if the message has been
+// leased by another client, and ErrInvalidMessage if the lock has already expired or the message was deleted.
+func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	row := c.db.QueryRowContext(ctx, `
+UPDATE queue_messages
+SET next_visible_at = ?1
+WHERE id = ?2 AND queue_name = ?3 AND dequeue_count = ?4 AND next_visible_at >= ?5
+RETURNING `+messageColumns,
+		now.Add(c.opts.MessageLockDuration).UnixNano(),
+		msg.ID,
+		c.opts.Name,
+		msg.DequeueCount,
+		now.UnixNano())
+
+	result, err := scanMessage(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.leaseError(ctx, msg)
+	} else if err != nil {
+		return err
+	}
+
+	*msg = *result
+	return nil
+}
+
+// leaseError returns the error for a leased message that could not be updated: ErrDequeuedMessage if the message
+// has been leased by another client, and ErrInvalidMessage otherwise.
+func (c *Client) leaseError(ctx context.Context, msg *queue.Message) error {
+	dequeueCount := 0
+	err := c.db.QueryRowContext(ctx, "SELECT dequeue_count FROM queue_messages WHERE id = ? AND queue_name = ?", msg.ID, c.opts.Name).Scan(&dequeueCount)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	} else if err == nil && dequeueCount != msg.DequeueCount {
+		return queue.ErrDequeuedMessage
+	}
+
+	return queue.ErrInvalidMessage
+}
+
+// DeadLetterMessage moves the message to the dead-letter sub-queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, reason string) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	result, err := c.db.ExecContext(ctx,
+		"UPDATE queue_messages SET queue_name = ?, dead_letter_reason = ? WHERE id = ? AND queue_name = ? AND dequeue_count = ?",
+		queue.DeadLetterQueueName(c.opts.Name),
+		reason,
+		msg.ID,
+		c.opts.Name,
+		msg.DequeueCount)
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return c.leaseError(ctx, msg)
+	}
+
+	msg.DeadLetterReason = reason
+	return nil
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter sub-queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*queue.Message, error) {
+	rows, err := c.db.QueryContext(ctx,
+		"SELECT "+messageColumns+" FROM queue_messages WHERE queue_name = ? ORDER BY enqueue_at ASC",
+		queue.DeadLetterQueueName(c.opts.Name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*queue.Message{}
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, msg)
+	}
+
+	return result, rows.Err()
+}
+
+// RequeueDeadLetterMessage moves the dead-lettered message back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	now := time.Now()
+	result, err := c.db.ExecContext(ctx, `
+UPDATE queue_messages
+SET queue_name = ?1, dequeue_count = 0, enqueue_at = ?2, expire_at = ?3, next_visible_at = ?2, dead_letter_reason = ''
+WHERE id = ?4 AND queue_name = ?5`,
+		c.opts.Name,
+		now.UnixNano(),
+		now.Add(c.opts.ExpiryDuration).UnixNano(),
+		id,
+		queue.DeadLetterQueueName(c.opts.Name))
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+// DeleteDeadLetterMessage deletes the dead-lettered message.
+func (c *Client) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	result, err := c.db.ExecContext(ctx, "DELETE FROM queue_messages WHERE id = ? AND queue_name = ?", id, queue.DeadLetterQueueName(c.opts.Name))
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+// requireAffected returns ErrMessageNotFound if no rows were affected by a statement on a dead-lettered message.
+func requireAffected(result sql.Result) error {
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return queue.ErrMessageNotFound
+	}
+
+	return nil
+}
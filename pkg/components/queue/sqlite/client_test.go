@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	databasesqlite "github.com/radius-project/radius/pkg/components/database/sqlite"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateID(t *testing.T) {
+	cli := &Client{opts: Options{Name: "applications.core"}}
+
+	id, err := cli.generateID()
+	require.NoError(t, err)
+	require.Equal(t, 61, len(id))
+}
+
+func TestClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	db, err := databasesqlite.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	cli, err := New(ctx, db, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM queue_messages")
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+
+	t.Run("queues are isolated by name", func(t *testing.T) {
+		clear(t)
+
+		other, err := New(ctx, db, Options{Name: "ucp"})
+		require.NoError(t, err)
+
+		err = other.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		msg, err := other.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, 1, msg.DequeueCount)
+		require.NoError(t, other.FinishMessage(ctx, msg))
+		require.ErrorIs(t, other.FinishMessage(ctx, msg), queue.ErrInvalidMessage)
+	})
+
+	t.Run("ExtendMessage fails when the message was leased by another client", func(t *testing.T) {
+		clear(t)
+
+		client1, err := New(ctx, db, Options{Name: "applications.core", MessageLockDuration: time.Duration(1) * time.Minute})
+		require.NoError(t, err)
+		client2, err := New(ctx, db, Options{Name: "applications.core", MessageLockDuration: time.Duration(1) * time.Minute})
+		require.NoError(t, err)
+
+		err = client1.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+		msg, err := client2.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// Make the message visible and lease it again to mimic the lock expiring.
+		_, err = db.ExecContext(ctx, "UPDATE queue_messages SET next_visible_at = 0 WHERE id = ?", msg.ID)
+		require.NoError(t, err)
+		_, err = client1.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = client2.ExtendMessage(ctx, msg)
+		require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+	})
+
+	t.Run("expired messages are deleted", func(t *testing.T) {
+		clear(t)
+
+		expiring, err := New(ctx, db, Options{Name: "applications.core", ExpiryDuration: time.Millisecond})
+		require.NoError(t, err)
+
+		err = expiring.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+		_, err = expiring.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+	})
+}
@@ -33,7 +33,7 @@ import (
 const (
 	EnvironmentComputeKindKubernetes = "kubernetes"
 	EnvironmentComputeKindACI        = "aci"
-	invalidLocalModulePathFmt        = "local module paths are not supported with Terraform Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
+	invalidLocalModulePathFmt        = "local module paths are not supported with %s Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
 )
 
 // ConvertTo converts from the versioned Environment resource to version-agnostic datamodel.
@@ -205,6 +205,7 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+			recipeConfig.Terraform.Backend = toTerraformBackendConfigDatamodel(config.Terraform.Backend)
 		}
 
 		if config.Bicep != nil {
@@ -250,6 +251,7 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+			recipeConfig.Terraform.Backend = fromTerraformBackendConfigDatamodel(config.Terraform.Backend)
 		}
 
 		if !reflect.DeepEqual(config.Bicep, datamodel.BicepConfigProperties{}) {
@@ -434,11 +436,8 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.EnvironmentRecipeProperties, error) {
 	switch c := e.(type) {
 	case *TerraformRecipeProperties:
-		if c.TemplatePath != nil {
-			// Check for local paths
-			if strings.HasPrefix(to.String(c.TemplatePath), "/") || strings.HasPrefix(to.String(c.TemplatePath), "./") || strings.HasPrefix(to.String(c.TemplatePath), "../") {
-				return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, to.String(c.TemplatePath)))
-			}
+		if isLocalModulePath(to.String(c.TemplatePath)) {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, "Terraform", to.String(c.TemplatePath)))
 		}
 		return datamodel.EnvironmentRecipeProperties{
 			TemplateKind:    types.TemplateKindTerraform,
@@ -446,6 +445,25 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath:    to.String(c.TemplatePath),
 			Parameters:      c.Parameters,
 		}, nil
+	case *OpenTofuRecipeProperties:
+		if isLocalModulePath(to.String(c.TemplatePath)) {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, "OpenTofu", to.String(c.TemplatePath)))
+		}
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindOpenTofu,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
+	case *PulumiRecipeProperties:
+		if isLocalModulePath(to.String(c.TemplatePath)) {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, "Pulumi", to.String(c.TemplatePath)))
+		}
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind: types.TemplateKindPulumi,
+			TemplatePath: to.String(c.TemplatePath),
+			Parameters:   c.Parameters,
+		}, nil
 	case *BicepRecipeProperties:
 		return datamodel.EnvironmentRecipeProperties{
 			TemplateKind: types.TemplateKindBicep,
@@ -457,6 +475,11 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
 
+// isLocalModulePath returns true if the template path of a recipe refers to the local file system.
+func isLocalModulePath(path string) bool {
+	return strings.HasPrefix(path, "/") || strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")
+}
+
 func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipeProperties) RecipePropertiesClassification {
 	switch e.TemplateKind {
 	case types.TemplateKindTerraform:
@@ -466,6 +489,19 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath:    new(e.TemplatePath),
 			Parameters:      e.Parameters,
 		}
+	case types.TemplateKindOpenTofu:
+		return &OpenTofuRecipeProperties{
+			TemplateKind:    new(e.TemplateKind),
+			TemplateVersion: new(e.TemplateVersion),
+			TemplatePath:    new(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
+	case types.TemplateKindPulumi:
+		return &PulumiRecipeProperties{
+			TemplateKind: new(e.TemplateKind),
+			TemplatePath: new(e.TemplatePath),
+			Parameters:   e.Parameters,
+		}
 	case types.TemplateKindBicep:
 		return &BicepRecipeProperties{
 			TemplateKind: new(e.TemplateKind),
@@ -518,6 +554,32 @@ func fromRecipeConfigTerraformProvidersDatamodel(config datamodel.RecipeConfigPr
 	return providers
 }
 
+func toTerraformBackendConfigDatamodel(backend *TerraformBackendConfig) *datamodel.TerraformBackendConfig {
+	if backend == nil {
+		return nil
+	}
+
+	return &datamodel.TerraformBackendConfig{
+		Type:                 to.String(backend.Type),
+		AdditionalProperties: backend.AdditionalProperties,
+		Secrets:              toSecretReferenceDatamodel(backend.Secrets),
+		MigrateFrom:          toTerraformBackendConfigDatamodel(backend.MigrateFrom),
+	}
+}
+
+func fromTerraformBackendConfigDatamodel(backend *datamodel.TerraformBackendConfig) *TerraformBackendConfig {
+	if backend == nil {
+		return nil
+	}
+
+	return &TerraformBackendConfig{
+		Type:                 new(backend.Type),
+		AdditionalProperties: backend.AdditionalProperties,
+		Secrets:              fromSecretReferenceDatamodel(backend.Secrets),
+		MigrateFrom:          fromTerraformBackendConfigDatamodel(backend.MigrateFrom),
+	}
+}
+
 func toSecretReferenceDatamodel(configSecrets map[string]*SecretReference) map[string]datamodel.SecretReference {
 	var secrets map[string]datamodel.SecretReference
 
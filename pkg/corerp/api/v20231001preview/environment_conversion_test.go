@@ -379,15 +379,15 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"opentofu\", \"pulumi\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"opentofu\", \"pulumi\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "Terraform", "../not-allowed/")},
 		},
 	}
 
@@ -1174,3 +1174,117 @@ func Test_fromSecretReferenceDatamodel(t *testing.T) {
 		})
 	}
 }
+
+func Test_TerraformBackendConfigConversion(t *testing.T) {
+	rawPayload := []byte(`{
+		"type": "s3",
+		"bucket": "tfstate",
+		"region": "us-west-2",
+		"use_path_style": true,
+		"secrets": {
+			"secret_key": {
+				"source": "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/s3",
+				"key": "secretKey"
+			}
+		},
+		"migrateFrom": {
+			"type": "kubernetes"
+		}
+	}`)
+
+	versioned := &TerraformBackendConfig{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	expected := &datamodel.TerraformBackendConfig{
+		Type: "s3",
+		AdditionalProperties: map[string]any{
+			"bucket":         "tfstate",
+			"region":         "us-west-2",
+			"use_path_style": true,
+		},
+		Secrets: map[string]datamodel.SecretReference{
+			"secret_key": {
+				Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/s3",
+				Key:    "secretKey",
+			},
+		},
+		MigrateFrom: &datamodel.TerraformBackendConfig{
+			Type: "kubernetes",
+		},
+	}
+
+	dm := toTerraformBackendConfigDatamodel(versioned)
+	require.Equal(t, expected, dm)
+	require.Nil(t, toTerraformBackendConfigDatamodel(nil))
+
+	roundTrip := fromTerraformBackendConfigDatamodel(dm)
+	require.Equal(t, versioned, roundTrip)
+	require.Nil(t, fromTerraformBackendConfigDatamodel(nil))
+}
+
+func Test_RecipePropertiesConversion(t *testing.T) {
+	tests := []struct {
+		desc      string
+		versioned RecipePropertiesClassification
+		dm        datamodel.EnvironmentRecipeProperties
+	}{
+		{
+			desc: "opentofu",
+			versioned: &OpenTofuRecipeProperties{
+				TemplateKind:    new(recipes.TemplateKindOpenTofu),
+				TemplatePath:    new("Azure/cosmosdb/azurerm"),
+				TemplateVersion: new("1.1.0"),
+				Parameters:      map[string]any{"port": float64(6379)},
+			},
+			dm: datamodel.EnvironmentRecipeProperties{
+				TemplateKind:    recipes.TemplateKindOpenTofu,
+				TemplatePath:    "Azure/cosmosdb/azurerm",
+				TemplateVersion: "1.1.0",
+				Parameters:      map[string]any{"port": float64(6379)},
+			},
+		},
+		{
+			desc: "pulumi",
+			versioned: &PulumiRecipeProperties{
+				TemplateKind: new(recipes.TemplateKindPulumi),
+				TemplatePath: new("git::https://github.com/radius-project/recipes.git//pulumi/redis"),
+				Parameters:   map[string]any{"port": float64(6379)},
+			},
+			dm: datamodel.EnvironmentRecipeProperties{
+				TemplateKind: recipes.TemplateKindPulumi,
+				TemplatePath: "git::https://github.com/radius-project/recipes.git//pulumi/redis",
+				Parameters:   map[string]any{"port": float64(6379)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			b, err := json.Marshal(tc.versioned)
+			require.NoError(t, err)
+			unmarshalled, err := unmarshalRecipePropertiesClassification(b)
+			require.NoError(t, err)
+			require.Equal(t, tc.versioned, unmarshalled)
+
+			dm, err := toEnvironmentRecipeProperties(tc.versioned)
+			require.NoError(t, err)
+			require.Equal(t, tc.dm, dm)
+			require.Equal(t, tc.versioned, fromRecipePropertiesClassificationDatamodel(dm))
+		})
+	}
+}
+
+func Test_RecipePropertiesConversion_LocalPath(t *testing.T) {
+	_, err := toEnvironmentRecipeProperties(&OpenTofuRecipeProperties{
+		TemplateKind: new(recipes.TemplateKindOpenTofu),
+		TemplatePath: new("./modules/redis"),
+	})
+	require.Equal(t, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, "OpenTofu", "./modules/redis")), err)
+
+	_, err = toEnvironmentRecipeProperties(&PulumiRecipeProperties{
+		TemplateKind: new(recipes.TemplateKindPulumi),
+		TemplatePath: new("/programs/redis"),
+	})
+	require.Equal(t, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidLocalModulePathFmt, "Pulumi", "/programs/redis")), err)
+}
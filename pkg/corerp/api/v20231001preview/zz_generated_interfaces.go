@@ -35,7 +35,7 @@ type HealthProbePropertiesClassification interface {
 // RecipePropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetRecipeProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *BicepRecipeProperties, *RecipeProperties, *TerraformRecipeProperties
+// - *BicepRecipeProperties, *OpenTofuRecipeProperties, *PulumiRecipeProperties, *RecipeProperties, *TerraformRecipeProperties
 type RecipePropertiesClassification interface {
 	// GetRecipeProperties returns the RecipeProperties content of the underlying type.
 	GetRecipeProperties() *RecipeProperties
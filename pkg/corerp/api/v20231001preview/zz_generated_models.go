@@ -464,6 +464,39 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DriftedResource - A resource deployed by a recipe that was changed outside of Radius.
+type DriftedResource struct {
+	// REQUIRED; The change made to the resource outside of Radius.
+	Change *DriftChange
+
+	// REQUIRED; The type of the resource.
+	Type *string
+
+	// The address of the resource in the recipe, such as a Terraform address.
+	Address *string
+
+	// The UCP resource ID of the resource, if known.
+	ID *string
+
+	// The name of the resource.
+	Name *string
+}
+
+// DriftStatus - The result of the drift detection of the infrastructure deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The time of the drift detection.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The drift state of the infrastructure.
+	State *DriftState
+
+	// The resources deployed by the recipe that were changed outside of Radius.
+	DriftedResources []*DriftedResource
+
+	// The details of the drift detection, such as the reason it failed.
+	Message *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -1061,6 +1094,31 @@ func (m *ManualScalingExtension) GetExtension() *Extension {
 	}
 }
 
+// OpenTofuRecipeProperties - Represents OpenTofu recipe properties.
+type OpenTofuRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+	// Version of the template to deploy. For OpenTofu recipes using a module registry this is required, but must be omitted
+	// for other module sources.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type OpenTofuRecipeProperties.
+func (o *OpenTofuRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters:   o.Parameters,
+		TemplateKind: o.TemplateKind,
+		TemplatePath: o.TemplatePath,
+	}
+}
+
 // Operation - Details of a REST API operation, returned from the Resource Provider Operations API
 type Operation struct {
 	// Localized display information for this particular operation.
@@ -1179,6 +1237,27 @@ type ProvidersAzure struct {
 	Scope *string
 }
 
+// PulumiRecipeProperties - Represents Pulumi recipe properties.
+type PulumiRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type PulumiRecipeProperties.
+func (p *PulumiRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters:   p.Parameters,
+		TemplateKind: p.TemplateKind,
+		TemplatePath: p.TemplatePath,
+	}
+}
+
 // Recipe - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type Recipe struct {
 	// REQUIRED; The name of the recipe within the environment to use
@@ -1297,6 +1376,9 @@ type ResourceStatus struct {
 	// Properties of an output resource
 	OutputResources []*OutputResource
 
+	// READ-ONLY; The result of the latest drift detection of the infrastructure deployed by the recipe
+	DriftStatus *DriftStatus
+
 	// READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -1493,12 +1575,34 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration of the Terraform backend used to store the state of Terraform Recipes. The additional
+// properties are the settings of the backend. The key of the state is generated for each resource, a key set in the settings
+// is used as its prefix. For more information, please see: https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+type TerraformBackendConfig struct {
+	// REQUIRED; The type of the backend. Supported types: kubernetes, s3, azurerm, http, local.
+	Type *string
+
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// The backend currently storing the state of the Terraform Recipes. When set, the state of a resource is moved from this
+	// backend to the configured backend the next time its recipe is executed.
+	MigrateFrom *TerraformBackendConfig
+
+	// Sensitive settings of the backend can be stored as secrets. The secrets are stored in Applications.Core/SecretStores resource.
+	Secrets map[string]*SecretReference
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
 	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
 	Authentication *AuthConfig
 
+	// Configuration of the Terraform backend used to store the state of Terraform Recipes. By default, the state is stored
+	// in a Kubernetes secret.
+	Backend *TerraformBackendConfig
+
 	// Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 	// other APIs. For more information, please see:
 	// https://developer.hashicorp.com/terraform/language/providers/configuration.
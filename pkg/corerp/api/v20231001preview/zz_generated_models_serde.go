@@ -1030,6 +1030,88 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type DriftedResource.
+func (d DriftedResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "address", d.Address)
+	populate(objectMap, "change", d.Change)
+	populate(objectMap, "id", d.ID)
+	populate(objectMap, "name", d.Name)
+	populate(objectMap, "type", d.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftedResource.
+func (d *DriftedResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "address":
+			err = unpopulate(val, "Address", &d.Address)
+			delete(rawMsg, key)
+		case "change":
+			err = unpopulate(val, "Change", &d.Change)
+			delete(rawMsg, key)
+		case "id":
+			err = unpopulate(val, "ID", &d.ID)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &d.Name)
+			delete(rawMsg, key)
+		case "type":
+			err = unpopulate(val, "Type", &d.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type DriftStatus.
+func (d DriftStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "driftedResources", d.DriftedResources)
+	populateDateTimeRFC3339(objectMap, "lastCheckedTime", d.LastCheckedTime)
+	populate(objectMap, "message", d.Message)
+	populate(objectMap, "state", d.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftStatus.
+func (d *DriftStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "driftedResources":
+			err = unpopulate(val, "DriftedResources", &d.DriftedResources)
+			delete(rawMsg, key)
+		case "lastCheckedTime":
+			err = unpopulateDateTimeRFC3339(val, "LastCheckedTime", &d.LastCheckedTime)
+			delete(rawMsg, key)
+		case "message":
+			err = unpopulate(val, "Message", &d.Message)
+			delete(rawMsg, key)
+		case "state":
+			err = unpopulate(val, "State", &d.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2432,6 +2514,45 @@ func (m *ManualScalingExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type OpenTofuRecipeProperties.
+func (o OpenTofuRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", o.Parameters)
+	objectMap["templateKind"] = "opentofu"
+	populate(objectMap, "templatePath", o.TemplatePath)
+	populate(objectMap, "templateVersion", o.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type OpenTofuRecipeProperties.
+func (o *OpenTofuRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", o, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+			err = unpopulate(val, "Parameters", &o.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+			err = unpopulate(val, "TemplateKind", &o.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+			err = unpopulate(val, "TemplatePath", &o.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+			err = unpopulate(val, "TemplateVersion", &o.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", o, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Operation.
 func (o Operation) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2746,6 +2867,41 @@ func (p *ProvidersAzure) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type PulumiRecipeProperties.
+func (p PulumiRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", p.Parameters)
+	objectMap["templateKind"] = "pulumi"
+	populate(objectMap, "templatePath", p.TemplatePath)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type PulumiRecipeProperties.
+func (p *PulumiRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", p, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+			err = unpopulate(val, "Parameters", &p.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+			err = unpopulate(val, "TemplateKind", &p.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+			err = unpopulate(val, "TemplatePath", &p.TemplatePath)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", p, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Recipe.
 func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3057,6 +3213,7 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 func (r ResourceStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "driftStatus", r.DriftStatus)
 	populate(objectMap, "outputResources", r.OutputResources)
 	populate(objectMap, "recipe", r.Recipe)
 	return json.Marshal(objectMap)
@@ -3074,6 +3231,9 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 		case "compute":
 			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "driftStatus":
+			err = unpopulate(val, "DriftStatus", &r.DriftStatus)
+			delete(rawMsg, key)
 		case "outputResources":
 			err = unpopulate(val, "OutputResources", &r.OutputResources)
 			delete(rawMsg, key)
@@ -3552,10 +3712,61 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendConfig.
+func (t TerraformBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "migrateFrom", t.MigrateFrom)
+	populate(objectMap, "secrets", t.Secrets)
+	populate(objectMap, "type", t.Type)
+	if t.AdditionalProperties != nil {
+		for key, val := range t.AdditionalProperties {
+			objectMap[key] = val
+		}
+	}
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendConfig.
+func (t *TerraformBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "migrateFrom":
+			err = unpopulate(val, "MigrateFrom", &t.MigrateFrom)
+			delete(rawMsg, key)
+		case "secrets":
+			err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		case "type":
+			err = unpopulate(val, "Type", &t.Type)
+			delete(rawMsg, key)
+		default:
+			if t.AdditionalProperties == nil {
+				t.AdditionalProperties = map[string]any{}
+			}
+			if val != nil {
+				var aux any
+				err = json.Unmarshal(val, &aux)
+				t.AdditionalProperties[key] = aux
+			}
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
 func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
 	populate(objectMap, "providers", t.Providers)
 	return json.Marshal(objectMap)
 }
@@ -3572,6 +3783,9 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 		case "authentication":
 			err = unpopulate(val, "Authentication", &t.Authentication)
 			delete(rawMsg, key)
+		case "backend":
+			err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
 		case "providers":
 			err = unpopulate(val, "Providers", &t.Providers)
 			delete(rawMsg, key)
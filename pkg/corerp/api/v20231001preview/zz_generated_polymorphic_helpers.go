@@ -114,6 +114,10 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "opentofu":
+		b = &OpenTofuRecipeProperties{}
+	case "pulumi":
+		b = &PulumiRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
@@ -161,6 +161,39 @@ type AzureResourceManagerCommonTypesTrackedResourceUpdate struct {
 	Type *string
 }
 
+// DriftedResource - A resource deployed by a recipe that was changed outside of Radius.
+type DriftedResource struct {
+	// REQUIRED; The change made to the resource outside of Radius.
+	Change *DriftChange
+
+	// REQUIRED; The type of the resource.
+	Type *string
+
+	// The address of the resource in the recipe, such as a Terraform address.
+	Address *string
+
+	// The UCP resource ID of the resource, if known.
+	ID *string
+
+	// The name of the resource.
+	Name *string
+}
+
+// DriftStatus - The result of the drift detection of the infrastructure deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The time of the drift detection.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The drift state of the infrastructure.
+	State *DriftState
+
+	// The resources deployed by the recipe that were changed outside of Radius.
+	DriftedResources []*DriftedResource
+
+	// The details of the drift detection, such as the reason it failed.
+	Message *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -420,7 +453,7 @@ type ProvidersKubernetes struct {
 
 // RecipeDefinition - Recipe definition for a specific resource type
 type RecipeDefinition struct {
-	// REQUIRED; The type of recipe (e.g., Terraform, Bicep)
+	// REQUIRED; The type of recipe (e.g., Terraform, Bicep, OpenTofu, Pulumi)
 	RecipeKind *RecipeKind
 
 	// REQUIRED; URL path to the recipe
@@ -533,6 +566,9 @@ type ResourceStatus struct {
 	// Properties of an output resource
 	OutputResources []*OutputResource
 
+	// READ-ONLY; The result of the latest drift detection of the infrastructure deployed by the recipe
+	DriftStatus *DriftStatus
+
 	// READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
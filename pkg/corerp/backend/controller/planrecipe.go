@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the async operation controller of the planRecipe action of environments.
+type PlanRecipe struct {
+	ctrl.BaseController
+	engine engine.Engine
+}
+
+// NewPlanRecipe creates a new PlanRecipe controller with the given options and recipe engine.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         engine,
+	}, nil
+}
+
+// Run plans the recipe of the resource described by the request body with the recipe engine, using the configuration
+// and the recipes of the environment. When the resource is already deployed, its output resources are compared with
+// the resources declared by the recipe. The plan is the response of the operation.
+func (c *PlanRecipe) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	planRequest := datamodel.RecipePlanRequest{}
+	if err := json.Unmarshal(request.Body, &planRequest); err != nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeInvalidRequestContent, Message: err.Error()}), nil
+	}
+
+	if planRequest.RecipeName == "" {
+		planRequest.RecipeName = datamodel.DefaultRecipeName
+	}
+
+	prevState, err := c.deployedResources(ctx, planRequest.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	plan, err := c.engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          planRequest.RecipeName,
+				EnvironmentID: request.ResourceID,
+				ApplicationID: planRequest.ApplicationID,
+				ResourceID:    planRequest.ResourceID,
+				Properties:    planRequest.Properties,
+				Parameters:    planRequest.Parameters,
+			},
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		// Recipe errors are caused by the recipe or its configuration, so they are returned to the caller.
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Response: plan}, nil
+}
+
+// deployedResources returns the IDs of the output resources of the resource if it is already deployed, so that the
+// plan can compare them with the resources declared by the recipe.
+func (c *PlanRecipe) deployedResources(ctx context.Context, resourceID string) ([]string, error) {
+	if resourceID == "" {
+		return []string{}, nil
+	}
+
+	obj, err := c.DatabaseClient().Get(ctx, resourceID)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	resource := struct {
+		Properties struct {
+			Status rpv1.ResourceStatus `json:"status,omitempty"`
+		} `json:"properties"`
+	}{}
+	if err := obj.As(&resource); err != nil {
+		return nil, err
+	}
+
+	prevState := []string{}
+	for _, outputResource := range resource.Properties.Status.OutputResources {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+	return prevState, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPlanRecipeRun(t *testing.T) {
+	const (
+		environmentID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+		resourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/cache"
+	)
+
+	setup := func(t *testing.T) (ctrl.Controller, *engine.MockEngine, *database.MockClient, *ctrl.Request) {
+		mctrl := gomock.NewController(t)
+		mEngine := engine.NewMockEngine(mctrl)
+		databaseClient := database.NewMockClient(mctrl)
+
+		c, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+
+		req := &ctrl.Request{
+			OperationID:   uuid.New(),
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|ACTIONPLANRECIPE",
+			ResourceID:    environmentID,
+			Body:          []byte(`{"resourceId":"` + resourceID + `","properties":{"size":"small"},"parameters":{"replicas":2}}`),
+		}
+
+		return c, mEngine, databaseClient, req
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		c, mEngine, databaseClient, req := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceID).Return(nil, &database.ErrNotFound{ID: resourceID})
+		plan := &recipes.PlanOutput{
+			Driver: recipes.TemplateKindTerraform,
+			Kind:   recipes.PlanKindWhatIf,
+			Changes: []recipes.ResourceChange{
+				{Address: "aws_elasticache_cluster.cache", Type: "aws_elasticache_cluster", Name: "cache", Action: recipes.PlanActionCreate},
+			},
+		}
+
+		mEngine.EXPECT().Plan(gomock.Any(), engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "default",
+					EnvironmentID: environmentID,
+					ResourceID:    resourceID,
+					Properties:    map[string]any{"size": "small"},
+					Parameters:    map[string]any{"replicas": float64(2)},
+				},
+			},
+			PreviousState: []string{},
+		}).Return(plan, nil)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, result.ProvisioningState())
+		require.Equal(t, plan, result.Response)
+	})
+
+	t.Run("deployed resource", func(t *testing.T) {
+		const outputResourceID = "/planes/kubernetes/local/namespaces/default/providers/core/Service/cache"
+
+		c, mEngine, databaseClient, req := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceID).Return(&database.Object{
+			Metadata: database.Metadata{ID: resourceID},
+			Data: map[string]any{
+				"properties": map[string]any{
+					"status": map[string]any{
+						"outputResources": []any{
+							map[string]any{"id": outputResourceID},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		plan := &recipes.PlanOutput{Driver: recipes.TemplateKindBicep, Kind: recipes.PlanKindWhatIf}
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.PlanOptions) (*recipes.PlanOutput, error) {
+				require.Equal(t, []string{outputResourceID}, opts.PreviousState)
+				return plan, nil
+			})
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, plan, result.Response)
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		c, mEngine, databaseClient, req := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceID).Return(nil, &database.ErrNotFound{ID: resourceID})
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeNotFoundFailure, "could not find recipe \"default\"", "", nil))
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, recipes.RecipeNotFoundFailure, result.Error.Code)
+	})
+
+	t.Run("engine failure", func(t *testing.T) {
+		c, mEngine, databaseClient, req := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceID).Return(nil, &database.ErrNotFound{ID: resourceID})
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		_, err := c.Run(context.Background(), req)
+		require.ErrorContains(t, err, "connection refused")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		c, _, _, req := setup(t)
+		req.Body = []byte(`[]`)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, v1.CodeInvalidRequestContent, result.Error.Code)
+	})
+}
@@ -40,6 +40,28 @@ type TerraformConfigProperties struct {
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Backend specifies the Terraform backend used to store the state of Terraform Recipes. By default, the state is stored
+	// in a Kubernetes secret.
+	Backend *TerraformBackendConfig `json:"backend,omitempty"`
+}
+
+// TerraformBackendConfig - Configuration of the Terraform backend used to store the state of Terraform Recipes:
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+type TerraformBackendConfig struct {
+	// Type is the type of the backend. Supported types: kubernetes, s3, azurerm, http, local.
+	Type string `json:"type"`
+
+	// AdditionalProperties represents the non-sensitive settings of the backend. The key of the state is generated for each
+	// resource, a key set here is used as its prefix.
+	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
+
+	// Secrets represents the sensitive settings of the backend, such as access keys or passwords.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
+
+	// MigrateFrom is the backend currently storing the state of the Terraform Recipes. When set, the state of a resource
+	// is moved from this backend to the configured backend the next time its recipe is executed.
+	MigrateFrom *TerraformBackendConfig `json:"migrateFrom,omitempty"`
 }
 
 // BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep plans and applies templates as part of Recipe
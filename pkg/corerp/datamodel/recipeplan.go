@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+const (
+	// RecipePlanActionName is the name of the action of environments that plans the recipe of a resource.
+	RecipePlanActionName = "planRecipe"
+
+	// DefaultRecipeName is the name of the recipe used by resources that do not name one.
+	DefaultRecipeName = "default"
+)
+
+// RecipePlanRequest is the request of the planRecipe action of environments. It describes the resource whose recipe
+// is planned. The resource does not need to exist: the plan shows what deploying it would change.
+type RecipePlanRequest struct {
+	// ResourceID is the ID of the resource whose recipe is planned.
+	ResourceID string `json:"resourceId"`
+
+	// ApplicationID is the ID of the application of the resource, if any.
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// RecipeName is the name of the recipe of the resource. Defaults to "default".
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Parameters are the recipe parameters set by the resource.
+	Parameters map[string]any `json:"parameters,omitempty"`
+
+	// Properties are the properties of the resource, passed to the recipe in its context.
+	Properties map[string]any `json:"properties,omitempty"`
+}
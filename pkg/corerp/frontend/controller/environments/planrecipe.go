@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// PlanRecipe is the controller implementation to plan the recipe of a resource in an environment. The plan runs as an
+// async operation, whose result is the list of the changes that deploying the resource would make to its
+// infrastructure.
+type PlanRecipe[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewPlanRecipe creates a new controller for planning the recipe of a resource in an environment.
+func NewPlanRecipe[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &PlanRecipe[P, T]{
+		Operation: ctrl.NewOperation[P](opts, resourceOpts),
+	}, nil
+}
+
+// Run validates the plan request and queues the async operation planning the recipe of the resource it describes.
+func (r *PlanRecipe[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := datamodel.RecipePlanRequest{}
+	if err := json.Unmarshal(content, &request); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid request body: %v", err)), nil
+	}
+
+	if _, err := resources.ParseResource(request.ResourceID); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid resource ID %q: resourceId must be the ID of a resource", request.ResourceID)), nil
+	}
+
+	return r.QueueAsyncAction(ctx, content)
+}
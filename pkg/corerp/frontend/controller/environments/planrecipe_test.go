@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPlanResourceID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func TestPlanRecipeRun(t *testing.T) {
+	setup := func(t *testing.T, environment *datamodel.Environment) (ctrl.Controller, *statusmanager.MockStatusManager) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		msm := statusmanager.NewMockStatusManager(mctrl)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				if environment == nil {
+					return nil, &database.ErrNotFound{ID: id}
+				}
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     environment,
+				}, nil
+			})
+
+		ctl, err := NewPlanRecipe[*datamodel.Environment](ctrl.Options{DatabaseClient: databaseClient, StatusManager: msm}, ctrl.ResourceOptions[datamodel.Environment]{
+			RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+			ResponseConverter: converter.EnvironmentDataModelToVersioned,
+		})
+		require.NoError(t, err)
+
+		return ctl, msm
+	}
+
+	run := func(t *testing.T, ctl ctrl.Controller, body any) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, body)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		return w
+	}
+
+	t.Run("queues plan", func(t *testing.T) {
+		ctl, msm := setup(t, &datamodel.Environment{})
+		request := datamodel.RecipePlanRequest{
+			ResourceID: testPlanResourceID,
+			Properties: map[string]any{"size": "small"},
+			Parameters: map[string]any{"replicas": 2},
+		}
+
+		msm.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+				actual := datamodel.RecipePlanRequest{}
+				require.NoError(t, json.Unmarshal(options.Body, &actual))
+				require.Equal(t, testPlanResourceID, actual.ResourceID)
+				require.Equal(t, map[string]any{"size": "small"}, actual.Properties)
+				return nil
+			})
+
+		w := run(t, ctl, request)
+		require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+		require.NotEmpty(t, w.Header().Get("Location"))
+		require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+	})
+
+	t.Run("queue failure", func(t *testing.T) {
+		ctl, msm := setup(t, &datamodel.Environment{})
+		msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("queue unavailable"))
+
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, datamodel.RecipePlanRequest{ResourceID: testPlanResourceID})
+		require.NoError(t, err)
+		_, err = ctl.Run(rpctest.NewARMRequestContext(req), httptest.NewRecorder(), req)
+		require.ErrorContains(t, err, "queue unavailable")
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		ctl, _ := setup(t, &datamodel.Environment{})
+
+		w := run(t, ctl, datamodel.RecipePlanRequest{ResourceID: "/planes/radius/local/resourceGroups/radius-test-rg"})
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		ctl, _ := setup(t, nil)
+
+		w := run(t, ctl, datamodel.RecipePlanRequest{ResourceID: testPlanResourceID})
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
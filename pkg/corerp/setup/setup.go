@@ -65,6 +65,19 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			datamodel.RecipePlanActionName: {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe[*datamodel.Environment](opt, apictrl.ResourceOptions[datamodel.Environment]{
+						RequestConverter:         converter.EnvironmentDataModelFromVersioned,
+						ResponseConverter:        converter.EnvironmentDataModelToVersioned,
+						AsyncOperationTimeout:    time.Minute * time.Duration(20),
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					})
+				},
+				AsyncJobController: func(opts asyncctrl.Options) (asyncctrl.Controller, error) {
+					return backend_ctrl.NewPlanRecipe(opts, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -277,6 +290,21 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 		Patch: builder.Operation[datamodel.Environment_v20250801preview]{
 			APIController: env_v20250801_ctrl.NewCreateOrUpdateEnvironmentv20250801preview,
 		},
+		Custom: map[string]builder.Operation[datamodel.Environment_v20250801preview]{
+			datamodel.RecipePlanActionName: {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe[*datamodel.Environment_v20250801preview](opt, apictrl.ResourceOptions[datamodel.Environment_v20250801preview]{
+						RequestConverter:         converter.Environment20250801DataModelFromVersioned,
+						ResponseConverter:        converter.Environment20250801DataModelToVersioned,
+						AsyncOperationTimeout:    time.Minute * time.Duration(20),
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					})
+				},
+				AsyncJobController: func(opts asyncctrl.Options) (asyncctrl.Controller, error) {
+					return backend_ctrl.NewPlanRecipe(opts, recipeControllerConfig.Engine)
+				},
+			},
+		},
 	})
 
 	_ = ns.AddResource("applications", &builder.ResourceOption[*datamodel.Application_v20250801preview, datamodel.Application_v20250801preview]{
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// KeyWrapper wraps and unwraps data encryption keys with a key encryption key held by an external key management
+// service (KMS). With envelope encryption, the data keys in the key store can only be used through the KMS.
+//
+// Implementations map to the key operations of the KMS:
+//   - HashiCorp Vault Transit: the encrypt and decrypt endpoints of a transit key.
+//   - Azure Key Vault: the wrapKey and unwrapKey operations of a key.
+//   - AWS KMS: the Encrypt and Decrypt operations of a KMS key.
+type KeyWrapper interface {
+	// WrapKey encrypts a data key. The result is opaque and stored in the key store.
+	WrapKey(ctx context.Context, key []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a data key encrypted with WrapKey.
+	UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error)
+}
+
+// KeyStoreSource loads and updates a versioned key store. KubernetesKeyProvider is a KeyStoreSource.
+type KeyStoreSource interface {
+	// LoadKeyStore loads the key store.
+	LoadKeyStore(ctx context.Context) (*KeyStore, error)
+
+	// UpdateKeyStore applies update to the key store.
+	UpdateKeyStore(ctx context.Context, update func(keyStore *KeyStore) error) error
+}
+
+// EnvelopeKeyProvider implements KeyProvider for a key store whose data keys are wrapped by an external KMS.
+//
+// New keys are wrapped before they are written by RotateKeys. Keys that are not wrapped yet, such as keys created
+// before envelope encryption was configured, are wrapped in the key store when they are first used.
+type EnvelopeKeyProvider struct {
+	source  KeyStoreSource
+	wrapper KeyWrapper
+
+	mu sync.Mutex
+	// unwrapped caches the unwrapped data keys by their wrapped value, to avoid a KMS call per decryption. Keys
+	// removed from the key store are evicted, so the cache holds at most the keys of the key store.
+	unwrapped map[string][]byte
+}
+
+var _ KeyProvider = (*EnvelopeKeyProvider)(nil)
+
+// NewEnvelopeKeyProvider creates a new EnvelopeKeyProvider for the key store of source, wrapped with wrapper.
+func NewEnvelopeKeyProvider(source KeyStoreSource, wrapper KeyWrapper) *EnvelopeKeyProvider {
+	return &EnvelopeKeyProvider{
+		source:    source,
+		wrapper:   wrapper,
+		unwrapped: map[string][]byte{},
+	}
+}
+
+// GetCurrentKey retrieves the current data key, unwrapped with the KMS.
+func (p *EnvelopeKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int, error) {
+	keyStore, err := p.source.LoadKeyStore(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	p.evictRetiredKeys(keyStore)
+
+	key, err := p.getKey(ctx, keyStore, keyStore.CurrentVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return key, keyStore.CurrentVersion, nil
+}
+
+// GetKeyByVersion retrieves a specific data key version, unwrapped with the KMS.
+func (p *EnvelopeKeyProvider) GetKeyByVersion(ctx context.Context, version int) ([]byte, error) {
+	keyStore, err := p.source.LoadKeyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.evictRetiredKeys(keyStore)
+
+	return p.getKey(ctx, keyStore, version)
+}
+
+// WrapKeys wraps all the keys of the key store that are not wrapped yet.
+func (p *EnvelopeKeyProvider) WrapKeys(ctx context.Context) error {
+	return p.source.UpdateKeyStore(ctx, func(keyStore *KeyStore) error {
+		for versionStr, keyData := range keyStore.Keys {
+			if keyData.Wrapped {
+				continue
+			}
+
+			key, err := decodeKey(keyData.Key, keyData.Version)
+			if err != nil {
+				return err
+			}
+
+			wrapped, err := p.wrapper.WrapKey(ctx, key)
+			if err != nil {
+				return fmt.Errorf("failed to wrap key version %d: %w", keyData.Version, err)
+			}
+
+			keyData.Key = base64.StdEncoding.EncodeToString(wrapped)
+			keyData.Wrapped = true
+			keyStore.Keys[versionStr] = keyData
+		}
+		return nil
+	})
+}
+
+// getKey returns the unwrapped data key of a version of the key store.
+func (p *EnvelopeKeyProvider) getKey(ctx context.Context, keyStore *KeyStore, version int) ([]byte, error) {
+	keyData, ok := keyStore.Keys[strconv.Itoa(version)]
+	if !ok {
+		return nil, fmt.Errorf("%w: version %d not found in key store", ErrKeyVersionNotFound, version)
+	}
+
+	if !keyData.Wrapped {
+		key, err := decodeKey(keyData.Key, version)
+		if err != nil {
+			return nil, err
+		}
+
+		// Do not use a key that is stored in plaintext until it is wrapped.
+		if err := p.WrapKeys(ctx); err != nil {
+			return nil, fmt.Errorf("%w: failed to wrap key version %d: %v", ErrKeyLoadFailed, version, err)
+		}
+
+		return key, nil
+	}
+
+	p.mu.Lock()
+	cached, ok := p.unwrapped[keyData.Key]
+	p.mu.Unlock()
+	if ok {
+		return append([]byte(nil), cached...), nil
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(keyData.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode wrapped key version %d: %v", ErrKeyLoadFailed, version, err)
+	}
+
+	key, err := p.wrapper.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to unwrap key version %d: %v", ErrKeyLoadFailed, version, err)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: key version %d has invalid size (expected %d bytes, got %d)", ErrKeyLoadFailed, version, KeySize, len(key))
+	}
+
+	p.mu.Lock()
+	p.unwrapped[keyData.Key] = append([]byte(nil), key...)
+	p.mu.Unlock()
+
+	return key, nil
+}
+
+// evictRetiredKeys removes the keys that are no longer in the key store from the cache of unwrapped keys.
+func (p *EnvelopeKeyProvider) evictRetiredKeys(keyStore *KeyStore) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.unwrapped) == 0 {
+		return
+	}
+
+	current := make(map[string]bool, len(keyStore.Keys))
+	for _, keyData := range keyStore.Keys {
+		if keyData.Wrapped {
+			current[keyData.Key] = true
+		}
+	}
+
+	for wrapped := range p.unwrapped {
+		if !current[wrapped] {
+			delete(p.unwrapped, wrapped)
+		}
+	}
+}
+
+// decodeKey decodes a base64-encoded data key that is not wrapped.
+func decodeKey(encoded string, version int) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode key version %d: %v", ErrKeyLoadFailed, version, err)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: key version %d has invalid size (expected %d bytes, got %d)", ErrKeyLoadFailed, version, KeySize, len(key))
+	}
+
+	return key, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/scheme"
+	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// countingKeyWrapper is a KeyWrapper that counts the unwrapped keys.
+type countingKeyWrapper struct {
+	KeyWrapper
+	unwrapped int
+	err       error
+}
+
+func (w *countingKeyWrapper) UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	w.unwrapped++
+	return w.KeyWrapper.UnwrapKey(ctx, wrappedKey)
+}
+
+func newTestLocalKeyWrapper(t *testing.T) *LocalKeyWrapper {
+	kek, err := GenerateKey()
+	require.NoError(t, err)
+	encryptor, err := NewEncryptor(kek)
+	require.NoError(t, err)
+	return &LocalKeyWrapper{encryptor: encryptor}
+}
+
+func createKeyStoreSecret(t *testing.T, k8sClient controller_runtime.Client, keys map[int][]byte, currentVersion int) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultEncryptionKeySecretName,
+			Namespace: RadiusNamespace,
+		},
+		Data: map[string][]byte{
+			DefaultEncryptionKeySecretKey: createTestKeyStore(t, keys, currentVersion),
+		},
+	}
+	require.NoError(t, k8sClient.Create(context.Background(), secret))
+}
+
+func TestEnvelopeKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	k8sClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+	createKeyStoreSecret(t, k8sClient, map[int][]byte{1: key1, 2: key2}, 2)
+
+	source := NewKubernetesKeyProvider(k8sClient, nil)
+	wrapper := &countingKeyWrapper{KeyWrapper: newTestLocalKeyWrapper(t)}
+	provider := NewEnvelopeKeyProvider(source, wrapper)
+
+	// Plaintext keys are wrapped in the key store on first use.
+	key, version, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, key2, key)
+	require.Equal(t, 2, version)
+
+	keyStore, err := source.LoadKeyStore(ctx)
+	require.NoError(t, err)
+	for _, keyData := range keyStore.Keys {
+		require.True(t, keyData.Wrapped)
+		require.NotEqual(t, base64.StdEncoding.EncodeToString(key1), keyData.Key)
+		require.NotEqual(t, base64.StdEncoding.EncodeToString(key2), keyData.Key)
+	}
+
+	// Wrapped keys cannot be used without the key management service.
+	_, _, err = source.GetCurrentKey(ctx)
+	require.ErrorIs(t, err, ErrKeyWrapped)
+
+	key, err = provider.GetKeyByVersion(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+
+	// Unwrapped keys are cached.
+	unwrapped := wrapper.unwrapped
+	key, err = provider.GetKeyByVersion(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+	require.Equal(t, unwrapped, wrapper.unwrapped)
+
+	_, err = provider.GetKeyByVersion(ctx, 3)
+	require.ErrorIs(t, err, ErrKeyVersionNotFound)
+
+	// Data encrypted with the envelope provider round trips.
+	handler, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+	data := map[string]any{"password": "secret"}
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"password"}, testResourceID))
+	require.NoError(t, handler.DecryptSensitiveFields(ctx, data, []string{"password"}, testResourceID))
+	require.Equal(t, "secret", data["password"])
+}
+
+func TestEnvelopeKeyProvider_UnwrapError(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	k8sClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+	createKeyStoreSecret(t, k8sClient, map[int][]byte{1: key}, 1)
+
+	source := NewKubernetesKeyProvider(k8sClient, nil)
+	require.NoError(t, NewEnvelopeKeyProvider(source, newTestLocalKeyWrapper(t)).WrapKeys(ctx))
+
+	// A key wrapped with another key encryption key cannot be unwrapped.
+	provider := NewEnvelopeKeyProvider(source, newTestLocalKeyWrapper(t))
+	_, _, err = provider.GetCurrentKey(ctx)
+	require.ErrorIs(t, err, ErrKeyLoadFailed)
+
+	wrapper := &countingKeyWrapper{KeyWrapper: newTestLocalKeyWrapper(t), err: errors.New("kms unavailable")}
+	_, _, err = NewEnvelopeKeyProvider(source, wrapper).GetCurrentKey(ctx)
+	require.ErrorIs(t, err, ErrKeyLoadFailed)
+	require.ErrorContains(t, err, "kms unavailable")
+}
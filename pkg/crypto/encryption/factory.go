@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KMSType represents types of external key management services wrapping the data keys.
+type KMSType string
+
+const (
+	// TypeLocalKMS represents a key encryption key read from a local file. See LocalKeyWrapper.
+	TypeLocalKMS KMSType = "local"
+)
+
+// KeyProviderOptions configures the provider of the keys encrypting sensitive fields. The data keys are stored in
+// the Kubernetes Secret managed by the key rotation CronJob.
+type KeyProviderOptions struct {
+	// KMS configures envelope encryption of the data keys with an external key management service. The data keys
+	// are stored in plaintext in the Kubernetes Secret if not set.
+	KMS *KMSOptions `yaml:"kms,omitempty"`
+}
+
+// KMSOptions configures the external key management service wrapping the data keys.
+type KMSOptions struct {
+	// Type is the type of key management service.
+	Type KMSType `yaml:"type"`
+
+	// Local configures the local key management service.
+	Local LocalKMSOptions `yaml:"local,omitempty"`
+}
+
+// LocalKMSOptions configures the local key management service.
+type LocalKMSOptions struct {
+	// KeyFile is the path of the file holding the base64-encoded 256-bit key encryption key.
+	KeyFile string `yaml:"keyFile"`
+}
+
+type keyWrapperFactoryFunc func(context.Context, KMSOptions) (KeyWrapper, error)
+
+var keyWrapperFactory = map[KMSType]keyWrapperFactoryFunc{
+	TypeLocalKMS: initLocalKeyWrapper,
+}
+
+// RegisterKeyWrapper registers the factory of the key wrapper of a type of key management service, such as Vault
+// Transit, Azure Key Vault or AWS KMS. It must be called before NewKeyProvider, for example from an init function.
+func RegisterKeyWrapper(kmsType KMSType, factory func(context.Context, KMSOptions) (KeyWrapper, error)) {
+	keyWrapperFactory[kmsType] = factory
+}
+
+// NewKeyProvider creates the KeyProvider configured by options, for the key store in the default Kubernetes Secret.
+func NewKeyProvider(ctx context.Context, client controller_runtime.Client, options KeyProviderOptions) (KeyProvider, error) {
+	source := NewKubernetesKeyProvider(client, nil)
+	if options.KMS == nil {
+		return source, nil
+	}
+
+	wrapper, err := NewKeyWrapper(ctx, *options.KMS)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnvelopeKeyProvider(source, wrapper), nil
+}
+
+// NewKeyWrapper creates the KeyWrapper of the key management service configured by options.
+func NewKeyWrapper(ctx context.Context, options KMSOptions) (KeyWrapper, error) {
+	factory, ok := keyWrapperFactory[options.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported key management service type %q", options.Type)
+	}
+
+	wrapper, err := factory(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize key management service %q: %w", options.Type, err)
+	}
+
+	return wrapper, nil
+}
+
+func initLocalKeyWrapper(ctx context.Context, options KMSOptions) (KeyWrapper, error) {
+	if options.Local.KeyFile == "" {
+		return nil, errors.New("local.keyFile is required")
+	}
+
+	return NewLocalKeyWrapper(options.Local.KeyFile)
+}
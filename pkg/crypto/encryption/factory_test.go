@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	"k8s.io/kubectl/pkg/scheme"
+)
+
+func TestNewKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+
+	kek, err := GenerateKey()
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "kek")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(kek)), 0600))
+
+	t.Run("kubernetes", func(t *testing.T) {
+		provider, err := NewKeyProvider(ctx, k8sClient, KeyProviderOptions{})
+		require.NoError(t, err)
+		require.IsType(t, &KubernetesKeyProvider{}, provider)
+	})
+
+	t.Run("local-kms", func(t *testing.T) {
+		provider, err := NewKeyProvider(ctx, k8sClient, KeyProviderOptions{
+			KMS: &KMSOptions{Type: TypeLocalKMS, Local: LocalKMSOptions{KeyFile: keyFile}},
+		})
+		require.NoError(t, err)
+		require.IsType(t, &EnvelopeKeyProvider{}, provider)
+	})
+
+	t.Run("local-kms-without-key-file", func(t *testing.T) {
+		_, err := NewKeyProvider(ctx, k8sClient, KeyProviderOptions{KMS: &KMSOptions{Type: TypeLocalKMS}})
+		require.ErrorContains(t, err, "local.keyFile is required")
+	})
+
+	t.Run("unsupported-kms", func(t *testing.T) {
+		_, err := NewKeyProvider(ctx, k8sClient, KeyProviderOptions{KMS: &KMSOptions{Type: "unknown"}})
+		require.ErrorContains(t, err, "unsupported key management service type")
+	})
+
+	t.Run("registered-kms", func(t *testing.T) {
+		kmsType := KMSType("test")
+		RegisterKeyWrapper(kmsType, func(ctx context.Context, options KMSOptions) (KeyWrapper, error) {
+			return NewLocalKeyWrapper(keyFile)
+		})
+		t.Cleanup(func() { delete(keyWrapperFactory, kmsType) })
+
+		provider, err := NewKeyProvider(ctx, k8sClient, KeyProviderOptions{KMS: &KMSOptions{Type: kmsType}})
+		require.NoError(t, err)
+		require.IsType(t, &EnvelopeKeyProvider{}, provider)
+	})
+}
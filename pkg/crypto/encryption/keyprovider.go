@@ -23,9 +23,11 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8s_error "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -42,6 +44,53 @@ const (
 	RadiusNamespace = "radius-system"
 )
 
+const (
+	// ReencryptionStatusAnnotation is the annotation of the encryption key Secret that records the status of the
+	// latest re-encryption of sensitive fields, as JSON.
+	ReencryptionStatusAnnotation = "radius.dev/reencryption-status"
+
+	// ReencryptedVersionAnnotation is the annotation of the encryption key Secret that records the key version all
+	// sensitive fields were last re-encrypted with. Key versions lower than this value are no longer used to encrypt
+	// stored data and can be retired.
+	ReencryptedVersionAnnotation = "radius.dev/reencrypted-version"
+
+	// LastRotationAnnotation is the annotation of the encryption key Secret that records the time of the latest key
+	// rotation.
+	LastRotationAnnotation = "radius.dev/last-rotation"
+)
+
+// ReencryptionState is the state of a re-encryption of sensitive fields.
+type ReencryptionState string
+
+const (
+	// ReencryptionStateRunning is the state of a re-encryption in progress.
+	ReencryptionStateRunning ReencryptionState = "Running"
+	// ReencryptionStateSucceeded is the state of a re-encryption that re-encrypted all sensitive fields.
+	ReencryptionStateSucceeded ReencryptionState = "Succeeded"
+	// ReencryptionStateFailed is the state of a re-encryption that could not re-encrypt all sensitive fields.
+	ReencryptionStateFailed ReencryptionState = "Failed"
+)
+
+// ReencryptionStatus reports the progress of a re-encryption of sensitive fields with the current key.
+type ReencryptionStatus struct {
+	// State is the state of the re-encryption.
+	State ReencryptionState `json:"state"`
+	// KeyVersion is the key version sensitive fields are re-encrypted with.
+	KeyVersion int `json:"keyVersion"`
+	// StartTime is the time the re-encryption started.
+	StartTime time.Time `json:"startTime"`
+	// EndTime is the time the re-encryption completed.
+	EndTime *time.Time `json:"endTime,omitempty"`
+	// ResourcesScanned is the number of resources with sensitive fields scanned so far.
+	ResourcesScanned int `json:"resourcesScanned"`
+	// FieldsReencrypted is the number of sensitive values re-encrypted so far.
+	FieldsReencrypted int `json:"fieldsReencrypted"`
+	// Failures is the number of resources that could not be re-encrypted.
+	Failures int `json:"failures"`
+	// Message describes the latest failure, if any.
+	Message string `json:"message,omitempty"`
+}
+
 // KeyStore represents a versioned key store containing multiple encryption keys.
 // This structure matches the format used by the key rotation CronJob.
 type KeyStore struct {
@@ -61,6 +110,8 @@ type KeyData struct {
 	CreatedAt string `json:"createdAt"`
 	// ExpiresAt is the timestamp when this key expires (RFC3339 format).
 	ExpiresAt string `json:"expiresAt"`
+	// Wrapped is true when Key is wrapped by an external key management service. See EnvelopeKeyProvider.
+	Wrapped bool `json:"wrapped,omitempty"`
 }
 
 var (
@@ -72,6 +123,9 @@ var (
 
 	// ErrKeyVersionNotFound is returned when a specific key version is not found.
 	ErrKeyVersionNotFound = errors.New("key version not found")
+
+	// ErrKeyWrapped is returned when a key wrapped by an external key management service is loaded without it.
+	ErrKeyWrapped = errors.New("key is wrapped by a key management service")
 )
 
 // KeyProvider defines the interface for retrieving encryption keys.
@@ -139,8 +193,8 @@ func NewKubernetesKeyProvider(client controller_runtime.Client, opts *Kubernetes
 	}
 }
 
-// loadKeyStore loads and parses the key store from the Kubernetes Secret.
-func (p *KubernetesKeyProvider) loadKeyStore(ctx context.Context) (*KeyStore, error) {
+// LoadKeyStore loads and parses the key store from the Kubernetes Secret.
+func (p *KubernetesKeyProvider) LoadKeyStore(ctx context.Context) (*KeyStore, error) {
 	secret := &corev1.Secret{}
 	objectKey := controller_runtime.ObjectKey{
 		Name:      p.secretName,
@@ -170,7 +224,7 @@ func (p *KubernetesKeyProvider) loadKeyStore(ctx context.Context) (*KeyStore, er
 // GetCurrentKey retrieves the current encryption key from the Kubernetes Secret.
 // Returns the key bytes, version number, and any error.
 func (p *KubernetesKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int, error) {
-	keyStore, err := p.loadKeyStore(ctx)
+	keyStore, err := p.LoadKeyStore(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -181,6 +235,10 @@ func (p *KubernetesKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int,
 		return nil, 0, fmt.Errorf("%w: current version %d not found in key store", ErrKeyVersionNotFound, keyStore.CurrentVersion)
 	}
 
+	if keyData.Wrapped {
+		return nil, 0, fmt.Errorf("%w: current version %d", ErrKeyWrapped, keyStore.CurrentVersion)
+	}
+
 	key, err := base64.StdEncoding.DecodeString(keyData.Key)
 	if err != nil {
 		return nil, 0, fmt.Errorf("%w: failed to decode key: %v", ErrKeyLoadFailed, err)
@@ -195,7 +253,7 @@ func (p *KubernetesKeyProvider) GetCurrentKey(ctx context.Context) ([]byte, int,
 
 // GetKeyByVersion retrieves a specific key version from the Kubernetes Secret.
 func (p *KubernetesKeyProvider) GetKeyByVersion(ctx context.Context, version int) ([]byte, error) {
-	keyStore, err := p.loadKeyStore(ctx)
+	keyStore, err := p.LoadKeyStore(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +264,10 @@ func (p *KubernetesKeyProvider) GetKeyByVersion(ctx context.Context, version int
 		return nil, fmt.Errorf("%w: version %d not found in key store", ErrKeyVersionNotFound, version)
 	}
 
+	if keyData.Wrapped {
+		return nil, fmt.Errorf("%w: version %d", ErrKeyWrapped, version)
+	}
+
 	key, err := base64.StdEncoding.DecodeString(keyData.Key)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to decode key version %d: %v", ErrKeyLoadFailed, version, err)
@@ -218,6 +280,149 @@ func (p *KubernetesKeyProvider) GetKeyByVersion(ctx context.Context, version int
 	return key, nil
 }
 
+// UpdateKeyStore applies update to the key store in the Kubernetes Secret. If the Secret is modified concurrently,
+// for example by the key rotation CronJob, update is applied again to the new key store.
+func (p *KubernetesKeyProvider) UpdateKeyStore(ctx context.Context, update func(keyStore *KeyStore) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return p.updateKeyStore(ctx, update)
+	})
+}
+
+// updateKeyStore applies update to the key store in the Kubernetes Secret. The update is rejected with a conflict if
+// the Secret is modified concurrently.
+func (p *KubernetesKeyProvider) updateKeyStore(ctx context.Context, update func(keyStore *KeyStore) error) error {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, controller_runtime.ObjectKey{Name: p.secretName, Namespace: p.namespace}, secret); err != nil {
+		if k8s_error.IsNotFound(err) {
+			return fmt.Errorf("%w: secret %s/%s not found", ErrKeyNotFound, p.namespace, p.secretName)
+		}
+		return fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	keysJSON, ok := secret.Data[p.secretKey]
+	if !ok {
+		return fmt.Errorf("%w: key %q not found in secret %s/%s", ErrKeyNotFound, p.secretKey, p.namespace, p.secretName)
+	}
+
+	var keyStore KeyStore
+	if err := json.Unmarshal(keysJSON, &keyStore); err != nil {
+		return fmt.Errorf("%w: failed to parse key store JSON: %v", ErrKeyLoadFailed, err)
+	}
+
+	if err := update(&keyStore); err != nil {
+		return err
+	}
+
+	updatedJSON, err := json.Marshal(&keyStore)
+	if err != nil {
+		return err
+	}
+
+	patch := controller_runtime.MergeFromWithOptions(secret.DeepCopy(), controller_runtime.MergeFromWithOptimisticLock{})
+	secret.Data[p.secretKey] = updatedJSON
+	if err := p.client.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("failed to save key store to secret %s/%s: %w", p.namespace, p.secretName, err)
+	}
+
+	return nil
+}
+
+// GetReencryptedVersion returns the key version all sensitive fields were last re-encrypted with, recorded in the
+// ReencryptedVersionAnnotation of the Kubernetes Secret, or 0 if it was not recorded.
+func (p *KubernetesKeyProvider) GetReencryptedVersion(ctx context.Context) (int, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, controller_runtime.ObjectKey{Name: p.secretName, Namespace: p.namespace}, secret); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	value, ok := secret.Annotations[ReencryptedVersionAnnotation]
+	if !ok || value == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse annotation %q: %w", ReencryptedVersionAnnotation, err)
+	}
+
+	return version, nil
+}
+
+// SaveLastRotation records the time of the latest key rotation in the LastRotationAnnotation of the Kubernetes Secret.
+func (p *KubernetesKeyProvider) SaveLastRotation(ctx context.Context, t time.Time) error {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, controller_runtime.ObjectKey{Name: p.secretName, Namespace: p.namespace}, secret); err != nil {
+		return fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	patch := controller_runtime.MergeFrom(secret.DeepCopy())
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[LastRotationAnnotation] = t.UTC().Format(time.RFC3339)
+
+	if err := p.client.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("failed to save last rotation to secret %s/%s: %w", p.namespace, p.secretName, err)
+	}
+
+	return nil
+}
+
+// GetReencryptionStatus returns the status of the latest re-encryption recorded on the Kubernetes Secret, or nil if
+// no re-encryption was recorded.
+func (p *KubernetesKeyProvider) GetReencryptionStatus(ctx context.Context) (*ReencryptionStatus, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, controller_runtime.ObjectKey{Name: p.secretName, Namespace: p.namespace}, secret); err != nil {
+		if k8s_error.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: secret %s/%s not found", ErrKeyNotFound, p.namespace, p.secretName)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	value, ok := secret.Annotations[ReencryptionStatusAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	status := &ReencryptionStatus{}
+	if err := json.Unmarshal([]byte(value), status); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %q: %w", ReencryptionStatusAnnotation, err)
+	}
+
+	return status, nil
+}
+
+// SaveReencryptionStatus records the status of a re-encryption on the Kubernetes Secret. When the re-encryption
+// succeeded without failures, it also records the key version in the ReencryptedVersionAnnotation, which allows the
+// key rotation CronJob to retire older key versions.
+func (p *KubernetesKeyProvider) SaveReencryptionStatus(ctx context.Context, status *ReencryptionStatus) error {
+	value, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, controller_runtime.ObjectKey{Name: p.secretName, Namespace: p.namespace}, secret); err != nil {
+		return fmt.Errorf("%w: %v", ErrKeyLoadFailed, err)
+	}
+
+	// Merge patch the annotations only: the CronJob may update the key store concurrently.
+	patch := controller_runtime.MergeFrom(secret.DeepCopy())
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ReencryptionStatusAnnotation] = string(value)
+	if status.State == ReencryptionStateSucceeded && status.Failures == 0 {
+		secret.Annotations[ReencryptedVersionAnnotation] = strconv.Itoa(status.KeyVersion)
+	}
+
+	if err := p.client.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("failed to save re-encryption status to secret %s/%s: %w", p.namespace, p.secretName, err)
+	}
+
+	return nil
+}
+
 // InMemoryKeyProvider implements KeyProvider with in-memory versioned keys.
 // This is useful for testing environments.
 type InMemoryKeyProvider struct {
@@ -369,6 +369,52 @@ func TestNewKubernetesKeyProvider_DefaultOptions(t *testing.T) {
 	require.Equal(t, RadiusNamespace, provider.namespace)
 }
 
+func TestKubernetesKeyProvider_ReencryptionStatus(t *testing.T) {
+	ctx := context.Background()
+	key := make([]byte, KeySize)
+
+	k8sClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultEncryptionKeySecretName,
+			Namespace: RadiusNamespace,
+		},
+		Data: map[string][]byte{
+			DefaultEncryptionKeySecretKey: createTestKeyStore(t, map[int][]byte{1: key, 2: key}, 2),
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, secret))
+
+	provider := NewKubernetesKeyProvider(k8sClient, nil)
+
+	status, err := provider.GetReencryptionStatus(ctx)
+	require.NoError(t, err)
+	require.Nil(t, status)
+
+	running := &ReencryptionStatus{State: ReencryptionStateRunning, KeyVersion: 2, ResourcesScanned: 3}
+	require.NoError(t, provider.SaveReencryptionStatus(ctx, running))
+
+	status, err = provider.GetReencryptionStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ReencryptionStateRunning, status.State)
+	require.Equal(t, 3, status.ResourcesScanned)
+
+	updated := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, controller_runtime.ObjectKey{Name: DefaultEncryptionKeySecretName, Namespace: RadiusNamespace}, updated))
+	require.NotContains(t, updated.Annotations, ReencryptedVersionAnnotation)
+
+	succeeded := &ReencryptionStatus{State: ReencryptionStateSucceeded, KeyVersion: 2}
+	require.NoError(t, provider.SaveReencryptionStatus(ctx, succeeded))
+
+	require.NoError(t, k8sClient.Get(ctx, controller_runtime.ObjectKey{Name: DefaultEncryptionKeySecretName, Namespace: RadiusNamespace}, updated))
+	require.Equal(t, "2", updated.Annotations[ReencryptedVersionAnnotation])
+
+	// The key store is left unchanged.
+	_, version, err := provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+}
+
 func TestInMemoryKeyProvider(t *testing.T) {
 	ctx := context.Background()
 	validKey := make([]byte, KeySize)
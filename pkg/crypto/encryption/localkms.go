@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// localKeyWrapperAD is the associated data of the data keys wrapped by LocalKeyWrapper.
+var localKeyWrapperAD = []byte("radius-data-encryption-key")
+
+// LocalKeyWrapper is a KeyWrapper that wraps data keys with a key encryption key read from a local file, such as a
+// Kubernetes Secret mounted in the pod or a key exported by a local HSM agent. It is the reference implementation of
+// KeyWrapper.
+type LocalKeyWrapper struct {
+	encryptor *Encryptor
+}
+
+var _ KeyWrapper = (*LocalKeyWrapper)(nil)
+
+// NewLocalKeyWrapper creates a LocalKeyWrapper with the base64-encoded 256-bit key encryption key in keyFile.
+func NewLocalKeyWrapper(keyFile string) (*LocalKeyWrapper, error) {
+	contents, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key encryption key file: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key encryption key file %q: %w", keyFile, err)
+	}
+
+	encryptor, err := NewEncryptor(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key encryption key in file %q: %w", keyFile, err)
+	}
+
+	return &LocalKeyWrapper{encryptor: encryptor}, nil
+}
+
+// WrapKey encrypts a data key with the key encryption key.
+func (w *LocalKeyWrapper) WrapKey(ctx context.Context, key []byte) ([]byte, error) {
+	return w.encryptor.Encrypt(key, localKeyWrapperAD)
+}
+
+// UnwrapKey decrypts a data key with the key encryption key.
+func (w *LocalKeyWrapper) UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error) {
+	return w.encryptor.Decrypt(wrappedKey, localKeyWrapperAD)
+}
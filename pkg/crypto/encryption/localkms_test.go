@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKeyWrapper(t *testing.T) {
+	ctx := context.Background()
+
+	kek, err := GenerateKey()
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "kek")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(kek)+"\n"), 0600))
+
+	wrapper, err := NewLocalKeyWrapper(keyFile)
+	require.NoError(t, err)
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	wrapped, err := wrapper.WrapKey(ctx, key)
+	require.NoError(t, err)
+	require.NotContains(t, string(wrapped), base64.StdEncoding.EncodeToString(key))
+
+	unwrapped, err := wrapper.UnwrapKey(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+}
+
+func TestNewLocalKeyWrapper_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewLocalKeyWrapper(filepath.Join(dir, "missing"))
+	require.ErrorContains(t, err, "failed to read key encryption key file")
+
+	notBase64 := filepath.Join(dir, "not-base64")
+	require.NoError(t, os.WriteFile(notBase64, []byte("not base64!"), 0600))
+	_, err = NewLocalKeyWrapper(notBase64)
+	require.ErrorContains(t, err, "failed to decode key encryption key file")
+
+	tooShort := filepath.Join(dir, "too-short")
+	require.NoError(t, os.WriteFile(tooShort, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600))
+	_, err = NewLocalKeyWrapper(tooShort)
+	require.ErrorIs(t, err, ErrInvalidKeySize)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveDataHandler_ReencryptSensitiveFields(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	provider, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+
+	handler1, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	paths := []string{"password", "credentials", "tokens[*]"}
+	data := map[string]any{
+		"name":        "test",
+		"password":    "secret",
+		"credentials": map[string]any{"username": "admin", "port": 5432},
+		"tokens":      []any{"a", "b"},
+	}
+	require.NoError(t, handler1.EncryptSensitiveFields(data, paths, testResourceID))
+
+	require.NoError(t, provider.SetCurrentVersion(2))
+	handler2, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	count, err := handler2.ReencryptSensitiveFields(ctx, data, paths, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+	require.Equal(t, "test", data["name"])
+	require.Equal(t, float64(2), data["password"].(map[string]any)["version"])
+	require.Equal(t, float64(2), data["credentials"].(map[string]any)["version"])
+
+	// Values encrypted with the current key are left unchanged.
+	count, err = handler2.ReencryptSensitiveFields(ctx, data, paths, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	// The data can be decrypted once version 1 is retired.
+	retired, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{2: key2}, 2)
+	require.NoError(t, err)
+	handler3, err := NewSensitiveDataHandlerFromProvider(ctx, retired)
+	require.NoError(t, err)
+
+	require.NoError(t, handler3.DecryptSensitiveFields(ctx, data, paths, testResourceID))
+	require.Equal(t, "secret", data["password"])
+	require.Equal(t, map[string]any{"username": "admin", "port": float64(5432)}, data["credentials"])
+	require.Equal(t, []any{"a", "b"}, data["tokens"])
+}
+
+func TestSensitiveDataHandler_ReencryptSensitiveFields_NotEncrypted(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	provider, err := NewInMemoryKeyProvider(key)
+	require.NoError(t, err)
+	handler, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	// Plaintext and missing fields are skipped.
+	data := map[string]any{"password": "redacted"}
+	count, err := handler.ReencryptSensitiveFields(ctx, data, []string{"password", "missing"}, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.Equal(t, "redacted", data["password"])
+}
+
+func TestSensitiveDataHandler_ReencryptSensitiveFields_ADMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+	provider, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+
+	handler, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	data := map[string]any{"password": "secret"}
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"password"}, testResourceID))
+
+	require.NoError(t, provider.SetCurrentVersion(2))
+	handler, err = NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	_, err = handler.ReencryptSensitiveFields(ctx, data, []string{"password"}, "/planes/radius/local/resourceGroups/other/providers/Foo.Bar/myResources/other")
+	require.ErrorIs(t, err, ErrFieldReencryptionFailed)
+}
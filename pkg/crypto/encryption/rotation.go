@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// RotationOptions configures a rotation of the data keys.
+type RotationOptions struct {
+	// Interval is the duration a new key remains valid for encryption.
+	Interval time.Duration
+
+	// GracePeriod is the duration expired keys are kept to decrypt stored data.
+	GracePeriod time.Duration
+
+	// ReencryptedVersion is the key version all sensitive fields were last re-encrypted with, or 0 if they were
+	// never re-encrypted. See ReencryptedVersionAnnotation.
+	ReencryptedVersion int
+}
+
+// RotateKeys adds a new current data key to the key store of source, and removes the keys that are past their grace
+// period and no longer needed to decrypt stored data. It returns the version of the new key.
+//
+// The new key is wrapped with wrapper before it is written, so that it is never stored in plaintext. A nil wrapper
+// stores the key in plaintext. The rules match the key rotation CronJob used without a key management service.
+func RotateKeys(ctx context.Context, source KeyStoreSource, wrapper KeyWrapper, options RotationOptions, now time.Time) (int, error) {
+	key, err := GenerateKey()
+	if err != nil {
+		return 0, err
+	}
+
+	keyData := KeyData{
+		Key:       base64.StdEncoding.EncodeToString(key),
+		CreatedAt: now.UTC().Format(time.RFC3339),
+		ExpiresAt: now.Add(options.Interval).UTC().Format(time.RFC3339),
+	}
+
+	if wrapper != nil {
+		wrapped, err := wrapper.WrapKey(ctx, key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to wrap new key: %w", err)
+		}
+		keyData.Key = base64.StdEncoding.EncodeToString(wrapped)
+		keyData.Wrapped = true
+	}
+
+	version := 0
+	err = source.UpdateKeyStore(ctx, func(keyStore *KeyStore) error {
+		version = keyStore.CurrentVersion + 1
+		keyData.Version = version
+
+		if keyStore.Keys == nil {
+			keyStore.Keys = map[string]KeyData{}
+		}
+		keyStore.Keys[strconv.Itoa(version)] = keyData
+		keyStore.CurrentVersion = version
+
+		retireKeys(keyStore, options, now)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// retireKeys removes the keys past their grace period from the key store. Once sensitive fields were re-encrypted,
+// only the versions older than the re-encrypted version are removed. Otherwise the current and previous versions are
+// always kept.
+func retireKeys(keyStore *KeyStore, options RotationOptions, now time.Time) {
+	if options.ReencryptedVersion == 0 && len(keyStore.Keys) <= 2 {
+		return
+	}
+
+	cutoff := now.Add(-options.GracePeriod)
+	for versionStr, keyData := range keyStore.Keys {
+		expiresAt, err := time.Parse(time.RFC3339, keyData.ExpiresAt)
+		if err != nil || expiresAt.After(cutoff) {
+			continue
+		}
+
+		if options.ReencryptedVersion != 0 {
+			if keyData.Version >= options.ReencryptedVersion {
+				continue
+			}
+		} else if keyData.Version >= keyStore.CurrentVersion-1 {
+			continue
+		}
+
+		delete(keyStore.Keys, versionStr)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	"k8s.io/kubectl/pkg/scheme"
+)
+
+func TestRotateKeys(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+
+	k8sClient := k8sutil.NewFakeKubeClient(scheme.Scheme)
+	createKeyStoreSecret(t, k8sClient, map[int][]byte{1: key1}, 1)
+
+	source := NewKubernetesKeyProvider(k8sClient, nil)
+	wrapper := &countingKeyWrapper{KeyWrapper: newTestLocalKeyWrapper(t)}
+	provider := NewEnvelopeKeyProvider(source, wrapper)
+	require.NoError(t, provider.WrapKeys(ctx))
+
+	_, err = provider.GetKeyByVersion(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, provider.unwrapped, 1)
+
+	// The new key is written wrapped.
+	now := time.Now()
+	version, err := RotateKeys(ctx, source, wrapper, RotationOptions{Interval: 24 * time.Hour, GracePeriod: time.Hour}, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+
+	keyStore, err := source.LoadKeyStore(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, keyStore.CurrentVersion)
+	require.True(t, keyStore.Keys["2"].Wrapped)
+
+	_, version, err = provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+
+	// Once re-encrypted with version 3, the expired versions 1 and 2 are retired and evicted from the cache.
+	later := now.Add(48 * time.Hour)
+	_, err = RotateKeys(ctx, source, wrapper, RotationOptions{Interval: 24 * time.Hour, GracePeriod: time.Hour}, later)
+	require.NoError(t, err)
+	_, err = RotateKeys(ctx, source, wrapper, RotationOptions{Interval: 24 * time.Hour, GracePeriod: time.Hour, ReencryptedVersion: 3}, later)
+	require.NoError(t, err)
+
+	keyStore, err = source.LoadKeyStore(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"3", "4"}, keysOf(keyStore))
+
+	_, _, err = provider.GetCurrentKey(ctx)
+	require.NoError(t, err)
+	require.Len(t, provider.unwrapped, 1)
+}
+
+func TestRetireKeys(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	valid := now.Add(time.Hour).UTC().Format(time.RFC3339)
+
+	newKeyStore := func(expiresAt ...string) *KeyStore {
+		keyStore := &KeyStore{CurrentVersion: len(expiresAt), Keys: map[string]KeyData{}}
+		for i, e := range expiresAt {
+			keyStore.Keys[strconv.Itoa(i+1)] = KeyData{Version: i + 1, ExpiresAt: e}
+		}
+		return keyStore
+	}
+
+	tests := []struct {
+		name               string
+		keyStore           *KeyStore
+		reencryptedVersion int
+		expected           []string
+	}{
+		{
+			name:     "two keys are kept",
+			keyStore: newKeyStore(expired, expired),
+			expected: []string{"1", "2"},
+		},
+		{
+			name:     "current and previous versions are kept",
+			keyStore: newKeyStore(expired, expired, expired, valid),
+			expected: []string{"3", "4"},
+		},
+		{
+			name:     "unexpired keys are kept",
+			keyStore: newKeyStore(valid, expired, expired, valid),
+			expected: []string{"1", "3", "4"},
+		},
+		{
+			name:               "re-encrypted versions are kept",
+			keyStore:           newKeyStore(expired, expired, expired),
+			reencryptedVersion: 2,
+			expected:           []string{"2", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retireKeys(tt.keyStore, RotationOptions{GracePeriod: time.Hour, ReencryptedVersion: tt.reencryptedVersion}, now)
+			require.ElementsMatch(t, tt.expected, keysOf(tt.keyStore))
+		})
+	}
+}
+
+func keysOf(keyStore *KeyStore) []string {
+	keys := []string{}
+	for versionStr := range keyStore.Keys {
+		keys = append(keys, versionStr)
+	}
+	return keys
+}
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/radius-project/radius/pkg/schema"
 )
@@ -38,13 +40,26 @@ var (
 
 	// ErrFieldDecryptionFailed is returned when decryption of a field fails.
 	ErrFieldDecryptionFailed = errors.New("field decryption failed")
+
+	// ErrFieldReencryptionFailed is returned when re-encryption of a field fails.
+	ErrFieldReencryptionFailed = errors.New("field re-encryption failed")
+)
+
+const (
+	// KeyRefreshInterval is the maximum age of the current key of a SensitiveDataHandler refreshed with
+	// RefreshCurrentKey. Once a key is rotated, data is encrypted with the new key within this interval.
+	KeyRefreshInterval = time.Minute
 )
 
 // SensitiveDataHandler provides methods for encrypting and decrypting sensitive fields
 // in data structures based on field paths marked with x-radius-sensitive annotation.
 type SensitiveDataHandler struct {
-	encryptor   *Encryptor
 	keyProvider KeyProvider
+
+	// mu guards the current encryptor, which is replaced when the current key is refreshed.
+	mu          sync.RWMutex
+	encryptor   *Encryptor
+	refreshedAt time.Time
 }
 
 // NewSensitiveDataHandler creates a new SensitiveDataHandler with the provided encryptor.
@@ -81,9 +96,53 @@ func NewSensitiveDataHandlerFromProvider(ctx context.Context, provider KeyProvid
 	return &SensitiveDataHandler{
 		encryptor:   encryptor,
 		keyProvider: provider,
+		refreshedAt: time.Now(),
 	}, nil
 }
 
+// KeyVersion returns the version of the key used to encrypt data.
+func (h *SensitiveDataHandler) KeyVersion() int {
+	return h.currentEncryptor().keyVersion
+}
+
+// RefreshCurrentKey reloads the current key from the key provider when it was loaded more than KeyRefreshInterval
+// ago, so that long-running processes encrypt data with the latest key after a key rotation. It does nothing for
+// handlers created without a key provider.
+func (h *SensitiveDataHandler) RefreshCurrentKey(ctx context.Context) error {
+	if h.keyProvider == nil {
+		return nil
+	}
+
+	h.mu.RLock()
+	fresh := time.Since(h.refreshedAt) < KeyRefreshInterval
+	h.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	key, version, err := h.keyProvider.GetCurrentKey(ctx)
+	if err != nil {
+		return err
+	}
+	encryptor, err := NewEncryptorWithVersion(key, version)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.encryptor = encryptor
+	h.refreshedAt = time.Now()
+	return nil
+}
+
+// currentEncryptor returns the encryptor of the current key.
+func (h *SensitiveDataHandler) currentEncryptor() *Encryptor {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.encryptor
+}
+
 // EncryptSensitiveFields encrypts all sensitive fields in the data based on the provided field paths.
 // The data is modified in place. Field paths support dot notation and [*] for arrays/maps.
 // Examples: "credentials.password", "secrets[*].value", "config[*]"
@@ -167,7 +226,7 @@ func (h *SensitiveDataHandler) DecryptSensitiveFieldsWithSchema(ctx context.Cont
 func (h *SensitiveDataHandler) getEncryptorForDecryption(ctx context.Context, encryptedJSON []byte) (*Encryptor, error) {
 	// If no key provider, use the default encryptor
 	if h.keyProvider == nil {
-		return h.encryptor, nil
+		return h.currentEncryptor(), nil
 	}
 
 	// Extract the version from the encrypted data
@@ -178,7 +237,7 @@ func (h *SensitiveDataHandler) getEncryptorForDecryption(ctx context.Context, en
 
 	// If version is 0 (unversioned/legacy data), use the default encryptor
 	if version == 0 {
-		return h.encryptor, nil
+		return h.currentEncryptor(), nil
 	}
 
 	// Fetch the key for this specific version
@@ -374,7 +433,7 @@ func (h *SensitiveDataHandler) encryptValue(value any, associatedData []byte) (a
 		}
 	}
 
-	encrypted, err := h.encryptor.Encrypt(dataToEncrypt, associatedData)
+	encrypted, err := h.currentEncryptor().Encrypt(dataToEncrypt, associatedData)
 	if err != nil {
 		return nil, err
 	}
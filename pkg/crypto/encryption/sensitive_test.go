@@ -19,6 +19,7 @@ package encryption
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/schema"
 	"github.com/stretchr/testify/require"
@@ -827,6 +828,36 @@ func TestSensitiveDataHandler_VersionedKeyRotation(t *testing.T) {
 	require.Equal(t, "secret-v2", data2["password"])
 }
 
+func TestSensitiveDataHandler_RefreshCurrentKey(t *testing.T) {
+	ctx := context.Background()
+
+	key1, err := GenerateKey()
+	require.NoError(t, err)
+	key2, err := GenerateKey()
+	require.NoError(t, err)
+
+	provider, err := NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+
+	handler, err := NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+	require.Equal(t, 1, handler.KeyVersion())
+
+	require.NoError(t, provider.SetCurrentVersion(2))
+
+	// The key is not reloaded within KeyRefreshInterval.
+	require.NoError(t, handler.RefreshCurrentKey(ctx))
+	require.Equal(t, 1, handler.KeyVersion())
+
+	handler.refreshedAt = time.Now().Add(-KeyRefreshInterval)
+	require.NoError(t, handler.RefreshCurrentKey(ctx))
+	require.Equal(t, 2, handler.KeyVersion())
+
+	data := map[string]any{"password": "secret"}
+	require.NoError(t, handler.EncryptSensitiveFields(data, []string{"password"}, testResourceID))
+	require.Equal(t, float64(2), data["password"].(map[string]any)["version"])
+}
+
 func TestSensitiveDataHandler_DecryptWithOldKeyVersion(t *testing.T) {
 	ctx := context.Background()
 
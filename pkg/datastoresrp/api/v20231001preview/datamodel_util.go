@@ -115,6 +115,41 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 	return status
 }
 
+func fromDriftStatus(driftStatus *rpv1.DriftStatus) *DriftStatus {
+	if driftStatus == nil {
+		return nil
+	}
+
+	status := &DriftStatus{
+		State:           to.Ptr(DriftState(driftStatus.State)),
+		LastCheckedTime: new(driftStatus.LastCheckedTime),
+	}
+
+	if driftStatus.Message != "" {
+		status.Message = new(driftStatus.Message)
+	}
+
+	for _, resource := range driftStatus.DriftedResources {
+		drifted := &DriftedResource{
+			Type:   new(resource.Type),
+			Change: to.Ptr(DriftChange(resource.Change)),
+		}
+		if resource.ID != "" {
+			drifted.ID = new(resource.ID)
+		}
+		if resource.Address != "" {
+			drifted.Address = new(resource.Address)
+		}
+		if resource.Name != "" {
+			drifted.Name = new(resource.Name)
+		}
+
+		status.DriftedResources = append(status.DriftedResources, drifted)
+	}
+
+	return status
+}
+
 func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r == nil {
 		return portableresources.ResourceRecipe{
@@ -102,6 +102,7 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(redis.Properties.Status.Recipe),
+			DriftStatus:     fromDriftStatus(redis.Properties.Status.DriftStatus),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
 		Environment:       new(redis.Properties.Environment),
@@ -100,6 +100,7 @@ func (dst *SQLDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(sql.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(sql.Properties.Status.Recipe),
+			DriftStatus:     fromDriftStatus(sql.Properties.Status.DriftStatus),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(sql.InternalMetadata.AsyncProvisioningState),
 		Environment:       new(sql.Properties.Environment),
@@ -38,6 +38,45 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// DriftChange - The change made outside of Radius to a resource deployed by a recipe.
+type DriftChange string
+
+const (
+	// DriftChangeDeleted - The resource was deleted outside of Radius.
+	DriftChangeDeleted DriftChange = "Deleted"
+	// DriftChangeModified - The resource was modified outside of Radius.
+	DriftChangeModified DriftChange = "Modified"
+)
+
+// PossibleDriftChangeValues returns the possible values for the DriftChange const type.
+func PossibleDriftChangeValues() []DriftChange {
+	return []DriftChange{
+		DriftChangeDeleted,
+		DriftChangeModified,
+	}
+}
+
+// DriftState - The drift state of the infrastructure deployed by a recipe.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The infrastructure was changed outside of Radius.
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateInSync - The infrastructure matches the recipe deployment.
+	DriftStateInSync DriftState = "InSync"
+	// DriftStateUnknown - The drift of the infrastructure could not be detected.
+	DriftStateUnknown DriftState = "Unknown"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{
+		DriftStateDrifted,
+		DriftStateInSync,
+		DriftStateUnknown,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
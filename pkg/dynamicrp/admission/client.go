@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/schema"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// maxResponseSize is the largest AdmissionReview response read from a webhook.
+	maxResponseSize = 1 << 20
+)
+
+// Client calls validation webhooks.
+type Client struct {
+	// httpClients caches the HTTP clients by the CA bundle of the webhooks, so that connections are reused.
+	httpClients sync.Map
+}
+
+// NewClient creates a new Client.
+func NewClient() *Client {
+	return &Client{}
+}
+
+// httpClient returns the HTTP client verifying the certificate of the webhook with its CA bundle.
+func (c *Client) httpClient(webhook *schema.ValidationWebhook) (*http.Client, error) {
+	key := string(webhook.CABundle)
+	if client, ok := c.httpClients.Load(key); ok {
+		return client.(*http.Client), nil
+	}
+
+	pool, err := webhook.CertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	client := &http.Client{
+		Transport: otelhttp.NewTransport(transport),
+		// The webhook must not redirect the review, which carries the resource, to another URL.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	actual, _ := c.httpClients.LoadOrStore(key, client)
+	return actual.(*http.Client), nil
+}
+
+// Review sends the request to the webhook and returns its decision. An error is returned if the webhook URL does not
+// use https, the webhook cannot be called within its timeout, responds with an error status, or returns a response
+// that is not a valid AdmissionReview for the request. The failure policy of the webhook is applied by the caller.
+func (c *Client) Review(ctx context.Context, webhook *schema.ValidationWebhook, request *AdmissionRequest) (*AdmissionResponse, error) {
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook url must use the https scheme, got %q", u.Scheme)
+	}
+
+	httpClient, err := c.httpClient(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(AdmissionReview{APIVersion: ReviewAPIVersion, Kind: ReviewKind, Request: request})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhook.Timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("webhook responded with status code %d", resp.StatusCode)
+	}
+
+	review := AdmissionReview{}
+	if err := json.Unmarshal(content, &review); err != nil {
+		return nil, fmt.Errorf("webhook response is not an AdmissionReview: %w", err)
+	}
+
+	if review.APIVersion != ReviewAPIVersion || review.Kind != ReviewKind {
+		return nil, fmt.Errorf("webhook response must have apiVersion %q and kind %q, got %q and %q", ReviewAPIVersion, ReviewKind, review.APIVersion, review.Kind)
+	}
+	if review.Response == nil {
+		return nil, fmt.Errorf("webhook response does not set the response")
+	}
+	if review.Response.UID != request.UID {
+		return nil, fmt.Errorf("webhook response uid %q does not match request uid %q", review.Response.UID, request.UID)
+	}
+
+	return review.Response, nil
+}
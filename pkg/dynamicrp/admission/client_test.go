@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWebhook(t *testing.T, handler func(review AdmissionReview) (int, any)) *schema.ValidationWebhook {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := AdmissionReview{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&review))
+
+		status, body := handler(review)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return &schema.ValidationWebhook{URL: server.URL, CABundle: testCABundle(server)}
+}
+
+// testCABundle returns the PEM encoded certificate of the test server.
+func testCABundle(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func reply(review AdmissionReview, response AdmissionResponse) AdmissionReview {
+	response.UID = review.Request.UID
+	return AdmissionReview{APIVersion: ReviewAPIVersion, Kind: ReviewKind, Response: &response}
+}
+
+func testRequest() *AdmissionRequest {
+	return &AdmissionRequest{
+		UID:          "1234",
+		Operation:    OperationCreate,
+		ResourceID:   "/planes/radius/local/resourceGroups/test/providers/Test.Resources/widgets/w1",
+		ResourceType: "Test.Resources/widgets",
+		Name:         "w1",
+		APIVersion:   "2025-01-01",
+		Object:       map[string]any{"properties": map[string]any{"size": "large"}},
+	}
+}
+
+func TestClient_Review(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			require.Equal(t, ReviewAPIVersion, review.APIVersion)
+			require.Equal(t, ReviewKind, review.Kind)
+			require.Equal(t, testRequest(), review.Request)
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: true, Warnings: []string{"size is deprecated"}})
+		})
+
+		response, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.NoError(t, err)
+		require.True(t, response.Allowed)
+		require.Equal(t, []string{"size is deprecated"}, response.Warnings)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: false, Status: &Status{Code: 403, Message: "size must be small"}})
+		})
+
+		response, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.NoError(t, err)
+		require.False(t, response.Allowed)
+		require.Equal(t, "size must be small", response.Status.Message)
+	})
+
+	t.Run("uid mismatch", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			response := reply(review, AdmissionResponse{Allowed: true})
+			response.Response.UID = "other"
+			return http.StatusOK, response
+		})
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "does not match request uid")
+	})
+
+	t.Run("wrong kind", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			response := reply(review, AdmissionResponse{Allowed: true})
+			response.Kind = "Review"
+			return http.StatusOK, response
+		})
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "must have apiVersion")
+	})
+
+	t.Run("missing response", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusOK, AdmissionReview{APIVersion: ReviewAPIVersion, Kind: ReviewKind}
+		})
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "does not set the response")
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusInternalServerError, map[string]any{}
+		})
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "status code 500")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			time.Sleep(2 * time.Second)
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: true})
+		})
+		webhook.TimeoutSeconds = new(1)
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "failed to call webhook")
+	})
+
+	t.Run("http url", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: true})
+		})
+		webhook.URL = strings.Replace(webhook.URL, "https://", "http://", 1)
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "must use the https scheme")
+	})
+
+	t.Run("certificate not trusted", func(t *testing.T) {
+		webhook := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: true})
+		})
+		webhook.CABundle = nil
+
+		_, err := NewClient().Review(context.Background(), webhook, testRequest())
+		require.ErrorContains(t, err, "failed to call webhook")
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		target := newTestWebhook(t, func(review AdmissionReview) (int, any) {
+			return http.StatusOK, reply(review, AdmissionResponse{Allowed: true})
+		})
+		server := httptest.NewTLSServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		t.Cleanup(server.Close)
+
+		_, err := NewClient().Review(context.Background(), &schema.ValidationWebhook{URL: server.URL, CABundle: testCABundle(server)}, testRequest())
+		require.ErrorContains(t, err, "status code 307")
+	})
+}
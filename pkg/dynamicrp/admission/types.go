@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admission implements the calls to the validation webhooks of user-defined resource types. Resource types
+// declare a webhook with the x-radius-validation-webhook schema annotation. Dynamic-rp posts an AdmissionReview to
+// the webhook when a resource is created or updated, and rejects the request if the webhook does not allow it.
+//
+// The request and response are modeled on the Kubernetes AdmissionReview, so that existing webhook frameworks can be
+// adapted with little effort.
+package admission
+
+const (
+	// ReviewAPIVersion is the apiVersion of the AdmissionReview sent to and returned by validation webhooks.
+	ReviewAPIVersion = "admission.radapp.io/v1"
+
+	// ReviewKind is the kind of the AdmissionReview sent to and returned by validation webhooks.
+	ReviewKind = "AdmissionReview"
+)
+
+// Operation is the operation being validated.
+type Operation string
+
+const (
+	// OperationCreate is the creation of a resource that does not exist yet.
+	OperationCreate Operation = "CREATE"
+
+	// OperationUpdate is the update of an existing resource.
+	OperationUpdate Operation = "UPDATE"
+)
+
+// AdmissionReview is the body of the request sent to a validation webhook, and of its response. The request sets
+// Request, the webhook responds with the same apiVersion and kind and sets Response.
+type AdmissionReview struct {
+	// APIVersion is ReviewAPIVersion.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is ReviewKind.
+	Kind string `json:"kind"`
+
+	// Request is the operation to validate. It is set in the request sent to the webhook.
+	Request *AdmissionRequest `json:"request,omitempty"`
+
+	// Response is the decision of the webhook. It is set in the response returned by the webhook.
+	Response *AdmissionResponse `json:"response,omitempty"`
+}
+
+// AdmissionRequest describes the operation to validate.
+type AdmissionRequest struct {
+	// UID identifies the review. The webhook must return it in AdmissionResponse.UID.
+	UID string `json:"uid"`
+
+	// Operation is the operation being validated.
+	Operation Operation `json:"operation"`
+
+	// ResourceID is the ID of the resource, e.g. "/planes/radius/local/resourceGroups/default/providers/MyCompany.Resources/testResources/test".
+	ResourceID string `json:"resourceId"`
+
+	// ResourceType is the fully-qualified resource type, e.g. "MyCompany.Resources/testResources".
+	ResourceType string `json:"resourceType"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// APIVersion is the API version of the request. Object and OldObject are in this API version.
+	APIVersion string `json:"apiVersion"`
+
+	// UserInfo describes the caller.
+	UserInfo UserInfo `json:"userInfo"`
+
+	// Object is the resource after the operation, as it is returned by the API. The properties marked with
+	// x-radius-sensitive are redacted.
+	Object map[string]any `json:"object"`
+
+	// OldObject is the existing resource for an update, and is not set for a create. The properties marked with
+	// x-radius-sensitive are redacted.
+	OldObject map[string]any `json:"oldObject,omitempty"`
+}
+
+// UserInfo describes the caller of the operation.
+type UserInfo struct {
+	// Username is the name of the caller, or "anonymous" when the caller is not authenticated.
+	Username string `json:"username"`
+}
+
+// AdmissionResponse is the decision of a validation webhook.
+type AdmissionResponse struct {
+	// UID is the UID of the AdmissionRequest.
+	UID string `json:"uid"`
+
+	// Allowed is true if the operation is allowed.
+	Allowed bool `json:"allowed"`
+
+	// Status describes why the operation is not allowed.
+	Status *Status `json:"status,omitempty"`
+
+	// Warnings are messages about the operation that do not prevent it. They are logged by Radius.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Status describes why an operation is not allowed.
+type Status struct {
+	// Code is an optional HTTP status code chosen by the webhook. It is informational.
+	Code int `json:"code,omitempty"`
+
+	// Message is the reason returned to the caller.
+	Message string `json:"message,omitempty"`
+}
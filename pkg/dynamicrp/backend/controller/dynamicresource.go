@@ -25,6 +25,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/schema"
@@ -108,6 +109,12 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipePutController(options, c.engine, c.configurationLoader)
 
+	case dynamicdatamodel.OperationPlanRecipe:
+		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
+			return NewInertPlanController(options)
+		}
+		return NewRecipePlanController(options, c.engine)
+
 	default:
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
@@ -129,7 +136,14 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return fmt.Errorf("failed to access and validate resource data: %w", err)
 	}
 
-	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, request.APIVersion)
+	// The resource may be stored with another API version than the one of the request when the resource type
+	// declares conversions between its API versions.
+	apiVersion := request.APIVersion
+	if updatedAPIVersion, ok := resourceData["updatedApiVersion"].(string); ok && updatedAPIVersion != "" {
+		apiVersion = updatedAPIVersion
+	}
+
+	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, apiVersion)
 	if err != nil {
 		if errors.Is(err, processor.ErrNoSchemaFound) {
 			logger := ucplog.FromContextOrDiscard(ctx)
@@ -28,6 +28,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
@@ -104,6 +105,32 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipeDeleteController{}, selected)
 	})
 
+	t.Run("inert planRecipe", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + inertResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: inertResourceType, Method: dynamicdatamodel.OperationPlanRecipe}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &InertPlanController{}, selected)
+	})
+
+	t.Run("recipe planRecipe", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: dynamicdatamodel.OperationPlanRecipe}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipePlanController{}, selected)
+	})
+
 	t.Run("unknown operation", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
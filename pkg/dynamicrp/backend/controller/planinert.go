@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// InertPlanController is the async operation controller of the planRecipe action of "inert" dynamic resources.
+type InertPlanController struct {
+	ctrl.BaseController
+}
+
+// NewInertPlanController creates a new InertPlanController.
+func NewInertPlanController(opts ctrl.Options) (ctrl.Controller, error) {
+	return &InertPlanController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+	}, nil
+}
+
+// Run fails the operation: inert resources are provisioned manually, so there is no recipe to plan.
+func (c *InertPlanController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	id, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.NewFailedResult(v1.ErrorDetails{
+		Code:    v1.CodeInvalid,
+		Message: fmt.Sprintf("resource type %q is provisioned manually and does not use recipes", id.Type()),
+		Target:  request.ResourceID,
+	}), nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	recipecontroller "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// RecipePlanController is the async operation controller of the planRecipe action of dynamic resources deployed
+// using recipes.
+type RecipePlanController struct {
+	ctrl.BaseController
+	engine engine.Engine
+}
+
+// NewRecipePlanController creates a new RecipePlanController.
+func NewRecipePlanController(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &RecipePlanController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         engine,
+	}, nil
+}
+
+// Run plans the recipe of the stored resource with the recipe engine. The plan is the response of the operation.
+//
+// The recipe is planned with the same context as a deployment: the sensitive fields of the resource are decrypted,
+// the properties of the connected resources are included, and the resources deployed by the recipe are compared with
+// the resources it declares.
+func (c *RecipePlanController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	properties, err := c.recipeProperties(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	metadata, err := recipecontroller.RecipeMetadata(ctx, c.DatabaseClient(), resource, resource.GetRecipe(), properties)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	prevState := []string{}
+	for _, outputResource := range resource.OutputResources() {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+
+	plan, err := c.engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		// Recipe errors are caused by the recipe or its configuration, so they are returned to the caller.
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Response: plan}, nil
+}
+
+// recipeProperties returns the properties of the resource passed to the recipe, with the sensitive fields decrypted.
+//
+// Sensitive fields are redacted from the properties once the resource has been deployed, their encrypted values
+// are restored from the sensitive properties retained with the resource.
+func (c *RecipePlanController) recipeProperties(ctx context.Context, resource *datamodel.DynamicResource) (map[string]any, error) {
+	properties := resource.Properties
+
+	apiVersion := resource.InternalMetadata.UpdatedAPIVersion
+	if apiVersion == "" {
+		return properties, nil
+	}
+
+	resourceSchema, err := schema.GetSchema(ctx, c.UcpClient(), resource.ID, resource.Type, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema for sensitive field detection: %w", err)
+	}
+	if resourceSchema == nil {
+		return properties, nil
+	}
+
+	sensitiveFieldPaths := schema.ExtractSensitiveFieldPaths(resourceSchema, "")
+	if len(sensitiveFieldPaths) == 0 {
+		return properties, nil
+	}
+
+	if resource.SensitiveProperties != nil {
+		properties = schema.MergeFields(properties, resource.SensitiveProperties)
+	}
+
+	keyProvider := c.KeyProvider()
+	if keyProvider == nil {
+		return nil, fmt.Errorf("kubernetes client not configured for sensitive data decryption")
+	}
+
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, keyProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize sensitive data handler: %w", err)
+	}
+
+	if err := handler.DecryptSensitiveFieldsWithSchema(ctx, properties, sensitiveFieldPaths, resource.ID, resourceSchema); err != nil {
+		return nil, fmt.Errorf("failed to decrypt sensitive fields: %w", err)
+	}
+
+	return properties, nil
+}
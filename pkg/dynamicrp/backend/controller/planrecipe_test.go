@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPlanResourceID    = "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+	testPlanEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/test-env"
+)
+
+func TestRecipePlanController_Run(t *testing.T) {
+	setup := func(t *testing.T, data map[string]any) (ctrl.Options, *engine.MockEngine) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().Get(gomock.Any(), testPlanResourceID).
+			Return(&database.Object{Metadata: database.Metadata{ID: testPlanResourceID}, Data: data}, nil)
+
+		return ctrl.Options{DatabaseClient: databaseClient}, engine.NewMockEngine(mctrl)
+	}
+
+	request := &ctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: v1.OperationType{Type: recipeResourceType, Method: dynamicdatamodel.OperationPlanRecipe}.String(),
+		ResourceID:    testPlanResourceID,
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		opts, mEngine := setup(t, map[string]any{
+			"id":   testPlanResourceID,
+			"type": recipeResourceType,
+			"properties": map[string]any{
+				"environment": testPlanEnvironmentID,
+				"size":        "small",
+				"recipe":      map[string]any{"name": "large", "parameters": map[string]any{"replicas": 2}},
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+					},
+				},
+			},
+		})
+		plan := &recipes.PlanOutput{Driver: recipes.TemplateKindTerraform, Kind: recipes.PlanKindWhatIf}
+
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, options engine.PlanOptions) (*recipes.PlanOutput, error) {
+			require.Equal(t, "large", options.Recipe.Name)
+			require.Equal(t, map[string]any{"replicas": float64(2)}, options.Recipe.Parameters)
+			require.Equal(t, testPlanEnvironmentID, options.Recipe.EnvironmentID)
+			require.Equal(t, testPlanResourceID, options.Recipe.ResourceID)
+			require.Equal(t, "small", options.Recipe.Properties["size"])
+			require.Equal(t, []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"}, options.PreviousState)
+			return plan, nil
+		})
+
+		c, err := NewRecipePlanController(opts, mEngine)
+		require.NoError(t, err)
+
+		result, err := c.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, result.ProvisioningState())
+		require.Equal(t, plan, result.Response)
+	})
+
+	t.Run("sensitive fields are decrypted", func(t *testing.T) {
+		key, err := encryption.GenerateKey()
+		require.NoError(t, err)
+		provider, err := encryption.NewInMemoryKeyProvider(key)
+		require.NoError(t, err)
+		handler, err := encryption.NewSensitiveDataHandlerFromProvider(context.Background(), provider)
+		require.NoError(t, err)
+
+		encrypted := map[string]any{"password": "secret123"}
+		require.NoError(t, handler.EncryptSensitiveFields(encrypted, []string{"password"}, testPlanResourceID))
+
+		// The resource has been deployed: the password is redacted and its encrypted value is retained.
+		opts, mEngine := setup(t, map[string]any{
+			"id":                testPlanResourceID,
+			"type":              recipeResourceType,
+			"updatedApiVersion": "2024-01-01",
+			"properties": map[string]any{
+				"environment": testPlanEnvironmentID,
+				"password":    nil,
+			},
+			"sensitiveProperties": encrypted,
+		})
+		opts.KeyProvider = provider
+		opts.UcpClient, err = testSchemaClientFactory(map[string]any{
+			"properties": map[string]any{
+				"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+			},
+		})
+		require.NoError(t, err)
+
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, options engine.PlanOptions) (*recipes.PlanOutput, error) {
+			require.Equal(t, "secret123", options.Recipe.Properties["password"])
+			return &recipes.PlanOutput{}, nil
+		})
+
+		c, err := NewRecipePlanController(opts, mEngine)
+		require.NoError(t, err)
+
+		result, err := c.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, result.ProvisioningState())
+	})
+
+	t.Run("recipe error", func(t *testing.T) {
+		opts, mEngine := setup(t, map[string]any{"id": testPlanResourceID, "type": recipeResourceType, "properties": map[string]any{}})
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeNotFoundFailure, "could not find recipe \"default\"", "", nil))
+
+		c, err := NewRecipePlanController(opts, mEngine)
+		require.NoError(t, err)
+
+		result, err := c.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, recipes.RecipeNotFoundFailure, result.Error.Code)
+	})
+
+	t.Run("engine failure", func(t *testing.T) {
+		opts, mEngine := setup(t, map[string]any{"id": testPlanResourceID, "type": recipeResourceType, "properties": map[string]any{}})
+		mEngine.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		c, err := NewRecipePlanController(opts, mEngine)
+		require.NoError(t, err)
+
+		_, err = c.Run(context.Background(), request)
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestInertPlanController_Run(t *testing.T) {
+	c, err := NewInertPlanController(ctrl.Options{})
+	require.NoError(t, err)
+
+	result, err := c.Run(context.Background(), &ctrl.Request{ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/" + inertResourceType + "/test-resource"})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Equal(t, v1.CodeInvalid, result.Error.Code)
+}
+
+func testSchemaClientFactory(schema map[string]any) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schema,
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+}
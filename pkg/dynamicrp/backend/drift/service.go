@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/recipes/drift"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewService creates the service detecting the drift of the infrastructure deployed by the recipes of dynamic
+// resources. It returns nil if drift detection is disabled.
+func NewService(options *dynamicrp.Options) *drift.Service {
+	return drift.NewService("dynamic-rp recipe drift detection", "dynamic-rp-drift-detection", options.Config.DriftDetection, func(ctx context.Context) (*drift.Job, error) {
+		databaseClient, err := options.DatabaseProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		recipeEngine, err := options.RecipeEngine()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create recipe engine: %w", err)
+		}
+
+		ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(options.UCP))
+		if err != nil {
+			return nil, err
+		}
+
+		return &drift.Job{
+			DatabaseClient: databaseClient,
+			Engine:         recipeEngine,
+			ResourceTypes:  &ResourceTypeLister{UCPClient: ucpClient},
+		}, nil
+	}, options.KubernetesProvider.ClientGoClient)
+}
+
+// ResourceTypeLister lists the resource types served by dynamic-rp: the resource types of the locations without an
+// address. Resource types of locations with an address are served by another resource provider.
+type ResourceTypeLister struct {
+	// UCPClient is the client used to list the resource providers and their locations.
+	UCPClient *v20231001preview.ClientFactory
+}
+
+var _ drift.ResourceTypeLister = (*ResourceTypeLister)(nil)
+
+// ListResourceTypes implements drift.ResourceTypeLister.
+func (l *ResourceTypeLister) ListResourceTypes(ctx context.Context) ([]string, error) {
+	types := []string{}
+	seen := map[string]bool{}
+
+	planes := l.UCPClient.NewRadiusPlanesClient().NewListPager(nil)
+	for planes.More() {
+		page, err := planes.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list radius planes: %w", err)
+		}
+
+		for _, plane := range page.Value {
+			if plane.Name == nil {
+				continue
+			}
+
+			planeTypes, err := l.listPlaneResourceTypes(ctx, *plane.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			// The same resource type can be registered in several planes, and is queried once across all planes.
+			for _, resourceType := range planeTypes {
+				if !seen[resourceType] {
+					seen[resourceType] = true
+					types = append(types, resourceType)
+				}
+			}
+		}
+	}
+
+	slices.Sort(types)
+	return types, nil
+}
+
+// listPlaneResourceTypes lists the resource types of a radius plane served by dynamic-rp.
+func (l *ResourceTypeLister) listPlaneResourceTypes(ctx context.Context, plane string) ([]string, error) {
+	types := []string{}
+
+	providers := l.UCPClient.NewResourceProvidersClient().NewListPager(plane, nil)
+	for providers.More() {
+		page, err := providers.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource providers of plane %q: %w", plane, err)
+		}
+
+		for _, provider := range page.Value {
+			if provider.Name == nil {
+				continue
+			}
+
+			locations := l.UCPClient.NewLocationsClient().NewListPager(plane, *provider.Name, nil)
+			for locations.More() {
+				page, err := locations.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list locations of provider %q: %w", *provider.Name, err)
+				}
+
+				for _, location := range page.Value {
+					if location.Properties == nil || location.Properties.Address != nil {
+						continue
+					}
+
+					for resourceType := range location.Properties.ResourceTypes {
+						types = append(types, *provider.Name+"/"+resourceType)
+					}
+				}
+			}
+		}
+	}
+
+	return types, nil
+}
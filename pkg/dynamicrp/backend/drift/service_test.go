@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewService(t *testing.T) {
+	options := &dynamicrp.Options{Config: &dynamicrp.Config{}}
+	require.Nil(t, NewService(options))
+
+	options.Config.DriftDetection = hostoptions.DriftDetectionOptions{Enabled: true}
+	require.NotNil(t, NewService(options))
+}
+
+func Test_ResourceTypeLister_ListResourceTypes(t *testing.T) {
+	serverFactory := fake.ServerFactory{
+		RadiusPlanesServer: fake.RadiusPlanesServer{
+			NewListPager: func(options *v20231001preview.RadiusPlanesClientListOptions) (resp azfake.PagerResponder[v20231001preview.RadiusPlanesClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.RadiusPlanesClientListResponse{
+					RadiusPlaneResourceListResult: v20231001preview.RadiusPlaneResourceListResult{
+						Value: []*v20231001preview.RadiusPlaneResource{{Name: to.Ptr("local")}, {Name: to.Ptr("other")}},
+					},
+				}, nil)
+				return
+			},
+		},
+		ResourceProvidersServer: fake.ResourceProvidersServer{
+			NewListPager: func(planeName string, options *v20231001preview.ResourceProvidersClientListOptions) (resp azfake.PagerResponder[v20231001preview.ResourceProvidersClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.ResourceProvidersClientListResponse{
+					ResourceProviderResourceListResult: v20231001preview.ResourceProviderResourceListResult{
+						Value: []*v20231001preview.ResourceProviderResource{{Name: to.Ptr("Applications.Datastores")}, {Name: to.Ptr("Radius.Data")}},
+					},
+				}, nil)
+				return
+			},
+		},
+		LocationsServer: fake.LocationsServer{
+			NewListPager: func(planeName string, resourceProviderName string, options *v20231001preview.LocationsClientListOptions) (resp azfake.PagerResponder[v20231001preview.LocationsClientListResponse]) {
+				location := &v20231001preview.LocationResource{
+					Name: to.Ptr("global"),
+					Properties: &v20231001preview.LocationProperties{
+						ResourceTypes: map[string]*v20231001preview.LocationResourceType{
+							"redisCaches":  {},
+							"sqlDatabases": {},
+						},
+					},
+				}
+				if resourceProviderName == "Applications.Datastores" {
+					location.Properties.Address = to.Ptr("http://applications-rp:5443")
+				}
+
+				resp.AddPage(http.StatusOK, v20231001preview.LocationsClientListResponse{
+					LocationResourceListResult: v20231001preview.LocationResourceListResult{
+						Value: []*v20231001preview.LocationResource{location},
+					},
+				}, nil)
+				return
+			},
+		},
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&serverFactory),
+		},
+	})
+	require.NoError(t, err)
+
+	lister := &ResourceTypeLister{UCPClient: ucpClient}
+	types, err := lister.ListResourceTypes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Radius.Data/redisCaches", "Radius.Data/sqlDatabases"}, types)
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// pageSize is the maximum number of resources read from the database at once.
+	pageSize = 100
+)
+
+// StatusStore records the status of re-encryption passes.
+type StatusStore interface {
+	// GetReencryptionStatus returns the status of the latest pass, or nil if no pass was recorded.
+	GetReencryptionStatus(ctx context.Context) (*encryption.ReencryptionStatus, error)
+
+	// SaveReencryptionStatus records the status of a pass.
+	SaveReencryptionStatus(ctx context.Context, status *encryption.ReencryptionStatus) error
+}
+
+// Job re-encrypts the sensitive fields of all dynamic resources with the current encryption key, so that older key
+// versions can be retired once it succeeds.
+type Job struct {
+	// DatabaseClient is the client for the database storing the resources.
+	DatabaseClient database.Client
+
+	// UCPClient is the client used to list resource types and their schemas.
+	UCPClient *v20231001preview.ClientFactory
+
+	// KeyProvider provides the encryption keys.
+	KeyProvider encryption.KeyProvider
+
+	// StatusStore records the progress of the job.
+	StatusStore StatusStore
+}
+
+// sensitiveResourceType is a resource type with sensitive fields in at least one of its API versions.
+type sensitiveResourceType struct {
+	// plane is the name of the radius plane of the resource type.
+	plane string
+
+	// name is the fully-qualified name of the resource type, for example "Applications.Test/testResources".
+	name string
+
+	// paths are the sensitive field paths by lowercased API version.
+	paths map[string][]string
+}
+
+// Run runs a re-encryption pass over all resources with sensitive fields, and returns its final status. Resources
+// that cannot be re-encrypted are reported as failures: they are retried by the next pass, and older key versions
+// must not be retired until a pass completes without failures.
+func (j *Job) Run(ctx context.Context) (*encryption.ReencryptionStatus, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Create a handler for this pass, which always encrypts with the key that is current when the pass starts.
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, j.KeyProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the current encryption key: %w", err)
+	}
+
+	status := &encryption.ReencryptionStatus{
+		State:      encryption.ReencryptionStateRunning,
+		KeyVersion: handler.KeyVersion(),
+		StartTime:  time.Now().UTC(),
+	}
+	if err := j.StatusStore.SaveReencryptionStatus(ctx, status); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Re-encrypting sensitive fields", "keyVersion", status.KeyVersion)
+
+	types, err := j.listSensitiveResourceTypes(ctx)
+	if err != nil {
+		return j.complete(ctx, status, err)
+	}
+
+	for _, resourceType := range types {
+		if err := j.reencryptResourceType(ctx, handler, resourceType, status); err != nil {
+			return j.complete(ctx, status, err)
+		}
+
+		logger.Info("Re-encrypted sensitive fields of resource type",
+			"resourceType", resourceType.name,
+			"resourcesScanned", status.ResourcesScanned,
+			"fieldsReencrypted", status.FieldsReencrypted,
+			"failures", status.Failures)
+
+		if err := j.StatusStore.SaveReencryptionStatus(ctx, status); err != nil {
+			return nil, err
+		}
+	}
+
+	return j.complete(ctx, status, nil)
+}
+
+// complete records the final status of a pass.
+func (j *Job) complete(ctx context.Context, status *encryption.ReencryptionStatus, err error) (*encryption.ReencryptionStatus, error) {
+	endTime := time.Now().UTC()
+	status.EndTime = &endTime
+	status.State = encryption.ReencryptionStateSucceeded
+	if err != nil {
+		status.Message = err.Error()
+	}
+	if err != nil || status.Failures > 0 {
+		status.State = encryption.ReencryptionStateFailed
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Re-encryption of sensitive fields completed",
+		"state", status.State,
+		"keyVersion", status.KeyVersion,
+		"resourcesScanned", status.ResourcesScanned,
+		"fieldsReencrypted", status.FieldsReencrypted,
+		"failures", status.Failures)
+
+	if saveErr := j.StatusStore.SaveReencryptionStatus(ctx, status); saveErr != nil {
+		return status, errors.Join(err, saveErr)
+	}
+
+	return status, err
+}
+
+// reencryptResourceType re-encrypts the sensitive fields of all resources of a resource type.
+func (j *Job) reencryptResourceType(ctx context.Context, handler *encryption.SensitiveDataHandler, resourceType sensitiveResourceType, status *encryption.ReencryptionStatus) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	query := database.Query{
+		RootScope:      "/planes/radius/" + resourceType.plane,
+		ScopeRecursive: true,
+		ResourceType:   resourceType.name,
+	}
+
+	token := ""
+	for {
+		result, err := j.DatabaseClient.Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(pageSize))
+		if err != nil {
+			return fmt.Errorf("failed to query resources of type %q: %w", resourceType.name, err)
+		}
+
+		for i := range result.Items {
+			obj := &result.Items[i]
+			if err := j.reencryptResource(ctx, handler, resourceType, obj, status); err != nil {
+				logger.Error(err, "Failed to re-encrypt sensitive fields", "resourceID", obj.ID)
+				status.Failures++
+				status.Message = fmt.Sprintf("failed to re-encrypt resource %q: %v", obj.ID, err)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// reencryptResource re-encrypts the sensitive fields of a resource, and saves it if any field was re-encrypted.
+// The resource is saved only if it was not updated since it was read.
+func (j *Job) reencryptResource(ctx context.Context, handler *encryption.SensitiveDataHandler, resourceType sensitiveResourceType, obj *database.Object, status *encryption.ReencryptionStatus) error {
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	// Sensitive fields are encrypted with the schema of the API version the resource is stored with.
+	apiVersion := resource.InternalMetadata.UpdatedAPIVersion
+	if apiVersion == "" {
+		apiVersion = resource.InternalMetadata.CreatedAPIVersion
+	}
+
+	paths := resourceType.paths[strings.ToLower(apiVersion)]
+	if len(paths) == 0 || (resource.Properties == nil && resource.SensitiveProperties == nil) {
+		return nil
+	}
+
+	status.ResourcesScanned++
+
+	// Encrypted values are in the properties until the backend has processed the resource, and are
+	// retained in the sensitive properties afterwards.
+	count := 0
+	for _, data := range []map[string]any{resource.Properties, resource.SensitiveProperties} {
+		if data == nil {
+			continue
+		}
+		reencrypted, err := handler.ReencryptSensitiveFields(ctx, data, paths, obj.ID)
+		if err != nil {
+			return err
+		}
+		count += reencrypted
+	}
+	if count == 0 {
+		return nil
+	}
+
+	obj.Data = resource
+	if err := j.DatabaseClient.Save(ctx, obj, database.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	status.FieldsReencrypted += count
+	return nil
+}
+
+// listSensitiveResourceTypes lists the resource types of all radius planes with sensitive fields.
+func (j *Job) listSensitiveResourceTypes(ctx context.Context) ([]sensitiveResourceType, error) {
+	types := []sensitiveResourceType{}
+
+	planes := j.UCPClient.NewRadiusPlanesClient().NewListPager(nil)
+	for planes.More() {
+		page, err := planes.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list radius planes: %w", err)
+		}
+
+		for _, plane := range page.Value {
+			if plane.Name == nil {
+				continue
+			}
+
+			planeTypes, err := j.listPlaneSensitiveResourceTypes(ctx, *plane.Name)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, planeTypes...)
+		}
+	}
+
+	return types, nil
+}
+
+// listPlaneSensitiveResourceTypes lists the resource types of a radius plane with sensitive fields.
+func (j *Job) listPlaneSensitiveResourceTypes(ctx context.Context, plane string) ([]sensitiveResourceType, error) {
+	types := []sensitiveResourceType{}
+
+	providers := j.UCPClient.NewResourceProvidersClient().NewListPager(plane, nil)
+	for providers.More() {
+		page, err := providers.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource providers of plane %q: %w", plane, err)
+		}
+
+		for _, provider := range page.Value {
+			if provider.Name == nil {
+				continue
+			}
+
+			resourceTypes := j.UCPClient.NewResourceTypesClient().NewListPager(plane, *provider.Name, nil)
+			for resourceTypes.More() {
+				page, err := resourceTypes.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list resource types of provider %q: %w", *provider.Name, err)
+				}
+
+				for _, resourceType := range page.Value {
+					if resourceType.Name == nil {
+						continue
+					}
+
+					paths, err := j.listSensitiveFieldPaths(ctx, plane, *provider.Name, *resourceType.Name)
+					if err != nil {
+						return nil, err
+					}
+					if len(paths) == 0 {
+						continue
+					}
+
+					types = append(types, sensitiveResourceType{
+						plane: plane,
+						name:  *provider.Name + "/" + *resourceType.Name,
+						paths: paths,
+					})
+				}
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// listSensitiveFieldPaths returns the sensitive field paths of each API version of a resource type that has any.
+func (j *Job) listSensitiveFieldPaths(ctx context.Context, plane, provider, resourceType string) (map[string][]string, error) {
+	paths := map[string][]string{}
+
+	apiVersions := j.UCPClient.NewAPIVersionsClient().NewListPager(plane, provider, resourceType, nil)
+	for apiVersions.More() {
+		page, err := apiVersions.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list API versions of resource type %q: %w", provider+"/"+resourceType, err)
+		}
+
+		for _, apiVersion := range page.Value {
+			if apiVersion.Name == nil || apiVersion.Properties == nil || apiVersion.Properties.Schema == nil {
+				continue
+			}
+
+			if versionPaths := schema.ExtractSensitiveFieldPaths(apiVersion.Properties.Schema, ""); len(versionPaths) > 0 {
+				paths[strings.ToLower(*apiVersion.Name)] = versionPaths
+			}
+		}
+	}
+
+	return paths, nil
+}
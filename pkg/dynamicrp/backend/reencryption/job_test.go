@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryption
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAPIVersion  = "2025-01-01-preview"
+	testResourceID  = "/planes/radius/local/resourceGroups/test-group/providers/Test.Resources/secrets/first"
+	testResourceID2 = "/planes/radius/local/resourceGroups/test-group/providers/Test.Resources/secrets/second"
+)
+
+// memoryStatusStore is a StatusStore that keeps the status in memory.
+type memoryStatusStore struct {
+	status *encryption.ReencryptionStatus
+	saves  int
+}
+
+func (s *memoryStatusStore) GetReencryptionStatus(ctx context.Context) (*encryption.ReencryptionStatus, error) {
+	return s.status, nil
+}
+
+func (s *memoryStatusStore) SaveReencryptionStatus(ctx context.Context, status *encryption.ReencryptionStatus) error {
+	copied := *status
+	s.status = &copied
+	s.saves++
+	return nil
+}
+
+func Test_Job_Run(t *testing.T) {
+	ctx := context.Background()
+	provider, databaseClient := setupRotatedKeys(t)
+
+	// The second resource has no sensitive value to re-encrypt.
+	saveResource(t, databaseClient, testResourceID2, map[string]any{"name": "second"})
+
+	store := &memoryStatusStore{}
+	job := &Job{
+		DatabaseClient: databaseClient,
+		UCPClient:      testUCPClientFactory(t),
+		KeyProvider:    provider,
+		StatusStore:    store,
+	}
+
+	status, err := job.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, encryption.ReencryptionStateSucceeded, status.State)
+	require.Equal(t, 2, status.KeyVersion)
+	require.Equal(t, 2, status.ResourcesScanned)
+	require.Equal(t, 1, status.FieldsReencrypted)
+	require.Equal(t, 0, status.Failures)
+	require.NotNil(t, status.EndTime)
+	require.Equal(t, status, store.status)
+
+	// The resource can be decrypted once the first key version is retired.
+	key2, err := provider.GetKeyByVersion(ctx, 2)
+	require.NoError(t, err)
+	retired, err := encryption.NewInMemoryKeyProviderWithVersions(map[int][]byte{2: key2}, 2)
+	require.NoError(t, err)
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, retired)
+	require.NoError(t, err)
+
+	resource := getResource(t, databaseClient, testResourceID)
+	require.NoError(t, handler.DecryptSensitiveFields(ctx, resource.Properties, []string{"password"}, testResourceID))
+	require.Equal(t, "secret", resource.Properties["password"])
+	require.Equal(t, "first", resource.Properties["name"])
+
+	// A second pass has nothing to re-encrypt.
+	status, err = job.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, encryption.ReencryptionStateSucceeded, status.State)
+	require.Equal(t, 0, status.FieldsReencrypted)
+}
+
+func Test_Job_Run_Failure(t *testing.T) {
+	ctx := context.Background()
+	provider, databaseClient := setupRotatedKeys(t)
+
+	// A value encrypted for another resource cannot be decrypted.
+	resource := getResource(t, databaseClient, testResourceID)
+	saveResource(t, databaseClient, testResourceID2, resource.Properties)
+
+	store := &memoryStatusStore{}
+	job := &Job{
+		DatabaseClient: databaseClient,
+		UCPClient:      testUCPClientFactory(t),
+		KeyProvider:    provider,
+		StatusStore:    store,
+	}
+
+	status, err := job.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, encryption.ReencryptionStateFailed, status.State)
+	require.Equal(t, 1, status.Failures)
+	require.Equal(t, 1, status.FieldsReencrypted)
+	require.Contains(t, status.Message, testResourceID2)
+}
+
+// setupRotatedKeys creates a key provider whose current key version 2 replaced version 1, and a database with a
+// resource whose sensitive field was encrypted with version 1.
+func setupRotatedKeys(t *testing.T) (*encryption.InMemoryKeyProvider, database.Client) {
+	ctx := context.Background()
+
+	key1, err := encryption.GenerateKey()
+	require.NoError(t, err)
+	key2, err := encryption.GenerateKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewInMemoryKeyProviderWithVersions(map[int][]byte{1: key1, 2: key2}, 1)
+	require.NoError(t, err)
+
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, provider)
+	require.NoError(t, err)
+
+	properties := map[string]any{"name": "first", "password": "secret"}
+	require.NoError(t, handler.EncryptSensitiveFields(properties, []string{"password"}, testResourceID))
+
+	databaseClient := inmemory.NewClient()
+	saveResource(t, databaseClient, testResourceID, properties)
+
+	require.NoError(t, provider.SetCurrentVersion(2))
+	return provider, databaseClient
+}
+
+func saveResource(t *testing.T, databaseClient database.Client, id string, properties map[string]any) {
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Type: "Test.Resources/secrets"},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: testAPIVersion,
+			},
+		},
+		Properties: properties,
+	}
+
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data:     resource,
+	})
+	require.NoError(t, err)
+}
+
+func getResource(t *testing.T, databaseClient database.Client, id string) *datamodel.DynamicResource {
+	obj, err := databaseClient.Get(context.Background(), id)
+	require.NoError(t, err)
+
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	return resource
+}
+
+// testUCPClientFactory creates a fake UCP client factory with a single resource type Test.Resources/secrets whose
+// "password" property is sensitive.
+func testUCPClientFactory(t *testing.T) *v20231001preview.ClientFactory {
+	serverFactory := fake.ServerFactory{
+		RadiusPlanesServer: fake.RadiusPlanesServer{
+			NewListPager: func(options *v20231001preview.RadiusPlanesClientListOptions) (resp azfake.PagerResponder[v20231001preview.RadiusPlanesClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.RadiusPlanesClientListResponse{
+					RadiusPlaneResourceListResult: v20231001preview.RadiusPlaneResourceListResult{
+						Value: []*v20231001preview.RadiusPlaneResource{{Name: to.Ptr("local")}},
+					},
+				}, nil)
+				return
+			},
+		},
+		ResourceProvidersServer: fake.ResourceProvidersServer{
+			NewListPager: func(planeName string, options *v20231001preview.ResourceProvidersClientListOptions) (resp azfake.PagerResponder[v20231001preview.ResourceProvidersClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.ResourceProvidersClientListResponse{
+					ResourceProviderResourceListResult: v20231001preview.ResourceProviderResourceListResult{
+						Value: []*v20231001preview.ResourceProviderResource{{Name: to.Ptr("Test.Resources")}},
+					},
+				}, nil)
+				return
+			},
+		},
+		ResourceTypesServer: fake.ResourceTypesServer{
+			NewListPager: func(planeName string, resourceProviderName string, options *v20231001preview.ResourceTypesClientListOptions) (resp azfake.PagerResponder[v20231001preview.ResourceTypesClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.ResourceTypesClientListResponse{
+					ResourceTypeResourceListResult: v20231001preview.ResourceTypeResourceListResult{
+						Value: []*v20231001preview.ResourceTypeResource{{Name: to.Ptr("secrets")}, {Name: to.Ptr("plain")}},
+					},
+				}, nil)
+				return
+			},
+		},
+		APIVersionsServer: fake.APIVersionsServer{
+			NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+				properties := map[string]any{"name": map[string]any{"type": "string"}}
+				if resourceTypeName == "secrets" {
+					properties["password"] = map[string]any{"type": "string", "x-radius-sensitive": true}
+				}
+
+				resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+					APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+						Value: []*v20231001preview.APIVersionResource{
+							{
+								Name: to.Ptr(testAPIVersion),
+								Properties: &v20231001preview.APIVersionProperties{
+									Schema: map[string]any{"type": "object", "properties": properties},
+								},
+							},
+						},
+					},
+				}, nil)
+				return
+			},
+		},
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&serverFactory),
+		},
+	})
+	require.NoError(t, err)
+	return ucpClient
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryption
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between two checks of the current encryption key version.
+	DefaultInterval = 5 * time.Minute
+
+	// leaseName is the name of the Kubernetes lease held by the replica running the re-encryption passes.
+	leaseName = "dynamic-rp-reencryption"
+
+	// settleTime is how long a new key version must be current before a pass starts. It leaves time for the
+	// frontends to refresh their current key, so that no data is encrypted with an older key once the pass completes.
+	settleTime = 2 * encryption.KeyRefreshInterval
+)
+
+// Service runs a re-encryption pass each time the current encryption key version changes. The passes run in a single
+// replica, elected with a Kubernetes lease.
+type Service struct {
+	options *dynamicrp.Options
+}
+
+// NewService creates a new re-encryption service. It returns nil if re-encryption is disabled.
+func NewService(options *dynamicrp.Options) *Service {
+	if enabled := options.Config.Reencryption.Enabled; enabled != nil && !*enabled {
+		return nil
+	}
+
+	return &Service{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (s *Service) Name() string {
+	return "dynamic-rp sensitive field re-encryption"
+}
+
+// Run runs the service.
+func (s *Service) Run(ctx context.Context) error {
+	interval, err := parseInterval(s.options.Config.Reencryption)
+	if err != nil {
+		return err
+	}
+
+	databaseClient, err := s.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := s.options.KubernetesProvider.RuntimeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes runtime client: %w", err)
+	}
+
+	leaseClient, err := s.options.KubernetesProvider.ClientGoClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	keyProvider, err := s.options.KeyProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create encryption key provider: %w", err)
+	}
+
+	scheduler := &scheduler{
+		job: &Job{
+			DatabaseClient: databaseClient,
+			UCPClient:      ucpClient,
+			KeyProvider:    keyProvider,
+			StatusStore:    encryption.NewKubernetesKeyProvider(kubeClient, nil),
+		},
+		now: time.Now,
+	}
+
+	return kubeutil.RunAsLeader(ctx, leaseClient, encryption.RadiusNamespace, leaseName, func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			scheduler.tick(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// parseInterval returns the interval between two checks of the current encryption key version.
+func parseInterval(options hostoptions.ReencryptionOptions) (time.Duration, error) {
+	if options.Interval == "" {
+		return DefaultInterval, nil
+	}
+
+	interval, err := time.ParseDuration(options.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid re-encryption interval %q: %w", options.Interval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid re-encryption interval %q: must be positive", options.Interval)
+	}
+
+	return interval, nil
+}
+
+// scheduler decides when to run a re-encryption pass.
+type scheduler struct {
+	job *Job
+	now func() time.Time
+
+	// observedVersion is the current key version at the last check, and observedAt the time it was first observed.
+	observedVersion int
+	observedAt      time.Time
+}
+
+// tick runs a re-encryption pass if the current key version was not re-encrypted yet, and has been current for at
+// least settleTime. A failed pass is retried on the next tick.
+func (s *scheduler) tick(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, version, err := s.job.KeyProvider.GetCurrentKey(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to load the current encryption key")
+		return
+	}
+
+	if version != s.observedVersion {
+		s.observedVersion = version
+		s.observedAt = s.now()
+	}
+
+	status, err := s.job.StatusStore.GetReencryptionStatus(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to load the re-encryption status")
+		return
+	}
+
+	if status != nil && status.State == encryption.ReencryptionStateSucceeded && status.KeyVersion >= version {
+		return
+	}
+
+	if s.now().Sub(s.observedAt) < settleTime {
+		return
+	}
+
+	if _, err := s.job.Run(ctx); err != nil {
+		logger.Error(err, "Failed to re-encrypt sensitive fields", "keyVersion", version)
+	}
+}
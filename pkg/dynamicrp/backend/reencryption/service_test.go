@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryption
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Scheduler_Tick(t *testing.T) {
+	ctx := context.Background()
+	provider, databaseClient := setupRotatedKeys(t)
+
+	store := &memoryStatusStore{
+		status: &encryption.ReencryptionStatus{State: encryption.ReencryptionStateSucceeded, KeyVersion: 1},
+	}
+	now := time.Now()
+	s := &scheduler{
+		job: &Job{
+			DatabaseClient: databaseClient,
+			UCPClient:      testUCPClientFactory(t),
+			KeyProvider:    provider,
+			StatusStore:    store,
+		},
+		now: func() time.Time { return now },
+	}
+
+	// The new key version must settle before a pass starts.
+	s.tick(ctx)
+	require.Equal(t, 1, store.status.KeyVersion)
+	require.Equal(t, 0, store.saves)
+
+	now = now.Add(settleTime)
+	s.tick(ctx)
+	require.Equal(t, encryption.ReencryptionStateSucceeded, store.status.State)
+	require.Equal(t, 2, store.status.KeyVersion)
+
+	// The key version was re-encrypted already.
+	saves := store.saves
+	now = now.Add(settleTime)
+	s.tick(ctx)
+	require.Equal(t, saves, store.saves)
+}
+
+func Test_ParseInterval(t *testing.T) {
+	interval, err := parseInterval(hostoptions.ReencryptionOptions{Interval: ""})
+	require.NoError(t, err)
+	require.Equal(t, DefaultInterval, interval)
+
+	interval, err = parseInterval(hostoptions.ReencryptionOptions{Interval: "1m"})
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, interval)
+
+	_, err = parseInterval(hostoptions.ReencryptionOptions{Interval: "-1m"})
+	require.Error(t, err)
+
+	_, err = parseInterval(hostoptions.ReencryptionOptions{Interval: "soon"})
+	require.Error(t, err)
+}
@@ -55,11 +55,8 @@ func (w *Service) Name() string {
 
 // Run runs the service.
 func (w *Service) Run(ctx context.Context) error {
-	if w.options.Config.Worker.MaxOperationConcurrency != nil {
-		w.Service.Options.MaxOperationConcurrency = *w.options.Config.Worker.MaxOperationConcurrency
-	}
-	if w.options.Config.Worker.MaxOperationRetryCount != nil {
-		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
+	if err := w.Service.Options.ApplyConfig(w.options.Config.Worker); err != nil {
+		return err
 	}
 
 	e, err := w.options.RecipeEngine()
@@ -81,9 +78,10 @@ func (w *Service) Run(ctx context.Context) error {
 
 	w.Service.DatabaseClient = databaseClient
 	w.Service.QueueClient = queueClient
+	w.Service.HistoryRecorder = w.options.HistoryRecorder
 	w.Service.OperationStatusManager = w.options.StatusManager
 
-	err = w.registerControllers()
+	err = w.registerControllers(ctx)
 	if err != nil {
 		return err
 	}
@@ -91,15 +89,21 @@ func (w *Service) Run(ctx context.Context) error {
 	return w.Start(ctx)
 }
 
-func (w *Service) registerControllers() error {
+func (w *Service) registerControllers(ctx context.Context) error {
 	kubeClient, err := w.options.KubernetesProvider.RuntimeClient()
 	if err != nil {
 		return fmt.Errorf("failed to get Kubernetes runtime client: %w", err)
 	}
 
+	keyProvider, err := w.options.KeyProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create encryption key provider: %w", err)
+	}
+
 	options := ctrl.Options{
 		DatabaseClient: w.Service.DatabaseClient,
 		KubeClient:     kubeClient,
+		KeyProvider:    keyProvider,
 	}
 
 	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(w.options.UCP))
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"gopkg.in/yaml.v3"
@@ -39,9 +40,18 @@ type Config struct {
 	// Bicep configures properties for the Bicep recipe driver.
 	Bicep hostoptions.BicepOptions `yaml:"bicep"`
 
+	// ClientIdentity is the configuration for verifying the client identity of the requests proxied by UCP.
+	ClientIdentity hostoptions.ClientIdentityOptions `yaml:"clientIdentity"`
+
 	// Database is the configuration for the database.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
+	// DriftDetection is the configuration for the detection of drift in the infrastructure deployed by recipes.
+	DriftDetection hostoptions.DriftDetectionOptions `yaml:"driftDetection"`
+
+	// Encryption is the configuration for the keys encrypting sensitive fields.
+	Encryption encryption.KeyProviderOptions `yaml:"encryption"`
+
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
@@ -54,15 +64,30 @@ type Config struct {
 	// Metrics is the configuration for the metrics endpoint.
 	Metrics metricsservice.Options `yaml:"metricsProvider"`
 
+	// OpenTofu configures properties for the OpenTofu recipe driver.
+	OpenTofu hostoptions.OpenTofuOptions `yaml:"opentofu"`
+
+	// OperationHistory is the configuration for the durable history of async operations.
+	OperationHistory hostoptions.OperationHistoryOptions `yaml:"operationHistory"`
+
 	// Profiler is the configuration for the profiler endpoint.
 	Profiler profilerservice.Options `yaml:"profilerProvider"`
 
+	// Pulumi configures properties for the Pulumi recipe driver.
+	Pulumi hostoptions.PulumiOptions `yaml:"pulumi"`
+
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
+	// Reencryption is the configuration for the re-encryption of sensitive fields after a key rotation.
+	Reencryption hostoptions.ReencryptionOptions `yaml:"reencryption"`
+
 	// Secrets is the configuration for the secret storage system.
 	Secrets secretprovider.SecretProviderOptions `yaml:"secretProvider"`
 
+	// SensitiveDataAccess is the configuration for reading sensitive fields with the listSecrets action.
+	SensitiveDataAccess hostoptions.SensitiveDataAccessOptions `yaml:"sensitiveDataAccess"`
+
 	// Server is the configuration for the HTTP server.
 	Server hostoptions.ServerOptions `yaml:"server"`
 
@@ -27,6 +27,10 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
+// OperationPlanRecipe is the operation method of the planRecipe action, which previews the changes the recipe of a
+// dynamic resource would make to its infrastructure.
+const OperationPlanRecipe v1.OperationMethod = "ACTIONPLANRECIPE"
+
 var _ v1.ResourceDataModel = (*DynamicResource)(nil)
 var _ rpv1.RadiusResourceModel = (*DynamicResource)(nil)
 var _ datamodel.RecipeDataModel = (*DynamicResource)(nil)
@@ -40,6 +44,13 @@ type DynamicResource struct {
 
 	// Properties stores the properties of the resource being tracked.
 	Properties map[string]any `json:"properties"`
+
+	// SensitiveProperties stores the encrypted values of the sensitive properties of the resource.
+	//
+	// Sensitive properties are redacted from Properties once the resource has been processed, the encrypted
+	// values are retained here so they can be returned by the listSecrets action. This field is never
+	// returned by the API.
+	SensitiveProperties map[string]any `json:"sensitiveProperties,omitempty"`
 }
 
 // Status() returns the status of the resource.
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// converterCache fetches the converter of a resource type at most once per request.
+type converterCache struct {
+	ucpClient *v20231001preview.ClientFactory
+	converter *schema.Converter
+	fetched   bool
+}
+
+// get returns the converter of the resource type.
+func (c *converterCache) get(ctx context.Context, resourceID string, resourceType string) (*schema.Converter, error) {
+	if c.fetched {
+		return c.converter, nil
+	}
+
+	converter, err := schema.GetConverter(ctx, c.ucpClient, resourceID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.converter = converter
+	c.fetched = true
+	return converter, nil
+}
+
+// convertToRequestedVersion converts the properties of the resource from the API version it is stored with to the
+// API version of the request. Resources are returned unchanged when no conversion is declared between the versions.
+func (c *converterCache) convertToRequestedVersion(ctx context.Context, resource *datamodel.DynamicResource) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	storedVersion := resource.InternalMetadata.UpdatedAPIVersion
+	if storedVersion == "" || storedVersion == serviceCtx.APIVersion || resource.Properties == nil {
+		return nil, nil
+	}
+
+	converter, err := c.get(ctx, resource.ID, resource.Type)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to fetch the conversion rules", "resourceType", resource.Type)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for API version conversion",
+			},
+		}), nil
+	}
+
+	if !converter.HasConversions() || !converter.CanConvert(storedVersion, serviceCtx.APIVersion) {
+		return nil, nil
+	}
+
+	properties, err := converter.Convert(resource.Properties, storedVersion, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	resource.Properties = properties
+
+	return nil, nil
+}
+
+// makeConversionFilter creates an UpdateFilter that converts the resource's Properties map to the storage version of
+// its resource type, which is the newest API version the default API version converts to. The resource is stored
+// unchanged with the API version of the request when no conversion is declared.
+//
+// The filter runs before the encryption filter, so that sensitive fields are encrypted at their path in the storage
+// version, where they are decrypted by the backend.
+func makeConversionFilter(ucpClient *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return convertToStorageVersion(ctx, newResource, ucpClient)
+	}
+}
+
+// convertToStorageVersion converts the properties of the resource from the API version of the request to the storage
+// version, and records the storage version as the API version of the resource. The request is rejected if the
+// properties cannot be read back in the API version of the request after the conversion.
+func convertToStorageVersion(ctx context.Context, resource *datamodel.DynamicResource, ucpClient *v20231001preview.ClientFactory) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resourceType := serviceCtx.ResourceID.Type()
+
+	converter, err := schema.GetConverter(ctx, ucpClient, serviceCtx.ResourceID.String(), resourceType)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to fetch the conversion rules", "resourceType", resourceType)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for API version conversion",
+			},
+		}), nil
+	}
+
+	if !converter.HasConversions() {
+		return nil, nil
+	}
+
+	storageVersion := converter.StorageVersion
+	if storageVersion == "" || storageVersion == serviceCtx.APIVersion || !converter.CanConvert(serviceCtx.APIVersion, storageVersion) {
+		return nil, nil
+	}
+
+	properties, err := converter.ConvertLossless(resource.Properties, serviceCtx.APIVersion, storageVersion)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	resource.Properties = properties
+	resource.InternalMetadata.UpdatedAPIVersion = storageVersion
+	return nil, nil
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	// testStorageAPIVersion is the default API version of the resource type in the conversion tests. It renames the
+	// "host" property of testAPIVersion to "hostname".
+	testStorageAPIVersion = "2024-01-01"
+
+	testPutURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource?api-version=2023-10-01-preview"
+)
+
+func TestGetResourceWithRedaction_ConvertsToRequestedVersion(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"hostname": "localhost",
+	})
+	resource.InternalMetadata.UpdatedAPIVersion = testStorageAPIVersion
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithConversions()
+	require.NoError(t, err)
+
+	c := newTestGetController(t, databaseClient, ucpClient)
+
+	req, err := http.NewRequest(http.MethodGet, testGetURL, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	properties, ok := body["properties"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "localhost", properties["host"])
+	require.NotContains(t, properties, "hostname")
+}
+
+func TestListResourcesWithRedaction_ConvertsToRequestedVersion(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	converted := newTestDynamicResource(testResourceID, "myResource", v1.ProvisioningStateSucceeded, map[string]any{
+		"hostname": "localhost",
+	})
+	converted.InternalMetadata.UpdatedAPIVersion = testStorageAPIVersion
+
+	// Resources stored with the API version of the request are returned unchanged.
+	unchanged := newTestDynamicResource(testResourceID+"2", "myResource2", v1.ProvisioningStateSucceeded, map[string]any{
+		"host": "example.com",
+	})
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.ObjectQueryResult{
+			Items: []database.Object{*rpctest.FakeStoreObject(converted), *rpctest.FakeStoreObject(unchanged)},
+		}, nil)
+
+	ucpClient, err := testUCPClientFactoryWithConversions()
+	require.NoError(t, err)
+
+	c := newTestListController(t, databaseClient, ucpClient)
+
+	req, err := http.NewRequest(http.MethodGet, testListURL, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var body struct {
+		Value []map[string]any `json:"value"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	require.Len(t, body.Value, 2)
+	require.Equal(t, "localhost", body.Value[0]["properties"].(map[string]any)["host"])
+	require.NotContains(t, body.Value[0]["properties"], "hostname")
+	require.Equal(t, "example.com", body.Value[1]["properties"].(map[string]any)["host"])
+}
+
+func TestCreateOrUpdateResourceWithConversion_StoresStorageVersion(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	var saved *database.Object
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(nil, &database.ErrNotFound{ID: testResourceID})
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			saved = obj
+			return nil
+		})
+
+	statusManager := statusmanager.NewMockStatusManager(mctrl)
+	statusManager.EXPECT().
+		QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	ucpClient, err := testUCPClientFactoryWithConversions()
+	require.NoError(t, err)
+
+	c := newTestPutController(t, databaseClient, statusManager, ucpClient)
+
+	body := []byte(`{"location": "global", "properties": {"host": "localhost"}}`)
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPut, testPutURL, body)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusCreated, w.Result().StatusCode)
+
+	// The resource is stored with the storage API version.
+	require.NotNil(t, saved)
+	stored, ok := saved.Data.(*datamodel.DynamicResource)
+	require.True(t, ok)
+	require.Equal(t, testStorageAPIVersion, stored.InternalMetadata.UpdatedAPIVersion)
+	require.Equal(t, map[string]any{"hostname": "localhost"}, stored.Properties)
+
+	// The response is returned in the API version of the request.
+	var response map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	properties, ok := response["properties"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "localhost", properties["host"])
+	require.NotContains(t, properties, "hostname")
+}
+
+func TestCreateOrUpdateResourceWithConversion_ConversionError(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+	ucpClient, err := testUCPClientFactoryWithConversions()
+	require.NoError(t, err)
+
+	c := newTestPutController(t, databaseClient, statusmanager.NewMockStatusManager(mctrl), ucpClient)
+
+	body := []byte(`{"location": "global", "properties": {"host": "localhost", "hostname": "example.com"}}`)
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPut, testPutURL, body)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_, ok := resp.(*rest.BadRequestResponse)
+	require.True(t, ok)
+}
+
+func TestConvertToStorageVersion_RoundTrip(t *testing.T) {
+	// The default API version is the older testAPIVersion, and testStorageAPIVersion adds "protocol" with a default
+	// value. Resources are stored with testStorageAPIVersion, so a value set in it is not removed.
+	schemas := map[string]map[string]any{
+		testAPIVersion: {
+			"type": "object",
+		},
+		testStorageAPIVersion: {
+			"type": "object",
+			"x-radius-conversions": map[string]any{
+				testAPIVersion: []any{
+					map[string]any{"default": "protocol", "value": "tcp"},
+				},
+			},
+		},
+	}
+
+	ucpClient, err := testUCPClientFactory(schemas, testAPIVersion)
+	require.NoError(t, err)
+
+	for _, apiVersion := range []string{testAPIVersion, testStorageAPIVersion} {
+		t.Run(apiVersion, func(t *testing.T) {
+			properties := map[string]any{"host": "localhost"}
+			if apiVersion == testStorageAPIVersion {
+				properties["protocol"] = "udp"
+			}
+
+			req, err := http.NewRequest(http.MethodPut, testResourceID+"?api-version="+apiVersion, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			resource := newTestDynamicResource(testResourceID, "myResource", v1.ProvisioningStateSucceeded, maps.Clone(properties))
+			resource.InternalMetadata.UpdatedAPIVersion = apiVersion
+
+			resp, err := convertToStorageVersion(ctx, resource, ucpClient)
+			require.NoError(t, err)
+			require.Nil(t, resp)
+			require.Equal(t, testStorageAPIVersion, resource.InternalMetadata.UpdatedAPIVersion)
+
+			cache := &converterCache{ucpClient: ucpClient}
+			resp, err = cache.convertToRequestedVersion(ctx, resource)
+			require.NoError(t, err)
+			require.Nil(t, resp)
+			require.Equal(t, properties, resource.Properties)
+		})
+	}
+}
+
+func newTestPutController(t *testing.T, databaseClient database.Client, statusManager statusmanager.StatusManager, ucpClient *v20231001preview.ClientFactory) ctrl.Controller {
+	t.Helper()
+
+	opts := ctrl.Options{
+		DatabaseClient: databaseClient,
+		StatusManager:  statusManager,
+	}
+	resourceOpts := ctrl.ResourceOptions[datamodel.DynamicResource]{
+		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+		UpdateFilters: []ctrl.UpdateFilter[datamodel.DynamicResource]{
+			makeConversionFilter(ucpClient),
+		},
+	}
+
+	c, err := NewCreateOrUpdateResourceWithConversion(opts, resourceOpts, ucpClient)
+	require.NoError(t, err)
+
+	return c
+}
+
+// testUCPClientFactoryWithConversions creates a mock UCP client factory for a resource type whose default API version
+// testStorageAPIVersion renames the "host" property of testAPIVersion to "hostname".
+func testUCPClientFactoryWithConversions() (*v20231001preview.ClientFactory, error) {
+	return testUCPClientFactory(map[string]map[string]any{
+		testAPIVersion: {
+			"type": "object",
+		},
+		testStorageAPIVersion: {
+			"type": "object",
+			"x-radius-conversions": map[string]any{
+				testAPIVersion: []any{
+					map[string]any{"rename": "host", "to": "hostname"},
+				},
+			},
+		},
+	}, testStorageAPIVersion)
+}
+
+// testUCPClientFactory creates a mock UCP client factory for a resource type with the given API version schemas and
+// default API version.
+func testUCPClientFactory(schemas map[string]map[string]any, defaultAPIVersion string) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name:       to.Ptr(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{Schema: schemas[apiVersionName]},
+				},
+			}, nil)
+			return
+		},
+		NewListPager: func(planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			page := v20231001preview.APIVersionsClientListResponse{}
+			for version, schema := range schemas {
+				page.Value = append(page.Value, &v20231001preview.APIVersionResource{
+					Name:       to.Ptr(version),
+					Properties: &v20231001preview.APIVersionProperties{Schema: schema},
+				})
+			}
+			resp.AddPage(http.StatusOK, page, nil)
+			return
+		},
+	}
+
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Properties: &v20231001preview.ResourceTypeProperties{
+						DefaultAPIVersion: to.Ptr(defaultAPIVersion),
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// CreateOrUpdateResourceWithConversion is a custom async PUT controller that returns the resource in the API version
+// of the request when it is stored with another API version.
+type CreateOrUpdateResourceWithConversion struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient *v20231001preview.ClientFactory
+}
+
+// NewCreateOrUpdateResourceWithConversion creates a new CreateOrUpdateResourceWithConversion controller.
+func NewCreateOrUpdateResourceWithConversion(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+) (ctrl.Controller, error) {
+	return &CreateOrUpdateResourceWithConversion{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient: ucpClient,
+	}, nil
+}
+
+// Run creates or updates the resource like the default async PUT controller. The conversion filter may store the
+// resource with the default API version of its resource type, so that recipes and readers of the resource see a
+// single shape of its properties. The response is returned in the API version of the request.
+func (c *CreateOrUpdateResourceWithConversion) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range c.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, c.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	if r, err := c.PrepareAsyncOperation(ctx, newResource, v1.ProvisioningStateAccepted, c.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	// The conversion returns a copy of the properties: the stored resource is left unchanged.
+	response := *newResource
+	conversions := &converterCache{ucpClient: c.ucpClient}
+	if r, err := conversions.convertToRequestedVersion(ctx, &response); r != nil || err != nil {
+		return r, err
+	}
+
+	return c.ConstructAsyncResponse(ctx, req.Method, etag, &response)
+}
@@ -64,7 +64,12 @@ func encryptSensitiveFields(
 
 	resourceID := serviceCtx.ResourceID.String()
 	resourceType := serviceCtx.ResourceID.Type()
-	apiVersion := serviceCtx.APIVersion
+
+	// Encrypt with the schema of the API version the resource is stored with, which the backend uses to decrypt it.
+	apiVersion := newResource.InternalMetadata.UpdatedAPIVersion
+	if apiVersion == "" {
+		apiVersion = serviceCtx.APIVersion
+	}
 
 	// If encryption handler is not configured, return an error.
 	if handler == nil {
@@ -106,6 +111,12 @@ func encryptSensitiveFields(
 		return nil, nil
 	}
 
+	// Pick up a rotated key, so that new data is not encrypted with a key version that is about to be retired. The
+	// previous key remains valid during the rotation grace period, so a failure to reload it is not fatal.
+	if err := handler.RefreshCurrentKey(ctx); err != nil {
+		logger.Error(err, "Failed to refresh the current encryption key", "keyVersion", handler.KeyVersion())
+	}
+
 	// Encrypt sensitive fields in the Properties map
 	// Field paths from schema are relative to "properties", so we operate on Properties directly
 	if err := handler.EncryptSensitiveFields(
@@ -114,6 +114,43 @@ func TestMakeEncryptionFilter_WithSensitiveFields(t *testing.T) {
 	require.Contains(t, encryptedData, "version")
 }
 
+func TestMakeEncryptionFilter_UsesStoredAPIVersion(t *testing.T) {
+	// Sensitive fields are looked up in the schema of the API version the resource is stored with
+	requested := []string{}
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			requested = append(requested, apiVersionName)
+			resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: new(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: map[string]any{"type": "object"},
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeEncryptionFilter(ucpClient, createTestHandler(t))
+
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{"name": "test"},
+	}
+	resource.InternalMetadata.UpdatedAPIVersion = "2024-01-01"
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, []string{"2024-01-01"}, requested)
+}
+
 func TestMakeEncryptionFilter_NilProperties(t *testing.T) {
 	// When resource has nil properties, filter should pass through
 	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
@@ -29,7 +29,8 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// GetResourceWithRedaction is a custom GET controller that redacts sensitive fields.
+// GetResourceWithRedaction is a custom GET controller that redacts sensitive fields and converts the resource to the
+// API version of the request.
 type GetResourceWithRedaction struct {
 	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
 	ucpClient *v20231001preview.ClientFactory
@@ -47,7 +48,7 @@ func NewGetResourceWithRedaction(
 	}, nil
 }
 
-// Run returns the requested resource with sensitive fields redacted.
+// Run returns the requested resource with sensitive fields redacted, in the API version of the request.
 //
 // Design consideration (GET Operation Update): When provisioningState is "Succeeded",
 // the backend has already redacted sensitive data from the database, so we skip the
@@ -105,5 +106,11 @@ func (c *GetResourceWithRedaction) Run(ctx context.Context, w http.ResponseWrite
 		}
 	}
 
+	// Return the resource in the API version of the request.
+	conversions := &converterCache{ucpClient: c.ucpClient}
+	if r, err := conversions.convertToRequestedVersion(ctx, resource); r != nil || err != nil {
+		return r, err
+	}
+
 	return c.ConstructSyncResponse(ctx, req.Method, etag, resource)
 }
@@ -30,7 +30,8 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// ListResourcesWithRedaction is a custom LIST controller that redacts sensitive fields.
+// ListResourcesWithRedaction is a custom LIST controller that redacts sensitive fields and converts the resources to
+// the API version of the request.
 type ListResourcesWithRedaction struct {
 	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
 	ucpClient          *v20231001preview.ClientFactory
@@ -50,7 +51,7 @@ func NewListResourcesWithRedaction(
 	}, nil
 }
 
-// Run returns the list of resources with sensitive fields redacted.
+// Run returns the list of resources with sensitive fields redacted, in the API version of the request.
 func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -70,6 +71,9 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 	// Different resources in the list may have been created with different API versions
 	sensitiveFieldPathsCache := make(map[string][]string)
 
+	// Resources are returned in the API version of the request. They share the conversion rules of the resource type.
+	conversions := &converterCache{ucpClient: c.ucpClient}
+
 	items := []any{}
 	for _, item := range result.Items {
 		resource := &datamodel.DynamicResource{}
@@ -116,6 +120,10 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 			}
 		}
 
+		if r, err := conversions.convertToRequestedVersion(ctx, resource); r != nil || err != nil {
+			return r, err
+		}
+
 		versioned, err := c.ResponseConverter()(resource, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// OperationListSecrets is the operation method of the listSecrets action.
+const OperationListSecrets v1.OperationMethod = "ACTIONLISTSECRETS"
+
+var _ ctrl.Controller = (*ListSecrets)(nil)
+
+// ListSecrets is the controller implementation of the listSecrets action, which returns the decrypted sensitive
+// fields of a dynamic resource to authorized callers.
+type ListSecrets struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient *v20231001preview.ClientFactory
+	handler   *encryption.SensitiveDataHandler
+	policy    *sensitiveDataAccessPolicy
+}
+
+// NewListSecrets creates a new instance of ListSecrets.
+func NewListSecrets(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+	handler *encryption.SensitiveDataHandler,
+	accessOptions hostoptions.SensitiveDataAccessOptions,
+) (ctrl.Controller, error) {
+	return &ListSecrets{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient: ucpClient,
+		handler:   handler,
+		policy:    newSensitiveDataAccessPolicy(accessOptions),
+	}, nil
+}
+
+// Run returns the decrypted sensitive fields of the specified resource, in the API version of the request.
+//
+// The encrypted values are read from the sensitive properties the backend retains when it redacts the resource, or
+// from the properties if the resource has not been processed yet. Every access is recorded in the audit log.
+func (c *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Request route for listSecrets has name of the operation as suffix which should be removed to get the resource id.
+	// route id format: /planes/radius/<plane>/resourceGroups/<resource_group>/providers/<namespace>/<type>/<resource_name>/listSecrets
+	resourceID := serviceCtx.ResourceID.Truncate()
+	resourceType := resourceID.Type()
+	caller := callerName(serviceCtx)
+
+	if !c.policy.allows(serviceCtx, resourceType) {
+		logger.Info("Denied access to sensitive fields", "resourceID", resourceID.String(), "caller", caller)
+		return rest.NewForbiddenResponse(resourceID, fmt.Sprintf("the caller is not authorized to read the sensitive fields of resource type '%s'", resourceType)), nil
+	}
+
+	resource, _, err := c.GetResource(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(resourceID), nil
+	}
+
+	if c.handler == nil {
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Sensitive data handler is not configured",
+			},
+		}), nil
+	}
+
+	// Sensitive fields are encrypted with the schema of the API version the resource is stored with.
+	apiVersion := resource.InternalMetadata.UpdatedAPIVersion
+	resourceSchema, err := schema.GetSchema(ctx, c.ucpClient, resourceID.String(), resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema for listSecrets", "resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for security validation",
+			},
+		}), nil
+	}
+
+	sensitiveFieldPaths := schema.ExtractSensitiveFieldPaths(resourceSchema, "")
+	if len(sensitiveFieldPaths) == 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("resource type '%s' does not define sensitive fields", resourceType)), nil
+	}
+
+	encrypted := resource.SensitiveProperties
+	if encrypted == nil {
+		encrypted = resource.Properties
+	}
+
+	secrets := schema.SelectFields(encrypted, sensitiveFieldPaths)
+	if err := c.handler.DecryptSensitiveFieldsWithSchema(ctx, secrets, sensitiveFieldPaths, resourceID.String(), resourceSchema); err != nil {
+		logger.Error(err, "Failed to decrypt sensitive fields for listSecrets", "resourceID", resourceID.String())
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to decrypt sensitive fields",
+			},
+		}), nil
+	}
+
+	// Return the sensitive fields in the API version of the request.
+	converted := &datamodel.DynamicResource{BaseResource: resource.BaseResource, Properties: secrets}
+	conversions := &converterCache{ucpClient: c.ucpClient}
+	if r, err := conversions.convertToRequestedVersion(ctx, converted); r != nil || err != nil {
+		return r, err
+	}
+
+	if apiVersion != "" && apiVersion != serviceCtx.APIVersion {
+		requestedPaths, err := schema.GetSensitiveFieldPaths(ctx, c.ucpClient, resourceID.String(), resourceType, serviceCtx.APIVersion)
+		if err != nil {
+			logger.Error(err, "Failed to fetch sensitive field paths for listSecrets", "resourceType", resourceType, "apiVersion", serviceCtx.APIVersion)
+			return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: "Failed to fetch schema for security validation",
+				},
+			}), nil
+		}
+		converted.Properties = schema.SelectFields(converted.Properties, requestedPaths)
+	}
+
+	logger.Info("Returned sensitive fields", "resourceID", resourceID.String(), "caller", caller, "count", len(sensitiveFieldPaths))
+	return rest.NewOKResponse(converted.Properties), nil
+}
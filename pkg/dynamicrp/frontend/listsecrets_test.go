@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testListSecretsURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource/listSecrets?api-version=2023-10-01-preview"
+	testCaller         = "user@contoso.com"
+)
+
+var testAccessOptions = hostoptions.SensitiveDataAccessOptions{
+	Rules: []hostoptions.SensitiveDataAccessRule{
+		{Callers: []string{testCaller}, ResourceTypes: []string{"Applications.Test/testResources"}},
+	},
+}
+
+func newTestListSecretsController(t *testing.T, databaseClient database.Client, ucpClient *v20231001preview.ClientFactory, handler *encryption.SensitiveDataHandler, accessOptions hostoptions.SensitiveDataAccessOptions) controller.Controller {
+	t.Helper()
+
+	opts := controller.Options{
+		DatabaseClient: databaseClient,
+	}
+	resourceOpts := controller.ResourceOptions[datamodel.DynamicResource]{
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+	}
+
+	c, err := NewListSecrets(opts, resourceOpts, ucpClient, handler, accessOptions)
+	require.NoError(t, err)
+
+	return c
+}
+
+func runListSecrets(t *testing.T, c controller.Controller, caller string) (rest.Response, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, testListSecretsURL, nil)
+	require.NoError(t, err)
+	if caller != "" {
+		req.Header.Set(v1.ClientPrincipalNameHeader, caller)
+	}
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NoError(t, resp.Apply(ctx, w, req))
+
+	return resp, w
+}
+
+func newEncryptedTestResource(t *testing.T, handler *encryption.SensitiveDataHandler, provisioningState v1.ProvisioningState) *datamodel.DynamicResource {
+	t.Helper()
+
+	properties := map[string]any{
+		"name":     "test",
+		"password": "secret123",
+	}
+	require.NoError(t, handler.EncryptSensitiveFields(properties, []string{"password"}, testResourceID))
+
+	return newGetTestDynamicResource(provisioningState, properties)
+}
+
+func TestListSecrets_ReturnsRetainedSensitiveProperties(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	handler := createTestHandler(t)
+
+	// After backend processing, the properties are redacted and the encrypted values are retained separately.
+	resource := newEncryptedTestResource(t, handler, v1.ProvisioningStateSucceeded)
+	resource.SensitiveProperties = map[string]any{"password": resource.Properties["password"]}
+	resource.Properties["password"] = nil
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().Get(gomock.Any(), testResourceID).Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
+	require.NoError(t, err)
+
+	c := newTestListSecretsController(t, databaseClient, ucpClient, handler, testAccessOptions)
+	_, w := runListSecrets(t, c, testCaller)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, map[string]any{"password": "secret123"}, body)
+}
+
+func TestListSecrets_ReturnsPropertiesBeforeProcessing(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	handler := createTestHandler(t)
+
+	resource := newEncryptedTestResource(t, handler, v1.ProvisioningStateAccepted)
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().Get(gomock.Any(), testResourceID).Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
+	require.NoError(t, err)
+
+	c := newTestListSecretsController(t, databaseClient, ucpClient, handler, testAccessOptions)
+	_, w := runListSecrets(t, c, testCaller)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, map[string]any{"password": "secret123"}, body)
+}
+
+func TestListSecrets_Forbidden(t *testing.T) {
+	tests := []struct {
+		name          string
+		caller        string
+		accessOptions hostoptions.SensitiveDataAccessOptions
+	}{
+		{
+			name:          "no rules",
+			caller:        testCaller,
+			accessOptions: hostoptions.SensitiveDataAccessOptions{},
+		},
+		{
+			name:          "anonymous caller",
+			caller:        "",
+			accessOptions: testAccessOptions,
+		},
+		{
+			name:          "other caller",
+			caller:        "other@contoso.com",
+			accessOptions: testAccessOptions,
+		},
+		{
+			name:   "other resource type",
+			caller: testCaller,
+			accessOptions: hostoptions.SensitiveDataAccessOptions{
+				Rules: []hostoptions.SensitiveDataAccessRule{
+					{Callers: []string{testCaller}, ResourceTypes: []string{"Applications.Test/otherResources"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+
+			// The resource is not read when access is denied.
+			databaseClient := database.NewMockClient(mctrl)
+
+			c := newTestListSecretsController(t, databaseClient, nil, createTestHandler(t), tt.accessOptions)
+			resp, w := runListSecrets(t, c, tt.caller)
+			require.IsType(t, &rest.ForbiddenResponse{}, resp)
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestListSecrets_NotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().Get(gomock.Any(), testResourceID).Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+	c := newTestListSecretsController(t, databaseClient, nil, createTestHandler(t), testAccessOptions)
+	_, w := runListSecrets(t, c, testCaller)
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestListSecrets_NoSensitiveFields(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"name": "test"})
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().Get(gomock.Any(), testResourceID).Return(rpctest.FakeStoreObject(resource), nil)
+
+	ucpClient, err := testUCPClientFactoryNoSensitiveFields()
+	require.NoError(t, err)
+
+	c := newTestListSecretsController(t, databaseClient, ucpClient, createTestHandler(t), testAccessOptions)
+	_, w := runListSecrets(t, c, testCaller)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSensitiveDataAccessPolicy_Allows(t *testing.T) {
+	tests := []struct {
+		name       string
+		rules      []hostoptions.SensitiveDataAccessRule
+		serviceCtx *v1.ARMRequestContext
+		allowed    bool
+	}{
+		{
+			name:       "wildcard caller",
+			rules:      []hostoptions.SensitiveDataAccessRule{{Callers: []string{"*"}}},
+			serviceCtx: &v1.ARMRequestContext{ClientPrincipalName: testCaller},
+			allowed:    true,
+		},
+		{
+			name:       "wildcard caller without identity",
+			rules:      []hostoptions.SensitiveDataAccessRule{{Callers: []string{"*"}}},
+			serviceCtx: &v1.ARMRequestContext{},
+			allowed:    false,
+		},
+		{
+			name:       "principal name with wildcard resource type",
+			rules:      []hostoptions.SensitiveDataAccessRule{{Callers: []string{"system:serviceaccount:default:app"}, ResourceTypes: []string{"*"}}},
+			serviceCtx: &v1.ARMRequestContext{ClientPrincipalName: "system:serviceaccount:default:APP"},
+			allowed:    true,
+		},
+		{
+			name:       "unverified identity headers",
+			rules:      []hostoptions.SensitiveDataAccessRule{{Callers: []string{"app-id", "object-id", "principal-id"}}},
+			serviceCtx: &v1.ARMRequestContext{ClientApplicationID: "app-id", ClientObjectID: "object-id", ClientPrincipalID: "principal-id"},
+			allowed:    false,
+		},
+		{
+			name: "second rule",
+			rules: []hostoptions.SensitiveDataAccessRule{
+				{Callers: []string{"*"}, ResourceTypes: []string{"Other.Test/resources"}},
+				{Callers: []string{testCaller}, ResourceTypes: []string{"applications.test/testresources"}},
+			},
+			serviceCtx: &v1.ARMRequestContext{ClientPrincipalName: testCaller},
+			allowed:    true,
+		},
+		{
+			name:       "rule without callers",
+			rules:      []hostoptions.SensitiveDataAccessRule{{ResourceTypes: []string{"*"}}},
+			serviceCtx: &v1.ARMRequestContext{ClientPrincipalName: testCaller},
+			allowed:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newSensitiveDataAccessPolicy(hostoptions.SensitiveDataAccessOptions{Rules: tt.rules})
+			require.Equal(t, tt.allowed, policy.allows(tt.serviceCtx, "Applications.Test/testResources"))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation of the planRecipe action, which previews the changes the recipe of a
+// dynamic resource would make to its infrastructure.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+}
+
+// NewPlanRecipe creates a new instance of PlanRecipe.
+func NewPlanRecipe(opts ctrl.Options, resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource]) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+	}, nil
+}
+
+// Run queues the async operation planning the recipe of the specified resource. The resource is planned as it is
+// stored, and the plan is returned by the operation result once the operation succeeded.
+func (c *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resource, _, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return c.QueueAsyncAction(ctx, nil)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testPlanRecipeURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource/planRecipe?api-version=2023-10-01-preview"
+
+func TestPlanRecipe(t *testing.T) {
+	setup := func(t *testing.T) (*database.MockClient, *statusmanager.MockStatusManager, controller.Controller) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		msm := statusmanager.NewMockStatusManager(mctrl)
+
+		c, err := NewPlanRecipe(controller.Options{DatabaseClient: databaseClient, StatusManager: msm}, controller.ResourceOptions[datamodel.DynamicResource]{})
+		require.NoError(t, err)
+
+		return databaseClient, msm, c
+	}
+
+	run := func(t *testing.T, c controller.Controller) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, testPlanRecipeURL, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+		w := httptest.NewRecorder()
+
+		resp, err := c.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		return w
+	}
+
+	t.Run("queues plan", func(t *testing.T) {
+		databaseClient, msm, c := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), testResourceID).
+			Return(rpctest.FakeStoreObject(newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"name": "test"})), nil)
+		msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+				require.Equal(t, testResourceID, sCtx.ResourceID.String())
+				require.Nil(t, options.Body)
+				return nil
+			})
+
+		w := run(t, c)
+		require.Equal(t, http.StatusAccepted, w.Code)
+		require.NotEmpty(t, w.Header().Get("Location"))
+		require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+	})
+
+	t.Run("resource not found", func(t *testing.T) {
+		databaseClient, _, c := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), testResourceID).Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+		w := run(t, c)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/admission"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -50,14 +51,29 @@ func (s *Service) registerRoutes(
 		pathBase = pathBase + "/"
 	}
 
+	// Create schema filter for defaults, readOnly and immutable fields
+	schemaFilter := makeSchemaFilter(ucpClient)
+
+	// Create validation webhook filter for the business rules of resource types
+	webhookFilter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+	// Create conversion filter for the storage API version
+	conversionFilter := makeConversionFilter(ucpClient)
+
 	// Create encryption filter for sensitive fields
 	encryptionFilter := makeEncryptionFilter(ucpClient, handler)
 
-	// Resource options with encryption filter applied to PUT operations
+	// Resource options with schema, validation webhook, conversion and encryption filters applied to PUT operations. The
+	// schema filter runs first so that immutable fields are compared and defaults are set in the API version of the
+	// request, the validation webhook sees the resource in the API version of the request, and sensitive fields are
+	// encrypted last, in the API version the resource is stored with.
 	resourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
 		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
 		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
 		UpdateFilters: []controller.UpdateFilter[datamodel.DynamicResource]{
+			schemaFilter,
+			webhookFilter,
+			conversionFilter,
 			encryptionFilter,
 		},
 		AsyncOperationRetryAfter: time.Second * 5,
@@ -80,7 +96,10 @@ func (s *Service) registerRoutes(
 			// Async operation status/results
 			r.Route("/locations/{locationName}", func(r chi.Router) {
 				r.Get("/{or:operation[Rr]esults}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationResultController))
+				r.Get("/operations", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListOperationHistoryController))
+				r.Get("/{os:operation[Ss]tatuses}", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListOperationStatusesController))
 				r.Get("/{os:operation[Ss]tatuses}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationStatusController))
+				r.Post("/{os:operation[Ss]tatuses}/{operationID}/cancel", dynamicOperationHandler(v1.OperationCancel, controllerOptions, makeCancelOperationController))
 			})
 		})
 
@@ -96,12 +115,20 @@ func (s *Service) registerRoutes(
 				}))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
-					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
+					return NewCreateOrUpdateResourceWithConversion(opts, resourceOptions, ucpClient)
 				}))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
 				}))
+			r.Post("/{resourceName}/{ls:list[Ss]ecrets}", dynamicOperationHandler(OperationListSecrets, controllerOptions,
+				func(opts controller.Options) (controller.Controller, error) {
+					return NewListSecrets(opts, resourceOptions, ucpClient, handler, s.options.Config.SensitiveDataAccess)
+				}))
+			r.Post("/{resourceName}/{pr:plan[Rr]ecipe}", dynamicOperationHandler(datamodel.OperationPlanRecipe, controllerOptions,
+				func(opts controller.Options) (controller.Controller, error) {
+					return NewPlanRecipe(opts, resourceOptions)
+				}))
 		})
 	})
 
@@ -115,3 +142,15 @@ func makeGetOperationResultController(opts controller.Options) (controller.Contr
 func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
+
+func makeListOperationStatusesController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewListOperationStatuses(opts)
+}
+
+func makeCancelOperationController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewCancelOperation(opts)
+}
+
+func makeListOperationHistoryController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewListOperationHistory(opts)
+}
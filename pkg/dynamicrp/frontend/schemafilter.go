@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeSchemaFilter creates an UpdateFilter that applies the schema of the resource type to the resource's Properties
+// map before it is encrypted and saved to the database.
+//
+// The filter:
+// 1. Removes the properties marked readOnly, which are computed by Radius and cannot be set by clients
+// 2. Sets the missing properties that have a default value
+// 3. Rejects updates changing properties marked with x-radius-immutable
+//
+// If the resource type has no schema, the resource passes through unchanged.
+func makeSchemaFilter(ucpClient *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return applySchema(ctx, newResource, oldResource, ucpClient)
+	}
+}
+
+// applySchema applies the defaults, readOnly and x-radius-immutable properties of the resource schema.
+func applySchema(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	schemaData, err := schema.GetSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema", "resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for resource properties",
+			},
+		}), nil
+	}
+
+	// No schema to apply
+	if schemaData == nil {
+		return nil, nil
+	}
+
+	if newResource.Properties == nil {
+		newResource.Properties = map[string]any{}
+	}
+
+	removed, err := schema.RemoveReadOnlyProperties(newResource.Properties, schemaData)
+	if err != nil {
+		return nil, err
+	}
+	if len(removed) > 0 {
+		logger.V(ucplog.LevelDebug).Info("Removed readOnly properties set by the client", "resourceID", resourceID, "properties", removed)
+	}
+
+	if err := schema.ApplyDefaults(newResource.Properties, schemaData); err != nil {
+		return nil, err
+	}
+
+	if oldResource == nil {
+		return nil, nil
+	}
+
+	// The existing resource may be stored with another API version: compare it in the API version of the request.
+	old := *oldResource
+	conversions := &converterCache{ucpClient: ucpClient}
+	if r, err := conversions.convertToRequestedVersion(ctx, &old); r != nil || err != nil {
+		return r, err
+	}
+
+	if err := schema.ValidateImmutableProperties(newResource.Properties, old.Properties, schemaData); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func testUCPClientFactoryWithSchemaAnnotations() (*v20231001preview.ClientFactory, error) {
+	return createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"region": map[string]any{
+				"type":               "string",
+				"x-radius-immutable": true,
+			},
+			"replicas": map[string]any{
+				"type":    "integer",
+				"default": 1,
+			},
+			"endpoint": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+		},
+	})
+}
+
+func newSchemaFilterTestResource(properties map[string]any) *datamodel.DynamicResource {
+	return &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: testAPIVersion,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func TestMakeSchemaFilter_Create(t *testing.T) {
+	// On create, readOnly properties are removed and defaults are applied
+	ucpClient, err := testUCPClientFactoryWithSchemaAnnotations()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+
+	ctx := createTestContext()
+	resource := newSchemaFilterTestResource(map[string]any{
+		"region":   "westus",
+		"endpoint": "http://localhost",
+	})
+
+	response, err := filter(ctx, resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, map[string]any{
+		"region":   "westus",
+		"replicas": float64(1),
+	}, resource.Properties)
+}
+
+func TestMakeSchemaFilter_UpdateUnchangedImmutable(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithSchemaAnnotations()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+
+	ctx := createTestContext()
+	oldResource := newSchemaFilterTestResource(map[string]any{"region": "westus", "replicas": float64(1)})
+	resource := newSchemaFilterTestResource(map[string]any{"region": "westus", "replicas": float64(3)})
+
+	response, err := filter(ctx, resource, oldResource, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+}
+
+func TestMakeSchemaFilter_UpdateChangedImmutable(t *testing.T) {
+	// Changing an immutable property is rejected
+	ucpClient, err := testUCPClientFactoryWithSchemaAnnotations()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+
+	ctx := createTestContext()
+	oldResource := newSchemaFilterTestResource(map[string]any{"region": "westus"})
+	resource := newSchemaFilterTestResource(map[string]any{"region": "eastus"})
+
+	response, err := filter(ctx, resource, oldResource, nil)
+	require.NoError(t, err)
+
+	badRequest, ok := response.(*rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeInvalid, badRequest.Body.Error.Code)
+	require.Contains(t, badRequest.Body.Error.Message, "property is immutable and cannot be changed once set")
+}
+
+func TestMakeSchemaFilter_NilClient(t *testing.T) {
+	// Without a UCP client there is no schema and the resource passes through unchanged
+	filter := makeSchemaFilter(nil)
+
+	ctx := createTestContext()
+	resource := newSchemaFilterTestResource(map[string]any{"endpoint": "http://localhost"})
+
+	response, err := filter(ctx, resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{"endpoint": "http://localhost"}, resource.Properties)
+}
+
+func TestMakeSchemaFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+
+	ctx := createTestContext()
+	resource := newSchemaFilterTestResource(map[string]any{"region": "westus"})
+
+	response, err := filter(ctx, resource, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+
+	internalErr, ok := response.(*rest.InternalServerErrorResponse)
+	require.True(t, ok)
+	require.Equal(t, "Failed to fetch schema for resource properties", internalErr.Body.Error.Message)
+}
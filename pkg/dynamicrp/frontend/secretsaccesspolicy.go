@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+)
+
+const (
+	// wildcard matches all callers or resource types in a sensitive data access rule.
+	wildcard = "*"
+
+	// anonymousCaller identifies callers without identity in the audit log.
+	anonymousCaller = "anonymous"
+)
+
+// sensitiveDataAccessPolicy decides which callers can read the sensitive fields of resources with the listSecrets action.
+//
+// Callers are identified by the principal name of the request. UCP removes the client identity headers sent by the
+// client, and sets the principal name to the Kubernetes user authenticated by the Kubernetes API server for the
+// requests it proxies to the UCP API service. Access is denied when no rule allows it, and to unauthenticated callers.
+type sensitiveDataAccessPolicy struct {
+	rules []hostoptions.SensitiveDataAccessRule
+}
+
+// newSensitiveDataAccessPolicy creates a sensitiveDataAccessPolicy from the configured rules.
+func newSensitiveDataAccessPolicy(options hostoptions.SensitiveDataAccessOptions) *sensitiveDataAccessPolicy {
+	return &sensitiveDataAccessPolicy{rules: options.Rules}
+}
+
+// allows returns true if a rule allows the caller of the request to read the sensitive fields of the resource type.
+func (p *sensitiveDataAccessPolicy) allows(serviceCtx *v1.ARMRequestContext, resourceType string) bool {
+	identities := callerIdentities(serviceCtx)
+	for _, rule := range p.rules {
+		if matchesResourceType(rule.ResourceTypes, resourceType) && matchesCaller(rule.Callers, identities) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesResourceType returns true if the resource type is one of the resource types of a rule.
+func matchesResourceType(resourceTypes []string, resourceType string) bool {
+	if len(resourceTypes) == 0 {
+		return true
+	}
+
+	for _, candidate := range resourceTypes {
+		if candidate == wildcard || strings.EqualFold(candidate, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesCaller returns true if one of the identities of the caller is one of the callers of a rule. The wildcard
+// only matches authenticated callers.
+func matchesCaller(callers []string, identities []string) bool {
+	if len(identities) == 0 {
+		return false
+	}
+
+	for _, candidate := range callers {
+		if candidate == wildcard {
+			return true
+		}
+
+		for _, identity := range identities {
+			if strings.EqualFold(candidate, identity) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// callerIdentities returns the identities of the caller of the request verified by UCP. The other client identity
+// headers are not verified and are ignored.
+func callerIdentities(serviceCtx *v1.ARMRequestContext) []string {
+	if serviceCtx.ClientPrincipalName == "" {
+		return []string{}
+	}
+
+	return []string{serviceCtx.ClientPrincipalName}
+}
+
+// callerName returns the identity of the caller recorded in the audit log.
+func callerName(serviceCtx *v1.ARMRequestContext) string {
+	if identities := callerIdentities(serviceCtx); len(identities) > 0 {
+		return identities[0]
+	}
+
+	return anonymousCaller
+}
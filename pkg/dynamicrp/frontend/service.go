@@ -89,6 +89,11 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 		ResourceType: "",  // Set dynamically
 	}
 
+	signingKey, err := middleware.LoadClientIdentitySigningKey(s.options.Config.ClientIdentity.SigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.registerRoutes(r, controllerOptions, ucpClient, sensitiveDataHandler)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register routes: %w", err)
@@ -110,6 +115,10 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 	// Remove this once otelhttp middleware is fixed - https://github.com/open-telemetry/opentelemetry-go-contrib/issues/3765
 	app = middleware.RemoveRemoteAddr(app)
 
+	// The client identity headers are only trusted when they are signed by UCP. dynamic-rp's service is reachable
+	// inside the cluster without going through UCP.
+	app = middleware.VerifiedClientIdentity(signingKey)(app)
+
 	server := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", s.options.Config.Server.Host, s.options.Config.Server.Port),
 		Handler: app,
@@ -152,17 +161,14 @@ func (s *Service) Run(ctx context.Context) error {
 }
 
 // createSensitiveDataHandler creates a SensitiveDataHandler for encrypting sensitive fields.
-// It loads the encryption key from a Kubernetes secret.
+// It loads the encryption key from a Kubernetes secret, unwrapped by the key management service if one is configured.
 func (s *Service) createSensitiveDataHandler(ctx context.Context) (*encryption.SensitiveDataHandler, error) {
-	// Get Kubernetes runtime client from provider
-	kubeClient, err := s.options.KubernetesProvider.RuntimeClient()
+	// Create key provider that loads encryption keys from Kubernetes secret
+	keyProvider, err := s.options.KeyProvider(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get Kubernetes client: %w", err)
+		return nil, fmt.Errorf("failed to create encryption key provider: %w", err)
 	}
 
-	// Create key provider that loads encryption keys from Kubernetes secret
-	keyProvider := encryption.NewKubernetesKeyProvider(kubeClient, nil)
-
 	// Create handler with versioned key support
 	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, keyProvider)
 	if err != nil {
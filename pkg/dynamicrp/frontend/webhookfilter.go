@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/admission"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeValidationWebhookFilter creates an UpdateFilter that calls the validation webhook declared by the schema of the
+// resource type with x-radius-validation-webhook, and rejects the request if the webhook does not allow it.
+//
+// The filter runs after the schema filter, so the webhook sees the resource with its defaults, in the API version of
+// the request. Sensitive properties are redacted from the resources sent to the webhook. When the webhook cannot be
+// called, the request is rejected unless the failure policy of the webhook is Ignore.
+//
+// If the resource type has no schema or does not declare a webhook, the resource passes through unchanged.
+func makeValidationWebhookFilter(ucpClient *v20231001preview.ClientFactory, client *admission.Client) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return callValidationWebhook(ctx, newResource, oldResource, ucpClient, client)
+	}
+}
+
+// callValidationWebhook sends the operation to the validation webhook of the resource type and applies its decision.
+func callValidationWebhook(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+	client *admission.Client,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	schemaData, err := schema.GetSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema", "resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for the validation webhook",
+			},
+		}), nil
+	}
+
+	// No schema, so no webhook
+	if schemaData == nil {
+		return nil, nil
+	}
+
+	webhook, err := schema.ExtractValidationWebhook(schemaData)
+	if err != nil {
+		logger.Error(err, "Invalid validation webhook", "resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: fmt.Sprintf("The validation webhook of resource type %q is invalid: %v", resourceType, err),
+			},
+		}), nil
+	}
+	if webhook == nil {
+		return nil, nil
+	}
+
+	sensitiveFieldPaths := schema.ExtractSensitiveFieldPaths(schemaData, "")
+	request := &admission.AdmissionRequest{
+		UID:          uuid.NewString(),
+		Operation:    admission.OperationCreate,
+		ResourceID:   resourceID,
+		ResourceType: resourceType,
+		Name:         serviceCtx.ResourceID.Name(),
+		APIVersion:   apiVersion,
+		UserInfo:     admission.UserInfo{Username: callerName(serviceCtx)},
+	}
+
+	request.Object, err = admissionObject(newResource, apiVersion, sensitiveFieldPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldResource != nil {
+		// The existing resource may be stored with another API version: send it in the API version of the request.
+		old := *oldResource
+		conversions := &converterCache{ucpClient: ucpClient}
+		if r, err := conversions.convertToRequestedVersion(ctx, &old); r != nil || err != nil {
+			return r, err
+		}
+
+		request.Operation = admission.OperationUpdate
+		request.OldObject, err = admissionObject(&old, apiVersion, sensitiveFieldPaths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := client.Review(ctx, webhook, request)
+	if err != nil {
+		if webhook.IgnoreFailures() {
+			logger.Info("Ignoring failed validation webhook call", "resourceID", resourceID, "url", webhook.URL, "error", err.Error())
+			return nil, nil
+		}
+
+		logger.Error(err, "Failed to call validation webhook", "resourceID", resourceID, "url", webhook.URL)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: fmt.Sprintf("Failed to call the validation webhook of resource type %q: %v", resourceType, err),
+			},
+		}), nil
+	}
+
+	for _, warning := range response.Warnings {
+		logger.Info("Validation webhook warning", "resourceID", resourceID, "warning", warning)
+	}
+
+	if response.Allowed {
+		return nil, nil
+	}
+
+	message := "no reason given"
+	if response.Status != nil && response.Status.Message != "" {
+		message = response.Status.Message
+	}
+
+	logger.Info("Validation webhook denied the request", "resourceID", resourceID, "url", webhook.URL, "reason", message)
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: fmt.Sprintf("The request was denied by the validation webhook of resource type %q: %s", resourceType, message),
+			Target:  resourceID,
+		},
+	}), nil
+}
+
+// admissionObject returns the resource as it is returned by the API in the given API version, with its sensitive
+// properties redacted.
+func admissionObject(resource *datamodel.DynamicResource, apiVersion string, sensitiveFieldPaths []string) (map[string]any, error) {
+	versioned, err := converter.DynamicResourceDataModelToVersioned(resource, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]any{}
+	if err := json.Unmarshal(bs, &object); err != nil {
+		return nil, err
+	}
+
+	if properties, ok := object["properties"].(map[string]any); ok {
+		schema.RedactFields(properties, sensitiveFieldPaths)
+	}
+
+	return object, nil
+}
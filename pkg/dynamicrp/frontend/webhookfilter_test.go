@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/admission"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestValidationWebhook starts a webhook that records the requests it receives and answers with the response
+// returned by decide. It returns the webhook annotation of the schema.
+func newTestValidationWebhook(t *testing.T, decide func(request *admission.AdmissionRequest) admission.AdmissionResponse) (map[string]any, *[]*admission.AdmissionRequest) {
+	requests := []*admission.AdmissionRequest{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := admission.AdmissionReview{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&review))
+		requests = append(requests, review.Request)
+
+		response := decide(review.Request)
+		response.UID = review.Request.UID
+		_ = json.NewEncoder(w).Encode(admission.AdmissionReview{
+			APIVersion: admission.ReviewAPIVersion,
+			Kind:       admission.ReviewKind,
+			Response:   &response,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return testWebhookAnnotation(server), &requests
+}
+
+// testWebhookAnnotation returns the webhook annotation of the schema for the test server.
+func testWebhookAnnotation(server *httptest.Server) map[string]any {
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return map[string]any{"url": server.URL, "caBundle": base64.StdEncoding.EncodeToString(caBundle)}
+}
+
+func testUCPClientFactoryWithWebhook(webhook map[string]any) (*v20231001preview.ClientFactory, error) {
+	return createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type": "string",
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-sensitive": true,
+			},
+		},
+		"x-radius-validation-webhook": webhook,
+	})
+}
+
+func TestMakeValidationWebhookFilter_NoWebhook(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithSchemaAnnotations()
+	require.NoError(t, err)
+
+	filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+	response, err := filter(createTestContext(), newSchemaFilterTestResource(map[string]any{"region": "westus"}), nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+}
+
+func TestMakeValidationWebhookFilter_Create(t *testing.T) {
+	webhook, requests := newTestValidationWebhook(t, func(request *admission.AdmissionRequest) admission.AdmissionResponse {
+		return admission.AdmissionResponse{Allowed: true}
+	})
+
+	ucpClient, err := testUCPClientFactoryWithWebhook(webhook)
+	require.NoError(t, err)
+
+	filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+	resource := newSchemaFilterTestResource(map[string]any{"size": "large", "password": "secret123"})
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	require.Equal(t, admission.OperationCreate, request.Operation)
+	require.Equal(t, testResourceID, request.ResourceID)
+	require.Equal(t, "Applications.Test/testResources", request.ResourceType)
+	require.Equal(t, "myResource", request.Name)
+	require.Equal(t, testAPIVersion, request.APIVersion)
+	require.Equal(t, anonymousCaller, request.UserInfo.Username)
+	require.Nil(t, request.OldObject)
+
+	// The webhook receives the resource as returned by the API, without its sensitive properties
+	require.Equal(t, map[string]any{"size": "large", "password": nil, "provisioningState": "Succeeded"}, request.Object["properties"])
+
+	// The resource itself is not redacted
+	require.Equal(t, "secret123", resource.Properties["password"])
+}
+
+func TestMakeValidationWebhookFilter_UpdateDenied(t *testing.T) {
+	webhook, requests := newTestValidationWebhook(t, func(request *admission.AdmissionRequest) admission.AdmissionResponse {
+		return admission.AdmissionResponse{
+			Allowed: false,
+			Status:  &admission.Status{Code: http.StatusForbidden, Message: "size cannot be reduced"},
+		}
+	})
+
+	ucpClient, err := testUCPClientFactoryWithWebhook(webhook)
+	require.NoError(t, err)
+
+	filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+	oldResource := newSchemaFilterTestResource(map[string]any{"size": "large"})
+	newResource := newSchemaFilterTestResource(map[string]any{"size": "small"})
+	response, err := filter(createTestContext(), newResource, oldResource, nil)
+	require.NoError(t, err)
+	require.IsType(t, &rest.BadRequestResponse{}, response)
+	require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, "size cannot be reduced")
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	require.Equal(t, admission.OperationUpdate, request.Operation)
+	require.Equal(t, map[string]any{"size": "small", "provisioningState": "Succeeded"}, request.Object["properties"])
+	require.Equal(t, map[string]any{"size": "large", "provisioningState": "Succeeded"}, request.OldObject["properties"])
+}
+
+func TestMakeValidationWebhookFilter_FailurePolicy(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	webhook := testWebhookAnnotation(server)
+
+	t.Run("fail", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithWebhook(webhook)
+		require.NoError(t, err)
+
+		filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+		response, err := filter(createTestContext(), newSchemaFilterTestResource(map[string]any{"size": "large"}), nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.InternalServerErrorResponse{}, response)
+	})
+
+	t.Run("ignore", func(t *testing.T) {
+		webhook["failurePolicy"] = "Ignore"
+		ucpClient, err := testUCPClientFactoryWithWebhook(webhook)
+		require.NoError(t, err)
+
+		filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+		response, err := filter(createTestContext(), newSchemaFilterTestResource(map[string]any{"size": "large"}), nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+}
+
+func TestMakeValidationWebhookFilter_InvalidWebhook(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithWebhook(map[string]any{"url": "not-a-url"})
+	require.NoError(t, err)
+
+	filter := makeValidationWebhookFilter(ucpClient, admission.NewClient())
+
+	response, err := filter(createTestContext(), newSchemaFilterTestResource(map[string]any{"size": "large"}), nil, nil)
+	require.NoError(t, err)
+	require.IsType(t, &rest.InternalServerErrorResponse{}, response)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+// This test covers the client identity of requests sent to dynamic-rp directly, rather than proxied by UCP. The identity
+// is recorded in the operation history of the resource.
+func Test_Dynamic_ClientIdentity(t *testing.T) {
+	signingKey := []byte(strings.Repeat("k", 32))
+	signingKeyFile := filepath.Join(t.TempDir(), "signing-key")
+	require.NoError(t, os.WriteFile(signingKeyFile, signingKey, 0600))
+
+	dynamic, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.Config.ClientIdentity.SigningKeyFile = signingKeyFile
+	}))
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+	createAPIVersion(ucp, inertResourceTypeName, nil)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	// put sends a PUT of the resource to dynamic-rp with the client identity header, and returns the client identity
+	// recorded for the operation.
+	put := func(t *testing.T, transport http.RoundTripper) string {
+		body, err := json.Marshal(map[string]any{"properties": map[string]any{"foo": "bar"}})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPut, dynamic.BaseURL()+testInertResourceURL, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(v1.ClientPrincipalNameHeader, "admin@contoso.com")
+
+		resp, err := (&http.Client{Transport: transport}).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Contains(t, []int{http.StatusOK, http.StatusCreated}, resp.StatusCode)
+
+		operationID := resp.Header.Get("Azure-AsyncOperation")
+		require.NotEmpty(t, operationID)
+		u, err := url.Parse(operationID)
+		require.NoError(t, err)
+		operationName := filepath.Base(u.Path)
+
+		historyURL := testPlaneID + "/providers/" + resourceProviderNamespace + "/locations/" + locationName + "/operations?api-version=" + apiVersion + "&resourceId=" + url.QueryEscape(testInertResourceID)
+		response := dynamic.MakeRequest(http.MethodGet, historyURL, nil)
+		response.EqualsStatusCode(http.StatusOK)
+
+		entries := struct {
+			Value []history.Entry `json:"value"`
+		}{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &entries))
+		for _, entry := range entries.Value {
+			if entry.Name == operationName {
+				return entry.Caller.PrincipalName
+			}
+		}
+
+		require.Fail(t, "operation not found in the history", operationName)
+		return ""
+	}
+
+	// wait waits for the operations on the resource to complete, so that the resource can be updated again.
+	wait := func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			response := dynamic.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+			resource := map[string]any{}
+			if response.Raw.StatusCode != http.StatusOK || json.Unmarshal(response.Body.Bytes(), &resource) != nil {
+				return false
+			}
+			properties, _ := resource["properties"].(map[string]any)
+			return properties["provisioningState"] == string(v1.ProvisioningStateSucceeded)
+		}, 30*time.Second, 100*time.Millisecond)
+	}
+
+	t.Run("spoofed header is rejected", func(t *testing.T) {
+		require.Empty(t, put(t, http.DefaultTransport))
+		wait(t)
+	})
+
+	t.Run("header signed by UCP is trusted", func(t *testing.T) {
+		require.Equal(t, "admin@contoso.com", put(t, middleware.NewClientIdentitySigner(signingKey, http.DefaultTransport)))
+	})
+}
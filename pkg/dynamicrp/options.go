@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
@@ -29,13 +30,16 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/pulumi"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
@@ -53,6 +57,9 @@ type Options struct {
 	// DatabaseProvider provides access to the database.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// HistoryRecorder records the history of the async operations. It is nil if the history is disabled.
+	HistoryRecorder *history.Recorder
+
 	// KubernetesProvider provides access to the Kubernetes clients.
 	KubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
 
@@ -77,8 +84,8 @@ type RecipeOptions struct {
 	// ConfigurationLoader is the loader for recipe configurations.
 	ConfigurationLoader configloader.ConfigurationLoader
 
-	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers are used (Bicep, Terraform) will
-	// be used.
+	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers (Bicep, Terraform, OpenTofu,
+	// Pulumi) will be used.
 	Drivers map[string]func(options *Options) (driver.Driver, error)
 
 	// SecretsLoader provides access to secrets for recipes.
@@ -106,7 +113,12 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
-	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
+	options.HistoryRecorder, err = history.FromOptions(databaseClient, config.Environment.RoleLocation, config.OperationHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation, statusmanager.WithHistory(options.HistoryRecorder))
 
 	options.KubernetesProvider, err = kubernetesclientprovider.FromOptions(config.Kubernetes)
 	if err != nil {
@@ -139,6 +151,8 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		o.Recipes.Drivers = map[string]func(options *Options) (driver.Driver, error){
 			recipes.TemplateKindBicep:     bicepDriver,
 			recipes.TemplateKindTerraform: terraformDriver,
+			recipes.TemplateKindOpenTofu:  opentofuDriver,
+			recipes.TemplateKindPulumi:    pulumiDriver,
 		}
 	}
 
@@ -162,6 +176,16 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		Drivers:             drivers}), nil
 }
 
+// KeyProvider creates the provider of the keys encrypting sensitive fields from the options.
+func (o *Options) KeyProvider(ctx context.Context) (encryption.KeyProvider, error) {
+	kubeClient, err := o.KubernetesProvider.RuntimeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	return encryption.NewKeyProvider(ctx, kubeClient, o.Config.Encryption)
+}
+
 func bicepDriver(options *Options) (driver.Driver, error) {
 	deploymentEngineClient, err := clients.NewResourceDeploymentsClient(&clients.Options{
 		Cred:             &aztoken.AnonymousCredential{},
@@ -205,11 +229,57 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 }
 
 func terraformDriver(options *Options) (driver.Driver, error) {
+	terraformCache, err := cache.New(cache.Options{
+		Path:    options.Config.Terraform.Cache.Path,
+		MaxSize: options.Config.Terraform.Cache.MaxSize,
+		Offline: options.Config.Terraform.Cache.Offline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return terraform.NewTerraformDriver(
 		options.UCP,
 		options.SecretProvider,
 		terraform.TerraformOptions{
 			Path:     options.Config.Terraform.Path,
 			LogLevel: options.Config.Terraform.LogLevel,
+			Cache:    terraformCache,
+		}, *options.KubernetesProvider), nil
+}
+
+func opentofuDriver(options *Options) (driver.Driver, error) {
+	terraformCache, err := cache.New(cache.Options{
+		Path:    options.Config.Terraform.Cache.Path,
+		MaxSize: options.Config.Terraform.Cache.MaxSize,
+		Offline: options.Config.Terraform.Cache.Offline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return terraform.NewOpenTofuDriver(
+		options.UCP,
+		options.SecretProvider,
+		terraform.TerraformOptions{
+			Path:     options.Config.Terraform.Path,
+			LogLevel: options.Config.Terraform.LogLevel,
+			Cache:    terraformCache,
+			ExecPath: options.Config.OpenTofu.ExecPath,
 		}, *options.KubernetesProvider), nil
 }
+
+func pulumiDriver(options *Options) (driver.Driver, error) {
+	path := options.Config.Pulumi.Path
+	if path == "" {
+		path = options.Config.Terraform.Path
+	}
+
+	return pulumi.NewPulumiDriver(pulumi.PulumiOptions{
+		Path:           path,
+		ExecPath:       options.Config.Pulumi.ExecPath,
+		BackendURL:     options.Config.Pulumi.BackendURL,
+		PassphraseFile: options.Config.Pulumi.PassphraseFile,
+		Env:            options.Config.Pulumi.Env,
+	}), nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend/drift"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend/reencryption"
 	"github.com/radius-project/radius/pkg/dynamicrp/frontend"
 )
 
@@ -48,6 +50,16 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Re-encryption of sensitive fields after a key rotation is provided via a service.
+	if service := reencryption.NewService(options); service != nil {
+		services = append(services, service)
+	}
+
+	// Drift detection of the infrastructure deployed by recipes is provided via an opt-in service.
+	if service := drift.NewService(options); service != nil {
+		services = append(services, service)
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeutil
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// leaseDuration is the duration the other candidates wait before taking over a lease that is not renewed.
+	leaseDuration = 15 * time.Second
+
+	// renewDeadline is the duration the leader retries renewing the lease before giving up the leadership.
+	renewDeadline = 10 * time.Second
+
+	// retryPeriod is the interval between two attempts to acquire or renew the lease.
+	retryPeriod = 2 * time.Second
+)
+
+// RunAsLeader runs fn while this process holds the Kubernetes lease with the given name and namespace, so that a
+// single replica runs fn at a time. The context passed to fn is cancelled when the leadership is lost; once fn returns,
+// RunAsLeader tries to acquire the lease again. RunAsLeader returns when ctx is cancelled.
+func RunAsLeader(ctx context.Context, client k8s.Interface, namespace string, name string, fn func(ctx context.Context)) error {
+	identity, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	// The hostname is the pod name. The suffix keeps the identity unique if the process restarts in the same pod.
+	identity = identity + "_" + uuid.NewString()
+
+	for ctx.Err() == nil {
+		started := atomic.Bool{}
+		done := make(chan struct{})
+
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock: &resourcelock.LeaseLock{
+				LeaseMeta:  metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Client:     client.CoordinationV1(),
+				LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+			},
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Name:            name,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					started.Store(true)
+					defer close(done)
+					fn(ctx)
+				},
+				OnStoppedLeading: func() {},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Run returns when the leadership is lost or ctx is cancelled. fn runs in another goroutine and must return
+		// before another replica can be trusted to run it, so the lease is only acquired again once it is done.
+		elector.Run(ctx)
+		if started.Load() {
+			<-done
+		}
+	}
+
+	return nil
+}
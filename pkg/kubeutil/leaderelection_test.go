@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeutil
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRunAsLeader(t *testing.T) {
+	client := fake.NewClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	running := atomic.Int32{}
+	leaders := atomic.Int32{}
+	fn := func(ctx context.Context) {
+		leaders.Add(1)
+		running.Add(1)
+		defer running.Add(-1)
+		<-ctx.Done()
+	}
+
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			done <- RunAsLeader(ctx, client, "radius-system", "test-lease", fn)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return leaders.Load() == 1 }, 10*time.Second, 10*time.Millisecond)
+
+	// The other candidate does not run fn while the lease is held.
+	time.Sleep(3 * retryPeriod / 2)
+	require.Equal(t, int32(1), running.Load())
+
+	cancel()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, <-done)
+	}
+	require.Equal(t, int32(0), running.Load())
+}
@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -102,6 +103,43 @@ func Test_fromRecipeStatus(t *testing.T) {
 	}
 }
 
+func Test_fromDriftStatus(t *testing.T) {
+	checked := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		driftStatus *rpv1.DriftStatus
+		expected    *DriftStatus
+	}{
+		{nil, nil},
+		{&rpv1.DriftStatus{
+			State:           rpv1.DriftStateInSync,
+			LastCheckedTime: checked,
+		}, &DriftStatus{
+			State:           to.Ptr(DriftStateInSync),
+			LastCheckedTime: new(checked),
+		}},
+		{&rpv1.DriftStatus{
+			State:           rpv1.DriftStateDrifted,
+			LastCheckedTime: checked,
+			Message:         "1 resource changed outside of Radius",
+			DriftedResources: []rpv1.DriftedResource{
+				{Address: "aws_s3_bucket.bucket", Type: "aws_s3_bucket", Name: "bucket", Change: rpv1.DriftChangeModified},
+			},
+		}, &DriftStatus{
+			State:           to.Ptr(DriftStateDrifted),
+			LastCheckedTime: new(checked),
+			Message:         new("1 resource changed outside of Radius"),
+			DriftedResources: []*DriftedResource{
+				{Address: new("aws_s3_bucket.bucket"), Type: new("aws_s3_bucket"), Name: new("bucket"), Change: to.Ptr(DriftChangeModified)},
+			},
+		}},
+	}
+
+	for _, tt := range testCases {
+		status := fromDriftStatus(tt.driftStatus)
+		require.Equal(t, tt.expected, status)
+	}
+}
+
 func TestFromSystemDataModel(t *testing.T) {
 	systemDataTests := []v1.SystemData{
 		{
@@ -93,6 +93,88 @@ func (a *AzureResourceManagerCommonTypesTrackedResourceUpdate) UnmarshalJSON(dat
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type DriftedResource.
+func (d DriftedResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "address", d.Address)
+	populate(objectMap, "change", d.Change)
+	populate(objectMap, "id", d.ID)
+	populate(objectMap, "name", d.Name)
+	populate(objectMap, "type", d.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftedResource.
+func (d *DriftedResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "address":
+			err = unpopulate(val, "Address", &d.Address)
+			delete(rawMsg, key)
+		case "change":
+			err = unpopulate(val, "Change", &d.Change)
+			delete(rawMsg, key)
+		case "id":
+			err = unpopulate(val, "ID", &d.ID)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &d.Name)
+			delete(rawMsg, key)
+		case "type":
+			err = unpopulate(val, "Type", &d.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type DriftStatus.
+func (d DriftStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "driftedResources", d.DriftedResources)
+	populateDateTimeRFC3339(objectMap, "lastCheckedTime", d.LastCheckedTime)
+	populate(objectMap, "message", d.Message)
+	populate(objectMap, "state", d.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftStatus.
+func (d *DriftStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "driftedResources":
+			err = unpopulate(val, "DriftedResources", &d.DriftedResources)
+			delete(rawMsg, key)
+		case "lastCheckedTime":
+			err = unpopulateDateTimeRFC3339(val, "LastCheckedTime", &d.LastCheckedTime)
+			delete(rawMsg, key)
+		case "message":
+			err = unpopulate(val, "Message", &d.Message)
+			delete(rawMsg, key)
+		case "state":
+			err = unpopulate(val, "State", &d.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -857,6 +939,7 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 func (r ResourceStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "driftStatus", r.DriftStatus)
 	populate(objectMap, "outputResources", r.OutputResources)
 	populate(objectMap, "recipe", r.Recipe)
 	return json.Marshal(objectMap)
@@ -874,6 +957,9 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 		case "compute":
 			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "driftStatus":
+			err = unpopulate(val, "DriftStatus", &r.DriftStatus)
+			delete(rawMsg, key)
 		case "outputResources":
 			err = unpopulate(val, "OutputResources", &r.OutputResources)
 			delete(rawMsg, key)
@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// clientIdentityHeaders are the headers identifying the client of a request.
+var clientIdentityHeaders = []string{
+	v1.ClientPrincipalNameHeader,
+	v1.ClientPrincipalIDHeader,
+	v1.ClientObjectIDHeader,
+	v1.ClientApplicationIDHeader,
+}
+
+// ClientIdentity is the middleware that replaces the client identity headers of incoming requests with the identity
+// verified by authenticate. The identity headers sent by the client are removed, so that they cannot be spoofed.
+// authenticate returns an empty string when the client is not authenticated, and can be nil.
+func ClientIdentity(authenticate func(r *http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			for _, header := range clientIdentityHeaders {
+				r.Header.Del(header)
+			}
+
+			if authenticate != nil {
+				if identity := authenticate(r); identity != "" {
+					r.Header.Set(v1.ClientPrincipalNameHeader, identity)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		authenticate func(r *http.Request) string
+		expected     string
+	}{
+		{
+			name:     "no authenticator",
+			expected: "",
+		},
+		{
+			name:         "unauthenticated",
+			authenticate: func(r *http.Request) string { return "" },
+			expected:     "",
+		},
+		{
+			name:         "authenticated",
+			authenticate: func(r *http.Request) string { return "system:serviceaccount:default:app" },
+			expected:     "system:serviceaccount:default:app",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual http.Header
+			handler := ClientIdentity(tt.authenticate)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual = r.Header
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			req.Header.Set(v1.ClientPrincipalNameHeader, "spoofed")
+			req.Header.Set(v1.ClientPrincipalIDHeader, "spoofed")
+			req.Header.Set(v1.ClientObjectIDHeader, "spoofed")
+			req.Header.Set(v1.ClientApplicationIDHeader, "spoofed")
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tt.expected, actual.Get(v1.ClientPrincipalNameHeader))
+			require.Empty(t, actual.Get(v1.ClientPrincipalIDHeader))
+			require.Empty(t, actual.Get(v1.ClientObjectIDHeader))
+			require.Empty(t, actual.Get(v1.ClientApplicationIDHeader))
+		})
+	}
+}
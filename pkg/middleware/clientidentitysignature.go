@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// ClientIdentitySignatureHeader is the header holding the signature of the client identity of a request proxied
+	// by UCP. The value has the format "t=<unix time>,sig=<hex HMAC-SHA256>".
+	ClientIdentitySignatureHeader = "X-Radius-Client-Identity-Signature"
+
+	// clientIdentitySignatureMaxAge is the maximum age of a signature. Older signatures are rejected, so that a
+	// captured request cannot be replayed later with its identity.
+	clientIdentitySignatureMaxAge = 5 * time.Minute
+
+	// minClientIdentitySigningKeySize is the minimum size of the signing key in bytes.
+	minClientIdentitySigningKeySize = 32
+)
+
+// LoadClientIdentitySigningKey reads the key signing client identities from the file. It returns nil if path is empty.
+func LoadClientIdentitySigningKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client identity signing key: %w", err)
+	}
+
+	key = bytes.TrimSpace(key)
+	if len(key) < minClientIdentitySigningKeySize {
+		return nil, fmt.Errorf("the client identity signing key must be at least %d bytes", minClientIdentitySigningKeySize)
+	}
+
+	return key, nil
+}
+
+// NewClientIdentitySigner creates a http.RoundTripper that signs the client identity of the requests sent by UCP to
+// the resource providers, see VerifiedClientIdentity. Requests are sent without a signature when key is empty or the
+// request has no client identity.
+func NewClientIdentitySigner(key []byte, inner http.RoundTripper) http.RoundTripper {
+	return &clientIdentitySigner{key: key, inner: inner, now: time.Now}
+}
+
+type clientIdentitySigner struct {
+	key   []byte
+	inner http.RoundTripper
+	now   func() time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (s *clientIdentitySigner) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	req.Header.Del(ClientIdentitySignatureHeader)
+
+	if principal := req.Header.Get(v1.ClientPrincipalNameHeader); principal != "" && len(s.key) > 0 {
+		timestamp := strconv.FormatInt(s.now().Unix(), 10)
+		signature := signClientIdentity(s.key, timestamp, principal, req.Method, req.URL.RequestURI())
+		req.Header.Set(ClientIdentitySignatureHeader, "t="+timestamp+",sig="+signature)
+	}
+
+	return s.inner.RoundTrip(req)
+}
+
+// VerifiedClientIdentity is the middleware that only keeps the client identity of requests signed by UCP with key.
+// The client identity headers of other requests are removed, so that a client calling the resource provider directly
+// cannot spoof them. All client identities are removed when key is empty.
+func VerifiedClientIdentity(key []byte) func(http.Handler) http.Handler {
+	return verifiedClientIdentity(key, time.Now)
+}
+
+func verifiedClientIdentity(key []byte, now func() time.Time) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			principal := r.Header.Get(v1.ClientPrincipalNameHeader)
+			verified := principal != "" && verifyClientIdentity(key, r.Header.Get(ClientIdentitySignatureHeader), principal, r.Method, r.URL.RequestURI(), now())
+
+			// UCP only forwards the principal name, the other identity headers are never verified.
+			for _, header := range clientIdentityHeaders {
+				r.Header.Del(header)
+			}
+			r.Header.Del(ClientIdentitySignatureHeader)
+
+			if verified {
+				r.Header.Set(v1.ClientPrincipalNameHeader, principal)
+			}
+
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// verifyClientIdentity returns true if value is a valid and recent signature of the client identity.
+func verifyClientIdentity(key []byte, value string, principal string, method string, requestURI string, now time.Time) bool {
+	if len(key) == 0 || value == "" {
+		return false
+	}
+
+	timestamp, signature, ok := strings.Cut(value, ",")
+	timestamp, hasTimestamp := strings.CutPrefix(timestamp, "t=")
+	signature, hasSignature := strings.CutPrefix(signature, "sig=")
+	if !ok || !hasTimestamp || !hasSignature {
+		return false
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > clientIdentitySignatureMaxAge || age < -clientIdentitySignatureMaxAge {
+		return false
+	}
+
+	expected := signClientIdentity(key, timestamp, principal, method, requestURI)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// signClientIdentity computes the signature of the client identity of a request. The method and request URI are
+// signed with the identity, so that a signature cannot be reused for another request.
+func signClientIdentity(key []byte, timestamp string, principal string, method string, requestURI string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join([]string{timestamp, principal, method, requestURI}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
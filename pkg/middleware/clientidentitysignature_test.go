@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrincipal = "system:serviceaccount:default:app"
+
+var testSigningKey = []byte(strings.Repeat("k", minClientIdentitySigningKeySize))
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// signedRequest returns the request as sent by the signer with the given key.
+func signedRequest(t *testing.T, key []byte, now time.Time, method string, target string, principal string) *http.Request {
+	var sent *http.Request
+	signer := &clientIdentitySigner{key: key, now: func() time.Time { return now }, inner: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		sent = r
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})}
+
+	req := httptest.NewRequest(method, target, nil)
+	if principal != "" {
+		req.Header.Set(v1.ClientPrincipalNameHeader, principal)
+	}
+	_, err := signer.RoundTrip(req)
+	require.NoError(t, err)
+
+	// The original request is not modified.
+	require.Empty(t, req.Header.Get(ClientIdentitySignatureHeader))
+
+	// Convert to a request as received by the server.
+	received := httptest.NewRequest(sent.Method, sent.URL.RequestURI(), nil)
+	received.Header = sent.Header.Clone()
+	return received
+}
+
+// receivedIdentity returns the client identity seen by the handler behind VerifiedClientIdentity.
+func receivedIdentity(key []byte, now time.Time, req *http.Request) http.Header {
+	var actual http.Header
+	handler := verifiedClientIdentity(key, func() time.Time { return now })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actual = r.Header
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return actual
+}
+
+func TestVerifiedClientIdentity(t *testing.T) {
+	now := time.Now()
+	target := "/planes/radius/local/resourceGroups/rg/providers/Test.Resources/testResources/r1/listSecrets?api-version=2023-10-01-preview"
+
+	t.Run("signed by UCP", func(t *testing.T) {
+		req := signedRequest(t, testSigningKey, now, http.MethodPost, target, testPrincipal)
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Equal(t, testPrincipal, actual.Get(v1.ClientPrincipalNameHeader))
+		require.Empty(t, actual.Get(ClientIdentitySignatureHeader))
+	})
+
+	t.Run("spoofed header sent directly", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, target, nil)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "spoofed")
+		req.Header.Set(v1.ClientObjectIDHeader, "spoofed")
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+		require.Empty(t, actual.Get(v1.ClientObjectIDHeader))
+	})
+
+	t.Run("principal replaced", func(t *testing.T) {
+		req := signedRequest(t, testSigningKey, now, http.MethodPost, target, testPrincipal)
+		req.Header.Set(v1.ClientPrincipalNameHeader, "spoofed")
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+	})
+
+	t.Run("signature reused for another request", func(t *testing.T) {
+		signed := signedRequest(t, testSigningKey, now, http.MethodGet, target, testPrincipal)
+		req := httptest.NewRequest(http.MethodPost, target, nil)
+		req.Header = signed.Header.Clone()
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+	})
+
+	t.Run("signed with another key", func(t *testing.T) {
+		req := signedRequest(t, []byte(strings.Repeat("x", minClientIdentitySigningKeySize)), now, http.MethodPost, target, testPrincipal)
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+	})
+
+	t.Run("expired signature", func(t *testing.T) {
+		req := signedRequest(t, testSigningKey, now.Add(-clientIdentitySignatureMaxAge-time.Minute), http.MethodPost, target, testPrincipal)
+		actual := receivedIdentity(testSigningKey, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+	})
+
+	t.Run("no signing key", func(t *testing.T) {
+		req := signedRequest(t, testSigningKey, now, http.MethodPost, target, testPrincipal)
+		actual := receivedIdentity(nil, now, req)
+		require.Empty(t, actual.Get(v1.ClientPrincipalNameHeader))
+	})
+
+	t.Run("unsigned without identity", func(t *testing.T) {
+		req := signedRequest(t, testSigningKey, now, http.MethodPost, target, "")
+		require.Empty(t, req.Header.Get(ClientIdentitySignatureHeader))
+	})
+}
+
+func TestLoadClientIdentitySigningKey(t *testing.T) {
+	key, err := LoadClientIdentitySigningKey("")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(path, append(testSigningKey, '\n'), 0600))
+	key, err = LoadClientIdentitySigningKey(path)
+	require.NoError(t, err)
+	require.Equal(t, testSigningKey, key)
+
+	require.NoError(t, os.WriteFile(path, []byte("short"), 0600))
+	_, err = LoadClientIdentitySigningKey(path)
+	require.Error(t, err)
+
+	_, err = LoadClientIdentitySigningKey(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
@@ -27,6 +27,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -106,13 +107,13 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 					return ctrl.Result{}, err
 				}
 
-				if c.KubeClient() == nil {
+				keyProvider := c.KeyProvider()
+				if keyProvider == nil {
 					err = fmt.Errorf("kubernetes client not configured for sensitive data decryption")
 					logger.Error(err, "Failed to initialize encryption key provider", "resourceID", req.ResourceID)
 					return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
 				}
 
-				keyProvider := encryption.NewKubernetesKeyProvider(c.KubeClient(), nil)
 				handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, keyProvider)
 				if err != nil {
 					logger.Error(err, "Failed to initialize sensitive data handler", "resourceID", req.ResourceID)
@@ -138,6 +139,13 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 					return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
 				}
 
+				// Retain the encrypted values so that authorized callers can still read them
+				// through the listSecrets action.
+				if err = applySensitivePropertiesToResource(resource, schemautil.SelectFields(properties, sensitiveFieldPaths)); err != nil {
+					logger.Error(err, "Failed to retain encrypted sensitive properties", "resourceID", req.ResourceID)
+					return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
+				}
+
 				update := &database.Object{
 					Metadata: database.Metadata{ID: req.ResourceID},
 					Data:     resource,
@@ -207,7 +215,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		},
 		Data: recipeDataModel.(rpv1.RadiusResourceModel),
 	}
-	err = c.DatabaseClient().Save(ctx, update, database.WithETag(currentETag))
+	err = c.save(ctx, update, currentETag)
 	if err != nil {
 		if redactionCompleted {
 			return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
@@ -234,7 +242,7 @@ func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, er
 		}
 
 		// Save portable resource with updated deployment status to track errors during deletion.
-		err = c.DatabaseClient().Save(ctx, update, database.WithETag(etag))
+		err = c.save(ctx, update, etag)
 		if err != nil {
 			if redactionCompleted {
 				return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
@@ -254,6 +262,35 @@ func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, er
 	return ctrl.Result{}, err
 }
 
+// save saves the resource processed by the operation if it was not updated since it was read with the given ETag.
+//
+// Background jobs, such as the drift detection, may update the resource while the recipe is executed. The resource
+// is then read again, and saved if its operation is still in progress: users can't update a resource while its
+// operation is in progress, so the resource processed by the operation replaces the updates of the background jobs.
+func (c *CreateOrUpdateResource[P, T]) save(ctx context.Context, update *database.Object, etag string) error {
+	err := c.DatabaseClient().Save(ctx, update, database.WithETag(etag))
+	if !errors.Is(err, &database.ErrConcurrency{}) {
+		return err
+	}
+
+	stored, getErr := c.DatabaseClient().Get(ctx, update.ID)
+	if getErr != nil {
+		return errors.Join(err, getErr)
+	}
+
+	resource := P(new(T))
+	if asErr := stored.As(resource); asErr != nil {
+		return errors.Join(err, asErr)
+	}
+
+	if resource.ProvisioningState().IsTerminal() {
+		return err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Resource was updated by a background job during the operation, saving the latest version", "resourceID", update.ID)
+	return c.DatabaseClient().Save(ctx, update, database.WithETag(stored.ETag))
+}
+
 func (c *CreateOrUpdateResource[P, T]) copyOutputResources(resource P) []string {
 	previousOutputResources := []string{}
 	for _, outputResource := range resource.OutputResources() {
@@ -275,24 +312,41 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		}
 	}
 
+	metadata, err := RecipeMetadata(ctx, c.DatabaseClient(), resource, recipe, resourceProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.engine.Execute(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: prevState,
+		Simulated:     simulated,
+	})
+}
+
+// RecipeMetadata returns the metadata of the recipe of the given resource, with the given resource properties and the
+// properties of the resources it is connected to.
+func RecipeMetadata[P rpv1.RadiusResourceModel](ctx context.Context, databaseClient database.Client, resource P, recipe *portableresources.ResourceRecipe, resourceProperties map[string]any) (recipes.ResourceMetadata, error) {
 	connectionsAndSourceIDs, err := resourceutil.GetConnectionNameandSourceIDs(resource)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get connected resource IDs: %w", err)
+		return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource IDs: %w", err)
 	}
 	connectedResourcesMetadata := make(map[string]recipes.ConnectedResource)
 
 	// If there are connected resources, we need to fetch their properties and add them to the recipe context.
 	for connName, connectedResourceID := range connectionsAndSourceIDs {
-		connectedResource, err := c.DatabaseClient().Get(ctx, connectedResourceID)
+		connectedResource, err := databaseClient.Get(ctx, connectedResourceID)
 		if errors.Is(&database.ErrNotFound{ID: connectedResourceID}, err) {
-			return nil, fmt.Errorf("connected resource %s not found: %w", connectedResourceID, err)
+			return recipes.ResourceMetadata{}, fmt.Errorf("connected resource %s not found: %w", connectedResourceID, err)
 		} else if err != nil {
-			return nil, fmt.Errorf("failed to get connected resource %s: %w", connectedResourceID, err)
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource %s: %w", connectedResourceID, err)
 		}
 
 		connectedResourceMetadata, err := resourceutil.GetAllPropertiesFromResource(connectedResource.Data)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
 		}
 
 		connectedResourcesMetadata[connName] = recipes.ConnectedResource{
@@ -303,7 +357,7 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		}
 	}
 
-	metadata := recipes.ResourceMetadata{
+	return recipes.ResourceMetadata{
 		Name:                         recipe.Name,
 		Parameters:                   recipe.Parameters,
 		EnvironmentID:                resource.ResourceMetadata().EnvironmentID(),
@@ -311,15 +365,7 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		ResourceID:                   resource.GetBaseResource().ID,
 		Properties:                   resourceProperties,
 		ConnectedResourcesProperties: connectedResourcesMetadata,
-	}
-
-	return c.engine.Execute(ctx, engine.ExecuteOptions{
-		BaseOptions: engine.BaseOptions{
-			Recipe: metadata,
-		},
-		PreviousState: prevState,
-		Simulated:     simulated,
-	})
+	}, nil
 }
 
 func getResourceAPIVersion[P rpv1.RadiusResourceModel](resource P) string {
@@ -357,12 +403,29 @@ func applyPropertiesToResource[P rpv1.RadiusResourceModel](resource P, propertie
 	return json.Unmarshal(bytes, resource)
 }
 
+// applySensitivePropertiesToResource stores the encrypted sensitive properties on resource types that retain them.
+// Resource types without a sensitiveProperties field ignore them.
+func applySensitivePropertiesToResource[P rpv1.RadiusResourceModel](resource P, sensitiveProperties map[string]any) error {
+	payload := map[string]any{
+		"sensitiveProperties": sensitiveProperties,
+	}
+
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bytes, resource)
+}
+
 // setRecipeStatus sets the recipe status for the given resource model.
 // It retrieves the resource metadata from the provided model, deep copies the current resource status,
 // updates the Recipe field with the supplied recipeStatus, and then applies the updated status back to the resource.
+// The drift status is cleared: the infrastructure was just deployed by the recipe, so an earlier drift no longer applies.
 func setRecipeStatus[P rpv1.RadiusResourceModel](data P, recipeStatus rpv1.RecipeStatus) {
 	rm := data.ResourceMetadata()
 	status := rm.GetResourceStatus().DeepCopyRecipeStatus()
 	status.Recipe = &recipeStatus
+	status.DriftStatus = nil
 	rm.SetResourceStatus(status)
 }
@@ -41,7 +41,9 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/crypto/encryption"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -1092,3 +1094,67 @@ func TestCreateOrUpdateResource_Run_SensitiveMultipleFields(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ctrl.Result{}, res)
 }
+
+func TestApplySensitivePropertiesToResource(t *testing.T) {
+	sensitiveProperties := map[string]any{
+		"secret": map[string]any{"encrypted": "ZW5j", "nonce": "bm9uY2U="},
+	}
+
+	t.Run("dynamic resource retains sensitive properties", func(t *testing.T) {
+		resource := &dynamicdatamodel.DynamicResource{
+			Properties: map[string]any{"secret": nil, "name": "value"},
+		}
+
+		err := applySensitivePropertiesToResource(resource, sensitiveProperties)
+		require.NoError(t, err)
+		require.Equal(t, sensitiveProperties, resource.SensitiveProperties)
+		require.Equal(t, map[string]any{"secret": nil, "name": "value"}, resource.Properties)
+	})
+
+	t.Run("other resource types ignore sensitive properties", func(t *testing.T) {
+		resource := &TestResource{}
+
+		err := applySensitivePropertiesToResource(resource, sensitiveProperties)
+		require.NoError(t, err)
+		require.Equal(t, &TestResource{}, resource)
+	})
+}
+
+func TestCreateOrUpdateResource_save(t *testing.T) {
+	setup := func(t *testing.T, provisioningState v1.ProvisioningState) (*CreateOrUpdateResource[*TestResource, TestResource], database.Client, string) {
+		databaseClient := inmemory.NewClient()
+
+		// The resource is read by the operation, then updated by a background job during the operation.
+		resource := &TestResource{BaseResource: v1.BaseResource{InternalMetadata: v1.InternalMetadata{AsyncProvisioningState: provisioningState}}}
+		obj := &database.Object{Metadata: database.Metadata{ID: TestResourceID}, Data: resource}
+		require.NoError(t, databaseClient.Save(context.Background(), obj))
+		readETag := obj.ETag
+		resource.Properties.Status.DriftStatus = &rpv1.DriftStatus{State: rpv1.DriftStateInSync}
+		require.NoError(t, databaseClient.Save(context.Background(), obj))
+
+		c, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: databaseClient}, successProcessorReference, nil, nil)
+		require.NoError(t, err)
+		return c.(*CreateOrUpdateResource[*TestResource, TestResource]), databaseClient, readETag
+	}
+
+	t.Run("operation in progress", func(t *testing.T) {
+		c, databaseClient, readETag := setup(t, v1.ProvisioningStateUpdating)
+
+		update := &database.Object{Metadata: database.Metadata{ID: TestResourceID}, Data: &TestResource{Properties: TestResourceProperties{IsProcessed: true}}}
+		require.NoError(t, c.save(context.Background(), update, readETag))
+
+		stored, err := databaseClient.Get(context.Background(), TestResourceID)
+		require.NoError(t, err)
+		resource := &TestResource{}
+		require.NoError(t, stored.As(resource))
+		require.True(t, resource.Properties.IsProcessed)
+	})
+
+	t.Run("operation completed", func(t *testing.T) {
+		c, _, readETag := setup(t, v1.ProvisioningStateSucceeded)
+
+		update := &database.Object{Metadata: database.Metadata{ID: TestResourceID}, Data: &TestResource{}}
+		err := c.save(context.Background(), update, readETag)
+		require.ErrorIs(t, err, &database.ErrConcurrency{})
+	})
+}
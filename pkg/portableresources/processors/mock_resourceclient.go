@@ -20,6 +20,7 @@ import (
 type MockResourceClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockResourceClientMockRecorder
+	isgomock struct{}
 }
 
 // MockResourceClientMockRecorder is the mock recorder for MockResourceClient.
@@ -40,17 +41,17 @@ func (m *MockResourceClient) EXPECT() *MockResourceClientMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockResourceClient) Delete(arg0 context.Context, arg1 string) error {
+func (m *MockResourceClient) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockResourceClientMockRecorder) Delete(arg0, arg1 any) *MockResourceClientDeleteCall {
+func (mr *MockResourceClientMockRecorder) Delete(ctx, id any) *MockResourceClientDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockResourceClient)(nil).Delete), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockResourceClient)(nil).Delete), ctx, id)
 	return &MockResourceClientDeleteCall{Call: call}
 }
 
@@ -76,3 +77,42 @@ func (c *MockResourceClientDeleteCall) DoAndReturn(f func(context.Context, strin
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Get mocks base method.
+func (m *MockResourceClient) Get(ctx context.Context, id string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockResourceClientMockRecorder) Get(ctx, id any) *MockResourceClientGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResourceClient)(nil).Get), ctx, id)
+	return &MockResourceClientGetCall{Call: call}
+}
+
+// MockResourceClientGetCall wrap *gomock.Call
+type MockResourceClientGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceClientGetCall) Return(arg0 map[string]any, arg1 error) *MockResourceClientGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceClientGetCall) Do(f func(context.Context, string) (map[string]any, error)) *MockResourceClientGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceClientGetCall) DoAndReturn(f func(context.Context, string) (map[string]any, error)) *MockResourceClientGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,6 +18,7 @@ package processors
 
 import (
 	context "context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -36,6 +37,7 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -86,6 +88,30 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// Get gets a resource, either through UCP, Azure, or Kubernetes, depending on the resource type.
+func (c *resourceClient) Get(ctx context.Context, id string) (map[string]any, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.Get", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	var resource map[string]any
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if !parsed.IsUCPQualified() || strings.HasPrefix(ns, "azure/") {
+		resource, err = c.getAzureResource(ctx, parsed)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		resource, err = c.getKubernetesResource(ctx, parsed)
+	} else {
+		resource, err = c.getUCPResource(ctx, parsed)
+	}
+
+	return resource, c.wrapError(parsed, err)
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -136,6 +162,35 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) getAzureResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	var err error
+	if id.IsUCPQualified() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetByID(ctx, id.String(), apiVersion, nil)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toMap(resp.GenericResource)
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -204,12 +259,85 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) getUCPResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	// NOTE: as for deletion, the API version of the generated client is ignored by UCP.
+	client, err := generated.NewGenericResourcesClient(id.Type(), id.RootScope(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.Name(), nil)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toMap(resp.GenericResource)
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
-	apiVersion, err := c.lookupKubernetesAPIVersion(id)
+	obj, err := c.kubernetesObject(id)
 	if err != nil {
 		return err
 	}
 
+	runtimeClient, err := c.kubernetesClient.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	err = runtime_client.IgnoreNotFound(runtimeClient.Delete(ctx, obj))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *resourceClient) getKubernetesResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	obj, err := c.kubernetesObject(id)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeClient, err := c.kubernetesClient.RuntimeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	err = runtimeClient.Get(ctx, runtime_client.ObjectKeyFromObject(obj), obj)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj.Object, nil
+}
+
+// toMap converts a resource returned by a client to its JSON representation.
+func toMap(resource any) (map[string]any, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// kubernetesObject returns an object holding the API version, kind, name and namespace of the Kubernetes resource.
+func (c *resourceClient) kubernetesObject(id resources.ID) (*unstructured.Unstructured, error) {
+	apiVersion, err := c.lookupKubernetesAPIVersion(id)
+	if err != nil {
+		return nil, err
+	}
+
 	group, kind, namespace, name := resources_kubernetes.ToParts(id)
 
 	metadata := map[string]any{
@@ -223,25 +351,13 @@ func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resour
 		apiVersion = fmt.Sprintf("%s/%s", group, apiVersion)
 	}
 
-	obj := unstructured.Unstructured{
+	return &unstructured.Unstructured{
 		Object: map[string]any{
 			"apiVersion": apiVersion,
 			"kind":       kind,
 			"metadata":   metadata,
 		},
-	}
-
-	runtimeClient, err := c.kubernetesClient.RuntimeClient()
-	if err != nil {
-		return err
-	}
-
-	err = runtime_client.IgnoreNotFound(runtimeClient.Delete(ctx, &obj))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	}, nil
 }
 
 func (c *resourceClient) lookupKubernetesAPIVersion(id resources.ID) (string, error) {
@@ -359,6 +359,161 @@ func Test_Delete_UCP(t *testing.T) {
 	})
 }
 
+func Test_Get_InvalidResourceID(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil)
+	_, err := c.Get(context.Background(), "invalid")
+	require.Error(t, err)
+}
+
+func Test_Get_ARM(t *testing.T) {
+	provider := handleJSONResponse(t, armresources.Provider{
+		Namespace: new("Microsoft.Compute"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{
+				ResourceType:      new("virtualMachines"),
+				DefaultAPIVersion: new(ARMAPIVersion),
+			},
+		},
+	}, 200)
+
+	t.Run("success - resource found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, armresources.GenericResource{ID: new(ARMResourceID)}, 200))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		resource, err := c.Get(context.Background(), AzureUCPResourceID)
+		require.NoError(t, err)
+		require.Equal(t, ARMResourceID, resource["id"])
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleNotFound(t))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		resource, err := c.Get(context.Background(), ARMResourceID)
+		require.NoError(t, err)
+		require.Nil(t, resource)
+	})
+
+	t.Run("failure - get fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code: v1.CodeConflict,
+			},
+		}, 409))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		_, err := c.Get(context.Background(), ARMResourceID)
+		require.Error(t, err)
+		require.IsType(t, &ResourceError{}, err)
+	})
+}
+
+func Test_Get_Kubernetes(t *testing.T) {
+	dc := &k8sutil.DiscoveryClient{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{
+						Name:    "api1",
+						Version: "v1",
+						Kind:    "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success - resource found", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+			Data: map[string][]byte{"key": []byte("value")},
+		}).Build()
+
+		kcp := kubernetesclientprovider.FromConfig(nil)
+		kcp.SetRuntimeClient(client)
+		kcp.SetDiscoveryClient(dc)
+
+		c := NewResourceClient(nil, nil, kcp)
+
+		resource, err := c.Get(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"key": "dmFsdWU="}, resource["data"])
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		kcp := kubernetesclientprovider.FromConfig(nil)
+		kcp.SetRuntimeClient(fake.NewClientBuilder().Build())
+		kcp.SetDiscoveryClient(dc)
+
+		c := NewResourceClient(nil, nil, kcp)
+
+		resource, err := c.Get(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.Nil(t, resource)
+	})
+}
+
+func Test_Get_UCP(t *testing.T) {
+	t.Run("success - resource found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, map[string]any{"id": AWSResourceID}, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil)
+
+		resource, err := c.Get(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.Equal(t, AWSResourceID, resource["id"])
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleNotFound(t))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil)
+
+		resource, err := c.Get(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.Nil(t, resource)
+	})
+}
+
 func newArmOptions(url string) *armauth.ArmConfig {
 	return &armauth.ArmConfig{
 		ClientOptions: clientv2.Options{
@@ -70,6 +70,12 @@ type ResourceClient interface {
 	//
 	// If the API version is omitted, then an attempt will be made to look up the API version.
 	Delete(ctx context.Context, id string) error
+
+	// Get gets a resource by id. It returns the resource as it is serialized by its API, or nil if the resource is
+	// not found.
+	//
+	// The API version is looked up as for Delete.
+	Get(ctx context.Context, id string) (map[string]any, error)
 }
 
 // ResourceError represents an error that occurred while processing a resource.
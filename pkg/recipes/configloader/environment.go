@@ -252,6 +252,8 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
 		definition.TemplateVersion = *c.TemplateVersion
+	case *v20231001preview.OpenTofuRecipeProperties:
+		definition.TemplateVersion = *c.TemplateVersion
 	case *v20231001preview.BicepRecipeProperties:
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
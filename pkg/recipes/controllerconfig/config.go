@@ -29,8 +29,10 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/pulumi"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 )
@@ -96,6 +98,20 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	terraformCache, err := cache.New(cache.Options{
+		Path:    options.Config.Terraform.Cache.Path,
+		MaxSize: options.Config.Terraform.Cache.MaxSize,
+		Offline: options.Config.Terraform.Cache.Offline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pulumiPath := options.Config.Pulumi.Path
+	if pulumiPath == "" {
+		pulumiPath = options.Config.Terraform.Path
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
@@ -114,7 +130,22 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 				terraform.TerraformOptions{
 					Path:     options.Config.Terraform.Path,
 					LogLevel: options.Config.Terraform.LogLevel,
+					Cache:    terraformCache,
+				}, *cfg.Kubernetes),
+			recipes.TemplateKindOpenTofu: terraform.NewOpenTofuDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
+				terraform.TerraformOptions{
+					Path:     options.Config.Terraform.Path,
+					LogLevel: options.Config.Terraform.LogLevel,
+					Cache:    terraformCache,
+					ExecPath: options.Config.OpenTofu.ExecPath,
 				}, *cfg.Kubernetes),
+			recipes.TemplateKindPulumi: pulumi.NewPulumiDriver(pulumi.PulumiOptions{
+				Path:           pulumiPath,
+				ExecPath:       options.Config.Pulumi.ExecPath,
+				BackendURL:     options.Config.Pulumi.BackendURL,
+				PassphraseFile: options.Config.Pulumi.PassphraseFile,
+				Env:            options.Config.Pulumi.Env,
+			}),
 		},
 	})
 
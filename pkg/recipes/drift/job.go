@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// pageSize is the maximum number of resources read from the database at once.
+	pageSize = 100
+
+	// checkResourceType is the type of the database records holding the time of the latest drift detection of a
+	// resource. The time is not stored in the resource, so that a detection that finds the same drift status does not
+	// update the resource.
+	checkResourceType = "System.Resources/driftChecks"
+)
+
+// ResourceTypeLister lists the resource types whose resources are checked for drift.
+type ResourceTypeLister interface {
+	// ListResourceTypes returns the fully-qualified names of the resource types, for example "Applications.Datastores/redisCaches".
+	ListResourceTypes(ctx context.Context) ([]string, error)
+}
+
+// StaticResourceTypes is a fixed list of resource types checked for drift.
+type StaticResourceTypes []string
+
+// ListResourceTypes implements ResourceTypeLister.
+func (s StaticResourceTypes) ListResourceTypes(ctx context.Context) ([]string, error) {
+	return s, nil
+}
+
+// Result is the result of a drift detection pass.
+type Result struct {
+	// ResourcesChecked is the number of resources deployed by a recipe that were checked.
+	ResourcesChecked int
+
+	// ResourcesDrifted is the number of resources whose infrastructure was changed outside of Radius.
+	ResourcesDrifted int
+
+	// Failures is the number of resources whose drift could not be detected or recorded.
+	Failures int
+}
+
+// Job detects the drift of the infrastructure deployed by recipes, and records it in the status of the resources.
+type Job struct {
+	// DatabaseClient is the client for the database storing the resources.
+	DatabaseClient database.Client
+
+	// Engine is the recipe engine detecting the drift of a recipe deployment.
+	Engine engine.Engine
+
+	// ResourceTypes lists the resource types to check.
+	ResourceTypes ResourceTypeLister
+}
+
+// check is the database record holding the time of the latest drift detection of a resource.
+type check struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string `json:"resourceId"`
+
+	// LastCheckedTime is the time of the latest drift detection of the resource.
+	LastCheckedTime time.Time `json:"lastCheckedTime"`
+}
+
+// recipeResource is the part of a stored resource needed to detect the drift of its recipe deployment. Portable
+// resources and dynamic resources share the same layout.
+type recipeResource struct {
+	ID                string               `json:"id"`
+	Type              string               `json:"type"`
+	ProvisioningState v1.ProvisioningState `json:"provisioningState,omitempty"`
+	Properties        struct {
+		Application          string                                 `json:"application,omitempty"`
+		Environment          string                                 `json:"environment,omitempty"`
+		Recipe               *portableresources.ResourceRecipe      `json:"recipe,omitempty"`
+		ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+		Status               rpv1.ResourceStatus                    `json:"status,omitempty"`
+	} `json:"properties"`
+}
+
+// Run runs a drift detection pass over all resources deployed by a recipe. A resource whose drift cannot be detected
+// is recorded with an unknown drift state and reported as a failure, and does not stop the pass. The check records of
+// the resources that were deleted are removed at the end of the pass.
+func (j *Job) Run(ctx context.Context) (*Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	result := &Result{}
+	start := time.Now().UTC()
+
+	resourceTypes, err := j.ResourceTypes.ListResourceTypes(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list resource types: %w", err)
+	}
+
+	for _, resourceType := range resourceTypes {
+		if err := j.checkResourceType(ctx, resourceType, result); err != nil {
+			return result, err
+		}
+	}
+
+	if err := j.pruneChecks(ctx, start); err != nil {
+		logger.Error(err, "Failed to remove the drift checks of deleted resources")
+	}
+
+	logger.Info("Drift detection completed",
+		"resourcesChecked", result.ResourcesChecked,
+		"resourcesDrifted", result.ResourcesDrifted,
+		"failures", result.Failures)
+
+	return result, nil
+}
+
+// checkResourceType detects the drift of all resources of a resource type.
+func (j *Job) checkResourceType(ctx context.Context, resourceType string, result *Result) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	query := database.Query{
+		RootScope:      "/",
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	token := ""
+	for {
+		page, err := j.DatabaseClient.Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(pageSize))
+		if err != nil {
+			return fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for i := range page.Items {
+			obj := &page.Items[i]
+			if err := j.checkResource(ctx, obj, result); err != nil {
+				logger.Error(err, "Failed to detect drift", "resourceID", obj.ID)
+				result.Failures++
+			}
+		}
+
+		if page.PaginationToken == "" {
+			return nil
+		}
+		token = page.PaginationToken
+	}
+}
+
+// checkResource detects the drift of a resource deployed by a recipe and records it in the status of the resource.
+// Resources not deployed by a recipe are skipped, as well as the resources with an operation in progress: the
+// operation saves the resource once it completes, and its recipe deployment is not complete yet. The resource is saved
+// only if its drift status changed and it was not updated since it was read, so that a resource whose drift status
+// is unchanged keeps its ETag. The time of the detection is saved in the check record of the resource.
+func (j *Job) checkResource(ctx context.Context, obj *database.Object, result *Result) error {
+	resource := &recipeResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	if !resource.ProvisioningState.IsTerminal() {
+		return nil
+	}
+
+	if resource.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual ||
+		resource.Properties.Status.Recipe == nil ||
+		len(resource.Properties.Status.OutputResources) == 0 {
+		return nil
+	}
+
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return err
+	}
+	properties, _ := data["properties"].(map[string]any)
+
+	recipeName := portableresources.DefaultRecipeName
+	var parameters map[string]any
+	if resource.Properties.Recipe != nil {
+		if resource.Properties.Recipe.Name != "" {
+			recipeName = resource.Properties.Recipe.Name
+		}
+		parameters = resource.Properties.Recipe.Parameters
+	}
+
+	result.ResourcesChecked++
+
+	// Connected resources are not loaded: they only provide input values for the recipe, and drift detection compares
+	// the deployed infrastructure with its actual state.
+	drift, detectErr := j.Engine.DetectDrift(ctx, engine.DriftOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          recipeName,
+				Parameters:    parameters,
+				EnvironmentID: resource.Properties.Environment,
+				ApplicationID: resource.Properties.Application,
+				ResourceID:    obj.ID,
+				Properties:    properties,
+			},
+		},
+		OutputResources: resource.Properties.Status.OutputResources,
+		RecipeStatus:    resource.Properties.Status.Recipe,
+	})
+
+	checkedTime := time.Now().UTC()
+	status := newDriftStatus(drift, detectErr, checkedTime)
+	if status.State == rpv1.DriftStateDrifted {
+		result.ResourcesDrifted++
+	}
+
+	driver := ""
+	if drift != nil {
+		driver = drift.Driver
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordDriftCheck(ctx, len(status.DriftedResources),
+		metrics.NewDriftAttributes(resource.Type, driver, string(status.State)))
+
+	if err := j.saveCheck(ctx, obj.ID, checkedTime); err != nil {
+		detectErr = errors.Join(detectErr, err)
+	}
+
+	if sameDriftStatus(resource.Properties.Status.DriftStatus, status) {
+		return detectErr
+	}
+
+	if properties == nil {
+		properties = map[string]any{}
+		data["properties"] = properties
+	}
+	statusData, _ := properties["status"].(map[string]any)
+	if statusData == nil {
+		statusData = map[string]any{}
+		properties["status"] = statusData
+	}
+	statusData["driftStatus"] = status
+
+	obj.Data = data
+	err := j.DatabaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated since it was read, and is checked again by the next pass.
+		ucplog.FromContextOrDiscard(ctx).Info("Resource was modified during drift detection, skipping", "resourceID", obj.ID)
+		return detectErr
+	} else if err != nil {
+		return errors.Join(detectErr, err)
+	}
+
+	return detectErr
+}
+
+// saveCheck saves the time of the latest drift detection of a resource in its check record.
+func (j *Job) saveCheck(ctx context.Context, resourceID string, checkedTime time.Time) error {
+	id, err := checkID(resourceID)
+	if err != nil {
+		return err
+	}
+
+	err = j.DatabaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data:     &check{ResourceID: resourceID, LastCheckedTime: checkedTime},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save the drift check: %w", err)
+	}
+
+	return nil
+}
+
+// pruneChecks deletes the check records not updated since the given time whose resource no longer exists.
+func (j *Job) pruneChecks(ctx context.Context, since time.Time) error {
+	query := database.Query{
+		RootScope:      "/",
+		ScopeRecursive: true,
+		ResourceType:   checkResourceType,
+	}
+
+	token := ""
+	for {
+		page, err := j.DatabaseClient.Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(pageSize))
+		if err != nil {
+			return fmt.Errorf("failed to query the drift checks: %w", err)
+		}
+
+		for i := range page.Items {
+			c := &check{}
+			if err := page.Items[i].As(c); err != nil {
+				return err
+			}
+			if !c.LastCheckedTime.Before(since) {
+				continue
+			}
+
+			_, err := j.DatabaseClient.Get(ctx, c.ResourceID)
+			if errors.Is(err, &database.ErrNotFound{}) {
+				err = j.DatabaseClient.Delete(ctx, page.Items[i].ID)
+			}
+			if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+				return err
+			}
+		}
+
+		if page.PaginationToken == "" {
+			return nil
+		}
+		token = page.PaginationToken
+	}
+}
+
+// checkID returns the ID of the check record of a resource. The record is stored in the scope of the resource, and is
+// named after the hash of the case-insensitive resource ID.
+func checkID(resourceID string) (string, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(id.String())))
+	return fmt.Sprintf("%s/providers/%s/%s", id.RootScope(), checkResourceType, hex.EncodeToString(hash[:])), nil
+}
+
+// sameDriftStatus returns true if the drift statuses have the same state, message and drifted resources.
+func sameDriftStatus(current *rpv1.DriftStatus, status *rpv1.DriftStatus) bool {
+	return current != nil &&
+		current.State == status.State &&
+		current.Message == status.Message &&
+		slices.Equal(current.DriftedResources, status.DriftedResources)
+}
+
+// newDriftStatus creates the drift status of a resource from the result of a drift detection run at the given time.
+func newDriftStatus(drift *recipes.DriftOutput, err error, checkedTime time.Time) *rpv1.DriftStatus {
+	status := &rpv1.DriftStatus{
+		State:           rpv1.DriftStateUnknown,
+		LastCheckedTime: checkedTime,
+	}
+
+	switch {
+	case err != nil:
+		status.Message = err.Error()
+	case drift == nil:
+		status.Message = "the recipe driver did not report any drift"
+	case len(drift.DriftedResources) > 0:
+		status.State = rpv1.DriftStateDrifted
+		status.DriftedResources = drift.DriftedResources
+		status.Message = strings.Join(drift.Messages, " ")
+	default:
+		status.State = rpv1.DriftStateInSync
+		status.Message = strings.Join(drift.Messages, " ")
+	}
+
+	return status
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceType     = "Applications.Datastores/redisCaches"
+	testEnvironmentID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	testResourcePrefix   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/"
+	testOutputResourceID = "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis"
+)
+
+func Test_Job_Run(t *testing.T) {
+	ctx := testcontext.New(t)
+	databaseClient := inmemory.NewClient()
+
+	saveResource(t, databaseClient, "drifted", recipeProperties("recipe"))
+	saveResource(t, databaseClient, "insync", recipeProperties("recipe"))
+	saveResource(t, databaseClient, "failed", recipeProperties(""))
+	saveResource(t, databaseClient, "manual", recipeProperties("manual"))
+	saveResource(t, databaseClient, "updating", recipeProperties("recipe"), "Updating")
+	saveResource(t, databaseClient, "undeployed", map[string]any{
+		"environment": testEnvironmentID,
+		"recipe":      map[string]any{"name": "default"},
+	})
+
+	mctrl := gomock.NewController(t)
+	eng := engine.NewMockEngine(mctrl)
+	eng.EXPECT().
+		DetectDrift(gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(ctx context.Context, opts engine.DriftOptions) (*recipes.DriftOutput, error) {
+			require.Equal(t, testEnvironmentID, opts.Recipe.EnvironmentID)
+			require.Len(t, opts.OutputResources, 1)
+			require.Equal(t, testOutputResourceID, opts.OutputResources[0].ID.String())
+
+			switch opts.Recipe.ResourceID {
+			case testResourcePrefix + "drifted":
+				require.Equal(t, "redis", opts.Recipe.Name)
+				return &recipes.DriftOutput{
+					Driver: recipes.TemplateKindBicep,
+					DriftedResources: []rpv1.DriftedResource{
+						{ID: testOutputResourceID, Type: "AWS.MemoryDB/Cluster", Change: rpv1.DriftChangeDeleted},
+					},
+				}, nil
+			case testResourcePrefix + "insync":
+				return &recipes.DriftOutput{Driver: recipes.TemplateKindBicep, DriftedResources: []rpv1.DriftedResource{}}, nil
+			default:
+				// The default recipe is used when the resource does not name one.
+				require.Equal(t, "default", opts.Recipe.Name)
+				return nil, errors.New("failed to read the state")
+			}
+		})
+
+	job := &Job{
+		DatabaseClient: databaseClient,
+		Engine:         eng,
+		ResourceTypes:  StaticResourceTypes{testResourceType},
+	}
+
+	result, err := job.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Result{ResourcesChecked: 3, ResourcesDrifted: 1, Failures: 1}, result)
+
+	status := getDriftStatus(t, databaseClient, "drifted")
+	require.Equal(t, rpv1.DriftStateDrifted, status.State)
+	require.False(t, status.LastCheckedTime.IsZero())
+	require.Equal(t, status.LastCheckedTime, getCheck(t, databaseClient, "drifted").LastCheckedTime)
+	require.Equal(t, []rpv1.DriftedResource{
+		{ID: testOutputResourceID, Type: "AWS.MemoryDB/Cluster", Change: rpv1.DriftChangeDeleted},
+	}, status.DriftedResources)
+
+	status = getDriftStatus(t, databaseClient, "insync")
+	require.Equal(t, rpv1.DriftStateInSync, status.State)
+	require.Empty(t, status.DriftedResources)
+
+	status = getDriftStatus(t, databaseClient, "failed")
+	require.Equal(t, rpv1.DriftStateUnknown, status.State)
+	require.Equal(t, "failed to read the state", status.Message)
+
+	require.Nil(t, getDriftStatus(t, databaseClient, "manual"))
+	require.Nil(t, getDriftStatus(t, databaseClient, "updating"))
+	require.Nil(t, getDriftStatus(t, databaseClient, "undeployed"))
+}
+
+func Test_Job_Run_UnchangedStatus(t *testing.T) {
+	ctx := testcontext.New(t)
+	databaseClient := inmemory.NewClient()
+	saveResource(t, databaseClient, "redis", recipeProperties("recipe"))
+
+	drifted := false
+	mctrl := gomock.NewController(t)
+	eng := engine.NewMockEngine(mctrl)
+	eng.EXPECT().
+		DetectDrift(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(ctx context.Context, opts engine.DriftOptions) (*recipes.DriftOutput, error) {
+			output := &recipes.DriftOutput{Driver: recipes.TemplateKindBicep}
+			if drifted {
+				output.DriftedResources = []rpv1.DriftedResource{{ID: testOutputResourceID, Type: "AWS.MemoryDB/Cluster", Change: rpv1.DriftChangeModified}}
+			}
+			return output, nil
+		})
+
+	job := &Job{
+		DatabaseClient: databaseClient,
+		Engine:         eng,
+		ResourceTypes:  StaticResourceTypes{testResourceType},
+	}
+
+	_, err := job.Run(ctx)
+	require.NoError(t, err)
+	first := getResource(t, databaseClient, "redis")
+	firstCheck := getCheck(t, databaseClient, "redis")
+
+	// The resource is not saved again when its drift status is unchanged, only the time of the check is.
+	_, err = job.Run(ctx)
+	require.NoError(t, err)
+	second := getResource(t, databaseClient, "redis")
+	require.Equal(t, first.ETag, second.ETag)
+	require.False(t, getCheck(t, databaseClient, "redis").LastCheckedTime.Before(firstCheck.LastCheckedTime))
+
+	// The resource is saved when its drift status changes.
+	drifted = true
+	_, err = job.Run(ctx)
+	require.NoError(t, err)
+	third := getResource(t, databaseClient, "redis")
+	require.NotEqual(t, first.ETag, third.ETag)
+	require.Equal(t, rpv1.DriftStateDrifted, getDriftStatus(t, databaseClient, "redis").State)
+}
+
+func Test_Job_Run_PruneChecks(t *testing.T) {
+	ctx := testcontext.New(t)
+	databaseClient := inmemory.NewClient()
+	saveResource(t, databaseClient, "redis", recipeProperties("recipe"))
+	saveResource(t, databaseClient, "deleted", recipeProperties("recipe"))
+
+	mctrl := gomock.NewController(t)
+	eng := engine.NewMockEngine(mctrl)
+	eng.EXPECT().
+		DetectDrift(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(&recipes.DriftOutput{Driver: recipes.TemplateKindBicep}, nil)
+
+	job := &Job{
+		DatabaseClient: databaseClient,
+		Engine:         eng,
+		ResourceTypes:  StaticResourceTypes{testResourceType},
+	}
+
+	_, err := job.Run(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, getCheck(t, databaseClient, "deleted"))
+
+	require.NoError(t, databaseClient.Delete(ctx, testResourcePrefix+"deleted"))
+	_, err = job.Run(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, getCheck(t, databaseClient, "redis"))
+	require.Nil(t, getCheck(t, databaseClient, "deleted"))
+}
+
+func Test_Job_Run_ListResourceTypesFailure(t *testing.T) {
+	job := &Job{
+		DatabaseClient: inmemory.NewClient(),
+		ResourceTypes:  failingResourceTypes{},
+	}
+
+	_, err := job.Run(testcontext.New(t))
+	require.ErrorContains(t, err, "failed to list resource types")
+}
+
+type failingResourceTypes struct{}
+
+func (failingResourceTypes) ListResourceTypes(ctx context.Context) ([]string, error) {
+	return nil, errors.New("unavailable")
+}
+
+// recipeProperties returns the properties of a resource deployed by a recipe. The recipe is named after the
+// resource provisioning, and the default recipe is used when it is empty.
+func recipeProperties(provisioning string) map[string]any {
+	properties := map[string]any{
+		"environment": testEnvironmentID,
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": testOutputResourceID, "radiusManaged": true},
+			},
+			"recipe": map[string]any{"templateKind": "bicep", "templatePath": "ghcr.io/recipes/redis:latest"},
+		},
+	}
+
+	switch provisioning {
+	case "manual":
+		properties["resourceProvisioning"] = "manual"
+	case "recipe":
+		properties["recipe"] = map[string]any{"name": "redis"}
+	}
+
+	return properties
+}
+
+// saveResource saves a resource with the given properties. The provisioning state of the resource, if given, is
+// the state of the operation in progress.
+func saveResource(t *testing.T, databaseClient database.Client, name string, properties map[string]any, provisioningState ...string) {
+	data := map[string]any{
+		"id":         testResourcePrefix + name,
+		"name":       name,
+		"type":       testResourceType,
+		"properties": properties,
+	}
+	if len(provisioningState) > 0 {
+		data["provisioningState"] = provisioningState[0]
+	}
+
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testResourcePrefix + name},
+		Data:     data,
+	})
+	require.NoError(t, err)
+}
+
+func getResource(t *testing.T, databaseClient database.Client, name string) *database.Object {
+	obj, err := databaseClient.Get(context.Background(), testResourcePrefix+name)
+	require.NoError(t, err)
+	return obj
+}
+
+// getCheck returns the check record of the resource, or nil if the resource has none.
+func getCheck(t *testing.T, databaseClient database.Client, name string) *check {
+	id, err := checkID(testResourcePrefix + name)
+	require.NoError(t, err)
+
+	obj, err := databaseClient.Get(context.Background(), id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	}
+	require.NoError(t, err)
+
+	c := &check{}
+	require.NoError(t, obj.As(c))
+	require.Equal(t, testResourcePrefix+name, c.ResourceID)
+	return c
+}
+
+func getDriftStatus(t *testing.T, databaseClient database.Client, name string) *rpv1.DriftStatus {
+	obj := getResource(t, databaseClient, name)
+
+	resource := &recipeResource{}
+	require.NoError(t, obj.As(resource))
+	return resource.Properties.Status.DriftStatus
+}
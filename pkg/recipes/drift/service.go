@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultInterval is the default interval between two drift detection passes.
+	DefaultInterval = time.Hour
+
+	// RadiusNamespace is the namespace of the Kubernetes leases used to elect the replica running the drift
+	// detection passes.
+	RadiusNamespace = "radius-system"
+)
+
+// JobFactory creates the job run by each drift detection pass.
+type JobFactory func(ctx context.Context) (*Job, error)
+
+// LeaseClientFactory creates the Kubernetes client used to elect the replica running the drift detection passes.
+type LeaseClientFactory func() (k8s.Interface, error)
+
+// Service periodically detects the drift of the infrastructure deployed by recipes. The passes run in a single
+// replica, elected with a Kubernetes lease.
+type Service struct {
+	name           string
+	leaseName      string
+	options        hostoptions.DriftDetectionOptions
+	newJob         JobFactory
+	newLeaseClient LeaseClientFactory
+}
+
+// NewService creates a new drift detection service with the given name used for logging. The replica running the
+// passes holds the Kubernetes lease with the given lease name in the radius namespace. It returns nil if drift
+// detection is disabled.
+func NewService(name string, leaseName string, options hostoptions.DriftDetectionOptions, newJob JobFactory, newLeaseClient LeaseClientFactory) *Service {
+	if !options.Enabled {
+		return nil
+	}
+
+	return &Service{name: name, leaseName: leaseName, options: options, newJob: newJob, newLeaseClient: newLeaseClient}
+}
+
+// Name returns the name of the service used for logging.
+func (s *Service) Name() string {
+	return s.name
+}
+
+// Run runs the service.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval, err := parseInterval(s.options)
+	if err != nil {
+		return err
+	}
+
+	job, err := s.newJob(ctx)
+	if err != nil {
+		return err
+	}
+
+	leaseClient, err := s.newLeaseClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	return kubeutil.RunAsLeader(ctx, leaseClient, RadiusNamespace, s.leaseName, func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if _, err := job.Run(ctx); err != nil {
+				logger.Error(err, "Failed to detect drift")
+			}
+		}
+	})
+}
+
+// parseInterval returns the interval between two drift detection passes.
+func parseInterval(options hostoptions.DriftDetectionOptions) (time.Duration, error) {
+	if options.Interval == "" {
+		return DefaultInterval, nil
+	}
+
+	interval, err := time.ParseDuration(options.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid drift detection interval %q: %w", options.Interval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid drift detection interval %q: must be positive", options.Interval)
+	}
+
+	return interval, nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewService(t *testing.T) {
+	require.Nil(t, NewService("test", "test-lease", hostoptions.DriftDetectionOptions{}, nil, nil))
+
+	service := NewService("test", "test-lease", hostoptions.DriftDetectionOptions{Enabled: true}, nil, nil)
+	require.NotNil(t, service)
+	require.Equal(t, "test", service.Name())
+}
+
+func Test_ParseInterval(t *testing.T) {
+	interval, err := parseInterval(hostoptions.DriftDetectionOptions{Interval: ""})
+	require.NoError(t, err)
+	require.Equal(t, DefaultInterval, interval)
+
+	interval, err = parseInterval(hostoptions.DriftDetectionOptions{Interval: "30m"})
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Minute, interval)
+
+	_, err = parseInterval(hostoptions.DriftDetectionOptions{Interval: "-1m"})
+	require.Error(t, err)
+
+	_, err = parseInterval(hostoptions.DriftDetectionOptions{Interval: "soon"})
+	require.Error(t, err)
+}
@@ -87,33 +87,11 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
+	recipeData, err := d.downloadTemplate(ctx, opts.BaseOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	registryClient := d.RegistryClient
-	// Get ORAS authentication client if secrets are found for the registry.
-	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
-		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
-		if err != nil {
-			return nil, err
-		}
-
-		registryClient = authClient
-	}
-
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
 	// create the context object to be passed to the recipe deployment
 	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
 	if err != nil {
@@ -194,9 +172,66 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// The deployed properties of the resources are recorded to detect the changes made outside of Radius.
+	recipeResponse.Status.ResourceHashes = d.resourceHashes(ctx, recipeResponse.Resources)
 	return recipeResponse, nil
 }
 
+// Plan fetches the recipe contents from the container registry and compares the resources declared by the template
+// with the resources previously deployed by the recipe. The deployment engine does not support what-if, so resources
+// are reported as created, updated or deleted, without the changes to their properties.
+func (d *bicepDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.PlanOutput, error) {
+	recipeData, err := d.downloadTemplate(ctx, opts.BaseOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := []resources.ID{}
+	for _, resourceID := range opts.PrevState {
+		id, err := resources.Parse(resourceID)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError)
+		}
+		deployed = append(deployed, id)
+	}
+
+	return preparePlanOutput(recipeData, deployed), nil
+}
+
+// downloadTemplate fetches the recipe contents from the container registry, using the registry credentials of the
+// recipe configuration when there are any.
+func (d *bicepDriver) downloadTemplate(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	registryClient := d.RegistryClient
+	// Get ORAS authentication client if secrets are found for the registry.
+	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
+		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+
+		registryClient = authClient
+	}
+
+	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	return recipeData, nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
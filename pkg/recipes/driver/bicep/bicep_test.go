@@ -514,6 +514,61 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	require.Equal(t, actualErr, &expErr)
 }
 
+func Test_Bicep_Plan_Success(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	ctx := testcontext.New(t)
+	driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+	recipeDefinition := recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: ts.TestImageURL,
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+
+	plan, err := driverBicep.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Definition: recipeDefinition,
+		},
+		PrevState: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipes.TemplateKindBicep, plan.Driver)
+	require.Equal(t, recipes.PlanKindWhatIf, plan.Kind)
+	require.Equal(t, []recipes.ResourceChange{
+		{
+			Address: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+			Type:    "core/Service",
+			Name:    "redis",
+			Action:  recipes.PlanActionDelete,
+		},
+	}, plan.Changes)
+	require.Equal(t, []string{whatIfNotSupportedMessage}, plan.Messages)
+}
+
+func Test_Bicep_Plan_DownloadError(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	ctx := testcontext.New(t)
+	driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+	recipeDefinition := recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: ts.TestServer.URL + "/nonexisting:latest",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+
+	_, err := driverBicep.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Definition: recipeDefinition,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDownloadFailed, recipes.GetErrorDetails(err).Code)
+}
+
 func Test_GetGCOutputResources(t *testing.T) {
 	d := &bicepDriver{}
 	before := []string{
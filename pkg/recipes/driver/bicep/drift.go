@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// driftNotComparedMessage explains why the properties of some resources were not compared.
+	driftNotComparedMessage = "The properties of resources deployed before drift detection was enabled are not compared: only their deletion is detected. Redeploy the resource to compare them."
+)
+
+// DetectDrift gets each output resource managed by Radius and compares it with the hash of its properties read after
+// the deployment. Resources that are not found are reported as deleted, and resources whose properties changed are
+// reported as modified.
+//
+// The deployment engine does not support what-if, so the comparison is made on the properties that are set by the
+// template: see resourceHash.
+func (d *bicepDriver) DetectDrift(ctx context.Context, opts driver.DriftOptions) (*recipes.DriftOutput, error) {
+	var hashes map[string]string
+	if opts.RecipeStatus != nil {
+		hashes = opts.RecipeStatus.ResourceHashes
+	}
+
+	changes := make([]rpv1.DriftChange, len(opts.OutputResources))
+	compared := make([]bool, len(opts.OutputResources))
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	for i := range opts.OutputResources {
+		outputResource := opts.OutputResources[i]
+
+		// Resources that are not managed by Radius are not deployed by the recipe.
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			continue
+		}
+
+		g.Go(func() error {
+			id := outputResource.ID.String()
+			resource, err := d.ResourceClient.Get(groupCtx, id)
+			if err != nil {
+				return recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+			}
+
+			if resource == nil {
+				changes[i] = rpv1.DriftChangeDeleted
+				compared[i] = true
+				return nil
+			}
+
+			deployed, ok := hashes[id]
+			if !ok {
+				return nil
+			}
+
+			actual, err := resourceHash(outputResource.ID, resource)
+			if err != nil {
+				return recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+			}
+
+			if actual != deployed {
+				changes[i] = rpv1.DriftChangeModified
+			}
+			compared[i] = true
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	output := &recipes.DriftOutput{
+		Driver:           recipes.TemplateKindBicep,
+		DriftedResources: []rpv1.DriftedResource{},
+	}
+	for i, outputResource := range opts.OutputResources {
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			continue
+		}
+
+		if !compared[i] && len(output.Messages) == 0 {
+			output.Messages = append(output.Messages, driftNotComparedMessage)
+		}
+
+		if changes[i] == "" {
+			continue
+		}
+
+		output.DriftedResources = append(output.DriftedResources, rpv1.DriftedResource{
+			ID:     outputResource.ID.String(),
+			Type:   outputResource.ID.Type(),
+			Name:   outputResource.ID.Name(),
+			Change: changes[i],
+		})
+	}
+
+	return output, nil
+}
+
+// resourceHashes gets the resources deployed by the recipe and returns the hashes of their properties, by resource
+// ID. Resources that cannot be read are omitted: drift detection only detects their deletion.
+func (d *bicepDriver) resourceHashes(ctx context.Context, ids []string) map[string]string {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	hashes := make([]string, len(ids))
+	g, groupCtx := errgroup.WithContext(ctx)
+	for i, id := range ids {
+		g.Go(func() error {
+			parsed, err := resources.ParseResource(id)
+			if err != nil {
+				logger.Info("Failed to parse the ID of a recipe resource, its drift will not be compared", "id", id, "error", err.Error())
+				return nil
+			}
+
+			resource, err := d.ResourceClient.Get(groupCtx, id)
+			if err != nil || resource == nil {
+				logger.Info("Failed to get a recipe resource, its drift will not be compared", "id", id, "error", err)
+				return nil
+			}
+
+			hashes[i], err = resourceHash(parsed, resource)
+			if err != nil {
+				logger.Info("Failed to hash a recipe resource, its drift will not be compared", "id", id, "error", err.Error())
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	result := map[string]string{}
+	for i, id := range ids {
+		if hashes[i] == "" {
+			continue
+		}
+
+		// Hashes are looked up by the ID of the output resource, so the ID is normalized the same way.
+		parsed, _ := resources.ParseResource(id)
+		result[parsed.String()] = hashes[i]
+	}
+
+	return result
+}
+
+// resourceHash returns the hash of the properties of a resource that are set by a template. Fields that are
+// maintained by the resource provider, such as the provisioning state or the status of Kubernetes resources, are
+// excluded so that they do not report drift.
+func resourceHash(id resources.ID, resource map[string]any) (string, error) {
+	fields := map[string]any{}
+	if strings.HasPrefix(strings.ToLower(id.PlaneNamespace()), "kubernetes/") {
+		for _, key := range []string{"spec", "data", "stringData", "binaryData"} {
+			if value, ok := resource[key]; ok {
+				fields[key] = value
+			}
+		}
+		// Annotations are not compared: they are also maintained by controllers, such as the revision of deployments.
+		if metadata, ok := resource["metadata"].(map[string]any); ok {
+			fields["labels"] = metadata["labels"]
+		}
+	} else {
+		if properties, ok := resource["properties"].(map[string]any); ok {
+			comparable := map[string]any{}
+			for key, value := range properties {
+				if key != "provisioningState" {
+					comparable[key] = value
+				}
+			}
+			fields["properties"] = comparable
+		}
+		fields["tags"] = resource["tags"]
+		fields["sku"] = resource["sku"]
+	}
+
+	// Maps are marshaled with sorted keys, so the hash does not depend on their order.
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Bicep_DetectDrift(t *testing.T) {
+	deployment := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "recipe-app", "redis")
+	service := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "recipe-app", "redis")
+	secret := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "recipe-app", "redis")
+
+	outputResources := []rpv1.OutputResource{
+		{ID: deployment, RadiusManaged: new(true)},
+		{ID: service, RadiusManaged: new(true)},
+		// We don't expect a check to be made when RadiusManaged is false.
+		{ID: secret, RadiusManaged: new(false)},
+	}
+
+	deployedDeployment := map[string]any{"spec": map[string]any{"replicas": float64(1)}, "status": map[string]any{"readyReplicas": float64(1)}}
+	deployedService := map[string]any{"spec": map[string]any{"ports": []any{map[string]any{"port": float64(6379)}}}}
+
+	deploymentHash, err := resourceHash(deployment, deployedDeployment)
+	require.NoError(t, err)
+	serviceHash, err := resourceHash(service, deployedService)
+	require.NoError(t, err)
+
+	recipeStatus := &rpv1.RecipeStatus{
+		ResourceHashes: map[string]string{deployment.String(): deploymentHash, service.String(): serviceHash},
+	}
+
+	t.Run("deleted resources", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Get(gomock.Any(), deployment.String()).Times(1).Return(deployedDeployment, nil)
+		client.EXPECT().Get(gomock.Any(), service.String()).Times(1).Return(nil, nil)
+
+		output, err := driverBicep.DetectDrift(ctx, driver.DriftOptions{OutputResources: outputResources, RecipeStatus: recipeStatus})
+		require.NoError(t, err)
+		require.Equal(t, recipes.TemplateKindBicep, output.Driver)
+		require.Equal(t, []rpv1.DriftedResource{
+			{ID: service.String(), Type: "core/Service", Name: "redis", Change: rpv1.DriftChangeDeleted},
+		}, output.DriftedResources)
+		require.Empty(t, output.Messages)
+	})
+
+	t.Run("modified resources", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		driverBicep, client := setupDeleteInputs(t)
+		// The status is maintained by Kubernetes, so only the change to the spec is a drift.
+		client.EXPECT().Get(gomock.Any(), deployment.String()).Times(1).
+			Return(map[string]any{"spec": map[string]any{"replicas": float64(3)}, "status": map[string]any{"readyReplicas": float64(3)}}, nil)
+		client.EXPECT().Get(gomock.Any(), service.String()).Times(1).
+			Return(map[string]any{"spec": deployedService["spec"], "status": map[string]any{"loadBalancer": map[string]any{}}}, nil)
+
+		output, err := driverBicep.DetectDrift(ctx, driver.DriftOptions{OutputResources: outputResources, RecipeStatus: recipeStatus})
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.DriftedResource{
+			{ID: deployment.String(), Type: "apps/Deployment", Name: "redis", Change: rpv1.DriftChangeModified},
+		}, output.DriftedResources)
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Get(gomock.Any(), deployment.String()).Times(1).Return(deployedDeployment, nil)
+		client.EXPECT().Get(gomock.Any(), service.String()).Times(1).Return(deployedService, nil)
+
+		output, err := driverBicep.DetectDrift(ctx, driver.DriftOptions{OutputResources: outputResources, RecipeStatus: recipeStatus})
+		require.NoError(t, err)
+		require.Empty(t, output.DriftedResources)
+	})
+
+	t.Run("properties not recorded", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(2).Return(map[string]any{"spec": map[string]any{}}, nil)
+
+		output, err := driverBicep.DetectDrift(ctx, driver.DriftOptions{OutputResources: outputResources})
+		require.NoError(t, err)
+		require.Empty(t, output.DriftedResources)
+		require.Equal(t, []string{driftNotComparedMessage}, output.Messages)
+	})
+
+	t.Run("check fails", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, errors.New("connection refused"))
+
+		_, err := driverBicep.DetectDrift(ctx, driver.DriftOptions{OutputResources: outputResources, RecipeStatus: recipeStatus})
+		require.Error(t, err)
+		require.Equal(t, recipes.RecipeDriftDetectionFailed, recipes.GetErrorDetails(err).Code)
+	})
+}
+
+func Test_Bicep_ResourceHashes(t *testing.T) {
+	ctx := testcontext.New(t)
+	driverBicep, client := setupDeleteInputs(t)
+
+	storageAccount := "/planes/azure/azurecloud/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/account"
+	failed := "/planes/azure/azurecloud/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/failed"
+
+	client.EXPECT().Get(gomock.Any(), storageAccount).Times(1).
+		Return(map[string]any{"properties": map[string]any{"accessTier": "Hot", "provisioningState": "Succeeded"}, "tags": map[string]any{"env": "dev"}}, nil)
+	client.EXPECT().Get(gomock.Any(), failed).Times(1).Return(nil, errors.New("connection refused"))
+
+	hashes := driverBicep.resourceHashes(ctx, []string{storageAccount, failed})
+	require.Len(t, hashes, 1)
+
+	// The provisioning state is maintained by the resource provider, so it is not compared.
+	id, err := resources.ParseResource(storageAccount)
+	require.NoError(t, err)
+	expected, err := resourceHash(id, map[string]any{"properties": map[string]any{"accessTier": "Hot", "provisioningState": "Updating"}, "tags": map[string]any{"env": "dev"}})
+	require.NoError(t, err)
+	require.Equal(t, expected, hashes[storageAccount])
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// whatIfNotSupportedMessage explains the limits of the plan of a Bicep recipe.
+	whatIfNotSupportedMessage = "The deployment engine does not support what-if: the resources declared by the recipe are compared with the resources it deployed by type and name. Resources that are already deployed are reported as updated, without the changes to their properties."
+)
+
+// preparePlanOutput compares the resources declared by the compiled template of a Bicep recipe with the resources
+// deployed by the recipe. Declared resources that are not deployed are created, deployed resources that are no longer
+// declared are deleted, and the others are updated. Resources declared as existing are only referenced by the
+// template, so they are left out.
+//
+// The deployment engine cannot evaluate the template without deploying it, so resources are matched by type and
+// name. A resource whose name is computed by an expression is matched with a deployed resource of the same type that
+// no other declared resource matches.
+//
+// Templates with symbolic names declare their resources as an object keyed by symbolic name, other templates as an
+// array:
+//
+//	{"resources": {"storage": {"type": "Microsoft.Storage/storageAccounts@2023-01-01", "name": "..."}}}
+//	{"resources": [{"type": "Microsoft.Storage/storageAccounts", "apiVersion": "2023-01-01", "name": "..."}]}
+func preparePlanOutput(template map[string]any, deployed []resources.ID) *recipes.PlanOutput {
+	output := &recipes.PlanOutput{
+		Driver:   recipes.TemplateKindBicep,
+		Kind:     recipes.PlanKindWhatIf,
+		Changes:  []recipes.ResourceChange{},
+		Messages: []string{whatIfNotSupportedMessage},
+	}
+
+	declared := declaredResources(template)
+
+	// Literal names are matched first, so that a resource whose name is an expression is not matched with a
+	// deployed resource that another resource declares by name.
+	matched := make([]bool, len(deployed))
+	match := func(change recipes.ResourceChange, literal bool) bool {
+		for i, id := range deployed {
+			if matched[i] || !strings.EqualFold(id.Type(), change.Type) {
+				continue
+			}
+			if literal && !strings.EqualFold(deployedResourceName(id), change.Name) {
+				continue
+			}
+
+			matched[i] = true
+			return true
+		}
+
+		return false
+	}
+
+	updated := make([]bool, len(declared))
+	for i, change := range declared {
+		if !isExpression(change.Name) {
+			updated[i] = match(change, true)
+		}
+	}
+	for i, change := range declared {
+		if !updated[i] && isExpression(change.Name) {
+			updated[i] = match(change, false)
+		}
+	}
+
+	for i, change := range declared {
+		change.Action = recipes.PlanActionCreate
+		if updated[i] {
+			change.Action = recipes.PlanActionUpdate
+		}
+		output.Changes = append(output.Changes, change)
+	}
+
+	for i, id := range deployed {
+		if matched[i] {
+			continue
+		}
+
+		output.Changes = append(output.Changes, recipes.ResourceChange{
+			Address: id.String(),
+			Type:    id.Type(),
+			Name:    deployedResourceName(id),
+			Action:  recipes.PlanActionDelete,
+		})
+	}
+
+	return output
+}
+
+// declaredResources returns the resources declared by the compiled template of a Bicep recipe, without an action.
+func declaredResources(template map[string]any) []recipes.ResourceChange {
+	changes := []recipes.ResourceChange{}
+	addResource := func(address string, value any) {
+		resource, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		if existing, ok := resource["existing"].(bool); ok && existing {
+			return
+		}
+
+		resourceType, _ := resource["type"].(string)
+		resourceType, _, _ = strings.Cut(resourceType, "@")
+
+		changes = append(changes, recipes.ResourceChange{
+			Address: address,
+			Type:    resourceType,
+			Name:    templateResourceName(resource),
+		})
+	}
+
+	switch resources := template["resources"].(type) {
+	case map[string]any:
+		names := make([]string, 0, len(resources))
+		for name := range resources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			addResource(name, resources[name])
+		}
+
+	case []any:
+		for i, resource := range resources {
+			addResource(fmt.Sprintf("resources[%d]", i), resource)
+		}
+	}
+
+	return changes
+}
+
+// isExpression returns true if the template value is an expression, such as "[parameters('name')]". Expressions are
+// only evaluated by the deployment.
+func isExpression(value string) bool {
+	return value == "" || strings.HasPrefix(value, "[") && !strings.HasPrefix(value, "[[")
+}
+
+// deployedResourceName returns the name of a deployed resource as it is declared in a template. The names of child
+// resources are declared with the names of their parents, e.g. "account/default".
+func deployedResourceName(id resources.ID) string {
+	if len(id.ExtensionSegments()) > 0 || len(id.TypeSegments()) == 0 {
+		return id.Name()
+	}
+
+	names := make([]string, len(id.TypeSegments()))
+	for i, segment := range id.TypeSegments() {
+		names[i] = segment.Name
+	}
+	return strings.Join(names, resources.SegmentSeparator)
+}
+
+// templateResourceName returns the name of a template resource. Resources of extensions declare their name in their
+// properties, or in their metadata for Kubernetes resources. Names computed by an expression are returned as the
+// expression.
+func templateResourceName(resource map[string]any) string {
+	if name, ok := resource["name"].(string); ok {
+		return name
+	}
+
+	if properties, ok := resource["properties"].(map[string]any); ok {
+		if name, ok := properties["name"].(string); ok {
+			return name
+		}
+
+		if metadata, ok := properties["metadata"].(map[string]any); ok {
+			if name, ok := metadata["name"].(string); ok {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PreparePlanOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		template map[string]any
+		deployed []string
+		expected []recipes.ResourceChange
+	}{
+		{
+			name: "symbolic names",
+			template: map[string]any{
+				"resources": map[string]any{
+					"storage": map[string]any{
+						"type": "Microsoft.Storage/storageAccounts@2023-01-01",
+						"name": "[parameters('name')]",
+					},
+					"existingVault": map[string]any{
+						"type":     "Microsoft.KeyVault/vaults@2023-02-01",
+						"existing": true,
+						"name":     "vault",
+					},
+					"configMap": map[string]any{
+						"import": "kubernetes",
+						"type":   "core/ConfigMap@v1",
+						"properties": map[string]any{
+							"name": "settings",
+						},
+					},
+				},
+			},
+			expected: []recipes.ResourceChange{
+				{Address: "configMap", Type: "core/ConfigMap", Name: "settings", Action: recipes.PlanActionCreate},
+				{Address: "storage", Type: "Microsoft.Storage/storageAccounts", Name: "[parameters('name')]", Action: recipes.PlanActionCreate},
+			},
+		},
+		{
+			name: "resources array",
+			template: map[string]any{
+				"resources": []any{
+					map[string]any{
+						"type":       "Microsoft.Storage/storageAccounts",
+						"apiVersion": "2023-01-01",
+						"name":       "account",
+					},
+				},
+			},
+			expected: []recipes.ResourceChange{
+				{Address: "resources[0]", Type: "Microsoft.Storage/storageAccounts", Name: "account", Action: recipes.PlanActionCreate},
+			},
+		},
+		{
+			name: "deployed resources",
+			template: map[string]any{
+				"resources": map[string]any{
+					"account": map[string]any{
+						"type": "Microsoft.Storage/storageAccounts@2023-01-01",
+						"name": "[parameters('name')]",
+					},
+					"blobs": map[string]any{
+						"type": "Microsoft.Storage/storageAccounts/blobServices@2023-01-01",
+						"name": "Account/default",
+					},
+					"deployment": map[string]any{
+						"import": "kubernetes",
+						"type":   "apps/Deployment@v1",
+						"properties": map[string]any{
+							"metadata": map[string]any{
+								"name": "redis",
+							},
+						},
+					},
+					"service": map[string]any{
+						"import": "kubernetes",
+						"type":   "core/Service@v1",
+						"properties": map[string]any{
+							"metadata": map[string]any{
+								"name": "redis",
+							},
+						},
+					},
+				},
+			},
+			deployed: []string{
+				"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/account",
+				"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/account/blobServices/default",
+				"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+				"/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/redis",
+			},
+			expected: []recipes.ResourceChange{
+				{Address: "account", Type: "Microsoft.Storage/storageAccounts", Name: "[parameters('name')]", Action: recipes.PlanActionUpdate},
+				{Address: "blobs", Type: "Microsoft.Storage/storageAccounts/blobServices", Name: "Account/default", Action: recipes.PlanActionUpdate},
+				{Address: "deployment", Type: "apps/Deployment", Name: "redis", Action: recipes.PlanActionCreate},
+				{Address: "service", Type: "core/Service", Name: "redis", Action: recipes.PlanActionUpdate},
+				{
+					Address: "/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/redis",
+					Type:    "core/ConfigMap",
+					Name:    "redis",
+					Action:  recipes.PlanActionDelete,
+				},
+			},
+		},
+		{
+			name:     "no resources",
+			template: map[string]any{},
+			expected: []recipes.ResourceChange{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deployed := []resources.ID{}
+			for _, id := range tc.deployed {
+				deployed = append(deployed, resources.MustParse(id))
+			}
+
+			plan := preparePlanOutput(tc.template, deployed)
+			require.Equal(t, recipes.TemplateKindBicep, plan.Driver)
+			require.Equal(t, recipes.PlanKindWhatIf, plan.Kind)
+			require.Equal(t, tc.expected, plan.Changes)
+			require.Equal(t, []string{whatIfNotSupportedMessage}, plan.Messages)
+		})
+	}
+}
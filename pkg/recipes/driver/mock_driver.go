@@ -21,6 +21,7 @@ import (
 type MockDriver struct {
 	ctrl     *gomock.Controller
 	recorder *MockDriverMockRecorder
+	isgomock struct{}
 }
 
 // MockDriverMockRecorder is the mock recorder for MockDriver.
@@ -41,17 +42,17 @@ func (m *MockDriver) EXPECT() *MockDriverMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockDriver) Delete(arg0 context.Context, arg1 DeleteOptions) error {
+func (m *MockDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, opts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDriverMockRecorder) Delete(arg0, arg1 any) *MockDriverDeleteCall {
+func (mr *MockDriverMockRecorder) Delete(ctx, opts any) *MockDriverDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriver)(nil).Delete), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriver)(nil).Delete), ctx, opts)
 	return &MockDriverDeleteCall{Call: call}
 }
 
@@ -78,19 +79,58 @@ func (c *MockDriverDeleteCall) DoAndReturn(f func(context.Context, DeleteOptions
 	return c
 }
 
+// DetectDrift mocks base method.
+func (m *MockDriver) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, opts)
+	ret0, _ := ret[0].(*recipes.DriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockDriverMockRecorder) DetectDrift(ctx, opts any) *MockDriverDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockDriver)(nil).DetectDrift), ctx, opts)
+	return &MockDriverDetectDriftCall{Call: call}
+}
+
+// MockDriverDetectDriftCall wrap *gomock.Call
+type MockDriverDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverDetectDriftCall) Return(arg0 *recipes.DriftOutput, arg1 error) *MockDriverDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverDetectDriftCall) Do(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockDriverDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverDetectDriftCall) DoAndReturn(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockDriverDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Execute mocks base method.
-func (m *MockDriver) Execute(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipeOutput, error) {
+func (m *MockDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret := m.ctrl.Call(m, "Execute", ctx, opts)
 	ret0, _ := ret[0].(*recipes.RecipeOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockDriverMockRecorder) Execute(arg0, arg1 any) *MockDriverExecuteCall {
+func (mr *MockDriverMockRecorder) Execute(ctx, opts any) *MockDriverExecuteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDriver)(nil).Execute), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDriver)(nil).Execute), ctx, opts)
 	return &MockDriverExecuteCall{Call: call}
 }
 
@@ -118,18 +158,18 @@ func (c *MockDriverExecuteCall) DoAndReturn(f func(context.Context, ExecuteOptio
 }
 
 // GetRecipeMetadata mocks base method.
-func (m *MockDriver) GetRecipeMetadata(arg0 context.Context, arg1 BaseOptions) (map[string]any, error) {
+func (m *MockDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", ctx, opts)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
-func (mr *MockDriverMockRecorder) GetRecipeMetadata(arg0, arg1 any) *MockDriverGetRecipeMetadataCall {
+func (mr *MockDriverMockRecorder) GetRecipeMetadata(ctx, opts any) *MockDriverGetRecipeMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriver)(nil).GetRecipeMetadata), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriver)(nil).GetRecipeMetadata), ctx, opts)
 	return &MockDriverGetRecipeMetadataCall{Call: call}
 }
 
@@ -155,3 +195,42 @@ func (c *MockDriverGetRecipeMetadataCall) DoAndReturn(f func(context.Context, Ba
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.PlanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, opts)
+	ret0, _ := ret[0].(*recipes.PlanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverMockRecorder) Plan(ctx, opts any) *MockDriverPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriver)(nil).Plan), ctx, opts)
+	return &MockDriverPlanCall{Call: call}
+}
+
+// MockDriverPlanCall wrap *gomock.Call
+type MockDriverPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverPlanCall) Return(arg0 *recipes.PlanOutput, arg1 error) *MockDriverPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverPlanCall) Do(f func(context.Context, ExecuteOptions) (*recipes.PlanOutput, error)) *MockDriverPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverPlanCall) DoAndReturn(f func(context.Context, ExecuteOptions) (*recipes.PlanOutput, error)) *MockDriverPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
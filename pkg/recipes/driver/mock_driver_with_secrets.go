@@ -21,6 +21,7 @@ import (
 type MockDriverWithSecrets struct {
 	ctrl     *gomock.Controller
 	recorder *MockDriverWithSecretsMockRecorder
+	isgomock struct{}
 }
 
 // MockDriverWithSecretsMockRecorder is the mock recorder for MockDriverWithSecrets.
@@ -41,17 +42,17 @@ func (m *MockDriverWithSecrets) EXPECT() *MockDriverWithSecretsMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockDriverWithSecrets) Delete(arg0 context.Context, arg1 DeleteOptions) error {
+func (m *MockDriverWithSecrets) Delete(ctx context.Context, opts DeleteOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, opts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDriverWithSecretsMockRecorder) Delete(arg0, arg1 any) *MockDriverWithSecretsDeleteCall {
+func (mr *MockDriverWithSecretsMockRecorder) Delete(ctx, opts any) *MockDriverWithSecretsDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriverWithSecrets)(nil).Delete), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriverWithSecrets)(nil).Delete), ctx, opts)
 	return &MockDriverWithSecretsDeleteCall{Call: call}
 }
 
@@ -78,19 +79,58 @@ func (c *MockDriverWithSecretsDeleteCall) DoAndReturn(f func(context.Context, De
 	return c
 }
 
+// DetectDrift mocks base method.
+func (m *MockDriverWithSecrets) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, opts)
+	ret0, _ := ret[0].(*recipes.DriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockDriverWithSecretsMockRecorder) DetectDrift(ctx, opts any) *MockDriverWithSecretsDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockDriverWithSecrets)(nil).DetectDrift), ctx, opts)
+	return &MockDriverWithSecretsDetectDriftCall{Call: call}
+}
+
+// MockDriverWithSecretsDetectDriftCall wrap *gomock.Call
+type MockDriverWithSecretsDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithSecretsDetectDriftCall) Return(arg0 *recipes.DriftOutput, arg1 error) *MockDriverWithSecretsDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithSecretsDetectDriftCall) Do(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockDriverWithSecretsDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithSecretsDetectDriftCall) DoAndReturn(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockDriverWithSecretsDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Execute mocks base method.
-func (m *MockDriverWithSecrets) Execute(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipeOutput, error) {
+func (m *MockDriverWithSecrets) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret := m.ctrl.Call(m, "Execute", ctx, opts)
 	ret0, _ := ret[0].(*recipes.RecipeOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockDriverWithSecretsMockRecorder) Execute(arg0, arg1 any) *MockDriverWithSecretsExecuteCall {
+func (mr *MockDriverWithSecretsMockRecorder) Execute(ctx, opts any) *MockDriverWithSecretsExecuteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDriverWithSecrets)(nil).Execute), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDriverWithSecrets)(nil).Execute), ctx, opts)
 	return &MockDriverWithSecretsExecuteCall{Call: call}
 }
 
@@ -118,18 +158,18 @@ func (c *MockDriverWithSecretsExecuteCall) DoAndReturn(f func(context.Context, E
 }
 
 // FindSecretIDs mocks base method.
-func (m *MockDriverWithSecrets) FindSecretIDs(arg0 context.Context, arg1 recipes.Configuration, arg2 recipes.EnvironmentDefinition) (map[string][]string, error) {
+func (m *MockDriverWithSecrets) FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (map[string][]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindSecretIDs", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindSecretIDs", ctx, config, definition)
 	ret0, _ := ret[0].(map[string][]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindSecretIDs indicates an expected call of FindSecretIDs.
-func (mr *MockDriverWithSecretsMockRecorder) FindSecretIDs(arg0, arg1, arg2 any) *MockDriverWithSecretsFindSecretIDsCall {
+func (mr *MockDriverWithSecretsMockRecorder) FindSecretIDs(ctx, config, definition any) *MockDriverWithSecretsFindSecretIDsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecretIDs", reflect.TypeOf((*MockDriverWithSecrets)(nil).FindSecretIDs), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecretIDs", reflect.TypeOf((*MockDriverWithSecrets)(nil).FindSecretIDs), ctx, config, definition)
 	return &MockDriverWithSecretsFindSecretIDsCall{Call: call}
 }
 
@@ -139,8 +179,8 @@ type MockDriverWithSecretsFindSecretIDsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDriverWithSecretsFindSecretIDsCall) Return(arg0 map[string][]string, arg1 error) *MockDriverWithSecretsFindSecretIDsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockDriverWithSecretsFindSecretIDsCall) Return(secretIDs map[string][]string, err error) *MockDriverWithSecretsFindSecretIDsCall {
+	c.Call = c.Call.Return(secretIDs, err)
 	return c
 }
 
@@ -157,18 +197,18 @@ func (c *MockDriverWithSecretsFindSecretIDsCall) DoAndReturn(f func(context.Cont
 }
 
 // GetRecipeMetadata mocks base method.
-func (m *MockDriverWithSecrets) GetRecipeMetadata(arg0 context.Context, arg1 BaseOptions) (map[string]any, error) {
+func (m *MockDriverWithSecrets) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", ctx, opts)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
-func (mr *MockDriverWithSecretsMockRecorder) GetRecipeMetadata(arg0, arg1 any) *MockDriverWithSecretsGetRecipeMetadataCall {
+func (mr *MockDriverWithSecretsMockRecorder) GetRecipeMetadata(ctx, opts any) *MockDriverWithSecretsGetRecipeMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriverWithSecrets)(nil).GetRecipeMetadata), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriverWithSecrets)(nil).GetRecipeMetadata), ctx, opts)
 	return &MockDriverWithSecretsGetRecipeMetadataCall{Call: call}
 }
 
@@ -194,3 +234,42 @@ func (c *MockDriverWithSecretsGetRecipeMetadataCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockDriverWithSecrets) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.PlanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, opts)
+	ret0, _ := ret[0].(*recipes.PlanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverWithSecretsMockRecorder) Plan(ctx, opts any) *MockDriverWithSecretsPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriverWithSecrets)(nil).Plan), ctx, opts)
+	return &MockDriverWithSecretsPlanCall{Call: call}
+}
+
+// MockDriverWithSecretsPlanCall wrap *gomock.Call
+type MockDriverWithSecretsPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithSecretsPlanCall) Return(arg0 *recipes.PlanOutput, arg1 error) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithSecretsPlanCall) Do(f func(context.Context, ExecuteOptions) (*recipes.PlanOutput, error)) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithSecretsPlanCall) DoAndReturn(f func(context.Context, ExecuteOptions) (*recipes.PlanOutput, error)) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
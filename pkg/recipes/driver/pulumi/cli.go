@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// maxErrorOutput is the maximum length of the error output of the Pulumi CLI included in errors.
+const maxErrorOutput = 4096
+
+// cli runs the commands of the Pulumi CLI.
+type cli interface {
+	// Run runs the Pulumi CLI with the given arguments in the given directory, and returns its standard output.
+	Run(ctx context.Context, dir string, env []string, args ...string) ([]byte, error)
+}
+
+// execCLI runs the commands of the Pulumi CLI binary.
+type execCLI struct {
+	// execPath is the path or the name of the Pulumi CLI binary.
+	execPath string
+}
+
+// Run runs the Pulumi CLI binary with the given arguments in the given directory, and returns its standard output.
+// The error includes the end of the standard error of the command, where the Pulumi CLI reports its failures.
+func (c *execCLI) Run(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		message := strings.TrimSpace(stderr.String())
+		if len(message) > maxErrorOutput {
+			message = "..." + message[len(message)-maxErrorOutput:]
+		}
+
+		exitErr := &exec.ExitError{}
+		if errors.As(err, &exitErr) && message != "" {
+			return nil, fmt.Errorf("pulumi %s failed: %s", args[0], message)
+		}
+
+		return nil, fmt.Errorf("pulumi %s failed: %w", args[0], err)
+	}
+
+	return stdout.Bytes(), nil
+}
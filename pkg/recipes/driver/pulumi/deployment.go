@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	awsresources "github.com/radius-project/radius/pkg/ucp/resources/aws"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+// deployment is the state of a stack, as exported by pulumi stack export.
+type deployment struct {
+	Deployment struct {
+		// SecretsProviders is the secrets provider encrypting the secrets of the state.
+		SecretsProviders struct {
+			Type  string `json:"type"`
+			State struct {
+				Salt string `json:"salt"`
+			} `json:"state"`
+		} `json:"secrets_providers"`
+
+		Resources []deploymentResource `json:"resources"`
+	} `json:"deployment"`
+}
+
+// salt returns the salt of the passphrase secrets provider of the state, if any.
+func (d *deployment) salt() string {
+	if d.Deployment.SecretsProviders.Type != "passphrase" {
+		return ""
+	}
+
+	return d.Deployment.SecretsProviders.State.Salt
+}
+
+// deploymentResource is a resource of the state of a stack.
+type deploymentResource struct {
+	// URN is the unique name of the resource in the stack.
+	URN string `json:"urn"`
+
+	// Custom is true for the resources managed by a provider, and false for component resources such as the stack.
+	Custom bool `json:"custom"`
+
+	// ID is the identifier of the resource assigned by its provider.
+	ID string `json:"id"`
+
+	// Type is the type of the resource, for example "azure-native:cache:Redis".
+	Type string `json:"type"`
+
+	// Outputs are the properties of the resource.
+	Outputs map[string]any `json:"outputs"`
+}
+
+// managed returns true if the resource is deployed by a provider. Component resources, such as the stack, and
+// provider resources are not deployed resources.
+func (r deploymentResource) managed() bool {
+	return r.Custom && !strings.HasPrefix(r.Type, "pulumi:providers:")
+}
+
+// preview is the result of pulumi preview --json.
+type preview struct {
+	Steps []previewStep `json:"steps"`
+}
+
+// previewStep is a step of a preview.
+type previewStep struct {
+	// Op is the operation of the step, for example "create".
+	Op string `json:"op"`
+
+	// URN is the unique name of the resource of the step.
+	URN string `json:"urn"`
+}
+
+// parseDeployment parses the output of pulumi stack export.
+func parseDeployment(b []byte) (*deployment, error) {
+	d := &deployment{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("invalid Pulumi deployment: %w", err)
+	}
+
+	return d, nil
+}
+
+// parseURN returns the type and the name of a resource from its URN, of the form
+// urn:pulumi:<stack>::<project>::<parent type>$<type>::<name>.
+func parseURN(urn string) (string, string) {
+	parts := strings.Split(urn, "::")
+	if len(parts) < 4 {
+		return "", urn
+	}
+
+	qualifiedType := parts[2]
+	if i := strings.LastIndex(qualifiedType, "$"); i >= 0 {
+		qualifiedType = qualifiedType[i+1:]
+	}
+
+	return qualifiedType, strings.Join(parts[3:], "::")
+}
+
+// resourceID returns the UCP resource ID of a deployed resource. Only Azure, AWS and Kubernetes resources have
+// a resource ID.
+func resourceID(r deploymentResource) (string, bool) {
+	provider, _, _ := strings.Cut(r.Type, ":")
+	switch provider {
+	case "azure-native", "azure":
+		if _, err := resources.ParseResource(r.ID); err == nil {
+			return r.ID, true
+		}
+
+	case "aws", "aws-native":
+		if arn, ok := r.Outputs["arn"].(string); ok {
+			if id, err := awsresources.ToUCPResourceID(arn); err == nil {
+				return id, true
+			}
+		}
+
+	case "kubernetes":
+		// Kubernetes types are of the form kubernetes:<group>/<version>:<kind>.
+		parts := strings.Split(r.Type, ":")
+		if len(parts) != 3 {
+			return "", false
+		}
+
+		group, _, _ := strings.Cut(parts[1], "/")
+		if group == "core" {
+			group = ""
+		}
+
+		metadata, _ := r.Outputs["metadata"].(map[string]any)
+		name, _ := metadata["name"].(string)
+		namespace, _ := metadata["namespace"].(string)
+		if id, err := kubernetesresources.ToUCPResourceID(namespace, parts[2], name, group); err == nil {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+// deployedResources returns the UCP resource IDs of the resources of the deployment.
+func deployedResources(d *deployment) []string {
+	ids := []string{}
+	for _, r := range d.Deployment.Resources {
+		if !r.managed() {
+			continue
+		}
+
+		if id, ok := resourceID(r); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// preparePlanOutput converts the steps of a preview to the changes of a recipe plan.
+func preparePlanOutput(p *preview) *recipes.PlanOutput {
+	output := &recipes.PlanOutput{
+		Driver:   recipes.TemplateKindPulumi,
+		Kind:     recipes.PlanKindWhatIf,
+		Changes:  []recipes.ResourceChange{},
+		Messages: []string{"Pulumi plans do not include the values of the resources."},
+	}
+
+	// A replacement is reported as several steps of the same resource.
+	replaced := map[string]bool{}
+	for _, step := range p.Steps {
+		resourceType, name := parseURN(step.URN)
+		if resourceType == "pulumi:pulumi:Stack" || strings.HasPrefix(resourceType, "pulumi:providers:") {
+			continue
+		}
+
+		var action string
+		switch step.Op {
+		case "same":
+			action = recipes.PlanActionNoOp
+		case "create", "import":
+			action = recipes.PlanActionCreate
+		case "update":
+			action = recipes.PlanActionUpdate
+		case "delete":
+			action = recipes.PlanActionDelete
+		case "replace", "create-replacement", "delete-replaced", "import-replacement":
+			if replaced[step.URN] {
+				continue
+			}
+			replaced[step.URN] = true
+			action = recipes.PlanActionReplace
+		default:
+			continue
+		}
+
+		output.Changes = append(output.Changes, recipes.ResourceChange{
+			Address: step.URN,
+			Type:    resourceType,
+			Name:    name,
+			Action:  action,
+		})
+	}
+
+	return output
+}
+
+// prepareDriftOutput compares the state of a stack before and after a refresh, and returns the resources that were
+// changed or deleted outside of Pulumi.
+func prepareDriftOutput(before, after *deployment) *recipes.DriftOutput {
+	output := &recipes.DriftOutput{
+		Driver:           recipes.TemplateKindPulumi,
+		DriftedResources: []rpv1.DriftedResource{},
+	}
+
+	refreshed := map[string]deploymentResource{}
+	for _, r := range after.Deployment.Resources {
+		refreshed[r.URN] = r
+	}
+
+	for _, r := range before.Deployment.Resources {
+		if !r.managed() {
+			continue
+		}
+
+		var change rpv1.DriftChange
+		if current, ok := refreshed[r.URN]; !ok {
+			change = rpv1.DriftChangeDeleted
+		} else if !reflect.DeepEqual(r.Outputs, current.Outputs) {
+			change = rpv1.DriftChangeModified
+		} else {
+			continue
+		}
+
+		_, name := parseURN(r.URN)
+		id, _ := resourceID(r)
+		output.DriftedResources = append(output.DriftedResources, rpv1.DriftedResource{
+			ID:      id,
+			Address: r.URN,
+			Type:    r.Type,
+			Name:    name,
+			Change:  change,
+		})
+	}
+
+	return output
+}
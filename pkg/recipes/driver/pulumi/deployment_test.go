@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseURN(t *testing.T) {
+	resourceType, name := parseURN("urn:pulumi:radius::redis::azure-native:cache:Redis::cache")
+	require.Equal(t, "azure-native:cache:Redis", resourceType)
+	require.Equal(t, "cache", name)
+
+	resourceType, name = parseURN("urn:pulumi:radius::redis::custom:Component$aws:s3/bucket:Bucket::bucket")
+	require.Equal(t, "aws:s3/bucket:Bucket", resourceType)
+	require.Equal(t, "bucket", name)
+
+	resourceType, name = parseURN("invalid")
+	require.Equal(t, "", resourceType)
+	require.Equal(t, "invalid", name)
+}
+
+func Test_ResourceID(t *testing.T) {
+	tests := []struct {
+		desc     string
+		resource deploymentResource
+		id       string
+	}{
+		{
+			desc:     "azure resource",
+			resource: deploymentResource{Type: "azure-native:cache:Redis", ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache"},
+			id:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache",
+		},
+		{
+			desc:     "azure resource without ARM ID",
+			resource: deploymentResource{Type: "azure-native:cache:Redis", ID: "not-an-arm-id"},
+		},
+		{
+			desc: "aws resource",
+			resource: deploymentResource{
+				Type:    "aws:s3/bucket:Bucket",
+				Outputs: map[string]any{"arn": "arn:aws:s3:::my-bucket"},
+			},
+			id: "/planes/aws/aws/accounts//regions/global/providers/AWS.s3/my-bucket",
+		},
+		{
+			desc:     "aws resource without arn",
+			resource: deploymentResource{Type: "aws:s3/bucket:Bucket", ID: "my-bucket"},
+		},
+		{
+			desc: "kubernetes core resource",
+			resource: deploymentResource{
+				Type:    "kubernetes:core/v1:Service",
+				Outputs: map[string]any{"metadata": map[string]any{"name": "svc", "namespace": "default"}},
+			},
+			id: "/planes/kubernetes/local/namespaces/default/providers/core/Service/svc",
+		},
+		{
+			desc:     "other resource",
+			resource: deploymentResource{Type: "random:index/randomPassword:RandomPassword", ID: "none"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			id, ok := resourceID(tc.resource)
+			if tc.id == "" {
+				require.False(t, ok, id)
+				return
+			}
+
+			require.True(t, ok)
+			require.Equal(t, tc.id, id)
+		})
+	}
+}
+
+func Test_PreparePlanOutput(t *testing.T) {
+	output := preparePlanOutput(&preview{
+		Steps: []previewStep{
+			{Op: "same", URN: "urn:pulumi:radius::redis::pulumi:providers:azure-native::default"},
+			{Op: "same", URN: "urn:pulumi:radius::redis::azure-native:resources:ResourceGroup::rg"},
+			{Op: "create-replacement", URN: "urn:pulumi:radius::redis::azure-native:cache:Redis::cache"},
+			{Op: "replace", URN: "urn:pulumi:radius::redis::azure-native:cache:Redis::cache"},
+			{Op: "delete-replaced", URN: "urn:pulumi:radius::redis::azure-native:cache:Redis::cache"},
+			{Op: "update", URN: "urn:pulumi:radius::redis::azure-native:cache:FirewallRule::rule"},
+			{Op: "delete", URN: "urn:pulumi:radius::redis::azure-native:cache:PatchSchedule::schedule"},
+			{Op: "refresh", URN: "urn:pulumi:radius::redis::azure-native:cache:LinkedServer::server"},
+		},
+	})
+
+	require.Equal(t, recipes.TemplateKindPulumi, output.Driver)
+	require.Len(t, output.Changes, 4)
+	require.Equal(t, 1, output.Count(recipes.PlanActionNoOp))
+	require.Equal(t, 1, output.Count(recipes.PlanActionReplace))
+	require.Equal(t, 1, output.Count(recipes.PlanActionUpdate))
+	require.Equal(t, 1, output.Count(recipes.PlanActionDelete))
+}
@@ -0,0 +1,458 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	getter "github.com/hashicorp/go-getter"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/ucp/util"
+)
+
+// DefaultExecPath is the Pulumi CLI binary executing recipes by default, looked up in the PATH.
+const DefaultExecPath = "pulumi"
+
+var _ driver.DriverWithSecrets = (*pulumiDriver)(nil)
+
+// NewPulumiDriver creates a new instance of driver to execute a Pulumi recipe.
+func NewPulumiDriver(options PulumiOptions) driver.Driver {
+	if options.ExecPath == "" {
+		options.ExecPath = DefaultExecPath
+	}
+
+	return &pulumiDriver{
+		cli:     &execCLI{execPath: options.ExecPath},
+		getters: remoteGetters(),
+		options: options,
+	}
+}
+
+// PulumiOptions represents the options required for execution of Pulumi driver.
+type PulumiOptions struct {
+	// Path is the path to the directory mounted to the container where Pulumi programs are executed in sub
+	// directories.
+	Path string
+
+	// ExecPath is the path or the name of the Pulumi CLI binary. Default: pulumi, looked up in the PATH.
+	ExecPath string
+
+	// BackendURL is the URL of the durable state backend storing the stacks of the recipes, for example
+	// s3://<bucket>, azblob://<container>, gs://<bucket> or file://<directory on a persistent volume>. Required.
+	BackendURL string
+
+	// PassphraseFile is the path of the file holding the passphrase encrypting the secrets of the state of the
+	// stacks. Default: the PULUMI_CONFIG_PASSPHRASE or PULUMI_CONFIG_PASSPHRASE_FILE environment variable, which
+	// is required if empty.
+	PassphraseFile string
+
+	// Env are the names of the environment variables of the process passed to the Pulumi CLI in addition to the
+	// defaults, for example the credentials of the state backend.
+	Env []string
+}
+
+// pulumiDriver represents a driver to interact with Pulumi recipes: Pulumi programs written in YAML or Go,
+// deployed with the Pulumi CLI. The stack of each resource is stored in its own directory of the state backend.
+type pulumiDriver struct {
+	// cli runs the Pulumi CLI.
+	cli cli
+
+	// getters download the Pulumi programs of recipes.
+	getters map[string]getter.Getter
+
+	// options contains options required to execute a Pulumi recipe.
+	options PulumiOptions
+}
+
+// Execute downloads the Pulumi program of the recipe and deploys its stack with pulumi up. It returns the stack
+// output named "result" and the resources of the stack, or an error if the deployment fails.
+func (d *pulumiDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer d.removeExecutionDirectory(ctx, requestDirPath)
+
+	ws, err := d.newWorkspace(ctx, requestDirPath, opts.BaseOptions)
+	if err != nil {
+		return nil, setupError(recipes.RecipeDeploymentFailed, err)
+	}
+
+	if err := ws.selectStack(ctx); err != nil {
+		return nil, setupError(recipes.RecipeDeploymentFailed, err)
+	}
+
+	if _, err := ws.run(ctx, "up", "--yes", "--skip-preview"); err != nil {
+		return nil, executionError(recipes.RecipeDeploymentFailed, "deployment", err)
+	}
+
+	b, err := ws.run(ctx, "stack", "output", "--json", "--show-secrets")
+	if err != nil {
+		return nil, executionError(recipes.RecipeDeploymentFailed, "deployment", err)
+	}
+
+	outputs := map[string]any{}
+	if err := json.Unmarshal(b, &outputs); err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the outputs of the stack: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	b, err = ws.run(ctx, "stack", "export")
+	if err != nil {
+		return nil, executionError(recipes.RecipeDeploymentFailed, "deployment", err)
+	}
+
+	state, err := parseDeployment(b)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeOutputs, err := prepareRecipeResponse(opts.Definition, outputs, state)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeOutputs, nil
+}
+
+// Delete destroys the resources of the stack of the recipe with pulumi destroy, and removes the stack from the
+// state backend. It fails if the recipe deployed resources and the stack is missing, because the resources would
+// be left behind.
+func (d *pulumiDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+	defer d.removeExecutionDirectory(ctx, requestDirPath)
+
+	ws, err := d.newWorkspace(ctx, requestDirPath, opts.BaseOptions)
+	if err != nil {
+		return setupError(recipes.RecipeDeletionFailed, err)
+	}
+
+	if ws.state == nil {
+		if len(opts.OutputResources) > 0 {
+			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, fmt.Sprintf("the Pulumi stack of resource %q was not found in the state backend, the %d resources deployed by the recipe were not deleted", opts.Recipe.ResourceID, len(opts.OutputResources)), "")
+		}
+
+		logger.Info(fmt.Sprintf("No Pulumi stack found for resource %q, nothing to delete", opts.Recipe.ResourceID))
+		return nil
+	}
+
+	if err := ws.selectStack(ctx); err != nil {
+		return setupError(recipes.RecipeDeletionFailed, err)
+	}
+
+	if _, err := ws.run(ctx, "destroy", "--yes", "--skip-preview"); err != nil {
+		return executionError(recipes.RecipeDeletionFailed, "deletion", err)
+	}
+
+	if _, err := ws.run(ctx, "stack", "rm", "--yes"); err != nil {
+		return executionError(recipes.RecipeDeletionFailed, "deletion", err)
+	}
+
+	return nil
+}
+
+// Plan runs pulumi preview on a copy of the stack of the recipe, and returns the resource changes of
+// the preview.
+func (d *pulumiDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.PlanOutput, error) {
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer d.removeExecutionDirectory(ctx, requestDirPath)
+
+	ws, err := d.newWorkspace(ctx, requestDirPath, opts.BaseOptions)
+	if err != nil {
+		return nil, setupError(recipes.RecipePlanFailed, err)
+	}
+
+	if err := ws.copyStack(ctx, filepath.Join(requestDirPath, stateDirName)); err != nil {
+		return nil, setupError(recipes.RecipePlanFailed, err)
+	}
+
+	b, err := ws.run(ctx, "preview", "--json")
+	if err != nil {
+		return nil, executionError(recipes.RecipePlanFailed, "plan", err)
+	}
+
+	p := &preview{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("invalid Pulumi preview: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return preparePlanOutput(p), nil
+}
+
+// DetectDrift refreshes a copy of the stack of the recipe with pulumi refresh, and returns the resources whose
+// state changed. The state of the stack and the infrastructure are never changed.
+func (d *pulumiDriver) DetectDrift(ctx context.Context, opts driver.DriftOptions) (*recipes.DriftOutput, error) {
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer d.removeExecutionDirectory(ctx, requestDirPath)
+
+	ws, err := d.newWorkspace(ctx, requestDirPath, opts.BaseOptions)
+	if err != nil {
+		return nil, setupError(recipes.RecipeDriftDetectionFailed, err)
+	}
+
+	if ws.state == nil {
+		return &recipes.DriftOutput{
+			Driver:           recipes.TemplateKindPulumi,
+			DriftedResources: []rpv1.DriftedResource{},
+			Messages:         []string{"The recipe has no Pulumi state."},
+		}, nil
+	}
+
+	if err := ws.copyStack(ctx, filepath.Join(requestDirPath, stateDirName)); err != nil {
+		return nil, setupError(recipes.RecipeDriftDetectionFailed, err)
+	}
+
+	// Secrets are compared in plain text, because they are encrypted again when the state is refreshed.
+	before, err := ws.run(ctx, "stack", "export", "--show-secrets")
+	if err != nil {
+		return nil, executionError(recipes.RecipeDriftDetectionFailed, "drift detection", err)
+	}
+
+	if _, err := ws.run(ctx, "refresh", "--yes", "--skip-preview"); err != nil {
+		return nil, executionError(recipes.RecipeDriftDetectionFailed, "drift detection", err)
+	}
+
+	after, err := ws.run(ctx, "stack", "export", "--show-secrets")
+	if err != nil {
+		return nil, executionError(recipes.RecipeDriftDetectionFailed, "drift detection", err)
+	}
+
+	beforeState, err := parseDeployment(before)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	afterState, err := parseDeployment(after)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return prepareDriftOutput(beforeState, afterState), nil
+}
+
+// GetRecipeMetadata downloads the Pulumi program of the recipe, and returns the parameters declared in the
+// configuration of its project.
+func (d *pulumiDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+	defer d.removeExecutionDirectory(ctx, requestDirPath)
+
+	p, err := download(ctx, d.getters, opts.Definition.TemplatePath, filepath.Join(requestDirPath, programDirName))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return map[string]any{
+		"parameters": projectParameters(p),
+	}, nil
+}
+
+// FindSecretIDs returns the secret stores and keys of the environment variables configured for recipes by the
+// environment.
+func (d *pulumiDriver) FindSecretIDs(ctx context.Context, envConfig recipes.Configuration, definition recipes.EnvironmentDefinition) (map[string][]string, error) {
+	secretStoreIDResourceKeys := map[string][]string{}
+	for _, reference := range envConfig.RecipeConfig.EnvSecrets {
+		if !slices.Contains(secretStoreIDResourceKeys[reference.Source], reference.Key) {
+			secretStoreIDResourceKeys[reference.Source] = append(secretStoreIDResourceKeys[reference.Source], reference.Key)
+		}
+	}
+
+	return secretStoreIDResourceKeys, nil
+}
+
+// newWorkspace downloads the Pulumi program of the recipe to the execution directory, and exports the stack of
+// the recipe from the state backend of the resource. The stack is not selected.
+func (d *pulumiDriver) newWorkspace(ctx context.Context, requestDirPath string, opts driver.BaseOptions) (*workspace, error) {
+	backendURL, err := stackBackendURL(d.options.BackendURL, opts.Recipe.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := environment(d.options, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := stackConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	programDir := filepath.Join(requestDirPath, programDirName)
+	p, err := download(ctx, d.getters, opts.Definition.TemplatePath, programDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createFileBackend(backendURL); err != nil {
+		return nil, err
+	}
+
+	ws := &workspace{cli: d.cli, programDir: programDir, backendURL: backendURL, project: p, config: config, env: env}
+	ws.state, err = ws.exportStack(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// createExecutionDirectory creates a unique directory for each execution of a Pulumi program.
+func (d *pulumiDriver) createExecutionDirectory(ctx context.Context, recipe recipes.ResourceMetadata, definition recipes.EnvironmentDefinition) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if d.options.Path == "" {
+		return "", fmt.Errorf("path is a required option for Pulumi driver")
+	}
+
+	// The directory is named after the operation ID of the async request, so that it can be traced to the resource.
+	dirID := ""
+	armCtx := v1.ARMRequestContextFromContext(ctx)
+	if armCtx.OperationID != uuid.Nil {
+		dirID = armCtx.OperationID.String() + "-" + uuid.NewString()
+	} else {
+		dirID = util.NormalizeStringToLower(recipe.ResourceID) + "-" + uuid.NewString()
+	}
+	requestDirPath := filepath.Join(d.options.Path, dirID)
+
+	logger.Info(fmt.Sprintf("Executing Pulumi recipe: %q, template: %q, execution directory: %q", recipe.Name, definition.TemplatePath, requestDirPath))
+	if err := os.MkdirAll(requestDirPath, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory %q to execute pulumi: %s", requestDirPath, err.Error())
+	}
+
+	return requestDirPath, nil
+}
+
+// removeExecutionDirectory removes the directory of an execution of a Pulumi program.
+func (d *pulumiDriver) removeExecutionDirectory(ctx context.Context, requestDirPath string) {
+	if err := os.RemoveAll(requestDirPath); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Failed to cleanup Pulumi execution directory %q. Err: %s", requestDirPath, err.Error()))
+	}
+}
+
+// prepareRecipeResponse populates the recipe response from the stack output named "result" and the resources of
+// the stack.
+func prepareRecipeResponse(definition recipes.EnvironmentDefinition, outputs map[string]any, state *deployment) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{}
+	if result, ok := outputs[recipes.ResultPropertyName].(map[string]any); ok {
+		if err := recipeResponse.PrepareRecipeResponse(result); err != nil {
+			return nil, err
+		}
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindPulumi,
+		TemplatePath:    definition.TemplatePath,
+		TemplateVersion: definition.TemplateVersion,
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, id := range recipeResponse.Resources {
+		uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(id))
+	}
+
+	for _, id := range deployedResources(state) {
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(id)) {
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+			uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(id))
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// projectParameters returns the parameters of a recipe from the configuration declared by its Pulumi project. The
+// recipe context is not a parameter.
+func projectParameters(p *project) map[string]any {
+	parameters := map[string]any{}
+	for _, schema := range []map[string]any{p.Configuration, p.Config} {
+		for name, declaration := range schema {
+			if name == recipecontext.RecipeContextParamKey {
+				continue
+			}
+
+			parameter := map[string]any{"name": name}
+			if properties, ok := declaration.(map[string]any); ok {
+				for _, key := range []string{"type", "description"} {
+					if value, ok := properties[key]; ok {
+						parameter[key] = value
+					}
+				}
+				if value, ok := properties["default"]; ok {
+					parameter["defaultValue"] = value
+				}
+				if secret, ok := properties["secret"].(bool); ok {
+					parameter["sensitive"] = secret
+				}
+			} else {
+				// The shorthand declaration of a configuration value is its default value.
+				parameter["defaultValue"] = declaration
+			}
+
+			parameters[name] = parameter
+		}
+	}
+
+	return parameters
+}
+
+// setupError returns the recipe error of a failure to prepare the execution of a Pulumi program. Download failures
+// are reported as such.
+func setupError(code string, err error) error {
+	recipeError := &recipes.RecipeError{}
+	if errors.As(err, &recipeError) {
+		return recipeError
+	}
+
+	return recipes.NewRecipeError(code, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+}
+
+// executionError returns the recipe error of a failed command of the Pulumi CLI.
+func executionError(code, operation string, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return recipes.NewRecipeError(recipes.RecipeCanceled, fmt.Sprintf("recipe %s was canceled: %s", operation, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipes.NewRecipeError(code, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+}
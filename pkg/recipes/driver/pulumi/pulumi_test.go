@@ -0,0 +1,537 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	getter "github.com/hashicorp/go-getter"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testProject = `name: redis
+runtime: yaml
+config:
+  size:
+    type: integer
+    default: 1
+    description: The size of the cache.
+  password:
+    type: string
+    secret: true
+  sku: Basic
+`
+
+	testDeployment = `{
+  "version": 3,
+  "deployment": {
+    "secrets_providers": {"type": "passphrase", "state": {"salt": "v1:existing"}},
+    "resources": [
+      {"urn": "urn:pulumi:radius::redis::pulumi:pulumi:Stack::redis-radius", "custom": false, "type": "pulumi:pulumi:Stack"},
+      {"urn": "urn:pulumi:radius::redis::pulumi:providers:azure-native::default", "custom": true, "id": "provider-id", "type": "pulumi:providers:azure-native"},
+      {"urn": "urn:pulumi:radius::redis::azure-native:cache:Redis::cache", "custom": true, "id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache", "type": "azure-native:cache:Redis", "outputs": {"sku": "Basic"}},
+      {"urn": "urn:pulumi:radius::redis::kubernetes:apps/v1:Deployment::app", "custom": true, "id": "default/app", "type": "kubernetes:apps/v1:Deployment", "outputs": {"metadata": {"name": "app", "namespace": "default"}}}
+    ]
+  }
+}`
+)
+
+// fakeCLI records the commands of the Pulumi CLI, and returns the outputs configured for each command in order.
+type fakeCLI struct {
+	calls   [][]string
+	envs    [][]string
+	outputs map[string][]string
+	errs    map[string]error
+}
+
+func (c *fakeCLI) Run(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	c.calls = append(c.calls, args)
+	c.envs = append(c.envs, env)
+
+	command := []string{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--") {
+			break
+		}
+		command = append(command, arg)
+	}
+	key := strings.Join(command, " ")
+
+	if key == "stack select" {
+		// Creating a stack adds the salt of its secrets to the configuration of the stack.
+		configFile := filepath.Join(dir, "Pulumi."+stackName+".yaml")
+		b, err := os.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(string(b), "encryptionsalt") {
+			if err := os.WriteFile(configFile, append(b, []byte("encryptionsalt: v1:salt\n")...), 0600); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := c.errs[key]; err != nil {
+		return nil, err
+	}
+
+	outputs := c.outputs[key]
+	if len(outputs) == 0 && key == "stack ls" {
+		return []byte("[]"), nil
+	} else if len(outputs) == 0 {
+		return []byte("{}"), nil
+	}
+	c.outputs[key] = outputs[1:]
+
+	return []byte(outputs[0]), nil
+}
+
+// commands returns the commands run by the CLI, without their flags.
+func (c *fakeCLI) commands() []string {
+	commands := []string{}
+	for _, args := range c.calls {
+		command := []string{}
+		for _, arg := range args {
+			if strings.HasPrefix(arg, "--") {
+				break
+			}
+			command = append(command, arg)
+		}
+		commands = append(commands, strings.Join(command, " "))
+	}
+
+	return commands
+}
+
+func setup(t *testing.T, project string) (*fakeCLI, *pulumiDriver, driver.BaseOptions) {
+	source := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(source, projectFileName), []byte(project), 0644))
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("passphrase"), 0600))
+
+	cli := &fakeCLI{outputs: map[string][]string{}, errs: map[string]error{}}
+	d := &pulumiDriver{
+		cli:     cli,
+		getters: getter.Getters,
+		options: PulumiOptions{Path: t.TempDir(), BackendURL: fileBackendURL(t.TempDir()), PassphraseFile: passphraseFile},
+	}
+
+	opts := driver.BaseOptions{
+		Configuration: recipes.Configuration{
+			RecipeConfig: datamodel.RecipeConfigProperties{
+				Env: datamodel.EnvironmentVariables{
+					AdditionalProperties: map[string]string{"ARM_USE_OIDC": "true"},
+				},
+				EnvSecrets: map[string]datamodel.SecretReference{
+					"ARM_CLIENT_SECRET": {Source: "secretstore", Key: "clientSecret"},
+				},
+			},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+			Parameters:    map[string]any{"size": 2},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:         "default",
+			Driver:       recipes.TemplateKindPulumi,
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplatePath: source,
+			Parameters:   map[string]any{"size": 1, "sku": "Standard"},
+		},
+		Secrets: map[string]recipes.SecretData{
+			"secretstore": {Type: "generic", Data: map[string]string{"clientSecret": "secret"}},
+		},
+	}
+
+	return cli, d, opts
+}
+
+func newContext(t *testing.T) context.Context {
+	return v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+}
+
+// existingStack configures the CLI to find the stack in the state backend, with the given state.
+func existingStack(cli *fakeCLI, state string) {
+	cli.outputs["stack ls"] = []string{`[{"name": "organization/redis/radius"}]`}
+	cli.outputs["stack export"] = append([]string{state}, cli.outputs["stack export"]...)
+}
+
+// backendURL returns the URL of the state backend of the resource of the recipe.
+func backendURL(t *testing.T, d *pulumiDriver, opts driver.BaseOptions) string {
+	u, err := stackBackendURL(d.options.BackendURL, opts.Recipe.ResourceID)
+	require.NoError(t, err)
+	return u
+}
+
+// stackConfigSalt returns the salt of the configuration of the stack written for the last command.
+func stackConfigSalt(t *testing.T, opts driver.BaseOptions) string {
+	b, err := os.ReadFile(filepath.Join(opts.Definition.TemplatePath, "Pulumi."+stackName+".yaml"))
+	require.NoError(t, err)
+	stackConfig := struct {
+		EncryptionSalt string `yaml:"encryptionsalt"`
+	}{}
+	require.NoError(t, yaml.Unmarshal(b, &stackConfig))
+	return stackConfig.EncryptionSalt
+}
+
+func requireRecipeError(t *testing.T, err error, code string) {
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, code, recipeError.ErrorDetails.Code)
+}
+
+func Test_Execute_Success(t *testing.T) {
+	ctx := newContext(t)
+	cli, d, opts := setup(t, testProject)
+
+	cli.outputs["stack output"] = []string{`{"result": {"values": {"host": "redis.example.com", "port": 6380}, "secrets": {"password": "password"}}}`}
+	cli.outputs["stack export"] = []string{testDeployment}
+
+	output, err := d.Execute(ctx, driver.ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{
+		Values:  map[string]any{"host": "redis.example.com", "port": float64(6380)},
+		Secrets: map[string]any{"password": "password"},
+		Resources: []string{
+			"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache",
+			"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/app",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindPulumi,
+			TemplatePath: opts.Definition.TemplatePath,
+		},
+	}, output)
+
+	require.Equal(t, []string{"stack ls", "stack select", "up", "stack output", "stack export"}, cli.commands())
+	require.Equal(t, []string{"up", "--yes", "--skip-preview", "--stack", stackName, "--non-interactive"}, cli.calls[2])
+
+	// The stack uses the state backend of the resource, and the environment variables of the environment.
+	require.Contains(t, cli.envs[2], backendURLEnvVar+"="+backendURL(t, d, opts))
+	require.Contains(t, cli.envs[2], "ARM_USE_OIDC=true")
+	require.Contains(t, cli.envs[2], "ARM_CLIENT_SECRET=secret")
+	require.Contains(t, cli.envs[2], passphraseFileEnvVar+"="+d.options.PassphraseFile)
+
+	// The salt of the secrets of a new stack is generated when it is created.
+	require.Equal(t, "v1:salt", stackConfigSalt(t, opts))
+
+	// The parameters of the resource override the parameters of the environment.
+	b, err := os.ReadFile(filepath.Join(opts.Definition.TemplatePath, "Pulumi."+stackName+".yaml"))
+	require.NoError(t, err)
+	stackConfig := struct {
+		Config map[string]any `yaml:"config"`
+	}{}
+	require.NoError(t, yaml.Unmarshal(b, &stackConfig))
+	require.Equal(t, 2, stackConfig.Config["redis:size"])
+	require.Equal(t, "Standard", stackConfig.Config["redis:sku"])
+	recipeContext, ok := stackConfig.Config["redis:context"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "redis", recipeContext["resource"].(map[string]any)["name"])
+}
+
+func Test_Execute_ExistingStack(t *testing.T) {
+	cli, d, opts := setup(t, testProject)
+	existingStack(cli, testDeployment)
+	cli.outputs["stack export"] = append(cli.outputs["stack export"], testDeployment)
+
+	_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Equal(t, []string{"stack ls", "stack export", "stack select", "up", "stack output", "stack export"}, cli.commands())
+
+	// The salt of the secrets of the stack is restored from its state.
+	require.Equal(t, "v1:existing", stackConfigSalt(t, opts))
+}
+
+func Test_Execute_Failure(t *testing.T) {
+	t.Run("deployment failure", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		cli.errs["up"] = errors.New("pulumi up failed: error: resource creation failed")
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDeploymentFailed)
+		require.ErrorContains(t, err, "resource creation failed")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		cli.errs["up"] = context.Canceled
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeCanceled)
+	})
+
+	t.Run("unsupported runtime", func(t *testing.T) {
+		cli, d, opts := setup(t, "name: redis\nruntime: nodejs\n")
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDeploymentFailed)
+		require.ErrorContains(t, err, "unsupported Pulumi runtime \"nodejs\"")
+		require.Empty(t, cli.calls)
+	})
+
+	t.Run("download failure", func(t *testing.T) {
+		_, d, opts := setup(t, testProject)
+		d.getters = remoteGetters()
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDownloadFailed)
+	})
+
+	t.Run("no state backend", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		d.options.BackendURL = ""
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDeploymentFailed)
+		require.ErrorContains(t, err, "a state backend URL must be configured")
+		require.Empty(t, cli.calls)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		_, d, opts := setup(t, testProject)
+		d.options.Path = ""
+
+		_, err := d.Execute(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDeploymentFailed)
+	})
+}
+
+func Test_Delete(t *testing.T) {
+	outputResources := []rpv1.OutputResource{{ID: resources.MustParse("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache")}}
+
+	t.Run("success", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		existingStack(cli, testDeployment)
+
+		err := d.Delete(newContext(t), driver.DeleteOptions{BaseOptions: opts, OutputResources: outputResources})
+		require.NoError(t, err)
+		require.Equal(t, []string{"stack ls", "stack export", "stack select", "destroy", "stack rm"}, cli.commands())
+		require.Contains(t, cli.envs[3], backendURLEnvVar+"="+backendURL(t, d, opts))
+		require.Equal(t, "v1:existing", stackConfigSalt(t, opts))
+	})
+
+	t.Run("no state", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+
+		err := d.Delete(newContext(t), driver.DeleteOptions{BaseOptions: opts})
+		require.NoError(t, err)
+		require.Equal(t, []string{"stack ls"}, cli.commands())
+	})
+
+	t.Run("missing state of deployed resources", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+
+		err := d.Delete(newContext(t), driver.DeleteOptions{BaseOptions: opts, OutputResources: outputResources})
+		requireRecipeError(t, err, recipes.RecipeDeletionFailed)
+		require.ErrorContains(t, err, "was not found in the state backend")
+		require.Equal(t, []string{"stack ls"}, cli.commands())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		existingStack(cli, testDeployment)
+		cli.errs["destroy"] = errors.New("pulumi destroy failed")
+
+		err := d.Delete(newContext(t), driver.DeleteOptions{BaseOptions: opts, OutputResources: outputResources})
+		requireRecipeError(t, err, recipes.RecipeDeletionFailed)
+		require.NotContains(t, cli.commands(), "stack rm")
+	})
+}
+
+func Test_Plan(t *testing.T) {
+	cli, d, opts := setup(t, testProject)
+	cli.outputs["preview"] = []string{`{"steps": [
+		{"op": "create", "urn": "urn:pulumi:radius::redis::pulumi:pulumi:Stack::redis-radius"},
+		{"op": "create", "urn": "urn:pulumi:radius::redis::azure-native:cache:Redis::cache"}
+	]}`}
+
+	plan, err := d.Plan(newContext(t), driver.ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{
+			Address: "urn:pulumi:radius::redis::azure-native:cache:Redis::cache",
+			Type:    "azure-native:cache:Redis",
+			Name:    "cache",
+			Action:  recipes.PlanActionCreate,
+		},
+	}, plan.Changes)
+
+	// The preview runs on a copy of the stack, and never creates the stack in the state backend.
+	require.Equal(t, []string{"stack ls", "stack select", "preview"}, cli.commands())
+	require.NotContains(t, cli.envs[2], backendURLEnvVar+"="+backendURL(t, d, opts))
+}
+
+func Test_DetectDrift(t *testing.T) {
+	t.Run("drifted", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+
+		refreshed := strings.Replace(testDeployment, `"outputs": {"sku": "Basic"}`, `"outputs": {"sku": "Premium"}`, 1)
+		refreshed = strings.Replace(refreshed, `{"urn": "urn:pulumi:radius::redis::kubernetes:apps/v1:Deployment::app", "custom": true, "id": "default/app", "type": "kubernetes:apps/v1:Deployment", "outputs": {"metadata": {"name": "app", "namespace": "default"}}}`, `{"urn": "urn:pulumi:radius::redis::pulumi:pulumi:Stack::other"}`, 1)
+		cli.outputs["stack export"] = []string{testDeployment, refreshed}
+		existingStack(cli, testDeployment)
+
+		output, err := d.DetectDrift(newContext(t), driver.DriftOptions{BaseOptions: opts})
+		require.NoError(t, err)
+		require.Equal(t, &recipes.DriftOutput{
+			Driver: recipes.TemplateKindPulumi,
+			DriftedResources: []rpv1.DriftedResource{
+				{
+					ID:      "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/cache",
+					Address: "urn:pulumi:radius::redis::azure-native:cache:Redis::cache",
+					Type:    "azure-native:cache:Redis",
+					Name:    "cache",
+					Change:  rpv1.DriftChangeModified,
+				},
+				{
+					ID:      "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/app",
+					Address: "urn:pulumi:radius::redis::kubernetes:apps/v1:Deployment::app",
+					Type:    "kubernetes:apps/v1:Deployment",
+					Name:    "app",
+					Change:  rpv1.DriftChangeDeleted,
+				},
+			},
+		}, output)
+
+		// The refresh runs on a copy of the stack of the resource.
+		require.Equal(t, []string{"stack ls", "stack export", "stack select", "stack import", "stack export", "refresh", "stack export"}, cli.commands())
+		require.NotContains(t, cli.envs[5], backendURLEnvVar+"="+backendURL(t, d, opts))
+		require.Equal(t, "v1:existing", stackConfigSalt(t, opts))
+	})
+
+	t.Run("no state", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+
+		output, err := d.DetectDrift(newContext(t), driver.DriftOptions{BaseOptions: opts})
+		require.NoError(t, err)
+		require.Empty(t, output.DriftedResources)
+		require.Equal(t, []string{"stack ls"}, cli.commands())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		cli, d, opts := setup(t, testProject)
+		existingStack(cli, testDeployment)
+		cli.errs["refresh"] = errors.New("pulumi refresh failed")
+
+		_, err := d.DetectDrift(newContext(t), driver.DriftOptions{BaseOptions: opts})
+		requireRecipeError(t, err, recipes.RecipeDriftDetectionFailed)
+	})
+}
+
+func Test_GetRecipeMetadata(t *testing.T) {
+	cli, d, opts := setup(t, testProject)
+
+	metadata, err := d.GetRecipeMetadata(newContext(t), opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"size":     map[string]any{"name": "size", "type": "integer", "defaultValue": 1, "description": "The size of the cache."},
+			"password": map[string]any{"name": "password", "type": "string", "sensitive": true},
+			"sku":      map[string]any{"name": "sku", "defaultValue": "Basic"},
+		},
+	}, metadata)
+	require.Empty(t, cli.calls)
+}
+
+func Test_StackBackendURL(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+	u, err := stackBackendURL("s3://bucket/radius?region=us-west-2", resourceID)
+	require.NoError(t, err)
+	require.Regexp(t, `^s3://bucket/radius/[0-9a-f]{64}\?region=us-west-2$`, u)
+
+	// Resource IDs are case insensitive.
+	other, err := stackBackendURL("s3://bucket/radius?region=us-west-2", strings.ToUpper(resourceID))
+	require.NoError(t, err)
+	require.Equal(t, u, other)
+
+	_, err = stackBackendURL("", resourceID)
+	require.ErrorContains(t, err, "a state backend URL must be configured")
+
+	_, err = stackBackendURL("/pulumi-state", resourceID)
+	require.ErrorContains(t, err, "invalid Pulumi state backend URL")
+}
+
+func Test_Environment(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "rp-secret")
+	t.Setenv(passphraseEnvVar, "")
+	t.Setenv(passphraseFileEnvVar, "")
+	_, _, opts := setup(t, testProject)
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("passphrase"), 0600))
+
+	t.Run("allowed variables", func(t *testing.T) {
+		env, err := environment(PulumiOptions{PassphraseFile: passphraseFile}, opts)
+		require.NoError(t, err)
+		require.Contains(t, env, "PATH=/usr/bin")
+		require.Contains(t, env, passphraseFileEnvVar+"="+passphraseFile)
+		require.Contains(t, env, "ARM_CLIENT_SECRET=secret")
+		require.NotContains(t, env, "AWS_SECRET_ACCESS_KEY=rp-secret")
+	})
+
+	t.Run("configured variables", func(t *testing.T) {
+		env, err := environment(PulumiOptions{PassphraseFile: passphraseFile, Env: []string{"AWS_SECRET_ACCESS_KEY"}}, opts)
+		require.NoError(t, err)
+		require.Contains(t, env, "AWS_SECRET_ACCESS_KEY=rp-secret")
+	})
+
+	t.Run("passphrase of the process", func(t *testing.T) {
+		t.Setenv(passphraseEnvVar, "passphrase")
+
+		env, err := environment(PulumiOptions{}, opts)
+		require.NoError(t, err)
+		require.Contains(t, env, passphraseEnvVar+"=passphrase")
+	})
+
+	t.Run("no passphrase", func(t *testing.T) {
+		_, err := environment(PulumiOptions{}, opts)
+		require.ErrorContains(t, err, "a passphrase must be configured")
+	})
+
+	t.Run("missing passphrase file", func(t *testing.T) {
+		_, err := environment(PulumiOptions{PassphraseFile: filepath.Join(t.TempDir(), "missing")}, opts)
+		require.ErrorContains(t, err, "failed to read the Pulumi passphrase file")
+	})
+}
+
+func Test_FindSecretIDs(t *testing.T) {
+	_, d, opts := setup(t, testProject)
+
+	secretIDs, err := d.FindSecretIDs(newContext(t), opts.Configuration, opts.Definition)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"secretstore": {"clientSecret"}}, secretIDs)
+}
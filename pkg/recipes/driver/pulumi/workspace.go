@@ -0,0 +1,414 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulumi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+)
+
+const (
+	// stackName is the name of the stack deploying the recipe of a resource. Each resource has its own state backend,
+	// so the stacks of all resources have the same name.
+	stackName = "radius"
+
+	projectFileName = "Pulumi.yaml"
+	programDirName  = "program"
+	stateDirName    = ".pulumi-state"
+	stateFileName   = "stack.json"
+	homeDirName     = ".pulumi-home"
+
+	backendURLEnvVar     = "PULUMI_BACKEND_URL"
+	homeEnvVar           = "PULUMI_HOME"
+	skipUpdateEnvVar     = "PULUMI_SKIP_UPDATE_CHECK"
+	passphraseEnvVar     = "PULUMI_CONFIG_PASSPHRASE"
+	passphraseFileEnvVar = "PULUMI_CONFIG_PASSPHRASE_FILE"
+)
+
+// supportedRuntimes are the runtimes of the Pulumi programs that can be used as recipes.
+var supportedRuntimes = []string{"yaml", "go"}
+
+// defaultEnv are the environment variables of the process passed to the Pulumi CLI: the environment needed to run
+// the CLI, its plugins and the Go toolchain. The credentials of the resource provider are never passed unless
+// configured, see PulumiOptions.Env.
+var defaultEnv = []string{
+	"PATH", "HOME", "TMPDIR",
+	"SSL_CERT_FILE", "SSL_CERT_DIR",
+	"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy",
+	"GOROOT", "GOPATH", "GOCACHE", "GOMODCACHE", "GOPROXY", "GOFLAGS",
+}
+
+// project is the Pulumi project of a recipe, read from its Pulumi.yaml file.
+type project struct {
+	// Name is the name of the project, which is the namespace of its configuration.
+	Name string `yaml:"name"`
+
+	// Runtime is the language runtime of the program.
+	Runtime projectRuntime `yaml:"runtime"`
+
+	// Config is the schema of the configuration of the program.
+	Config map[string]any `yaml:"config"`
+
+	// Configuration is the schema of the configuration of Pulumi YAML programs written before the config property
+	// was supported by all runtimes.
+	Configuration map[string]any `yaml:"configuration"`
+}
+
+// projectRuntime is the name of the language runtime of a Pulumi project, which is either a string or an object
+// with runtime options.
+type projectRuntime string
+
+// UnmarshalYAML decodes the runtime of a Pulumi project.
+func (r *projectRuntime) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode((*string)(r))
+	}
+
+	runtime := struct {
+		Name string `yaml:"name"`
+	}{}
+	if err := node.Decode(&runtime); err != nil {
+		return err
+	}
+
+	*r = projectRuntime(runtime.Name)
+	return nil
+}
+
+// workspace is the directory where the Pulumi program of a recipe is downloaded and executed.
+type workspace struct {
+	// cli runs the Pulumi CLI.
+	cli cli
+
+	// programDir is the directory of the Pulumi program.
+	programDir string
+
+	// backendURL is the URL of the state backend of the stack.
+	backendURL string
+
+	// project is the Pulumi project of the program.
+	project *project
+
+	// config is the configuration of the stack.
+	config map[string]any
+
+	// state is the state of the stack exported from the state backend when the workspace was created, or nil if
+	// the stack did not exist.
+	state []byte
+
+	// env is the environment of the Pulumi CLI, without the state backend.
+	env []string
+}
+
+// run runs the Pulumi CLI with the given arguments on the stack of the workspace.
+func (w *workspace) run(ctx context.Context, args ...string) ([]byte, error) {
+	args = append(args, "--stack", stackName, "--non-interactive")
+	return w.cli.Run(ctx, w.programDir, w.environ(), args...)
+}
+
+// environ returns the environment of the Pulumi CLI using the state backend of the workspace.
+func (w *workspace) environ() []string {
+	return append(slices.Clone(w.env), backendURLEnvVar+"="+w.backendURL)
+}
+
+// exportStack returns the state of the stack in the state backend, or nil if the stack does not exist.
+func (w *workspace) exportStack(ctx context.Context) ([]byte, error) {
+	// Stacks are listed for all projects: the stack of a recipe whose project was renamed must not be created again.
+	b, err := w.cli.Run(ctx, w.programDir, w.environ(), "stack", "ls", "--all", "--json", "--non-interactive")
+	if err != nil {
+		return nil, err
+	}
+
+	stacks := []struct {
+		Name string `json:"name"`
+	}{}
+	if err := json.Unmarshal(b, &stacks); err != nil {
+		return nil, fmt.Errorf("invalid list of Pulumi stacks: %w", err)
+	}
+
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+
+	return w.run(ctx, "stack", "export")
+}
+
+// selectStack writes the configuration of the stack, and selects the stack, creating it if it does not exist yet.
+func (w *workspace) selectStack(ctx context.Context) error {
+	namespaced := map[string]any{}
+	for key, value := range w.config {
+		namespaced[w.project.Name+":"+key] = value
+	}
+
+	stackConfig := map[string]any{"config": namespaced}
+
+	// Pulumi stores the salt of the passphrase secrets provider in the configuration of the stack, which is
+	// generated for each execution, and with the secrets provider in the state of the stack. The salt of an
+	// existing stack is restored from its state, so that its secrets can be decrypted.
+	if w.state != nil {
+		d, err := parseDeployment(w.state)
+		if err != nil {
+			return err
+		}
+
+		if salt := d.salt(); salt != "" {
+			stackConfig["encryptionsalt"] = salt
+		}
+	}
+
+	b, err := yaml.Marshal(stackConfig)
+	if err != nil {
+		return err
+	}
+
+	stackConfigFile := filepath.Join(w.programDir, "Pulumi."+stackName+".yaml")
+	if err := os.WriteFile(stackConfigFile, b, 0600); err != nil {
+		return fmt.Errorf("failed to write the configuration of the stack: %w", err)
+	}
+
+	_, err = w.run(ctx, "stack", "select", "--create")
+	return err
+}
+
+// copyStack selects a copy of the stack in a file state backend in the given directory, so that the stack can be
+// used without changing its state.
+func (w *workspace) copyStack(ctx context.Context, dir string) error {
+	w.backendURL = fileBackendURL(dir)
+	if err := createFileBackend(w.backendURL); err != nil {
+		return err
+	}
+
+	if err := w.selectStack(ctx); err != nil {
+		return err
+	}
+
+	if w.state == nil {
+		return nil
+	}
+
+	stateFile := filepath.Join(dir, stateFileName)
+	if err := os.WriteFile(stateFile, w.state, 0600); err != nil {
+		return fmt.Errorf("failed to copy the Pulumi state: %w", err)
+	}
+
+	_, err := w.run(ctx, "stack", "import", "--file", stateFile)
+	return err
+}
+
+// remoteGetters returns the getters downloading Pulumi programs. Programs are never read from the local file system
+// of the container.
+func remoteGetters() map[string]getter.Getter {
+	getters := maps.Clone(getter.Getters)
+	delete(getters, "file")
+	return getters
+}
+
+// download downloads the Pulumi program of the recipe to the program directory with the given getters, and reads
+// its project.
+func download(ctx context.Context, getters map[string]getter.Getter, source, programDir string) (*project, error) {
+	client := &getter.Client{
+		Ctx:             ctx,
+		Src:             source,
+		Dst:             programDir,
+		Pwd:             filepath.Dir(programDir),
+		Mode:            getter.ClientModeDir,
+		Getters:         getters,
+		DisableSymlinks: true,
+	}
+
+	if err := client.Get(); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, fmt.Sprintf("failed to download Pulumi program from source %q: %s", source, err.Error()), "", recipes.GetErrorDetails(err))
+	}
+
+	return readProject(programDir)
+}
+
+// readProject reads the Pulumi project of the program in the given directory.
+func readProject(programDir string) (*project, error) {
+	b, err := os.ReadFile(filepath.Join(programDir, projectFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("the recipe is not a Pulumi program: %s not found", projectFileName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	p := &project{}
+	if err := yaml.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", projectFileName, err)
+	}
+
+	if p.Name == "" {
+		return nil, fmt.Errorf("invalid %s: the project has no name", projectFileName)
+	}
+
+	if !slices.Contains(supportedRuntimes, string(p.Runtime)) {
+		return nil, fmt.Errorf("unsupported Pulumi runtime %q, the supported runtimes are: %s", p.Runtime, strings.Join(supportedRuntimes, ", "))
+	}
+
+	return p, nil
+}
+
+// stackBackendURL returns the URL of the state backend of the recipe of the resource: a directory named after the
+// resource under the configured state backend.
+func stackBackendURL(backendURL, resourceID string) (string, error) {
+	if backendURL == "" {
+		return "", errors.New("a state backend URL must be configured to execute Pulumi recipes")
+	}
+
+	u, err := url.Parse(backendURL)
+	if err != nil || u.Scheme == "" {
+		return "", errors.New("invalid Pulumi state backend URL, the URL must have a scheme such as file://, s3://, azblob:// or gs://")
+	}
+
+	h := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	return u.JoinPath(hex.EncodeToString(h[:])).String(), nil
+}
+
+// fileBackendURL returns the URL of the file state backend in the given directory.
+func fileBackendURL(dir string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(dir)}).String()
+}
+
+// createFileBackend creates the directory of a file state backend, which Pulumi requires to exist. Other state
+// backends are left unchanged.
+func createFileBackend(backendURL string) error {
+	u, err := url.Parse(backendURL)
+	if err != nil || u.Scheme != "file" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.FromSlash(u.Path), 0700); err != nil {
+		return fmt.Errorf("failed to create the Pulumi state directory: %w", err)
+	}
+
+	return nil
+}
+
+// stackConfig returns the configuration of the stack deploying the recipe: the parameters of the recipe, and the
+// recipe context as the "context" value.
+func stackConfig(opts driver.BaseOptions) (map[string]any, error) {
+	parameters := map[string]any{}
+	maps.Copy(parameters, opts.Definition.Parameters)
+	maps.Copy(parameters, opts.Recipe.Parameters)
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	recipeContext.Resource.Connections = opts.Recipe.ConnectedResourcesProperties
+	parameters[recipecontext.RecipeContextParamKey] = recipeContext
+
+	// Values are converted to their JSON representation, so that they are written to the configuration of the stack
+	// with the names of their JSON fields.
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{}
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// environment returns the environment of the Pulumi CLI, without the state backend: the allowed environment
+// variables of the process, the passphrase of the secrets of the state, and the environment variables configured
+// for recipes by the environment.
+func environment(options PulumiOptions, opts driver.BaseOptions) ([]string, error) {
+	env := []string{}
+	for _, name := range slices.Concat(defaultEnv, options.Env) {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+
+	env = append(env,
+		homeEnvVar+"="+filepath.Join(options.Path, homeDirName),
+		skipUpdateEnvVar+"=true",
+	)
+
+	passphrase, err := passphraseEnv(options.PassphraseFile)
+	if err != nil {
+		return nil, err
+	}
+	env = append(env, passphrase)
+
+	recipeConfig := opts.Configuration.RecipeConfig
+	for _, name := range slices.Sorted(maps.Keys(recipeConfig.Env.AdditionalProperties)) {
+		env = append(env, name+"="+recipeConfig.Env.AdditionalProperties[name])
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(recipeConfig.EnvSecrets)) {
+		reference := recipeConfig.EnvSecrets[name]
+		secretData, ok := opts.Secrets[reference.Source]
+		if !ok {
+			return nil, fmt.Errorf("missing secret source: %s", reference.Source)
+		}
+
+		value, ok := secretData.Data[reference.Key]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key in secret store id: %s", reference.Source)
+		}
+
+		env = append(env, name+"="+value)
+	}
+
+	return env, nil
+}
+
+// passphraseEnv returns the environment variable of the passphrase encrypting the secrets of the state: the
+// configured passphrase file, or the passphrase set in the environment of the process. The secrets of the state
+// are never encrypted with an empty passphrase.
+func passphraseEnv(passphraseFile string) (string, error) {
+	if passphraseFile != "" {
+		if _, err := os.Stat(passphraseFile); err != nil {
+			return "", fmt.Errorf("failed to read the Pulumi passphrase file: %w", err)
+		}
+
+		return passphraseFileEnvVar + "=" + passphraseFile, nil
+	}
+
+	if value := os.Getenv(passphraseEnvVar); value != "" {
+		return passphraseEnvVar + "=" + value, nil
+	}
+
+	if value := os.Getenv(passphraseFileEnvVar); value != "" {
+		return passphraseFileEnvVar + "=" + value, nil
+	}
+
+	return "", fmt.Errorf("a passphrase must be configured to encrypt the secrets of the Pulumi state: set the passphraseFile option or the %s environment variable", passphraseEnvVar)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// prepareDriftOutput converts the resource drift of a refresh-only Terraform plan to the drift of a recipe. Terraform
+// reports the resources modified outside of Terraform with an update action, and the deleted ones with a delete
+// action. Data sources are read, not managed, so they are left out.
+func prepareDriftOutput(plan *tfjson.Plan) *recipes.DriftOutput {
+	output := &recipes.DriftOutput{
+		Driver:           recipes.TemplateKindTerraform,
+		DriftedResources: []rpv1.DriftedResource{},
+	}
+	if plan == nil {
+		return output
+	}
+
+	for _, rc := range plan.ResourceDrift {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var change rpv1.DriftChange
+		switch {
+		case rc.Change.Actions.Delete():
+			change = rpv1.DriftChangeDeleted
+		case rc.Change.Actions.Update():
+			change = rpv1.DriftChangeModified
+		default:
+			continue
+		}
+
+		output.DriftedResources = append(output.DriftedResources, rpv1.DriftedResource{
+			Address: rc.Address,
+			Type:    rc.Type,
+			Name:    rc.Name,
+			Change:  change,
+		})
+	}
+
+	return output
+}
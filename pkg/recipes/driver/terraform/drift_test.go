@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PrepareDriftOutput(t *testing.T) {
+	t.Run("nil plan", func(t *testing.T) {
+		output := prepareDriftOutput(nil)
+		require.Equal(t, recipes.TemplateKindTerraform, output.Driver)
+		require.Empty(t, output.DriftedResources)
+	})
+
+	t.Run("drifted resources", func(t *testing.T) {
+		plan := &tfjson.Plan{
+			ResourceDrift: []*tfjson.ResourceChange{
+				{
+					Address: "aws_s3_bucket.bucket",
+					Mode:    tfjson.ManagedResourceMode,
+					Type:    "aws_s3_bucket",
+					Name:    "bucket",
+					Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+				},
+				{
+					Address: "aws_sqs_queue.queue",
+					Mode:    tfjson.ManagedResourceMode,
+					Type:    "aws_sqs_queue",
+					Name:    "queue",
+					Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+				},
+				{
+					Address: "data.aws_region.current",
+					Mode:    tfjson.DataResourceMode,
+					Type:    "aws_region",
+					Name:    "current",
+					Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+				},
+				{
+					Address: "aws_iam_role.role",
+					Mode:    tfjson.ManagedResourceMode,
+					Type:    "aws_iam_role",
+					Name:    "role",
+					Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+				},
+			},
+		}
+
+		output := prepareDriftOutput(plan)
+		require.Equal(t, []rpv1.DriftedResource{
+			{Address: "aws_s3_bucket.bucket", Type: "aws_s3_bucket", Name: "bucket", Change: rpv1.DriftChangeModified},
+			{Address: "aws_sqs_queue.queue", Type: "aws_sqs_queue", Name: "queue", Change: rpv1.DriftChangeDeleted},
+		}, output.DriftedResources)
+	})
+}
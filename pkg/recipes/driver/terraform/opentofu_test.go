@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	tfjson "github.com/hashicorp/terraform-json"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func setupOpenTofu(t *testing.T) (*terraform.MockTerraformExecutor, *terraformDriver) {
+	ctrl := gomock.NewController(t)
+	tfExecutor := terraform.NewMockTerraformExecutor(ctrl)
+
+	d := NewOpenTofuDriver(nil, nil, TerraformOptions{Path: t.TempDir()}, kubernetesclientprovider.KubernetesClientProvider{}).(*terraformDriver)
+	d.terraformExecutor = tfExecutor
+
+	return tfExecutor, d
+}
+
+func Test_OpenTofu_Execute_Success(t *testing.T) {
+	ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+
+	tfExecutor, tofuDriver := setupOpenTofu(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envRecipe.Driver = recipes.TemplateKindOpenTofu
+
+	tfState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address:         "azurerm_redis_cache.redis",
+						Type:            "azurerm_redis_cache",
+						ProviderName:    OpenTofuAzureProvider,
+						AttributeValues: map[string]any{"id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/redis"},
+					},
+				},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ any, options terraform.Options) (*tfjson.State, error) {
+		require.Equal(t, DefaultOpenTofuExecPath, options.ExecPath)
+		return tfState, nil
+	})
+
+	recipeOutput, err := tofuDriver.Execute(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/Redis/redis"}, recipeOutput.Resources)
+	require.Equal(t, &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindOpenTofu,
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0",
+	}, recipeOutput.Status)
+}
+
+func Test_OpenTofu_Plan_Success(t *testing.T) {
+	ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+
+	tfExecutor, tofuDriver := setupOpenTofu(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envRecipe.Driver = recipes.TemplateKindOpenTofu
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(&tfjson.Plan{}, nil)
+
+	plan, err := tofuDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipes.TemplateKindOpenTofu, plan.Driver)
+}
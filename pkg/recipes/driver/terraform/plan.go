@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// sensitivePlanValue replaces the values Terraform marks as sensitive in a plan.
+	sensitivePlanValue = "(sensitive value)"
+
+	// unknownPlanValue replaces the values of a plan that are only known once the plan is applied.
+	unknownPlanValue = "(known after apply)"
+)
+
+// preparePlanOutput converts the resource changes of a Terraform plan to a recipe plan. Data sources are read, not
+// managed, so they are left out.
+func preparePlanOutput(plan *tfjson.Plan) *recipes.PlanOutput {
+	output := &recipes.PlanOutput{
+		Driver:  recipes.TemplateKindTerraform,
+		Kind:    recipes.PlanKindWhatIf,
+		Changes: []recipes.ResourceChange{},
+	}
+	if plan == nil {
+		return output
+	}
+
+	for _, rc := range plan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		output.Changes = append(output.Changes, recipes.ResourceChange{
+			Address: rc.Address,
+			Type:    rc.Type,
+			Name:    rc.Name,
+			Action:  planAction(rc.Change.Actions),
+			Before:  maskPlanValue(rc.Change.Before, rc.Change.BeforeSensitive, nil),
+			After:   maskPlanValue(rc.Change.After, rc.Change.AfterSensitive, rc.Change.AfterUnknown),
+		})
+	}
+
+	return output
+}
+
+// planAction returns the recipe plan action of the Terraform actions of a resource change.
+func planAction(actions tfjson.Actions) string {
+	switch {
+	case actions.Replace():
+		return recipes.PlanActionReplace
+	case actions.Create():
+		return recipes.PlanActionCreate
+	case actions.Update():
+		return recipes.PlanActionUpdate
+	case actions.Delete():
+		return recipes.PlanActionDelete
+	default:
+		return recipes.PlanActionNoOp
+	}
+}
+
+// maskPlanValue returns a copy of a value of a resource change in which the values marked in sensitive are replaced
+// by sensitivePlanValue, and the values marked in unknown by unknownPlanValue. Terraform marks values with true, or
+// with objects and arrays of the same shape as the value.
+func maskPlanValue(value any, sensitive any, unknown any) any {
+	if marked, ok := sensitive.(bool); ok && marked {
+		return sensitivePlanValue
+	}
+	if marked, ok := unknown.(bool); ok && marked {
+		return unknownPlanValue
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		sensitiveFields, _ := sensitive.(map[string]any)
+		unknownFields, _ := unknown.(map[string]any)
+
+		masked := make(map[string]any, len(v))
+		for key, field := range v {
+			masked[key] = maskPlanValue(field, sensitiveFields[key], unknownFields[key])
+		}
+
+		// Values that are unknown until apply are not part of the value.
+		for key, marked := range unknownFields {
+			if _, ok := masked[key]; !ok {
+				if marked, ok := marked.(bool); ok && marked {
+					masked[key] = unknownPlanValue
+				}
+			}
+		}
+
+		return masked
+
+	case []any:
+		sensitiveItems, _ := sensitive.([]any)
+		unknownItems, _ := unknown.([]any)
+
+		masked := make([]any, len(v))
+		for i, item := range v {
+			masked[i] = maskPlanValue(item, itemAt(sensitiveItems, i), itemAt(unknownItems, i))
+		}
+
+		return masked
+
+	case nil:
+		// A resource that is created has no value before the change, and a deleted one has none after it. Values
+		// that are entirely unknown are handled above.
+		if unknownFields, ok := unknown.(map[string]any); ok && len(unknownFields) > 0 {
+			return maskPlanValue(map[string]any{}, sensitive, unknown)
+		}
+
+		return nil
+	}
+
+	return value
+}
+
+// itemAt returns the item at index i, or nil if the slice is too short.
+func itemAt(items []any, i int) any {
+	if i < len(items) {
+		return items[i]
+	}
+
+	return nil
+}
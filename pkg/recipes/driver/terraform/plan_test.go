@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PreparePlanOutput(t *testing.T) {
+	plan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "data.aws_caller_identity.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "aws_caller_identity",
+				Name:    "current",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+			{
+				Address: "aws_db_instance.db",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_db_instance",
+				Name:    "db",
+				Change: &tfjson.Change{
+					Actions:        tfjson.Actions{tfjson.ActionCreate},
+					After:          map[string]any{"engine": "postgres", "password": "secret"},
+					AfterSensitive: map[string]any{"password": true},
+					AfterUnknown:   map[string]any{"endpoint": true},
+				},
+			},
+			{
+				Address: "aws_s3_bucket.bucket",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionUpdate},
+					Before:  map[string]any{"tags": []any{"a"}},
+					After:   map[string]any{"tags": []any{"a", "b"}},
+				},
+			},
+			{
+				Address: "aws_instance.vm",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_instance",
+				Name:    "vm",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+					Before:  map[string]any{"ami": "ami-1"},
+					After:   map[string]any{"ami": "ami-2"},
+				},
+			},
+			{
+				Address: "aws_sqs_queue.queue",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_sqs_queue",
+				Name:    "queue",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionDelete},
+					Before:  map[string]any{"name": "queue"},
+				},
+			},
+			{
+				Address: "aws_iam_role.role",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_iam_role",
+				Name:    "role",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionNoop},
+					Before:  map[string]any{"name": "role"},
+					After:   map[string]any{"name": "role"},
+				},
+			},
+		},
+	}
+
+	expected := &recipes.PlanOutput{
+		Driver: recipes.TemplateKindTerraform,
+		Kind:   recipes.PlanKindWhatIf,
+		Changes: []recipes.ResourceChange{
+			{
+				Address: "aws_db_instance.db",
+				Type:    "aws_db_instance",
+				Name:    "db",
+				Action:  recipes.PlanActionCreate,
+				After: map[string]any{
+					"engine":   "postgres",
+					"password": sensitivePlanValue,
+					"endpoint": unknownPlanValue,
+				},
+			},
+			{
+				Address: "aws_s3_bucket.bucket",
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Action:  recipes.PlanActionUpdate,
+				Before:  map[string]any{"tags": []any{"a"}},
+				After:   map[string]any{"tags": []any{"a", "b"}},
+			},
+			{
+				Address: "aws_instance.vm",
+				Type:    "aws_instance",
+				Name:    "vm",
+				Action:  recipes.PlanActionReplace,
+				Before:  map[string]any{"ami": "ami-1"},
+				After:   map[string]any{"ami": "ami-2"},
+			},
+			{
+				Address: "aws_sqs_queue.queue",
+				Type:    "aws_sqs_queue",
+				Name:    "queue",
+				Action:  recipes.PlanActionDelete,
+				Before:  map[string]any{"name": "queue"},
+			},
+			{
+				Address: "aws_iam_role.role",
+				Type:    "aws_iam_role",
+				Name:    "role",
+				Action:  recipes.PlanActionNoOp,
+				Before:  map[string]any{"name": "role"},
+				After:   map[string]any{"name": "role"},
+			},
+		},
+	}
+
+	require.Equal(t, expected, preparePlanOutput(plan))
+}
+
+func Test_PreparePlanOutput_Empty(t *testing.T) {
+	require.Equal(t, &recipes.PlanOutput{Driver: recipes.TemplateKindTerraform, Kind: recipes.PlanKindWhatIf, Changes: []recipes.ResourceChange{}}, preparePlanOutput(nil))
+}
+
+func Test_MaskPlanValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     any
+		sensitive any
+		unknown   any
+		expected  any
+	}{
+		{
+			name:     "plain value",
+			value:    "value",
+			expected: "value",
+		},
+		{
+			name:      "sensitive value",
+			value:     "secret",
+			sensitive: true,
+			expected:  sensitivePlanValue,
+		},
+		{
+			name:     "unknown value",
+			unknown:  true,
+			expected: unknownPlanValue,
+		},
+		{
+			name:      "nested sensitive value",
+			value:     map[string]any{"auth": map[string]any{"user": "admin", "password": "secret"}},
+			sensitive: map[string]any{"auth": map[string]any{"password": true}},
+			expected:  map[string]any{"auth": map[string]any{"user": "admin", "password": sensitivePlanValue}},
+		},
+		{
+			name:      "sensitive array item",
+			value:     []any{"a", "secret"},
+			sensitive: []any{false, true},
+			expected:  []any{"a", sensitivePlanValue},
+		},
+		{
+			name:     "unknown fields of a created resource",
+			unknown:  map[string]any{"id": true, "tags": false},
+			expected: map[string]any{"id": unknownPlanValue},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, maskPlanValue(tc.value, tc.sensitive, tc.unknown))
+		})
+	}
+}
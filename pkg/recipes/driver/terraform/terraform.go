@@ -35,6 +35,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/sdk"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
@@ -53,6 +54,21 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *secretprovider.S
 	return &terraformDriver{
 		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, kubernetesClients),
 		options:           options,
+		templateKind:      recipes.TemplateKindTerraform,
+	}
+}
+
+// NewOpenTofuDriver creates a new instance of driver to execute an OpenTofu recipe. OpenTofu recipes are Terraform
+// modules executed with the tofu binary, and use the Terraform recipe configuration of the environment.
+func NewOpenTofuDriver(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, options TerraformOptions, kubernetesClients kubernetesclientprovider.KubernetesClientProvider) driver.Driver {
+	if options.ExecPath == "" {
+		options.ExecPath = DefaultOpenTofuExecPath
+	}
+
+	return &terraformDriver{
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, kubernetesClients),
+		options:           options,
+		templateKind:      recipes.TemplateKindOpenTofu,
 	}
 }
 
@@ -63,6 +79,12 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution. Valid values: TRACE, DEBUG, INFO, WARN, ERROR, OFF. Default: ERROR.
 	LogLevel string
+
+	// Cache is the cache of Terraform modules and provider plugins shared by recipe executions. Optional.
+	Cache *cache.Cache
+
+	// ExecPath is the path or the name of the binary executing recipes. Terraform is installed when empty.
+	ExecPath string
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -72,6 +94,9 @@ type terraformDriver struct {
 
 	// options contains options required to execute a Terraform recipe, such as the path to the directory mounted to the container where Terraform can be executed in sub directories.
 	options TerraformOptions
+
+	// templateKind is the template kind of the recipes executed by the driver: terraform or opentofu.
+	templateKind string
 }
 
 // Execute creates a unique directory for each execution of terraform and deploys the recipe using the
@@ -109,6 +134,8 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
+		ExecPath:         d.options.ExecPath,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -116,7 +143,9 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		return nil, unsetError
 	}
 
-	if err != nil {
+	if errors.Is(err, context.Canceled) {
+		return nil, recipes.NewRecipeError(recipes.RecipeCanceled, fmt.Sprintf("recipe deployment was canceled: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	} else if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
@@ -163,6 +192,8 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
+		ExecPath:         d.options.ExecPath,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -170,13 +201,101 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 		return unsetError
 	}
 
-	if err != nil {
+	if errors.Is(err, context.Canceled) {
+		return recipes.NewRecipeError(recipes.RecipeCanceled, fmt.Sprintf("recipe deletion was canceled: %s", err.Error()), "", recipes.GetErrorDetails(err))
+	} else if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
 	}
 
 	return nil
 }
 
+// Plan creates a unique directory for each execution of terraform and plans the recipe using the Terraform CLI
+// through terraform-exec. It returns the resource changes of the plan, or an error if the plan fails.
+func (d *terraformDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.PlanOutput, error) {
+	tfPlan, err := d.plan(ctx, opts.BaseOptions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	output := preparePlanOutput(tfPlan)
+	output.Driver = d.templateKind
+	return output, nil
+}
+
+// DetectDrift creates a unique directory for each execution of terraform and runs a refresh-only plan of the recipe
+// using the Terraform CLI through terraform-exec. It returns the resources that were changed outside of Terraform
+// since the recipe was deployed, or an error if the plan fails.
+func (d *terraformDriver) DetectDrift(ctx context.Context, opts driver.DriftOptions) (*recipes.DriftOutput, error) {
+	tfPlan, err := d.plan(ctx, opts.BaseOptions, true)
+	if err != nil {
+		return nil, err
+	}
+
+	output := prepareDriftOutput(tfPlan)
+	output.Driver = d.templateKind
+	return output, nil
+}
+
+// plan runs a plan of the recipe in a unique directory, and returns the Terraform plan. A refresh-only plan only
+// reports the changes made to the deployed resources outside of Terraform.
+func (d *terraformDriver) plan(ctx context.Context, opts driver.BaseOptions, refreshOnly bool) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	errorCode, operation := recipes.RecipePlanFailed, "plan"
+	if refreshOnly {
+		errorCode, operation = recipes.RecipeDriftDetectionFailed, "drift detection"
+	}
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Get the secret store ID associated with the git private terraform repository source.
+	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add credential information to .gitconfig for module source of type git if applicable.
+	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:          requestDirPath,
+		EnvConfig:        &opts.Configuration,
+		ResourceRecipe:   &opts.Recipe,
+		EnvRecipe:        &opts.Definition,
+		Secrets:          opts.Secrets,
+		StateLockTimeout: terraform.DefaultStateLockTimeout,
+		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
+		ExecPath:         d.options.ExecPath,
+		RefreshOnly:      refreshOnly,
+	})
+
+	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return nil, recipes.NewRecipeError(recipes.RecipeCanceled, fmt.Sprintf("recipe %s was canceled: %s", operation, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	} else if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return tfPlan, nil
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -200,7 +319,7 @@ func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition
 	}
 
 	recipeResponse.Status = &rpv1.RecipeStatus{
-		TemplateKind:    recipes.TemplateKindTerraform,
+		TemplateKind:    d.templateKind,
 		TemplatePath:    definition.TemplatePath,
 		TemplateVersion: definition.TemplateVersion,
 	}
@@ -289,6 +408,8 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts driver.Bas
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 		LogLevel:       d.options.LogLevel,
+		Cache:          d.options.Cache,
+		ExecPath:       d.options.ExecPath,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -344,7 +465,7 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 
 	for _, resource := range module.Resources {
 		switch resource.ProviderName {
-		case TerraformKubernetesProvider:
+		case TerraformKubernetesProvider, OpenTofuKubernetesProvider:
 			var resourceType, resourceName, namespace, provider string
 			// For resource type "kubernetes_manifest" get the required details from the manifest property.
 			// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs/resources/manifest
@@ -399,7 +520,7 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 				return []string{}, err
 			}
 			recipeResources = append(recipeResources, kubernetesResourceID)
-		case TerraformAzureProvider:
+		case TerraformAzureProvider, OpenTofuAzureProvider:
 			if resource.AttributeValues != nil {
 				if id, ok := resource.AttributeValues["id"].(string); ok {
 					_, err := resources.ParseResource(id)
@@ -411,7 +532,7 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 					}
 				}
 			}
-		case TerraformAWSProvider:
+		case TerraformAWSProvider, OpenTofuAWSProvider:
 			if resource.AttributeValues != nil {
 				if arn, ok := resource.AttributeValues["arn"].(string); ok {
 					awsResourceID, err := awsresources.ToUCPResourceID(arn)
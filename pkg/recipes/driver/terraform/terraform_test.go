@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -42,7 +43,7 @@ func setup(t *testing.T) (terraform.MockTerraformExecutor, terraformDriver) {
 	ctrl := gomock.NewController(t)
 	tfExecutor := terraform.NewMockTerraformExecutor(ctrl)
 
-	driver := terraformDriver{tfExecutor, TerraformOptions{Path: t.TempDir()}}
+	driver := terraformDriver{tfExecutor, TerraformOptions{Path: t.TempDir()}, recipes.TemplateKindTerraform}
 
 	return *tfExecutor, driver
 }
@@ -170,6 +171,36 @@ func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Execute_Canceled(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeCanceled,
+			Message: "recipe deployment was canceled: terraform apply failure: context canceled",
+		},
+		DeploymentStatus: "executionError",
+	}
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, fmt.Errorf("terraform apply failure: %w", context.Canceled))
+
+	_, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, err, &recipeError)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -462,8 +493,124 @@ func Test_Terraform_Delete_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Delete_Canceled(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Delete(ctx, gomock.Any()).Times(1).
+		Return(fmt.Errorf("terraform destroy failure: %w", context.Canceled))
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeCanceled,
+			Message: "recipe deletion was canceled: terraform destroy failure: context canceled",
+		},
+	}
+
+	err := tfDriver.Delete(ctx, driver.DeleteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		OutputResources: []rpv1.OutputResource{},
+	})
+	require.Error(t, err)
+	require.Equal(t, &expErr, err)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "aws_s3_bucket.bucket",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionCreate},
+					After:   map[string]any{"bucket": "my-bucket"},
+				},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.PlanOutput{
+		Driver: recipes.TemplateKindTerraform,
+		Kind:   recipes.PlanKindWhatIf,
+		Changes: []recipes.ResourceChange{
+			{
+				Address: "aws_s3_bucket.bucket",
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Action:  recipes.PlanActionCreate,
+				After:   map[string]any{"bucket": "my-bucket"},
+			},
+		},
+	}, plan)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).
+		Return(nil, errors.New("Failed to plan terraform module"))
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "Failed to plan terraform module",
+		},
+		DeploymentStatus: "executionError",
+	}
+
+	_, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, &expErr, err)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
-	d := &terraformDriver{}
+	d := &terraformDriver{templateKind: recipes.TemplateKindTerraform}
 	tests := []struct {
 		desc             string
 		state            *tfjson.State
@@ -1018,3 +1165,86 @@ func createTerraformConfigWithEnvSecrets() recipes.Configuration {
 		},
 	}
 }
+
+func Test_Terraform_DetectDrift_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceDrift: []*tfjson.ResourceChange{
+			{
+				Address: "aws_s3_bucket.bucket",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionUpdate},
+				},
+			},
+		},
+	}
+	tfExecutor.EXPECT().
+		Plan(ctx, gomock.Cond(func(opts terraform.Options) bool { return opts.RefreshOnly })).
+		Times(1).
+		Return(tfPlan, nil)
+
+	drift, err := tfDriver.DetectDrift(ctx, driver.DriftOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.DriftOutput{
+		Driver: recipes.TemplateKindTerraform,
+		DriftedResources: []rpv1.DriftedResource{
+			{
+				Address: "aws_s3_bucket.bucket",
+				Type:    "aws_s3_bucket",
+				Name:    "bucket",
+				Change:  rpv1.DriftChangeModified,
+			},
+		},
+	}, drift)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_DetectDrift_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).
+		Return(nil, errors.New("expected terraform state is not found in the backend"))
+
+	expErr := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDriftDetectionFailed,
+			Message: "expected terraform state is not found in the backend",
+		},
+		DeploymentStatus: "executionError",
+	}
+
+	_, err := tfDriver.DetectDrift(ctx, driver.DriftOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, &expErr, err)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
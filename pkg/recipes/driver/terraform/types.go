@@ -26,8 +26,14 @@ const (
 	TerraformAzureProvider            = "registry.terraform.io/hashicorp/azurerm"
 	TerraformAWSProvider              = "registry.terraform.io/hashicorp/aws"
 	TerraformKubernetesProvider       = "registry.terraform.io/hashicorp/kubernetes"
+	OpenTofuAzureProvider             = "registry.opentofu.org/hashicorp/azurerm"
+	OpenTofuAWSProvider               = "registry.opentofu.org/hashicorp/aws"
+	OpenTofuKubernetesProvider        = "registry.opentofu.org/hashicorp/kubernetes"
 	PrivateRegistrySecretKey_Pat      = "pat"
 	PrivateRegistrySecretKey_Username = "username"
+
+	// DefaultOpenTofuExecPath is the binary executing OpenTofu recipes by default, looked up in the PATH.
+	DefaultOpenTofuExecPath = "tofu"
 )
 
 // GetPrivateGitRepoSecretStoreID returns secretstore resource ID associated with git private terraform repository source.
@@ -33,6 +33,14 @@ type Driver interface {
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
+	// Plan fetches the recipe contents and returns the changes that executing the recipe would make, without
+	// deploying it.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.PlanOutput, error)
+
+	// DetectDrift compares the infrastructure deployed by the recipe with its actual state, and returns the resources
+	// that were changed outside of Radius. It never changes the infrastructure.
+	DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error)
+
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
 }
@@ -90,3 +98,14 @@ type DeleteOptions struct {
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
 }
+
+// DriftOptions is the options for the DetectDrift method.
+type DriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+
+	// RecipeStatus is the status of the recipe deployment.
+	RecipeStatus *rpv1.RecipeStatus
+}
@@ -112,6 +112,139 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 	return res, definition, nil
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then plans the recipe using the driver. It returns the planned changes
+// and an error if one occurs.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.PlanOutput, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.PlanOutput, *recipes.EnvironmentDefinition, error) {
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Nothing is deployed in a simulated environment, so nothing would change.
+	if configuration.Simulated {
+		return &recipes.PlanOutput{
+			Driver:   definition.Driver,
+			Kind:     recipes.PlanKindWhatIf,
+			Changes:  []recipes.ResourceChange{},
+			Messages: []string{"The environment is simulated: executing the recipe would not deploy any resource."},
+		}, definition, nil
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
+// DetectDrift loads the recipe definition from the environment, finds the driver associated with the recipe, loads
+// the configuration associated with the recipe, and then uses the driver to compare the infrastructure deployed by the
+// recipe with its actual state. It returns the resources that were changed outside of Radius and an error if one occurs.
+func (e *engine) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error) {
+	driftStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	drift, definition, err := e.detectDriftCore(ctx, opts)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, driftStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name,
+			definition, result))
+
+	return drift, err
+}
+
+// detectDriftCore function is the core logic of the DetectDrift function.
+// Any changes to the core logic of the DetectDrift function should be made here.
+func (e *engine) detectDriftCore(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, *recipes.EnvironmentDefinition, error) {
+	recipe := opts.Recipe
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Nothing is deployed in a simulated environment, so nothing can drift.
+	if configuration.Simulated {
+		return &recipes.DriftOutput{
+			Driver:           definition.Driver,
+			DriftedResources: []rpv1.DriftedResource{},
+			Messages:         []string{"The environment is simulated: the recipe did not deploy any resource."},
+		}, definition, nil
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	drift, err := driver.DetectDrift(ctx, recipedriver.DriftOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+		OutputResources: opts.OutputResources,
+		RecipeStatus:    opts.RecipeStatus,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return drift, definition, nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
@@ -1015,6 +1015,227 @@ func Test_GetRecipeMetadata_Driver_InvalidDriver(t *testing.T) {
 	require.Contains(t, err.Error(), "could not find driver invalid")
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	plan := &recipes.PlanOutput{
+		Driver: recipes.TemplateKindBicep,
+		Kind:   recipes.PlanKindResourceList,
+		Changes: []recipes.ResourceChange{
+			{Address: "account", Type: "Microsoft.DocumentDB/databaseAccounts"},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+		}).
+		Times(1).
+		Return(plan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, plan, result)
+}
+
+func Test_Engine_Plan_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Simulated: true,
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	// Note: the driver is not called as the environment is simulated
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipes.TemplateKindBicep, result.Driver)
+	require.Empty(t, result.Changes)
+	require.Len(t, result.Messages, 1)
+}
+
+func Test_Engine_Plan_Failure(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{}
+	recipeErr := recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan recipe", "", nil)
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipeErr)
+
+	_, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Equal(t, recipeErr, err)
+}
+
+func Test_Engine_DetectDrift_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	drift := &recipes.DriftOutput{
+		Driver: recipes.TemplateKindBicep,
+		DriftedResources: []rpv1.DriftedResource{
+			{ID: outputResources[0].ID.String(), Type: "Microsoft.DocumentDB/databaseAccounts", Change: rpv1.DriftChangeDeleted},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		DetectDrift(ctx, recipedriver.DriftOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			OutputResources: outputResources,
+		}).
+		Times(1).
+		Return(drift, nil)
+
+	result, err := engine.DetectDrift(ctx, DriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.NoError(t, err)
+	require.Equal(t, drift, result)
+}
+
+func Test_Engine_DetectDrift_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Simulated: true,
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	// Note: the driver is not called as the environment is simulated
+
+	result, err := engine.DetectDrift(ctx, DriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipes.TemplateKindBicep, result.Driver)
+	require.Empty(t, result.DriftedResources)
+	require.Len(t, result.Messages, 1)
+}
+
+func Test_Engine_DetectDrift_Failure(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{}
+	recipeErr := recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, "failed to detect drift", "", nil)
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		DetectDrift(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipeErr)
+
+	_, err := engine.DetectDrift(ctx, DriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.Equal(t, recipeErr, err)
+}
+
 func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition, []rpv1.OutputResource) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
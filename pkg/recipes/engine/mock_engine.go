@@ -21,6 +21,7 @@ import (
 type MockEngine struct {
 	ctrl     *gomock.Controller
 	recorder *MockEngineMockRecorder
+	isgomock struct{}
 }
 
 // MockEngineMockRecorder is the mock recorder for MockEngine.
@@ -41,17 +42,17 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockEngine) Delete(arg0 context.Context, arg1 DeleteOptions) error {
+func (m *MockEngine) Delete(ctx context.Context, opts DeleteOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, opts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockEngineMockRecorder) Delete(arg0, arg1 any) *MockEngineDeleteCall {
+func (mr *MockEngineMockRecorder) Delete(ctx, opts any) *MockEngineDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEngine)(nil).Delete), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEngine)(nil).Delete), ctx, opts)
 	return &MockEngineDeleteCall{Call: call}
 }
 
@@ -78,19 +79,58 @@ func (c *MockEngineDeleteCall) DoAndReturn(f func(context.Context, DeleteOptions
 	return c
 }
 
+// DetectDrift mocks base method.
+func (m *MockEngine) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, opts)
+	ret0, _ := ret[0].(*recipes.DriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockEngineMockRecorder) DetectDrift(ctx, opts any) *MockEngineDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockEngine)(nil).DetectDrift), ctx, opts)
+	return &MockEngineDetectDriftCall{Call: call}
+}
+
+// MockEngineDetectDriftCall wrap *gomock.Call
+type MockEngineDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEngineDetectDriftCall) Return(arg0 *recipes.DriftOutput, arg1 error) *MockEngineDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEngineDetectDriftCall) Do(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockEngineDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEngineDetectDriftCall) DoAndReturn(f func(context.Context, DriftOptions) (*recipes.DriftOutput, error)) *MockEngineDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Execute mocks base method.
-func (m *MockEngine) Execute(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipeOutput, error) {
+func (m *MockEngine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret := m.ctrl.Call(m, "Execute", ctx, opts)
 	ret0, _ := ret[0].(*recipes.RecipeOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockEngineMockRecorder) Execute(arg0, arg1 any) *MockEngineExecuteCall {
+func (mr *MockEngineMockRecorder) Execute(ctx, opts any) *MockEngineExecuteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEngine)(nil).Execute), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEngine)(nil).Execute), ctx, opts)
 	return &MockEngineExecuteCall{Call: call}
 }
 
@@ -118,18 +158,18 @@ func (c *MockEngineExecuteCall) DoAndReturn(f func(context.Context, ExecuteOptio
 }
 
 // GetRecipeMetadata mocks base method.
-func (m *MockEngine) GetRecipeMetadata(arg0 context.Context, arg1 GetRecipeMetadataOptions) (map[string]any, error) {
+func (m *MockEngine) GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", ctx, opts)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
-func (mr *MockEngineMockRecorder) GetRecipeMetadata(arg0, arg1 any) *MockEngineGetRecipeMetadataCall {
+func (mr *MockEngineMockRecorder) GetRecipeMetadata(ctx, opts any) *MockEngineGetRecipeMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockEngine)(nil).GetRecipeMetadata), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockEngine)(nil).GetRecipeMetadata), ctx, opts)
 	return &MockEngineGetRecipeMetadataCall{Call: call}
 }
 
@@ -155,3 +195,42 @@ func (c *MockEngineGetRecipeMetadataCall) DoAndReturn(f func(context.Context, Ge
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockEngine) Plan(ctx context.Context, opts PlanOptions) (*recipes.PlanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, opts)
+	ret0, _ := ret[0].(*recipes.PlanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockEngineMockRecorder) Plan(ctx, opts any) *MockEnginePlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockEngine)(nil).Plan), ctx, opts)
+	return &MockEnginePlanCall{Call: call}
+}
+
+// MockEnginePlanCall wrap *gomock.Call
+type MockEnginePlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnginePlanCall) Return(arg0 *recipes.PlanOutput, arg1 error) *MockEnginePlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnginePlanCall) Do(f func(context.Context, PlanOptions) (*recipes.PlanOutput, error)) *MockEnginePlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnginePlanCall) DoAndReturn(f func(context.Context, PlanOptions) (*recipes.PlanOutput, error)) *MockEnginePlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
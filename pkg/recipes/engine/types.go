@@ -33,6 +33,15 @@ type Engine interface {
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
+	// Plan gathers environment configuration, recipe definition and calls the driver to plan the recipe. It returns
+	// the changes that executing the recipe would make, without deploying it.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.PlanOutput, error)
+
+	// DetectDrift gathers environment configuration, recipe definition and calls the driver to compare the
+	// infrastructure deployed by the recipe with its actual state. It returns the resources that were changed outside
+	// of Radius.
+	DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.DriftOutput, error)
+
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
 }
@@ -60,6 +69,24 @@ type DeleteOptions struct {
 	OutputResources []rpv1.OutputResource
 }
 
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+	// PreviousState represents previously deployed state of output resource IDs.
+	PreviousState []string
+}
+
+// DriftOptions is the options for the DetectDrift method.
+type DriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+
+	// RecipeStatus is the status of the recipe deployment.
+	RecipeStatus *rpv1.RecipeStatus
+}
+
 type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
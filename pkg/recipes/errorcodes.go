@@ -26,6 +26,15 @@ const (
 	// Used for recipe deletion failures.
 	RecipeDeletionFailed = "RecipeDeletionFailed"
 
+	// Used for failures to plan the changes of a recipe.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used for failures to detect the drift of the infrastructure deployed by a recipe.
+	RecipeDriftDetectionFailed = "RecipeDriftDetectionFailed"
+
+	// Used when a recipe deployment or deletion is canceled before it completes.
+	RecipeCanceled = "RecipeCanceled"
+
 	// Used for errors encountered during processing recipe outputs.
 	InvalidRecipeOutputs = "InvalidRecipeOutputs"
 
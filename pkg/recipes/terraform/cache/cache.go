@@ -0,0 +1,645 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache implements a cache of the Terraform modules and provider plugins downloaded by recipe executions.
+// The cache is a directory that can be shared by several processes, for example by mounting the same volume in the
+// applications-rp and dynamic-rp containers.
+//
+// The cache directory has the following layout:
+//
+//	modules/<key>/entry.json           the source and version of the module
+//	modules/<key>/modules/             the modules downloaded by terraform get, with their modules.json manifest
+//	modules/<key>/locks/<lock>.hcl     the provider lock files created by the first terraform init, by provider lock
+//	plugins/                           the Terraform provider plugin cache (TF_PLUGIN_CACHE_DIR)
+//	tmp/                               the entries being written
+//	.lock                              the file lock held while entries are read or evicted
+//
+// The processes sharing the cache hold a shared file lock while they read an entry, and an exclusive file lock while
+// they evict entries, so that an entry is never evicted while it is read.
+//
+// A cache populated by an installation with network access can be copied to an air-gapped installation, and used
+// in offline mode.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// DefaultMaxSize is the default maximum size of the cache.
+	DefaultMaxSize = "10Gi"
+
+	// PluginCacheDirEnvVar is the environment variable configuring the Terraform provider plugin cache.
+	PluginCacheDirEnvVar = "TF_PLUGIN_CACHE_DIR"
+
+	// lockRetryDelay is the delay between two attempts to acquire the file lock of the cache.
+	lockRetryDelay = 100 * time.Millisecond
+
+	// evictionGracePeriod is how long an entry is kept after it was last used, regardless of the size of the cache.
+	// It leaves time for the executions using the entry to complete, including in other processes sharing the cache.
+	evictionGracePeriod = time.Hour
+
+	modulesDir     = "modules"
+	pluginsDir     = "plugins"
+	tempDir        = "tmp"
+	entryFileName  = "entry.json"
+	locksDir       = "locks"
+	cacheLockName  = ".lock"
+	lockFileName   = ".terraform.lock.hcl"
+	manifestName   = "modules.json"
+	moduleRootDir  = ".terraform/modules"
+	providersDir   = ".terraform/providers"
+	pluginDirDepth = 5 // <hostname>/<namespace>/<type>/<version>/<os>_<arch>
+)
+
+// Options represents the options of the cache.
+type Options struct {
+	// Path is the directory of the cache. The cache is disabled when empty.
+	Path string
+
+	// MaxSize is the maximum size of the cache as a quantity, for example "10Gi". Defaults to DefaultMaxSize.
+	MaxSize string
+
+	// Offline disables downloads: modules and provider plugins must be in the cache already. The cache is never
+	// updated or evicted in offline mode.
+	Offline bool
+}
+
+// ModuleKey identifies a module in the cache.
+type ModuleKey struct {
+	// Driver is the recipe driver downloading the module. Terraform and OpenTofu download registry modules from
+	// different registries.
+	Driver string
+
+	// Source is the source of the module, for example "Azure/cosmosdb/azurerm".
+	Source string
+
+	// Version is the version of the module, if any.
+	Version string
+
+	// Scope restricts the reuse of the module, for example to the environment whose credentials were used to
+	// download it. Modules with an empty scope are shared by all recipe executions.
+	Scope string
+
+	// ProviderLock is the hash of the provider requirements of the module, which select the provider plugins recorded
+	// in its provider lock file. Executions with different provider requirements never share a provider lock file.
+	// It is not part of the name of the module entry, since it is only known once the module is downloaded or
+	// restored: it selects the provider lock file in the entry.
+	ProviderLock string
+}
+
+// hash returns the name of the cache entry of the module.
+func (k ModuleKey) hash() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n%s\n", k.Driver, k.Source, k.Version, strings.ToLower(k.Scope))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// entry is the metadata of a module in the cache.
+type entry struct {
+	// Source is the source of the module.
+	Source string `json:"source"`
+
+	// Version is the version of the module, if any.
+	Version string `json:"version,omitempty"`
+
+	// ModuleName is the name of the module in the configuration that downloaded it.
+	ModuleName string `json:"moduleName"`
+}
+
+// Cache is a cache of the Terraform modules and provider plugins downloaded by recipe executions.
+type Cache struct {
+	root    string
+	maxSize int64
+	offline bool
+	now     func() time.Time
+}
+
+// New creates the cache with the given options. It returns nil if the cache is disabled.
+func New(options Options) (*Cache, error) {
+	if options.Path == "" {
+		return nil, nil
+	}
+
+	maxSize := options.MaxSize
+	if maxSize == "" {
+		maxSize = DefaultMaxSize
+	}
+
+	quantity, err := resource.ParseQuantity(maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid terraform cache size %q: %w", maxSize, err)
+	}
+	if quantity.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid terraform cache size %q: must be positive", maxSize)
+	}
+
+	c := &Cache{root: options.Path, maxSize: quantity.Value(), offline: options.Offline, now: time.Now}
+	if !c.offline {
+		for _, dir := range []string{c.modulesDir(), c.pluginsDir(), c.tempDir()} {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, fmt.Errorf("failed to create terraform cache directory: %w", err)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// Offline returns true if downloads are disabled.
+func (c *Cache) Offline() bool {
+	return c.offline
+}
+
+// Env returns the environment variables of the Terraform process using the cache.
+func (c *Cache) Env() map[string]string {
+	if c.offline {
+		// Provider plugins are installed from the cache directory with InitOptions.
+		return map[string]string{}
+	}
+
+	return map[string]string{PluginCacheDirEnvVar: c.pluginsDir()}
+}
+
+// InitOptions returns the options of terraform init using the cache. In offline mode, the modules are restored from
+// the cache before terraform init, and the provider plugins are installed from the cache instead of their registry.
+func (c *Cache) InitOptions() []tfexec.InitOption {
+	if !c.offline {
+		return nil
+	}
+
+	return []tfexec.InitOption{tfexec.Get(false), tfexec.PluginDir(c.pluginsDir())}
+}
+
+// RestoreModule copies the module from the cache to the working directory, as if it was downloaded by terraform
+// get with the given module name. It returns false if the module is not in the cache. The provider lock file is
+// restored by RestoreLockFile.
+func (c *Cache) RestoreModule(ctx context.Context, key ModuleKey, workingDir, moduleName string) (bool, error) {
+	unlock, err := c.lock(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	entryDir := filepath.Join(c.modulesDir(), key.hash())
+
+	e, err := readEntry(entryDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	// Record the use of the entry first, so that it is not evicted while it is copied.
+	c.touch(entryDir)
+
+	if err := copyModules(filepath.Join(entryDir, modulesDir), filepath.Join(workingDir, moduleRootDir), e.ModuleName, moduleName); err != nil {
+		return false, fmt.Errorf("failed to restore terraform module from the cache: %w", err)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Restored Terraform module %q from the cache", key.Source))
+	return true, nil
+}
+
+// RestoreLockFile copies the provider lock file of the module with the provider lock of the key from the cache to the
+// working directory, so that terraform init selects the same provider plugins as the execution that stored it. It
+// returns false if the cache has no such provider lock file.
+func (c *Cache) RestoreLockFile(ctx context.Context, key ModuleKey, workingDir string) (bool, error) {
+	unlock, err := c.lock(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	entryDir := filepath.Join(c.modulesDir(), key.hash())
+	lockFile, err := os.ReadFile(c.lockFilePath(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	c.touch(entryDir)
+
+	if err := os.WriteFile(filepath.Join(workingDir, lockFileName), lockFile, 0644); err != nil {
+		return false, fmt.Errorf("failed to restore terraform lock file from the cache: %w", err)
+	}
+
+	return true, nil
+}
+
+// StoreModule copies the module downloaded by terraform get in the working directory with the given module name to
+// the cache. Modules are never updated once cached.
+func (c *Cache) StoreModule(ctx context.Context, key ModuleKey, workingDir, moduleName string) error {
+	if c.offline {
+		return nil
+	}
+
+	entryDir := filepath.Join(c.modulesDir(), key.hash())
+	if _, err := os.Stat(entryDir); err == nil {
+		return nil
+	}
+
+	staging, err := os.MkdirTemp(c.tempDir(), "module-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	if err := os.CopyFS(filepath.Join(staging, modulesDir), os.DirFS(filepath.Join(workingDir, moduleRootDir))); err != nil {
+		return fmt.Errorf("failed to copy terraform module to the cache: %w", err)
+	}
+
+	b, err := json.Marshal(entry{Source: key.Source, Version: key.Version, ModuleName: moduleName})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(staging, entryFileName), b, 0644); err != nil {
+		return err
+	}
+
+	// Entries are written in a staging directory and renamed, so that partially written entries are never used.
+	// The rename fails if the entry was stored concurrently, in which case the staging directory is discarded.
+	if err := os.Rename(staging, entryDir); err != nil {
+		if _, statErr := os.Stat(entryDir); statErr == nil {
+			return nil
+		}
+		return err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Stored Terraform module %q in the cache", key.Source))
+	return c.Evict(ctx)
+}
+
+// AfterInit updates the cache after terraform init succeeded in the working directory: it stores the provider lock
+// file with the module, so that later executions with the same provider lock select the same provider plugins from
+// the cache, records the use of the provider plugins, and evicts the least recently used entries if the cache is full.
+func (c *Cache) AfterInit(ctx context.Context, key ModuleKey, workingDir string) error {
+	if c.offline {
+		return nil
+	}
+
+	if err := c.storeLockFile(ctx, key, workingDir); err != nil {
+		return err
+	}
+
+	return c.Evict(ctx)
+}
+
+// storeLockFile copies the provider lock file of the working directory to the cache entry of the module, if the
+// entry does not have one for the provider lock of the key, and records the use of the provider plugins.
+func (c *Cache) storeLockFile(ctx context.Context, key ModuleKey, workingDir string) error {
+	unlock, err := c.lock(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	c.touchPlugins(workingDir)
+
+	entryDir := filepath.Join(c.modulesDir(), key.hash())
+	if _, err := os.Stat(entryDir); err != nil {
+		return nil
+	}
+
+	target := c.lockFilePath(key)
+	if _, err := os.Stat(target); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	lockFile, err := os.ReadFile(filepath.Join(workingDir, lockFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	staging, err := os.CreateTemp(c.tempDir(), "lock-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(staging.Name())
+
+	if _, err := staging.Write(lockFile); err != nil {
+		_ = staging.Close()
+		return err
+	}
+	if err := staging.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(staging.Name(), target)
+}
+
+// touchPlugins records the use of the provider plugins installed in the working directory from the cache.
+func (c *Cache) touchPlugins(workingDir string) {
+	installed := filepath.Join(workingDir, providersDir)
+	for _, dir := range listDirs(installed, pluginDirDepth) {
+		rel, err := filepath.Rel(installed, dir)
+		if err != nil {
+			continue
+		}
+
+		c.touch(filepath.Join(c.pluginsDir(), rel))
+	}
+}
+
+// item is an evictable entry of the cache.
+type item struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// Evict removes the least recently used modules and provider plugins until the size of the cache is below its
+// maximum size. Entries used during the last evictionGracePeriod are kept, so the cache can temporarily exceed its
+// maximum size.
+func (c *Cache) Evict(ctx context.Context) error {
+	if c.offline {
+		return nil
+	}
+
+	unlock, err := c.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	now := c.now()
+
+	// Remove the staging directories left by interrupted writes.
+	for _, dir := range listDirs(c.tempDir(), 1) {
+		if info, err := os.Stat(dir); err == nil && now.Sub(info.ModTime()) > evictionGracePeriod {
+			_ = os.RemoveAll(dir)
+		}
+	}
+
+	items := []item{}
+	total := int64(0)
+	for _, dir := range append(listDirs(c.modulesDir(), 1), listDirs(c.pluginsDir(), pluginDirDepth)...) {
+		info, err := os.Stat(dir)
+		if err != nil {
+			continue
+		}
+
+		size, err := dirSize(dir)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, item{path: dir, size: size, lastUsed: info.ModTime()})
+		total += size
+	}
+
+	if total <= c.maxSize {
+		return nil
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].lastUsed.Before(items[j].lastUsed) })
+	for _, it := range items {
+		if total <= c.maxSize || now.Sub(it.lastUsed) < evictionGracePeriod {
+			break
+		}
+
+		// Entries are renamed before they are removed, so that partially removed entries are never used.
+		trash := filepath.Join(c.tempDir(), "evicted-"+filepath.Base(it.path)+"-"+fmt.Sprint(now.UnixNano()))
+		if err := os.Rename(it.path, trash); err != nil {
+			logger.Info(fmt.Sprintf("Failed to evict %q from the Terraform cache: %s", it.path, err.Error()))
+			continue
+		}
+		if err := os.RemoveAll(trash); err != nil {
+			logger.Info(fmt.Sprintf("Failed to remove evicted entry %q of the Terraform cache: %s", trash, err.Error()))
+		}
+
+		total -= it.size
+		logger.Info(fmt.Sprintf("Evicted %q from the Terraform cache", it.path))
+	}
+
+	return nil
+}
+
+// lock acquires the file lock of the cache, shared by the processes using the cache, and returns the function
+// releasing it. The lock is exclusive while entries are evicted, and shared while they are read. Offline caches are
+// never evicted, and may be read-only, so they are not locked.
+func (c *Cache) lock(ctx context.Context, exclusive bool) (func(), error) {
+	if c.offline {
+		return func() {}, nil
+	}
+
+	// Each call uses its own file lock, so that the goroutines of this process are synchronized as well.
+	fileLock := flock.New(filepath.Join(c.root, cacheLockName))
+	var err error
+	if exclusive {
+		_, err = fileLock.TryLockContext(ctx, lockRetryDelay)
+	} else {
+		_, err = fileLock.TryRLockContext(ctx, lockRetryDelay)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock the terraform cache: %w", err)
+	}
+
+	return func() { _ = fileLock.Unlock() }, nil
+}
+
+// lockFilePath returns the path of the provider lock file of the module with the provider lock of the key.
+func (c *Cache) lockFilePath(key ModuleKey) string {
+	name := sha256.Sum256([]byte(key.ProviderLock))
+	return filepath.Join(c.modulesDir(), key.hash(), locksDir, hex.EncodeToString(name[:])+".hcl")
+}
+
+func (c *Cache) modulesDir() string {
+	return filepath.Join(c.root, modulesDir)
+}
+
+func (c *Cache) pluginsDir() string {
+	return filepath.Join(c.root, pluginsDir)
+}
+
+func (c *Cache) tempDir() string {
+	return filepath.Join(c.root, tempDir)
+}
+
+// touch records the use of a cache entry in the modification time of its directory.
+func (c *Cache) touch(path string) {
+	now := c.now()
+	_ = os.Chtimes(path, now, now)
+}
+
+// readEntry reads the metadata of a module in the cache.
+func readEntry(entryDir string) (*entry, error) {
+	b, err := os.ReadFile(filepath.Join(entryDir, entryFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, fmt.Errorf("invalid terraform cache entry %q: %w", entryDir, err)
+	}
+
+	return e, nil
+}
+
+// copyModules copies the modules downloaded by terraform get from the source directory to the target directory,
+// renaming the module from the given name to the new name in the directory names and in the modules.json manifest.
+// Terraform names the directories of the nested modules after their parent module, for example "redis.network".
+func copyModules(source, target, from, to string) error {
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.Name() == manifestName {
+			if err := copyManifest(filepath.Join(source, manifestName), filepath.Join(target, manifestName), from, to); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.CopyFS(filepath.Join(target, renameModule(e.Name(), from, to)), os.DirFS(filepath.Join(source, e.Name()))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyManifest copies the modules.json manifest of terraform get, renaming the module from the given name to the
+// new name.
+func copyManifest(source, target, from, to string) error {
+	b, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	manifest := map[string]any{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return fmt.Errorf("invalid terraform modules manifest: %w", err)
+	}
+
+	records, _ := manifest["Modules"].([]any)
+	for _, r := range records {
+		record, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if key, ok := record["Key"].(string); ok && key != "" {
+			record["Key"] = renameModule(key, from, to)
+		}
+
+		if dir, ok := record["Dir"].(string); ok && strings.HasPrefix(dir, moduleRootDir+"/") {
+			name, rest, _ := strings.Cut(strings.TrimPrefix(dir, moduleRootDir+"/"), "/")
+			dir = moduleRootDir + "/" + renameModule(name, from, to)
+			if rest != "" {
+				dir += "/" + rest
+			}
+			record["Dir"] = dir
+		}
+	}
+
+	b, err = json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(target, b, 0644)
+}
+
+// renameModule renames a module key, or the key of one of its nested modules, from the given name to the new name.
+func renameModule(key, from, to string) string {
+	if key == from {
+		return to
+	}
+
+	if rest, ok := strings.CutPrefix(key, from+"."); ok {
+		return to + "." + rest
+	}
+
+	return key
+}
+
+// listDirs returns the directories at the given depth below the root directory.
+func listDirs(root string, depth int) []string {
+	dirs := []string{root}
+	for range depth {
+		next := []string{}
+		for _, dir := range dirs {
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				continue
+			}
+
+			for _, e := range entries {
+				path := filepath.Join(dir, e.Name())
+				// Terraform links the provider plugins of the working directory to the plugin cache.
+				if info, err := os.Stat(path); err == nil && info.IsDir() {
+					next = append(next, path)
+				}
+			}
+		}
+		dirs = next
+	}
+
+	return dirs
+}
+
+// dirSize returns the size of the files in the directory.
+func dirSize(root string) (int64, error) {
+	size := int64(0)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
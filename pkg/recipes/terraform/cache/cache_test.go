@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"registry.terraform.io/test/redis/azurerm","Version":"1.0.0","Dir":".terraform/modules/redis"},{"Key":"redis.network","Source":"./network","Dir":".terraform/modules/redis/network"}]}`
+
+// writeModule writes the modules downloaded by terraform get for the given module name to the working directory.
+func writeModule(t *testing.T, workingDir, moduleName string) {
+	root := filepath.Join(workingDir, moduleRootDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, moduleName, "network"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, moduleName, "main.tf"), []byte("# main"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, moduleName, "network", "main.tf"), []byte("# network"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, manifestName), []byte(testManifest), 0644))
+}
+
+func Test_New(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		c, err := New(Options{})
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("default size", func(t *testing.T) {
+		root := t.TempDir()
+		c, err := New(Options{Path: root})
+		require.NoError(t, err)
+		require.Equal(t, int64(10*1024*1024*1024), c.maxSize)
+		require.DirExists(t, filepath.Join(root, modulesDir))
+		require.DirExists(t, filepath.Join(root, pluginsDir))
+		require.Equal(t, map[string]string{PluginCacheDirEnvVar: filepath.Join(root, pluginsDir)}, c.Env())
+		require.Empty(t, c.InitOptions())
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, err := New(Options{Path: t.TempDir(), MaxSize: "lots"})
+		require.ErrorContains(t, err, "invalid terraform cache size \"lots\"")
+
+		_, err = New(Options{Path: t.TempDir(), MaxSize: "0"})
+		require.ErrorContains(t, err, "must be positive")
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		root := t.TempDir()
+		c, err := New(Options{Path: root, Offline: true})
+		require.NoError(t, err)
+		require.True(t, c.Offline())
+		require.NoDirExists(t, filepath.Join(root, modulesDir))
+		require.Empty(t, c.Env())
+		require.Len(t, c.InitOptions(), 2)
+	})
+}
+
+func Test_StoreAndRestoreModule(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	key := ModuleKey{Source: "test/redis/azurerm", Version: "1.0.0", ProviderLock: "lock"}
+
+	restored, err := c.RestoreModule(ctx, key, t.TempDir(), "cache")
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	source := t.TempDir()
+	writeModule(t, source, "redis")
+	require.NoError(t, os.WriteFile(filepath.Join(source, lockFileName), []byte("# lock"), 0644))
+	require.NoError(t, c.StoreModule(ctx, key, source, "redis"))
+	require.NoError(t, c.AfterInit(ctx, key, source))
+
+	target := t.TempDir()
+	restored, err = c.RestoreModule(ctx, key, target, "cache")
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	require.FileExists(t, filepath.Join(target, moduleRootDir, "cache", "main.tf"))
+	require.FileExists(t, filepath.Join(target, moduleRootDir, "cache", "network", "main.tf"))
+	require.NoDirExists(t, filepath.Join(target, moduleRootDir, "redis"))
+	require.NoFileExists(t, filepath.Join(target, lockFileName))
+
+	restored, err = c.RestoreLockFile(ctx, key, target)
+	require.NoError(t, err)
+	require.True(t, restored)
+	lockFile, err := os.ReadFile(filepath.Join(target, lockFileName))
+	require.NoError(t, err)
+	require.Equal(t, "# lock", string(lockFile))
+
+	// Provider lock files are not shared by modules with different provider requirements.
+	other := t.TempDir()
+	restored, err = c.RestoreLockFile(ctx, ModuleKey{Source: key.Source, Version: key.Version, ProviderLock: "other"}, other)
+	require.NoError(t, err)
+	require.False(t, restored)
+	require.NoFileExists(t, filepath.Join(other, lockFileName))
+
+	b, err := os.ReadFile(filepath.Join(target, moduleRootDir, manifestName))
+	require.NoError(t, err)
+	manifest := struct {
+		Modules []struct {
+			Key string
+			Dir string
+		}
+	}{}
+	require.NoError(t, json.Unmarshal(b, &manifest))
+	require.Len(t, manifest.Modules, 3)
+	require.Equal(t, "", manifest.Modules[0].Key)
+	require.Equal(t, ".", manifest.Modules[0].Dir)
+	require.Equal(t, "cache", manifest.Modules[1].Key)
+	require.Equal(t, ".terraform/modules/cache", manifest.Modules[1].Dir)
+	require.Equal(t, "cache.network", manifest.Modules[2].Key)
+	require.Equal(t, ".terraform/modules/cache/network", manifest.Modules[2].Dir)
+
+	// Modules with a different scope are not shared.
+	restored, err = c.RestoreModule(ctx, ModuleKey{Source: key.Source, Version: key.Version, Scope: "env"}, t.TempDir(), "cache")
+	require.NoError(t, err)
+	require.False(t, restored)
+}
+
+func Test_Offline(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	key := ModuleKey{Source: "test/redis/azurerm", Version: "1.0.0"}
+
+	// Pre-seed the cache, as copied from an installation with network access.
+	online, err := New(Options{Path: root})
+	require.NoError(t, err)
+	source := t.TempDir()
+	writeModule(t, source, "redis")
+	require.NoError(t, online.StoreModule(ctx, key, source, "redis"))
+
+	c, err := New(Options{Path: root, Offline: true})
+	require.NoError(t, err)
+
+	restored, err := c.RestoreModule(ctx, key, t.TempDir(), "redis")
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	// Offline caches are never updated.
+	other := ModuleKey{Source: "test/other/azurerm"}
+	require.NoError(t, c.StoreModule(ctx, other, source, "redis"))
+	require.NoDirExists(t, filepath.Join(root, modulesDir, other.hash()))
+}
+
+func Test_Evict(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	c, err := New(Options{Path: root, MaxSize: "30"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	// Write three entries of 20 bytes, the oldest of which is the first one.
+	write := func(dir string, lastUsed time.Time) {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "data"), make([]byte, 20), 0644))
+		require.NoError(t, os.Chtimes(dir, lastUsed, lastUsed))
+	}
+
+	oldest := filepath.Join(root, modulesDir, "oldest")
+	old := filepath.Join(root, pluginsDir, "registry.terraform.io", "hashicorp", "azurerm", "3.0.0", "linux_amd64")
+	recent := filepath.Join(root, modulesDir, "recent")
+	write(oldest, now.Add(-3*evictionGracePeriod))
+	write(old, now.Add(-2*evictionGracePeriod))
+	write(recent, now.Add(-time.Minute))
+
+	require.NoError(t, c.Evict(ctx))
+
+	// The least recently used entries are evicted, except the ones used during the grace period.
+	require.NoDirExists(t, oldest)
+	require.NoDirExists(t, old)
+	require.DirExists(t, recent)
+
+	entries, err := os.ReadDir(filepath.Join(root, tempDir))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func Test_Evict_WaitsForReaders(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	// A reader in another process holds a shared lock on the cache.
+	reader := flock.New(filepath.Join(c.root, cacheLockName))
+	locked, err := reader.TryRLock()
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	evicted := make(chan error, 1)
+	go func() { evicted <- c.Evict(ctx) }()
+
+	select {
+	case <-evicted:
+		require.Fail(t, "eviction did not wait for the reader")
+	case <-time.After(5 * lockRetryDelay):
+	}
+
+	require.NoError(t, reader.Unlock())
+	require.NoError(t, <-evicted)
+}
+
+func Test_RenameModule(t *testing.T) {
+	require.Equal(t, "cache", renameModule("redis", "redis", "cache"))
+	require.Equal(t, "cache.network", renameModule("redis.network", "redis", "cache"))
+	require.Equal(t, "redisnetwork", renameModule("redisnetwork", "redis", "cache"))
+	require.Equal(t, "", renameModule("", "redis", "cache"))
+}
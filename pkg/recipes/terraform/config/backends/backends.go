@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// BackendS3 is the type of the backend storing the state in an S3-compatible bucket.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+	BackendS3 = "s3"
+
+	// BackendAzureRM is the type of the backend storing the state in an Azure storage account.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+	BackendAzureRM = "azurerm"
+
+	// BackendHTTP is the type of the backend storing the state with a REST endpoint.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/http
+	BackendHTTP = "http"
+
+	// BackendLocal is the type of the backend storing the state in a directory of the filesystem, such as a mounted
+	// persistent volume.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/local
+	BackendLocal = "local"
+
+	// defaultStateKeyPrefix is the prefix of the state keys generated for the resources when none is configured.
+	defaultStateKeyPrefix = "radius"
+
+	// stateFileExtension is the extension of the state files generated for the resources.
+	stateFileExtension = ".tfstate"
+)
+
+// New returns the backend configured to store the state of Terraform Recipes. secrets holds the values of the secrets
+// referenced by the configuration. The Kubernetes backend is returned when no backend is configured.
+func New(config *datamodel.TerraformBackendConfig, secrets map[string]recipes.SecretData, k8sClientSet kubernetes.Interface) (Backend, error) {
+	if config == nil {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	settings, err := backendSettings(config, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(config.Type) {
+	case "", BackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case BackendS3, BackendAzureRM:
+		return NewRemoteBackend(strings.ToLower(config.Type), settings), nil
+	case BackendHTTP:
+		return NewHTTPBackend(settings, nil)
+	case BackendLocal:
+		return NewLocalBackend(settings)
+	default:
+		return nil, fmt.Errorf("unsupported Terraform backend type %q, supported types are: %s", config.Type,
+			strings.Join([]string{BackendKubernetes, BackendS3, BackendAzureRM, BackendHTTP, BackendLocal}, ", "))
+	}
+}
+
+// backendSettings merges the settings of the backend with the values of its secrets. Secrets override the settings
+// with the same name.
+func backendSettings(config *datamodel.TerraformBackendConfig, secrets map[string]recipes.SecretData) (map[string]any, error) {
+	settings := map[string]any{}
+	maps.Copy(settings, config.AdditionalProperties)
+
+	for name, reference := range config.Secrets {
+		secretData, ok := secrets[reference.Source]
+		if !ok {
+			return nil, fmt.Errorf("missing secret store id: %s", reference.Source)
+		}
+
+		value, ok := secretData.Data[reference.Key]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key in secret store id: %s", reference.Source)
+		}
+
+		settings[name] = value
+	}
+
+	return settings, nil
+}
+
+// stateKey returns the key of the state file of the resource, using the given prefix.
+func stateKey(prefix string, resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	if prefix == "" {
+		prefix = defaultStateKeyPrefix
+	}
+
+	return path.Join(prefix, suffix+stateFileExtension), nil
+}
+
+// stringSetting returns the value of a string setting of the backend.
+func stringSetting(settings map[string]any, name string) (string, error) {
+	value, ok := settings[name]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("the %q setting of the Terraform backend must be a string, got %T", name, value)
+	}
+
+	return str, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretStoreID = "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/backend"
+
+func Test_New(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *datamodel.TerraformBackendConfig
+		expected Backend
+		err      string
+	}{
+		{
+			name:     "default backend",
+			config:   nil,
+			expected: &kubernetesBackend{},
+		},
+		{
+			name:     "kubernetes backend",
+			config:   &datamodel.TerraformBackendConfig{Type: "Kubernetes"},
+			expected: &kubernetesBackend{},
+		},
+		{
+			name: "s3 backend",
+			config: &datamodel.TerraformBackendConfig{
+				Type:                 BackendS3,
+				AdditionalProperties: map[string]any{"bucket": "tfstate", "region": "us-west-2"},
+				Secrets: map[string]datamodel.SecretReference{
+					"secret_key": {Source: testSecretStoreID, Key: "secretKey"},
+				},
+			},
+			expected: &remoteBackend{
+				backendType: BackendS3,
+				settings:    map[string]any{"bucket": "tfstate", "region": "us-west-2", "secret_key": "secret-value"},
+			},
+		},
+		{
+			name: "azurerm backend",
+			config: &datamodel.TerraformBackendConfig{
+				Type:                 BackendAzureRM,
+				AdditionalProperties: map[string]any{"storage_account_name": "tfstate", "container_name": "state"},
+			},
+			expected: &remoteBackend{
+				backendType: BackendAzureRM,
+				settings:    map[string]any{"storage_account_name": "tfstate", "container_name": "state"},
+			},
+		},
+		{
+			name: "local backend",
+			config: &datamodel.TerraformBackendConfig{
+				Type:                 BackendLocal,
+				AdditionalProperties: map[string]any{"path": "/var/lib/radius/tfstate"},
+			},
+			expected: &localBackend{directory: "/var/lib/radius/tfstate"},
+		},
+		{
+			name:   "unsupported backend",
+			config: &datamodel.TerraformBackendConfig{Type: "gcs"},
+			err:    "unsupported Terraform backend type \"gcs\", supported types are: kubernetes, s3, azurerm, http, local",
+		},
+		{
+			name: "missing secret store",
+			config: &datamodel.TerraformBackendConfig{
+				Type: BackendS3,
+				Secrets: map[string]datamodel.SecretReference{
+					"secret_key": {Source: testSecretStoreID + "-missing", Key: "secretKey"},
+				},
+			},
+			err: "missing secret store id: " + testSecretStoreID + "-missing",
+		},
+		{
+			name: "missing secret key",
+			config: &datamodel.TerraformBackendConfig{
+				Type: BackendS3,
+				Secrets: map[string]datamodel.SecretReference{
+					"secret_key": {Source: testSecretStoreID, Key: "missing"},
+				},
+			},
+			err: "missing secret key in secret store id: " + testSecretStoreID,
+		},
+	}
+
+	secrets := map[string]recipes.SecretData{
+		testSecretStoreID: {
+			Type: "generic",
+			Data: map[string]string{"secretKey": "secret-value"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backend, err := New(tc.config, secrets, nil)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, backend)
+		})
+	}
+}
+
+func Test_New_HTTPBackend(t *testing.T) {
+	backend, err := New(&datamodel.TerraformBackendConfig{
+		Type:                 BackendHTTP,
+		AdditionalProperties: map[string]any{"address": "https://state.example.com/radius"},
+	}, nil, nil)
+	require.NoError(t, err)
+	require.IsType(t, &httpBackend{}, backend)
+}
+
+func Test_StateKey(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	key, err := stateKey("", &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "radius/"+suffix+".tfstate", key)
+
+	key, err = stateKey("recipes/prod", &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "recipes/prod/"+suffix+".tfstate", key)
+
+	resourceRecipe.ResourceID = "invalid"
+	_, err = stateKey("", &resourceRecipe)
+	require.EqualError(t, err, "'invalid' is not a valid resource id")
+}
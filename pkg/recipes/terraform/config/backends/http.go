@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*httpBackend)(nil)
+
+// httpAddressSettings are the settings of the HTTP backend holding the addresses of the state, which are suffixed
+// with the name of the state file of each resource.
+var httpAddressSettings = []string{"address", "lock_address", "unlock_address"}
+
+// httpBackend is a backend storing the state of each resource at its own address of a REST endpoint.
+type httpBackend struct {
+	settings map[string]any
+	client   *http.Client
+}
+
+// NewHTTPBackend returns an HTTP backend using the given settings. The "address" setting is required, it is used
+// with "lock_address" and "unlock_address" as the base of the addresses of the state files. The client is used to
+// check and delete the state files, http.DefaultClient is used if nil.
+func NewHTTPBackend(settings map[string]any, client *http.Client) (Backend, error) {
+	address, err := stringSetting(settings, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	if address == "" {
+		return nil, errors.New("the \"address\" setting is required for the http Terraform backend")
+	}
+
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("the \"address\" setting of the http Terraform backend is invalid: %w", err)
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &httpBackend{settings: settings, client: client}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration with the addresses of the state file of the resource.
+func (p *httpBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{}
+	maps.Copy(config, p.settings)
+	for _, setting := range httpAddressSettings {
+		address, err := stringSetting(p.settings, setting)
+		if err != nil {
+			return nil, err
+		}
+
+		if address != "" {
+			config[setting] = joinAddress(address, suffix)
+		}
+	}
+
+	return map[string]any{BackendHTTP: config}, nil
+}
+
+// ValidateBackendExists checks if a non-empty state file is stored at the given address.
+func (p *httpBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	resp, err := p.do(ctx, http.MethodGet, name)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent:
+		return false, nil
+	case resp.StatusCode >= 400:
+		return false, fmt.Errorf("unexpected status code %d retrieving Terraform state from %q", resp.StatusCode, name)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	return len(strings.TrimSpace(string(body))) > 0, nil
+}
+
+// StateName returns the address of the state file of the resource.
+func (p *httpBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	address, err := stringSetting(p.settings, "address")
+	if err != nil {
+		return "", err
+	}
+
+	return joinAddress(address, suffix), nil
+}
+
+// DeleteState deletes the state file stored at the given address.
+func (p *httpBackend) DeleteState(ctx context.Context, name string) error {
+	resp, err := p.do(ctx, http.MethodDelete, name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected status code %d deleting Terraform state from %q", resp.StatusCode, name)
+	}
+
+	return nil
+}
+
+// do sends a request to the given address, authenticated with the credentials of the backend, if any.
+func (p *httpBackend) do(ctx context.Context, method string, address string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := stringSetting(p.settings, "username")
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := stringSetting(p.settings, "password")
+	if err != nil {
+		return nil, err
+	}
+
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	return p.client.Do(req)
+}
+
+// joinAddress returns the address of the state file with the given name under the base address. The query of the
+// base address, if any, is preserved.
+func joinAddress(base string, name string) string {
+	address, query, found := strings.Cut(base, "?")
+	address = strings.TrimSuffix(address, "/") + "/" + name
+	if found {
+		address += "?" + query
+	}
+
+	return address
+}
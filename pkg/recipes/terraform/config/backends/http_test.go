@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewHTTPBackend_Invalid(t *testing.T) {
+	_, err := NewHTTPBackend(map[string]any{}, nil)
+	require.EqualError(t, err, "the \"address\" setting is required for the http Terraform backend")
+
+	_, err = NewHTTPBackend(map[string]any{"address": "not a url"}, nil)
+	require.ErrorContains(t, err, "the \"address\" setting of the http Terraform backend is invalid")
+}
+
+func Test_HTTPBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	backend, err := NewHTTPBackend(map[string]any{
+		"address":       "https://state.example.com/radius/",
+		"lock_address":  "https://state.example.com/lock?env=prod",
+		"lock_method":   "PUT",
+		"password":      "secret",
+		"retry_max":     float64(3),
+		"update_method": "POST",
+	}, nil)
+	require.NoError(t, err)
+
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendHTTP: map[string]any{
+			"address":       "https://state.example.com/radius/" + suffix,
+			"lock_address":  "https://state.example.com/lock/" + suffix + "?env=prod",
+			"lock_method":   "PUT",
+			"password":      "secret",
+			"retry_max":     float64(3),
+			"update_method": "POST",
+		},
+	}, config)
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "https://state.example.com/radius/"+suffix, name)
+}
+
+func Test_HTTPBackend_State(t *testing.T) {
+	states := map[string]string{
+		"/state/full":  `{"version": 4}`,
+		"/state/empty": "",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "radius" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		state, ok := states[r.URL.Path]
+		if r.URL.Path == "/state/error" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		} else if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(state))
+		case http.MethodDelete:
+			delete(states, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	backend, err := NewHTTPBackend(map[string]any{
+		"address":  server.URL + "/state",
+		"username": "radius",
+		"password": "secret",
+	}, server.Client())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	exists, err := backend.ValidateBackendExists(ctx, server.URL+"/state/full")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = backend.ValidateBackendExists(ctx, server.URL+"/state/empty")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = backend.ValidateBackendExists(ctx, server.URL+"/state/missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = backend.ValidateBackendExists(ctx, server.URL+"/state/error")
+	require.EqualError(t, err, "unexpected status code 500 retrieving Terraform state from \""+server.URL+"/state/error\"")
+
+	require.NoError(t, backend.DeleteState(ctx, server.URL+"/state/full"))
+	require.NotContains(t, states, "/state/full")
+
+	// Deleting a state file that does not exist is not an error.
+	require.NoError(t, backend.DeleteState(ctx, server.URL+"/state/missing"))
+
+	err = backend.DeleteState(ctx, server.URL+"/state/error")
+	require.EqualError(t, err, "unexpected status code 500 deleting Terraform state from \""+server.URL+"/state/error\"")
+}
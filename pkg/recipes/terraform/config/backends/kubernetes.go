@@ -89,6 +89,26 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// StateName returns the name of the Kubernetes secret created by Terraform to store the state file of the resource.
+func (p *kubernetesBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
+// DeleteState deletes the Kubernetes secret storing the Terraform state file.
+func (p *kubernetesBackend) DeleteState(ctx context.Context, name string) error {
+	err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
@@ -156,3 +156,34 @@ func Test_ValidateBackendExists(t *testing.T) {
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_KubernetesBackend_StateName(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	name, err := NewKubernetesBackend(nil).StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, KubernetesBackendNamePrefix+suffix, name)
+}
+
+func Test_KubernetesBackend_DeleteState(t *testing.T) {
+	clientset := fake.NewClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: RadiusNamespace,
+		},
+	})
+
+	b := NewKubernetesBackend(clientset)
+	err := b.DeleteState(context.Background(), "test-secret")
+	require.NoError(t, err)
+
+	exists, err := b.ValidateBackendExists(context.Background(), "test-secret")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Deleting a secret that does not exist is not an error.
+	err = b.DeleteState(context.Background(), "test-secret")
+	require.NoError(t, err)
+}
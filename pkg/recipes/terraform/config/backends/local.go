@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+// localBackupExtension is the extension added by Terraform to the backup of a local state file.
+const localBackupExtension = ".backup"
+
+// localBackend is a backend storing the state of each resource in its own file of a directory, such as a mounted
+// persistent volume shared by the replicas of Radius.
+type localBackend struct {
+	directory string
+}
+
+// NewLocalBackend returns a local backend using the given settings. The "path" setting is required, it is the
+// absolute path of the directory storing the state files.
+func NewLocalBackend(settings map[string]any) (Backend, error) {
+	directory, err := stringSetting(settings, "path")
+	if err != nil {
+		return nil, err
+	}
+
+	if directory == "" {
+		return nil, errors.New("the \"path\" setting is required for the local Terraform backend")
+	}
+
+	if !filepath.IsAbs(directory) {
+		return nil, fmt.Errorf("the \"path\" setting of the local Terraform backend must be an absolute path, got %q", directory)
+	}
+
+	return &localBackend{directory: directory}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration with the path of the state file of the resource.
+func (p *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	statePath, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": statePath,
+		},
+	}, nil
+}
+
+// ValidateBackendExists checks if the state file exists at the given path.
+func (p *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	_, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StateName returns the path of the state file of the resource.
+func (p *localBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.directory, suffix+stateFileExtension), nil
+}
+
+// DeleteState deletes the state file at the given path and its backup.
+func (p *localBackend) DeleteState(ctx context.Context, name string) error {
+	for _, file := range []string{name, name + localBackupExtension} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
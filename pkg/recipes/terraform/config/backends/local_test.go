@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewLocalBackend_Invalid(t *testing.T) {
+	_, err := NewLocalBackend(map[string]any{})
+	require.EqualError(t, err, "the \"path\" setting is required for the local Terraform backend")
+
+	_, err = NewLocalBackend(map[string]any{"path": "relative/path"})
+	require.EqualError(t, err, "the \"path\" setting of the local Terraform backend must be an absolute path, got \"relative/path\"")
+}
+
+func Test_LocalBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	directory := t.TempDir()
+	backend, err := NewLocalBackend(map[string]any{"path": directory})
+	require.NoError(t, err)
+
+	statePath := filepath.Join(directory, suffix+".tfstate")
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendLocal: map[string]any{"path": statePath},
+	}, config)
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, statePath, name)
+
+	ctx := context.Background()
+	exists, err := backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, os.WriteFile(statePath, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(statePath+".backup", []byte("{}"), 0600))
+
+	exists, err = backend.ValidateBackendExists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, backend.DeleteState(ctx, name))
+	require.NoFileExists(t, statePath)
+	require.NoFileExists(t, statePath+".backup")
+
+	// Deleting a state file that does not exist is not an error.
+	require.NoError(t, backend.DeleteState(ctx, name))
+}
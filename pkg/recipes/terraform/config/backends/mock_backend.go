@@ -21,6 +21,7 @@ import (
 type MockBackend struct {
 	ctrl     *gomock.Controller
 	recorder *MockBackendMockRecorder
+	isgomock struct{}
 }
 
 // MockBackendMockRecorder is the mock recorder for MockBackend.
@@ -41,18 +42,18 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 }
 
 // BuildBackend mocks base method.
-func (m *MockBackend) BuildBackend(arg0 *recipes.ResourceMetadata) (map[string]any, error) {
+func (m *MockBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildBackend", arg0)
+	ret := m.ctrl.Call(m, "BuildBackend", resourceRecipe)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildBackend indicates an expected call of BuildBackend.
-func (mr *MockBackendMockRecorder) BuildBackend(arg0 any) *MockBackendBuildBackendCall {
+func (mr *MockBackendMockRecorder) BuildBackend(resourceRecipe any) *MockBackendBuildBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), resourceRecipe)
 	return &MockBackendBuildBackendCall{Call: call}
 }
 
@@ -79,19 +80,96 @@ func (c *MockBackendBuildBackendCall) DoAndReturn(f func(*recipes.ResourceMetada
 	return c
 }
 
+// DeleteState mocks base method.
+func (m *MockBackend) DeleteState(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBackendMockRecorder) DeleteState(ctx, name any) *MockBackendDeleteStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBackend)(nil).DeleteState), ctx, name)
+	return &MockBackendDeleteStateCall{Call: call}
+}
+
+// MockBackendDeleteStateCall wrap *gomock.Call
+type MockBackendDeleteStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendDeleteStateCall) Return(arg0 error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendDeleteStateCall) Do(f func(context.Context, string) error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendDeleteStateCall) DoAndReturn(f func(context.Context, string) error) *MockBackendDeleteStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StateName mocks base method.
+func (m *MockBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateName", resourceRecipe)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateName indicates an expected call of StateName.
+func (mr *MockBackendMockRecorder) StateName(resourceRecipe any) *MockBackendStateNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateName", reflect.TypeOf((*MockBackend)(nil).StateName), resourceRecipe)
+	return &MockBackendStateNameCall{Call: call}
+}
+
+// MockBackendStateNameCall wrap *gomock.Call
+type MockBackendStateNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendStateNameCall) Return(arg0 string, arg1 error) *MockBackendStateNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendStateNameCall) Do(f func(*recipes.ResourceMetadata) (string, error)) *MockBackendStateNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendStateNameCall) DoAndReturn(f func(*recipes.ResourceMetadata) (string, error)) *MockBackendStateNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ValidateBackendExists mocks base method.
-func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBackendExists", arg0, arg1)
+	ret := m.ctrl.Call(m, "ValidateBackendExists", ctx, name)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ValidateBackendExists indicates an expected call of ValidateBackendExists.
-func (mr *MockBackendMockRecorder) ValidateBackendExists(arg0, arg1 any) *MockBackendValidateBackendExistsCall {
+func (mr *MockBackendMockRecorder) ValidateBackendExists(ctx, name any) *MockBackendValidateBackendExistsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBackendExists", reflect.TypeOf((*MockBackend)(nil).ValidateBackendExists), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBackendExists", reflect.TypeOf((*MockBackend)(nil).ValidateBackendExists), ctx, name)
 	return &MockBackendValidateBackendExistsCall{Call: call}
 }
 
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"maps"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*remoteBackend)(nil)
+
+// remoteBackend is a backend storing the state of each resource under its own key in a cloud storage service, such as
+// an S3-compatible bucket or an Azure storage account. Credentials are read by Terraform from the settings of the
+// backend or from the environment variables of the recipe configuration.
+type remoteBackend struct {
+	backendType string
+	settings    map[string]any
+}
+
+// NewRemoteBackend returns a backend of the given type, s3 or azurerm, using the given settings. The "key" setting,
+// if any, is used as the prefix of the keys of the state files.
+func NewRemoteBackend(backendType string, settings map[string]any) Backend {
+	return &remoteBackend{backendType: backendType, settings: settings}
+}
+
+// BuildBackend generates the Terraform backend configuration with the key of the state file of the resource.
+func (p *remoteBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{}
+	maps.Copy(config, p.settings)
+	config["key"] = key
+
+	return map[string]any{p.backendType: config}, nil
+}
+
+// ValidateBackendExists always returns true. The state is read by Terraform when it is initialized, and a state
+// file that does not exist is treated as an empty state.
+func (p *remoteBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
+
+// StateName returns the key of the state file of the resource.
+func (p *remoteBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	prefix, err := stringSetting(p.settings, "key")
+	if err != nil {
+		return "", err
+	}
+
+	return stateKey(prefix, resourceRecipe)
+}
+
+// DeleteState is a no-op: the state file can only be removed with the SDK of the storage service. The state file left
+// in the storage service is emptied with Terraform instead, when the resources are destroyed or when the state is
+// migrated to another backend.
+func (p *remoteBackend) DeleteState(ctx context.Context, name string) error {
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RemoteBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	settings := map[string]any{"bucket": "tfstate", "region": "us-west-2", "key": "prod"}
+	backend := NewRemoteBackend(BackendS3, settings)
+
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendS3: map[string]any{
+			"bucket": "tfstate",
+			"region": "us-west-2",
+			"key":    "prod/" + suffix + ".tfstate",
+		},
+	}, config)
+
+	// The settings of the backend are not modified.
+	require.Equal(t, "prod", settings["key"])
+
+	name, err := backend.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "prod/"+suffix+".tfstate", name)
+}
+
+func Test_RemoteBackend_InvalidKey(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+
+	backend := NewRemoteBackend(BackendAzureRM, map[string]any{"key": 42})
+	_, err := backend.BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "the \"key\" setting of the Terraform backend must be a string, got int")
+}
+
+func Test_RemoteBackend_State(t *testing.T) {
+	backend := NewRemoteBackend(BackendAzureRM, map[string]any{})
+
+	exists, err := backend.ValidateBackendExists(context.Background(), "radius/state.tfstate")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = backend.DeleteState(context.Background(), "radius/state.tfstate")
+	require.NoError(t, err)
+}
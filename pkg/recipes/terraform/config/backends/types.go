@@ -35,4 +35,12 @@ type Backend interface {
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// StateName returns the name of the Terraform state file of the resource in the backend, such as the name of
+	// the Kubernetes secret. It is the name expected by ValidateBackendExists and DeleteState.
+	StateName(resourceRecipe *recipes.ResourceMetadata) (string, error)
+
+	// DeleteState deletes the Terraform state file with the given name from the backend.
+	// Deleting a state file that does not exist is not an error.
+	DeleteState(ctx context.Context, name string) error
 }
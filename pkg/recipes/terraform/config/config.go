@@ -262,8 +262,8 @@ func getProviderConfigs(ctx context.Context, requiredProviders map[string]*Requi
 }
 
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
-// Save() must be called to save the generated backend config.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// Save() must be called to save the generated backend config. Adding a backend replaces the backend added previously, if any.
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration
 func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
 	backendConfig, err := backend.BuildBackend(resourceRecipe)
 	if err != nil {
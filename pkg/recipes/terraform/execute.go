@@ -23,6 +23,7 @@ import (
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -39,7 +40,6 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -70,47 +70,79 @@ type executor struct {
 func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, ExecPath: options.ExecPath})
 	if err != nil {
 		return nil, err
 	}
 
-	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	// Set environment variables for the Terraform process. They may hold the credentials of the state backend,
+	// so they are set before Terraform is initialized.
+	err = e.setEnvironmentVariables(tf, options)
 	if err != nil {
 		return nil, err
 	}
 
-	if options.EnvConfig != nil {
-		// Set environment variables for the Terraform process.
-		err = e.setEnvironmentVariables(tf, options)
-		if err != nil {
-			return nil, err
-		}
+	// Create Terraform config in the working directory. The state is migrated to the configured backend, if the
+	// environment configures a backend to migrate the state from.
+	backend, stateName, err := e.generateConfig(ctx, tf, options, true)
+	if err != nil {
+		return nil, err
 	}
 
 	// Run TF Init and Apply in the working directory
-	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	state, err := initAndApply(ctx, tf, stateLockTimeout)
+	state, err := initAndApply(ctx, tf, options)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	// Validate that the terraform state file exists in the backend. For the Kubernetes backend, the secret storing
+	// the state file is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error getting kubernetes client: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state from the backend: %w", err)
+	} else if !backendExists {
+		return nil, errors.New("expected terraform state is not found in the backend")
 	}
 
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	return state, nil
+}
+
+// Plan ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the plan or an error if any of these steps fail. The plan is never applied.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, ExecPath: options.ExecPath})
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
-	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, err
 	}
 
-	return state, nil
+	// Set environment variables for the Terraform process.
+	err = e.setEnvironmentVariables(tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory. The state backend is the one used by Deploy, so the plan
+	// is computed against the resources deployed by the recipe, if any. The state is not migrated by a plan.
+	backend, stateName, err := e.generateConfig(ctx, tf, options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// A refresh-only plan compares the state of the recipe deployment with the actual infrastructure, so the state
+	// must exist.
+	if options.RefreshOnly {
+		backendExists, err := backend.ValidateBackendExists(ctx, stateName)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving terraform state from the backend: %w", err)
+		} else if !backendExists {
+			return nil, errors.New("expected terraform state is not found in the backend")
+		}
+	}
+
+	// Run TF Init and Plan in the working directory
+	return initAndPlan(ctx, tf, options)
 }
 
 // Delete ensures Terraform is available, creates a working directory, generates a config, and runs Terraform destroy
@@ -120,7 +152,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, ExecPath: options.ExecPath})
 	// Note: We use a global shared binary approach, so we should NOT call i.Remove()
 	// as it would remove the shared global binary that other operations might be using.
 	// The global binary will persist across operations to eliminate race conditions.
@@ -128,21 +160,23 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
-	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	// Set environment variables for the Terraform process. They may hold the credentials of the state backend.
+	err = e.setEnvironmentVariables(tf, options)
 	if err != nil {
 		return err
 	}
 
-	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
-	// If the state file source has been deleted or wasn't created due to a failure during apply then
-	// terraform initialization will fail due to missing backend source.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	// Create Terraform config in the working directory. The state is not migrated: if it has not been migrated yet,
+	// the resources are destroyed with the state of the backend to migrate from.
+	backend, stateName, err := e.generateConfig(ctx, tf, options, false)
 	if err != nil {
-		return fmt.Errorf("error getting kubernetes client: %w", err)
+		return err
 	}
 
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
+	// If the state file source has been deleted or wasn't created due to a failure during apply then
+	// terraform initialization will fail due to missing backend source.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -154,18 +188,15 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	err = initAndDestroy(ctx, tf, stateLockTimeout)
+	err = initAndDestroy(ctx, tf, options)
 	if err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = kubernetesClient.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend.
+	err = backend.DeleteState(ctx, stateName)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return fmt.Errorf("error deleting terraform state from the backend: %w", err)
 	}
 
 	return nil
@@ -174,7 +205,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, ExecPath: options.ExecPath})
 	if err != nil {
 		return nil, err
 	}
@@ -195,17 +226,29 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 }
 
 // setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
-// Terraform process will use environment variables as input for the recipe deployment.
+// Terraform process will use environment variables as input for the recipe deployment. The environment variables
+// configuring the cache of provider plugins are set as well, if a cache is configured.
 func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options) error {
-	if options.EnvConfig == nil {
+	if options.EnvConfig == nil && options.Cache == nil {
 		return nil
 	}
 
 	// Populate envVars with the environment variables from current process
 	envVars := splitEnvVar(os.Environ())
-	recipeConfig := &options.EnvConfig.RecipeConfig
 	var envVarUpdate bool
 
+	if options.Cache != nil {
+		if cacheEnv := options.Cache.Env(); len(cacheEnv) > 0 {
+			envVarUpdate = true
+			maps.Copy(envVars, cacheEnv)
+		}
+	}
+
+	if options.EnvConfig == nil {
+		return setEnv(tf, envVars, envVarUpdate)
+	}
+
+	recipeConfig := &options.EnvConfig.RecipeConfig
 	if len(recipeConfig.Env.AdditionalProperties) > 0 {
 		envVarUpdate = true
 		maps.Copy(envVars, recipeConfig.Env.AdditionalProperties)
@@ -227,7 +270,11 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 		}
 	}
 
-	// Set the environment variables for the Terraform process
+	return setEnv(tf, envVars, envVarUpdate)
+}
+
+// setEnv sets the environment variables for the Terraform process if they were updated.
+func setEnv(tf *tfexec.Terraform, envVars map[string]string, envVarUpdate bool) error {
 	if envVarUpdate {
 		if err := tf.SetEnv(envVars); err != nil {
 			return fmt.Errorf("failed to set environment variables: %w", err)
@@ -251,47 +298,65 @@ func splitEnvVar(envVars []string) map[string]string {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the backend storing the Terraform state of the recipe, with the name of the state file in the backend.
+// If the environment configures a backend to migrate the state from, the state is migrated to the configured backend
+// when migrate is true. Otherwise the backend to migrate from is used until the state has been migrated.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, migrate bool) (backends.Backend, string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	loadedModule, err := downloadAndInspect(ctx, tf, options)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetUCPConfiguredTerraformProviders(e.ucpConn, e.secretProvider),
 		options.EnvConfig, options.Secrets); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
 	if err != nil {
-		return "", fmt.Errorf("error getting kubernetes client: %w", err)
+		return nil, "", fmt.Errorf("error getting kubernetes client: %w", err)
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(kubernetesClient))
+	backendConfig := getBackendConfig(options.EnvConfig)
+	backend, err := backends.New(backendConfig, options.Secrets, kubernetesClient)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
+	if backendConfig != nil && backendConfig.MigrateFrom != nil {
+		source, err := backends.New(backendConfig.MigrateFrom, options.Secrets, kubernetesClient)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid backend to migrate the terraform state from: %w", err)
+		}
+
+		if migrate {
+			if err := migrateState(ctx, tf, tfConfig, options, source, backend); err != nil {
+				return nil, "", err
+			}
+		} else if backend, err = stateBackend(ctx, tf, tfConfig, options, source, backend); err != nil {
+			return nil, "", err
 		}
 	}
 
+	stateName, err := backend.StateName(options.ResourceRecipe)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend); err != nil {
+		return nil, "", err
+	}
+
 	// Add recipe context parameter to the generated Terraform config's module parameters.
 	// This should only be added if the recipe context variable is declared in the downloaded module.
 	if loadedModule.ContextVarExists {
@@ -300,7 +365,7 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return nil, "", err
 		}
 
 		//update the recipe context with connected resources properties
@@ -309,12 +374,12 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return nil, "", err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return nil, "", err
 		}
 	}
 
@@ -322,10 +387,10 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	return secretSuffix, nil
+	return backend, stateName, nil
 }
 
 // getTerraformConfig initializes the Terraform json config with provided module source and saves it
@@ -363,22 +428,64 @@ func getStateLockTimeout(timeout string) string {
 	return timeout
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) (*tfjson.State, error) {
+// initTerraform runs Terraform init in the provided working directory, using the cache of modules and provider
+// plugins if one is configured.
+func initTerraform(ctx context.Context, tf *tfexec.Terraform, options Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	// The working directory may have been initialized with another backend to migrate the state, the backend of the
+	// configuration is used regardless.
+	if err := tf.Init(ctx, append(cacheInitOptions(options), tfexec.Reconfigure(true))...); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
-		return nil, fmt.Errorf("terraform init failure: %w", err)
+		return fmt.Errorf("terraform init failure: %w", err)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
 
+	// The cache is an optimization: failing to update it does not fail the execution.
+	if options.Cache != nil {
+		if err := updateCache(ctx, tf, options); err != nil {
+			logger.Info(fmt.Sprintf("Failed to update the Terraform cache: %s", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// updateCache updates the cache after Terraform was initialized, with the provider lock of the module downloaded in
+// the working directory.
+func updateCache(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	loadedModule, err := inspectModule(tf.WorkingDir(), options.EnvRecipe)
+	if err != nil {
+		return err
+	}
+
+	return options.Cache.AfterInit(ctx, moduleCacheKey(options, loadedModule.ProviderLock), tf.WorkingDir())
+}
+
+// cacheInitOptions returns the options of Terraform init using the cache of modules and provider plugins, if one is
+// configured.
+func cacheInitOptions(options Options) []tfexec.InitOption {
+	if options.Cache == nil {
+		return nil
+	}
+
+	return options.Cache.InitOptions()
+}
+
+// initAndApply runs Terraform init and apply in the provided working directory.
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, options Options) (*tfjson.State, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
+
+	if err := initTerraform(ctx, tf, options); err != nil {
+		return nil, err
+	}
+
 	// Apply Terraform configuration with state lock timeout
 	logger.Info("Running Terraform apply with state lock timeout: " + stateLockTimeout)
 	if err := tf.Apply(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
@@ -404,20 +511,43 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	return tf.Show(ctx)
 }
 
-// initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) error {
+// initAndPlan runs Terraform init and plan in the provided working directory, and returns the saved plan. A
+// refresh-only plan only reports the changes made to the resources outside of Terraform.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform, options Options) (*tfjson.Plan, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
 
-	// Initialize Terraform
-	logger.Info("Initializing Terraform")
-	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
-			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+	if err := initTerraform(ctx, tf, options); err != nil {
+		return nil, err
+	}
 
-		return fmt.Errorf("terraform init failure: %w", err)
+	// Plan Terraform configuration with state lock timeout
+	logger.Info("Running Terraform plan with state lock timeout: " + stateLockTimeout)
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	planOptions := []tfexec.PlanOption{tfexec.Out(planFile), tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)}
+	if options.RefreshOnly {
+		planOptions = append(planOptions, tfexec.RefreshOnly(true))
+	}
+	if _, err := tf.Plan(ctx, planOptions...); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	// Suppress stdout during tf.ShowPlanFile to prevent the plan (which may
+	// contain sensitive values) from being written to the Radius logs.
+	tf.SetStdout(io.Discard)
+	defer tf.SetStdout(&tfLogWrapper{logger: logger})
+
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
+// initAndDestroy runs Terraform init and destroy in the provided working directory.
+func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
+
+	if err := initTerraform(ctx, tf, options); err != nil {
+		return err
 	}
-	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
 
 	// Destroy Terraform configuration with state lock timeout
 	logger.Info("Running Terraform destroy with state lock timeout: " + stateLockTimeout)
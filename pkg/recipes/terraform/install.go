@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"sync"
 	"time"
 
@@ -53,6 +54,10 @@ type InstallOptions struct {
 
 	// LogLevel controls the verbosity of Terraform execution logs.
 	LogLevel string
+
+	// ExecPath is the path or the name of a Terraform compatible binary, such as OpenTofu, to use instead of
+	// installing Terraform. Names are looked up in the PATH.
+	ExecPath string
 }
 
 // getGlobalTerraformPaths returns the terraform paths, allowing override for testing
@@ -76,6 +81,10 @@ var (
 func Install(ctx context.Context, installer *install.Installer, opts InstallOptions) (*tfexec.Terraform, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	if opts.ExecPath != "" {
+		return useBinary(ctx, opts)
+	}
+
 	// Use global shared binary approach with proper locking
 	execPath, err := ensureGlobalTerraformBinary(ctx, installer, logger)
 	if err != nil {
@@ -94,6 +103,24 @@ func Install(ctx context.Context, installer *install.Installer, opts InstallOpti
 	return tf, nil
 }
 
+// useBinary creates a Terraform instance using the Terraform compatible binary of the install options, which is
+// provided by the container image or a mounted volume.
+func useBinary(ctx context.Context, opts InstallOptions) (*tfexec.Terraform, error) {
+	execPath, err := exec.LookPath(opts.ExecPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find binary %q: %w", opts.ExecPath, err)
+	}
+
+	tf, err := NewTerraform(ctx, opts.RootDir, execPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Terraform instance with binary %q: %w", execPath, err)
+	}
+
+	configureTerraformLogs(ctx, tf, opts.LogLevel)
+
+	return tf, nil
+}
+
 // ensureGlobalTerraformBinary ensures a global shared Terraform binary is available.
 // Uses mutex-based locking to prevent race conditions during concurrent access.
 func ensureGlobalTerraformBinary(ctx context.Context, installer *install.Installer, logger logr.Logger) (string, error) {
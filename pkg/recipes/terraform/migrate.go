@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// migratedStateFile is the name of the file in the working directory holding the emptied state of a migrated
+// resource, before it is pushed to the backend the state was migrated from.
+const migratedStateFile = "migrated.tfstate"
+
+// getBackendConfig returns the Terraform backend configured by the environment, or nil if the default backend is used.
+func getBackendConfig(envConfig *recipes.Configuration) *dm.TerraformBackendConfig {
+	if envConfig == nil {
+		return nil
+	}
+
+	return envConfig.RecipeConfig.Terraform.Backend
+}
+
+// migrateState moves the Terraform state of the resource from the source backend to the target backend. The state is
+// only moved if the source backend holds resources and the target backend doesn't, so the migration is skipped once
+// it is completed. Once the target backend is verified to hold the state, the state in the source backend is emptied
+// and then deleted, if the backend supports it, so that a stale copy of the state is never migrated again, for
+// example when the resource is redeployed after it was deleted.
+//
+// The state is only migrated when the recipe is deployed. The backend of the Terraform configuration is replaced by
+// this function, it must be set to the target backend again before the configuration is saved.
+func migrateState(ctx context.Context, tf *tfexec.Terraform, tfConfig *config.TerraformConfig, options Options, source backends.Backend, target backends.Backend) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	resourceRecipe := options.ResourceRecipe
+
+	targetHasState, err := backendHasState(ctx, tf, tfConfig, options, target)
+	if err != nil {
+		return fmt.Errorf("error retrieving terraform state from the backend: %w", err)
+	} else if targetHasState {
+		logger.Info("Skipping migration of Terraform state: the state is already stored in the configured backend.")
+		return nil
+	}
+
+	sourceHasState, err := backendHasState(ctx, tf, tfConfig, options, source)
+	if err != nil {
+		return fmt.Errorf("error retrieving terraform state from the backend to migrate from: %w", err)
+	} else if !sourceHasState {
+		logger.Info("Skipping migration of Terraform state: no state is stored in the backend to migrate from.")
+		return nil
+	}
+
+	// Terraform is now initialized with the source backend. Initializing it again with the target backend copies
+	// the state from the source backend to the target backend.
+	logger.Info("Migrating Terraform state to the configured backend")
+	if err := saveBackend(ctx, tf, tfConfig, resourceRecipe, target); err != nil {
+		return err
+	}
+
+	if err := tf.Init(ctx, append(cacheInitOptions(options), tfexec.ForceCopy(true))...); err != nil {
+		return fmt.Errorf("terraform state migration failure: %w", err)
+	}
+
+	// The state in the source backend is the only copy until the target backend is verified to hold it.
+	migrated, err := backendHasState(ctx, tf, tfConfig, options, target)
+	if err != nil {
+		return fmt.Errorf("error verifying terraform state migrated to the backend: %w", err)
+	} else if !migrated {
+		return fmt.Errorf("terraform state migration failure: the state was not copied to the configured backend, the backend to migrate from is left unchanged")
+	}
+
+	// Not every backend can delete a state file, such as the s3 and azurerm backends, so the state is emptied first.
+	if err := emptyState(ctx, tf, tfConfig, options, source); err != nil {
+		return fmt.Errorf("error emptying terraform state in the backend to migrate from: %w", err)
+	}
+
+	sourceStateName, err := source.StateName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	if err := source.DeleteState(ctx, sourceStateName); err != nil {
+		return fmt.Errorf("error deleting terraform state from the backend to migrate from: %w", err)
+	}
+
+	return nil
+}
+
+// stateBackend returns the backend storing the Terraform state of the resource when the state is not migrated: the
+// source backend if the state has not been migrated to the target backend yet, the target backend otherwise. It is
+// used to plan and delete the resources deployed by the recipe before the state is migrated.
+func stateBackend(ctx context.Context, tf *tfexec.Terraform, tfConfig *config.TerraformConfig, options Options, source backends.Backend, target backends.Backend) (backends.Backend, error) {
+	targetHasState, err := backendHasState(ctx, tf, tfConfig, options, target)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state from the backend: %w", err)
+	} else if targetHasState {
+		return target, nil
+	}
+
+	sourceHasState, err := backendHasState(ctx, tf, tfConfig, options, source)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state from the backend to migrate from: %w", err)
+	} else if sourceHasState {
+		return source, nil
+	}
+
+	return target, nil
+}
+
+// emptyState replaces the Terraform state of the resource stored in the backend with a state that holds no
+// resources, so that it is not migrated again.
+func emptyState(ctx context.Context, tf *tfexec.Terraform, tfConfig *config.TerraformConfig, options Options, backend backends.Backend) error {
+	if err := saveBackend(ctx, tf, tfConfig, options.ResourceRecipe, backend); err != nil {
+		return err
+	}
+
+	if err := tf.Init(ctx, append(cacheInitOptions(options), tfexec.Reconfigure(true))...); err != nil {
+		return fmt.Errorf("terraform init failure: %w", err)
+	}
+
+	state, err := tf.StatePull(ctx)
+	if err != nil {
+		return err
+	}
+
+	empty, err := emptiedState(state)
+	if err != nil {
+		return err
+	}
+
+	statePath := filepath.Join(tf.WorkingDir(), migratedStateFile)
+	if err := os.WriteFile(statePath, empty, 0600); err != nil {
+		return err
+	}
+	defer os.Remove(statePath)
+
+	return tf.StatePush(ctx, statePath)
+}
+
+// emptiedState returns the next serial of the raw Terraform state, without resources and outputs. The lineage is kept
+// so that the state can be pushed to the backend in place of the given state.
+func emptiedState(state string) ([]byte, error) {
+	parsed := map[string]any{}
+	if err := json.Unmarshal([]byte(state), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse terraform state: %w", err)
+	}
+
+	serial, _ := parsed["serial"].(float64)
+	parsed["serial"] = serial + 1
+	parsed["resources"] = []any{}
+	parsed["outputs"] = map[string]any{}
+
+	return json.Marshal(parsed)
+}
+
+// backendHasState initializes Terraform with the given backend and returns true if the state of the resource stored
+// in the backend holds resources.
+func backendHasState(ctx context.Context, tf *tfexec.Terraform, tfConfig *config.TerraformConfig, options Options, backend backends.Backend) (bool, error) {
+	resourceRecipe := options.ResourceRecipe
+	stateName, err := backend.StateName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	// Terraform can't be initialized with a backend source that does not exist, such as a missing Kubernetes secret.
+	exists, err := backend.ValidateBackendExists(ctx, stateName)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	if err := saveBackend(ctx, tf, tfConfig, resourceRecipe, backend); err != nil {
+		return false, err
+	}
+
+	if err := tf.Init(ctx, append(cacheInitOptions(options), tfexec.Reconfigure(true))...); err != nil {
+		return false, fmt.Errorf("terraform init failure: %w", err)
+	}
+
+	state, err := tf.StatePull(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return stateHasResources(state)
+}
+
+// saveBackend sets the backend of the Terraform configuration and saves it in the working directory.
+func saveBackend(ctx context.Context, tf *tfexec.Terraform, tfConfig *config.TerraformConfig, resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) error {
+	if _, err := tfConfig.AddTerraformBackend(resourceRecipe, backend); err != nil {
+		return err
+	}
+
+	return tfConfig.Save(ctx, tf.WorkingDir())
+}
+
+// stateHasResources returns true if the raw Terraform state holds resources.
+func stateHasResources(state string) (bool, error) {
+	if strings.TrimSpace(state) == "" {
+		return false, nil
+	}
+
+	parsed := struct {
+		Resources []json.RawMessage `json:"resources"`
+	}{}
+	if err := json.Unmarshal([]byte(state), &parsed); err != nil {
+		return false, fmt.Errorf("failed to parse terraform state: %w", err)
+	}
+
+	return len(parsed.Resources) > 0, nil
+}
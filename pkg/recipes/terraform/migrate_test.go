@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeTerraform is a Terraform executable for the state migration tests. It reads the state from the file of the
+// local backend in the configuration, and its init never copies the state between backends.
+const fakeTerraform = `#!/bin/sh
+case "$1" in
+version)
+  echo '{"terraform_version": "1.9.0", "platform": "linux_amd64", "provider_selections": {}, "terraform_outdated": false}'
+  ;;
+init)
+  ;;
+state)
+  if [ "$2" != "pull" ]; then
+    echo "unexpected state command: $2" >&2
+    exit 1
+  fi
+  if grep -q target.tfstate main.tf.json; then
+    cat target.tfstate 2>/dev/null || true
+  else
+    cat source.tfstate
+  fi
+  ;;
+*)
+  echo "unexpected command: $1" >&2
+  exit 1
+  ;;
+esac
+`
+
+// newTestStateBackend returns a backend storing the state of the resource in the given file of the working directory.
+func newTestStateBackend(mctrl *gomock.Controller, stateFile string) *backends.MockBackend {
+	backend := backends.NewMockBackend(mctrl)
+	backend.EXPECT().StateName(gomock.Any()).Return(stateFile, nil).AnyTimes()
+	backend.EXPECT().ValidateBackendExists(gomock.Any(), stateFile).Return(true, nil).AnyTimes()
+	backend.EXPECT().BuildBackend(gomock.Any()).Return(map[string]any{"local": map[string]any{"path": stateFile}}, nil).AnyTimes()
+	return backend
+}
+
+func Test_MigrateState_NotCopied(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Terraform executable is a shell script")
+	}
+
+	workingDir := t.TempDir()
+	execPath := filepath.Join(workingDir, "terraform")
+	require.NoError(t, os.WriteFile(execPath, []byte(fakeTerraform), 0700))
+
+	sourceState := `{"version": 4, "serial": 1, "lineage": "abc", "resources": [{"mode": "managed", "type": "random_password", "name": "password"}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "source.tfstate"), []byte(sourceState), 0600))
+
+	tf, err := tfexec.NewTerraform(workingDir, execPath)
+	require.NoError(t, err)
+
+	mctrl := gomock.NewController(t)
+	source := newTestStateBackend(mctrl, "source.tfstate")
+	target := newTestStateBackend(mctrl, "target.tfstate")
+
+	// The state in the source backend must not be deleted, it is the only copy.
+	source.EXPECT().DeleteState(gomock.Any(), gomock.Any()).Times(0)
+
+	options := Options{ResourceRecipe: &recipes.ResourceMetadata{Name: "redis"}}
+	err = migrateState(context.Background(), tf, &config.TerraformConfig{}, options, source, target)
+	require.ErrorContains(t, err, "the state was not copied to the configured backend")
+
+	actual, err := os.ReadFile(filepath.Join(workingDir, "source.tfstate"))
+	require.NoError(t, err)
+	require.Equal(t, sourceState, string(actual))
+}
+
+func Test_GetBackendConfig(t *testing.T) {
+	require.Nil(t, getBackendConfig(nil))
+	require.Nil(t, getBackendConfig(&recipes.Configuration{}))
+
+	backend := &datamodel.TerraformBackendConfig{Type: "s3"}
+	envConfig := &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{Backend: backend},
+		},
+	}
+	require.Same(t, backend, getBackendConfig(envConfig))
+}
+
+func Test_StateHasResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		expected bool
+		err      string
+	}{
+		{
+			name:     "no state",
+			state:    "",
+			expected: false,
+		},
+		{
+			name:     "empty state",
+			state:    `{"version": 4, "serial": 3, "resources": []}`,
+			expected: false,
+		},
+		{
+			name:     "state with resources",
+			state:    `{"version": 4, "serial": 1, "resources": [{"mode": "managed", "type": "random_password", "name": "password"}]}`,
+			expected: true,
+		},
+		{
+			name:  "invalid state",
+			state: "not json",
+			err:   "failed to parse terraform state",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hasResources, err := stateHasResources(tc.state)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, hasResources)
+		})
+	}
+}
+
+func Test_EmptiedState(t *testing.T) {
+	state := `{"version": 4, "terraform_version": "1.6.0", "serial": 3, "lineage": "abc", "outputs": {"result": {"value": "x"}}, "resources": [{"mode": "managed", "type": "random_password", "name": "password"}]}`
+
+	empty, err := emptiedState(state)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version": 4, "terraform_version": "1.6.0", "serial": 4, "lineage": "abc", "outputs": {}, "resources": []}`, string(empty))
+
+	hasResources, err := stateHasResources(string(empty))
+	require.NoError(t, err)
+	require.False(t, hasResources)
+
+	_, err = emptiedState("not json")
+	require.ErrorContains(t, err, "failed to parse terraform state")
+}
@@ -21,6 +21,7 @@ import (
 type MockTerraformExecutor struct {
 	ctrl     *gomock.Controller
 	recorder *MockTerraformExecutorMockRecorder
+	isgomock struct{}
 }
 
 // MockTerraformExecutorMockRecorder is the mock recorder for MockTerraformExecutor.
@@ -41,17 +42,17 @@ func (m *MockTerraformExecutor) EXPECT() *MockTerraformExecutorMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockTerraformExecutor) Delete(arg0 context.Context, arg1 Options) error {
+func (m *MockTerraformExecutor) Delete(ctx context.Context, options Options) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTerraformExecutorMockRecorder) Delete(arg0, arg1 any) *MockTerraformExecutorDeleteCall {
+func (mr *MockTerraformExecutorMockRecorder) Delete(ctx, options any) *MockTerraformExecutorDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTerraformExecutor)(nil).Delete), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTerraformExecutor)(nil).Delete), ctx, options)
 	return &MockTerraformExecutorDeleteCall{Call: call}
 }
 
@@ -79,18 +80,18 @@ func (c *MockTerraformExecutorDeleteCall) DoAndReturn(f func(context.Context, Op
 }
 
 // Deploy mocks base method.
-func (m *MockTerraformExecutor) Deploy(arg0 context.Context, arg1 Options) (*tfjson.State, error) {
+func (m *MockTerraformExecutor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deploy", arg0, arg1)
+	ret := m.ctrl.Call(m, "Deploy", ctx, options)
 	ret0, _ := ret[0].(*tfjson.State)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Deploy indicates an expected call of Deploy.
-func (mr *MockTerraformExecutorMockRecorder) Deploy(arg0, arg1 any) *MockTerraformExecutorDeployCall {
+func (mr *MockTerraformExecutorMockRecorder) Deploy(ctx, options any) *MockTerraformExecutorDeployCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockTerraformExecutor)(nil).Deploy), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockTerraformExecutor)(nil).Deploy), ctx, options)
 	return &MockTerraformExecutorDeployCall{Call: call}
 }
 
@@ -118,18 +119,18 @@ func (c *MockTerraformExecutorDeployCall) DoAndReturn(f func(context.Context, Op
 }
 
 // GetRecipeMetadata mocks base method.
-func (m *MockTerraformExecutor) GetRecipeMetadata(arg0 context.Context, arg1 Options) (map[string]any, error) {
+func (m *MockTerraformExecutor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", ctx, options)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
-func (mr *MockTerraformExecutorMockRecorder) GetRecipeMetadata(arg0, arg1 any) *MockTerraformExecutorGetRecipeMetadataCall {
+func (mr *MockTerraformExecutorMockRecorder) GetRecipeMetadata(ctx, options any) *MockTerraformExecutorGetRecipeMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockTerraformExecutor)(nil).GetRecipeMetadata), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockTerraformExecutor)(nil).GetRecipeMetadata), ctx, options)
 	return &MockTerraformExecutorGetRecipeMetadataCall{Call: call}
 }
 
@@ -155,3 +156,42 @@ func (c *MockTerraformExecutorGetRecipeMetadataCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockTerraformExecutor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, options)
+	ret0, _ := ret[0].(*tfjson.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockTerraformExecutorMockRecorder) Plan(ctx, options any) *MockTerraformExecutorPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockTerraformExecutor)(nil).Plan), ctx, options)
+	return &MockTerraformExecutorPlanCall{Call: call}
+}
+
+// MockTerraformExecutorPlanCall wrap *gomock.Call
+type MockTerraformExecutorPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTerraformExecutorPlanCall) Return(arg0 *tfjson.Plan, arg1 error) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTerraformExecutorPlanCall) Do(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTerraformExecutorPlanCall) DoAndReturn(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,8 +18,11 @@ package terraform
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -53,6 +57,10 @@ type moduleInspectResult struct {
 	// The parameter variables defined by the recipe
 	Parameters map[string]any
 
+	// ProviderLock is the hash of the required providers of the module, which select the provider lock file of the
+	// module in the cache.
+	ProviderLock string
+
 	// Any other module information required in the future can be added here.
 }
 
@@ -60,6 +68,67 @@ type moduleInspectResult struct {
 func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	restored, err := restoreModule(ctx, tf.WorkingDir(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	if !restored {
+		if err := downloadModule(ctx, tf, options); err != nil {
+			return nil, err
+		}
+	}
+
+	// Load the downloaded module to retrieve providers and variables required by the module.
+	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
+	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
+	loadedModule, err := inspectModule(tf.WorkingDir(), options.EnvRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provider lock file is restored before Terraform is initialized for the first time, which may happen to
+	// migrate the state.
+	if options.Cache != nil {
+		if _, err := options.Cache.RestoreLockFile(ctx, moduleCacheKey(options, loadedModule.ProviderLock), tf.WorkingDir()); err != nil {
+			logger.Info(fmt.Sprintf("Failed to restore Terraform provider lock file from the cache: %s", err.Error()))
+		}
+	}
+
+	return loadedModule, nil
+}
+
+// restoreModule restores the module from the cache to the working directory, if a cache is configured. It returns
+// false if the module must be downloaded. In offline mode, the module must be in the cache.
+func restoreModule(ctx context.Context, workingDir string, options Options) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if options.Cache == nil {
+		return false, nil
+	}
+
+	restored, err := options.Cache.RestoreModule(ctx, moduleCacheKey(options, ""), workingDir, options.EnvRecipe.Name)
+	if err != nil && options.Cache.Offline() {
+		return false, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	} else if err != nil {
+		// The cache is an optimization: the module is downloaded if it can't be restored.
+		logger.Info(fmt.Sprintf("Failed to restore Terraform module from the cache: %s", err.Error()))
+		return false, nil
+	}
+
+	if !restored && options.Cache.Offline() {
+		errMsg := fmt.Sprintf("Terraform module from source %q, version %q is not in the cache, and downloads are disabled", options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion)
+		return false, recipes.NewRecipeError(recipes.RecipeDownloadFailed, errMsg, util.RecipeSetupError, nil)
+	}
+
+	return restored, nil
+}
+
+// downloadModule downloads the module to the working directory with terraform get, and stores it in the cache if
+// one is configured.
+func downloadModule(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
 	// Run Terraform Get command to download the module from the source specified in the config.
 	// The downloaded module is stored in the working directory.
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
@@ -70,22 +139,40 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 				options.EnvRecipe, recipes.RecipeDownloadFailed))
 
 		errMsg := fmt.Sprintf("failed to download Terraform module from source %q, version %q: %s", options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion, err.Error())
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, errMsg, util.RecipeSetupError, recipes.GetErrorDetails(err))
+		return recipes.NewRecipeError(recipes.RecipeDownloadFailed, errMsg, util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 			options.EnvRecipe, metrics.SuccessfulOperationState))
 
-	// Load the downloaded module to retrieve providers and variables required by the module.
-	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
-	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
-	loadedModule, err := inspectModule(tf.WorkingDir(), options.EnvRecipe)
-	if err != nil {
-		return nil, err
+	if options.Cache != nil {
+		if err := options.Cache.StoreModule(ctx, moduleCacheKey(options, ""), tf.WorkingDir(), options.EnvRecipe.Name); err != nil {
+			logger.Info(fmt.Sprintf("Failed to store Terraform module in the cache: %s", err.Error()))
+		}
 	}
 
-	return loadedModule, nil
+	return nil
+}
+
+// moduleCacheKey returns the key of the module of the recipe in the cache, with the given provider lock. Modules are
+// cached by source and version, and their provider lock files by provider lock as well. The provider lock is empty
+// when the module is restored or stored, since it is only known once the module is inspected. When the environment
+// configures credentials for private git repositories, its modules are only reused by the environment, so that they
+// are never used without the credentials that downloaded them.
+func moduleCacheKey(options Options, providerLock string) cache.ModuleKey {
+	key := cache.ModuleKey{
+		Driver:       options.EnvRecipe.Driver,
+		Source:       options.EnvRecipe.TemplatePath,
+		Version:      options.EnvRecipe.TemplateVersion,
+		ProviderLock: providerLock,
+	}
+
+	if options.EnvConfig != nil && len(options.EnvConfig.RecipeConfig.Terraform.Authentication.Git.PAT) > 0 && options.ResourceRecipe != nil {
+		key.Scope = options.ResourceRecipe.EnvironmentID
+	}
+
+	return key
 }
 
 // inspectModule inspects the module present at workingDir/.terraform/modules/<localModuleName> directory
@@ -138,6 +225,8 @@ func inspectModule(workingDir string, recipe *recipes.EnvironmentDefinition) (*m
 		result.RequiredProviders[k] = requiredprovider
 	}
 
+	result.ProviderLock = providerLock(result.RequiredProviders)
+
 	// Check if an output named "result" is defined in the module.
 	if _, ok := mod.Outputs[recipes.ResultPropertyName]; ok {
 		result.ResultOutputExists = true
@@ -159,3 +248,18 @@ func inspectModule(workingDir string, recipe *recipes.EnvironmentDefinition) (*m
 
 	return result, nil
 }
+
+// providerLock returns the hash of the sources and version constraints of the required providers.
+func providerLock(requiredProviders map[string]*config.RequiredProviderInfo) string {
+	names := make([]string, 0, len(requiredProviders))
+	for name := range requiredProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s\n%s\n%s\n", name, requiredProviders[name].Source, requiredProviders[name].Version)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
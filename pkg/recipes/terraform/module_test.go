@@ -17,10 +17,13 @@ limitations under the License.
 package terraform
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/stretchr/testify/require"
 )
@@ -144,7 +147,107 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+
+			// The provider lock is the hash of the required providers, see Test_ProviderLock.
+			tc.result.ProviderLock = providerLock(tc.result.RequiredProviders)
 			require.Equal(t, tc.result, result)
 		})
 	}
 }
+
+func Test_ModuleCacheKey(t *testing.T) {
+	envRecipe := &recipes.EnvironmentDefinition{
+		Name:            "redis",
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}
+	resourceRecipe := &recipes.ResourceMetadata{
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+	}
+
+	t.Run("public module", func(t *testing.T) {
+		key := moduleCacheKey(Options{EnvRecipe: envRecipe, ResourceRecipe: resourceRecipe, EnvConfig: &recipes.Configuration{}}, "")
+		require.Equal(t, cache.ModuleKey{Source: "Azure/redis/azurerm", Version: "1.0.0"}, key)
+	})
+
+	t.Run("private git modules are scoped to the environment", func(t *testing.T) {
+		envConfig := &recipes.Configuration{}
+		envConfig.RecipeConfig.Terraform.Authentication.Git.PAT = map[string]dm.SecretConfig{
+			"github.com": {Secret: "secret-store"},
+		}
+
+		key := moduleCacheKey(Options{EnvRecipe: envRecipe, ResourceRecipe: resourceRecipe, EnvConfig: envConfig}, "")
+		require.Equal(t, cache.ModuleKey{Source: "Azure/redis/azurerm", Version: "1.0.0", Scope: resourceRecipe.EnvironmentID}, key)
+	})
+
+	t.Run("provider lock", func(t *testing.T) {
+		key := moduleCacheKey(Options{EnvRecipe: envRecipe, ResourceRecipe: resourceRecipe, EnvConfig: &recipes.Configuration{}}, "lock")
+		require.Equal(t, cache.ModuleKey{Source: "Azure/redis/azurerm", Version: "1.0.0", ProviderLock: "lock"}, key)
+	})
+
+	tofuRecipe := &recipes.EnvironmentDefinition{
+		Name:            "redis",
+		Driver:          recipes.TemplateKindOpenTofu,
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}
+
+	t.Run("opentofu public module", func(t *testing.T) {
+		key := moduleCacheKey(Options{EnvRecipe: tofuRecipe, ResourceRecipe: resourceRecipe, EnvConfig: &recipes.Configuration{}}, "")
+		require.Equal(t, cache.ModuleKey{Driver: recipes.TemplateKindOpenTofu, Source: "Azure/redis/azurerm", Version: "1.0.0"}, key)
+
+		// OpenTofu modules are downloaded from another registry and must not be shared with Terraform.
+		require.NotEqual(t, moduleCacheKey(Options{EnvRecipe: envRecipe, ResourceRecipe: resourceRecipe, EnvConfig: &recipes.Configuration{}}, ""), key)
+	})
+
+	t.Run("opentofu private git modules are scoped to the environment", func(t *testing.T) {
+		envConfig := &recipes.Configuration{}
+		envConfig.RecipeConfig.Terraform.Authentication.Git.PAT = map[string]dm.SecretConfig{
+			"github.com": {Secret: "secret-store"},
+		}
+
+		key := moduleCacheKey(Options{EnvRecipe: tofuRecipe, ResourceRecipe: resourceRecipe, EnvConfig: envConfig}, "")
+		require.Equal(t, cache.ModuleKey{Driver: recipes.TemplateKindOpenTofu, Source: "Azure/redis/azurerm", Version: "1.0.0", Scope: resourceRecipe.EnvironmentID}, key)
+	})
+}
+
+func Test_ProviderLock(t *testing.T) {
+	requiredProviders := map[string]*config.RequiredProviderInfo{
+		"azurerm": {Source: "hashicorp/azurerm", Version: "~> 3.0"},
+		"random":  {Source: "hashicorp/random", Version: ">= 3.1"},
+	}
+
+	lock := providerLock(requiredProviders)
+	require.Len(t, lock, 64)
+	require.Equal(t, lock, providerLock(map[string]*config.RequiredProviderInfo{
+		"random":  {Source: "hashicorp/random", Version: ">= 3.1"},
+		"azurerm": {Source: "hashicorp/azurerm", Version: "~> 3.0"},
+	}))
+
+	// A change of the version constraints selects another provider lock file.
+	require.NotEqual(t, lock, providerLock(map[string]*config.RequiredProviderInfo{
+		"azurerm": {Source: "hashicorp/azurerm", Version: "~> 4.0"},
+		"random":  {Source: "hashicorp/random", Version: ">= 3.1"},
+	}))
+}
+
+func Test_RestoreModule_Offline(t *testing.T) {
+	c, err := cache.New(cache.Options{Path: t.TempDir(), Offline: true})
+	require.NoError(t, err)
+
+	options := Options{
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:            "redis",
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0.0",
+		},
+		Cache: c,
+	}
+
+	restored, err := restoreModule(context.Background(), t.TempDir(), options)
+	require.False(t, restored)
+
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+}
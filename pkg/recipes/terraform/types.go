@@ -28,11 +28,13 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 const (
 	executionSubDir                = "deploy"
+	planFileName                   = "radius.tfplan"
 	workingDirFileMode fs.FileMode = 0700
 
 	// DefaultStateLockTimeout is the default timeout for acquiring Terraform state locks
@@ -44,6 +46,11 @@ type TerraformExecutor interface {
 	// Deploy installs terraform and runs terraform init and apply on the terraform module referenced by the recipe using terraform-exec.
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using
+	// terraform-exec, and returns the plan without applying it. With Options.RefreshOnly, the plan is a refresh-only
+	// plan, whose resource drift lists the changes made to the deployed resources outside of Terraform.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
 	// and deletes the Kubernetes secret created for terraform state store.
 	Delete(ctx context.Context, options Options) error
@@ -76,6 +83,18 @@ type Options struct {
 
 	// LogLevel is the log level for Terraform execution (e.g., TRACE, DEBUG, INFO, WARN, ERROR).
 	LogLevel string
+
+	// RefreshOnly makes Plan run a refresh-only plan, which compares the state of the recipe deployment with the
+	// actual infrastructure instead of with the configuration.
+	RefreshOnly bool
+
+	// Cache is the cache of Terraform modules and provider plugins shared by recipe executions. Modules and
+	// provider plugins are downloaded for each execution when nil.
+	Cache *cache.Cache
+
+	// ExecPath is the path or the name of a Terraform compatible binary, such as OpenTofu, executing the recipe.
+	// Terraform is installed when empty.
+	ExecPath string
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -118,6 +137,9 @@ func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]strin
 	// Extract secrets from environment variables
 	extractEnvSecretIDs(envConfig.RecipeConfig.EnvSecrets, providerSecretIDs, &mu)
 
+	// Extract secrets from Terraform backend configuration
+	extractBackendSecretIDs(envConfig.RecipeConfig.Terraform.Backend, providerSecretIDs, &mu)
+
 	return providerSecretIDs
 }
 
@@ -141,6 +163,15 @@ func extractEnvSecretIDs(envSecrets map[string]dm.SecretReference, secrets map[s
 	}
 }
 
+// extractBackendSecretIDs extracts secrets from the Terraform backend configuration and the backend to migrate the state from.
+func extractBackendSecretIDs(backend *dm.TerraformBackendConfig, secrets map[string][]string, mu *sync.Mutex) {
+	for ; backend != nil; backend = backend.MigrateFrom {
+		for _, secret := range backend.Secrets {
+			addSecretKeys(secrets, secret.Source, secret.Key, mu)
+		}
+	}
+}
+
 // addSecretKeys updates the secrets map with secretStoreID and key, ensuring thread safety with a mutex.
 func addSecretKeys(secrets map[string][]string, secretStoreID, key string, mu *sync.Mutex) {
 	if secretStoreID == "" || key == "" {
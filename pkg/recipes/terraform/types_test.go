@@ -166,6 +166,30 @@ func TestGetProviderEnvSecretIDs(t *testing.T) {
 				"my-env-secret-source-id": {"secret-key-env"},
 			},
 		},
+		{
+			name: "backend secrets populated",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackendConfig{
+							Type: "s3",
+							Secrets: map[string]datamodel.SecretReference{
+								"secret_key": {Source: "my-backend-secret-source-id", Key: "secret-key-s3"},
+							},
+							MigrateFrom: &datamodel.TerraformBackendConfig{
+								Type: "azurerm",
+								Secrets: map[string]datamodel.SecretReference{
+									"access_key": {Source: "my-backend-secret-source-id", Key: "secret-key-azurerm"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string][]string{
+				"my-backend-secret-source-id": {"secret-key-s3", "secret-key-azurerm"},
+			},
+		},
 		{
 			name: "secrets are declared nil",
 			envConfig: recipes.Configuration{
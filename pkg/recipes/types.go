@@ -108,13 +108,15 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindOpenTofu  = "opentofu"
+	TemplateKindPulumi    = "pulumi"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindOpenTofu, TemplateKindPulumi}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -132,6 +134,93 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// Actions of a resource change in a recipe plan.
+const (
+	// PlanActionCreate is the action of a resource that would be created.
+	PlanActionCreate = "create"
+	// PlanActionUpdate is the action of a resource that would be updated in place.
+	PlanActionUpdate = "update"
+	// PlanActionReplace is the action of a resource that would be deleted and created again.
+	PlanActionReplace = "replace"
+	// PlanActionDelete is the action of a resource that would be deleted.
+	PlanActionDelete = "delete"
+	// PlanActionNoOp is the action of a resource that would be left unchanged.
+	PlanActionNoOp = "no-op"
+)
+
+// Kinds of recipe plans.
+const (
+	// PlanKindWhatIf is the kind of the plans that list the changes executing the recipe would make, computed by
+	// comparing the recipe with the deployed infrastructure.
+	PlanKindWhatIf = "whatIf"
+	// PlanKindResourceList is the kind of the plans of drivers that cannot compute the changes. The plan lists the
+	// resources declared by the recipe, without an action.
+	PlanKindResourceList = "resourceList"
+)
+
+// PlanOutput represents the changes a recipe would make to its infrastructure if it was executed.
+type PlanOutput struct {
+	// Driver is the driver of the recipe.
+	Driver string `json:"driver"`
+
+	// Kind is the kind of the plan, one of the PlanKind values.
+	Kind string `json:"kind"`
+
+	// Changes is the list of changes to the resources managed by the recipe. For a plan of kind
+	// PlanKindResourceList, it is the list of the resources declared by the recipe.
+	Changes []ResourceChange `json:"changes"`
+
+	// Messages contains notes on the plan, such as the limits of what the driver can predict.
+	Messages []string `json:"messages,omitempty"`
+}
+
+// ResourceChange represents the planned change to a resource managed by a recipe.
+type ResourceChange struct {
+	// Address identifies the resource in the recipe, such as a Terraform address or a Bicep symbolic name.
+	Address string `json:"address"`
+
+	// Type is the type of the resource.
+	Type string `json:"type"`
+
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+
+	// Action is the planned action, one of the PlanAction values. It is empty in a plan of kind
+	// PlanKindResourceList.
+	Action string `json:"action,omitempty"`
+
+	// Before is the value of the resource before the change, if known. Sensitive values are masked.
+	Before any `json:"before,omitempty"`
+
+	// After is the value of the resource after the change, if known. Sensitive values and values that are only
+	// known once the change is applied are masked.
+	After any `json:"after,omitempty"`
+}
+
+// Count returns the number of changes of the plan with the given action.
+func (p *PlanOutput) Count(action string) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// DriftOutput represents the changes made outside of Radius to the infrastructure deployed by a recipe.
+type DriftOutput struct {
+	// Driver is the driver of the recipe.
+	Driver string `json:"driver"`
+
+	// DriftedResources is the list of resources deployed by the recipe that were changed outside of Radius.
+	DriftedResources []rpv1.DriftedResource `json:"driftedResources"`
+
+	// Messages contains notes on the drift detection, such as the limits of what the driver can detect.
+	Messages []string `json:"messages,omitempty"`
+}
+
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
 type SecretData struct {
 	Type string            `json:"type"`
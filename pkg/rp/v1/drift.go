@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// DriftState is the drift state of the infrastructure deployed by the recipe of a resource.
+type DriftState string
+
+const (
+	// DriftStateInSync is the state of infrastructure that matches the recipe deployment.
+	DriftStateInSync DriftState = "InSync"
+	// DriftStateDrifted is the state of infrastructure that was changed outside of Radius.
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateUnknown is the state of infrastructure whose drift could not be detected.
+	DriftStateUnknown DriftState = "Unknown"
+)
+
+// DriftChange is the change made outside of Radius to a resource deployed by a recipe.
+type DriftChange string
+
+const (
+	// DriftChangeModified is the change of a resource that was modified outside of Radius.
+	DriftChangeModified DriftChange = "Modified"
+	// DriftChangeDeleted is the change of a resource that was deleted outside of Radius.
+	DriftChangeDeleted DriftChange = "Deleted"
+)
+
+// DriftStatus is the result of the latest drift detection of the infrastructure deployed by the recipe of a resource.
+type DriftStatus struct {
+	// State is the drift state of the infrastructure.
+	State DriftState `json:"state"`
+
+	// LastCheckedTime is the time of the drift detection that recorded the status. The detections finding the same
+	// status do not update the resource, and their time is stored in a separate record.
+	LastCheckedTime time.Time `json:"lastCheckedTime"`
+
+	// DriftedResources are the resources deployed by the recipe that were changed outside of Radius.
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// Message contains the details of the drift detection, such as the reason it failed.
+	Message string `json:"message,omitempty"`
+}
+
+// DriftedResource is a resource deployed by a recipe that was changed outside of Radius.
+type DriftedResource struct {
+	// ID is the UCP resource ID of the resource, if known.
+	ID string `json:"id,omitempty"`
+
+	// Address identifies the resource in the recipe, such as a Terraform address.
+	Address string `json:"address,omitempty"`
+
+	// Type is the type of the resource.
+	Type string `json:"type"`
+
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+
+	// Change is the change made to the resource outside of Radius.
+	Change DriftChange `json:"change"`
+}
@@ -26,4 +26,8 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// ResourceHashes are the hashes of the properties of the resources deployed by the recipe, by resource ID, as
+	// they were read after the deployment. They are compared with the actual resources to detect drift.
+	ResourceHashes map[string]string `json:"resourceHashes,omitempty"`
 }
@@ -103,6 +103,9 @@ type ResourceStatus struct {
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
 	Recipe          *RecipeStatus    `json:"recipe,omitempty"`
+
+	// DriftStatus is the result of the latest drift detection of the infrastructure deployed by the recipe.
+	DriftStatus *DriftStatus `json:"driftStatus,omitempty"`
 }
 
 // DeepCopyRecipeStatus creates a copy of ResourceStatus.
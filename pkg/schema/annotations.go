@@ -277,6 +277,157 @@ func RedactFields(data map[string]any, paths []string) {
 	}
 }
 
+// SelectFields returns a new map containing only the values at the given field paths,
+// preserving their nested structure. Paths support dot notation, wildcards [*], and array
+// indices [N]. Arrays keep their original length so that indices remain stable; elements
+// that are not selected are nil. Missing fields and invalid paths are silently skipped.
+func SelectFields(data map[string]any, paths []string) map[string]any {
+	result := map[string]any{}
+	if data == nil {
+		return result
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		segments := ParseFieldPath(path)
+		if len(segments) == 0 {
+			continue
+		}
+		selected, ok := selectAtSegments(data, segments)
+		if !ok {
+			continue
+		}
+		if selectedMap, ok := mergeSelected(result, selected).(map[string]any); ok {
+			result = selectedMap
+		}
+	}
+	return result
+}
+
+// MergeFields merges the values selected by SelectFields back into the data map, for example to restore
+// the encrypted values of redacted fields. Values that were not selected are left unchanged.
+func MergeFields(data map[string]any, selected map[string]any) map[string]any {
+	if data == nil {
+		data = map[string]any{}
+	}
+	if merged, ok := mergeSelected(data, selected).(map[string]any); ok {
+		return merged
+	}
+	return data
+}
+
+// selectAtSegments traverses the data following the path segments and returns a copy of the
+// structure leading to the selected values. The boolean result is false if nothing was selected.
+func selectAtSegments(current any, segments []FieldPathSegment) (any, bool) {
+	if len(segments) == 0 {
+		return deepCopyValue(current), true
+	}
+
+	segment := segments[0]
+	remaining := segments[1:]
+
+	// Handle wildcard [*] - select from every element of an array or map
+	if segment.IsWildcard() {
+		switch v := current.(type) {
+		case []any:
+			selected := make([]any, len(v))
+			found := false
+			for i := range v {
+				if value, ok := selectAtSegments(v[i], remaining); ok {
+					selected[i] = value
+					found = true
+				}
+			}
+			return selected, found
+		case map[string]any:
+			selected := map[string]any{}
+			for key := range v {
+				if value, ok := selectAtSegments(v[key], remaining); ok {
+					selected[key] = value
+				}
+			}
+			return selected, len(selected) > 0
+		}
+		return nil, false
+	}
+
+	// Handle array index [N]
+	if segment.IsIndex() {
+		arr, ok := current.([]any)
+		if !ok {
+			return nil, false
+		}
+
+		idx, err := strconv.Atoi(segment.Value)
+		if err != nil || idx < 0 || idx >= len(arr) {
+			return nil, false
+		}
+
+		value, ok := selectAtSegments(arr[idx], remaining)
+		if !ok {
+			return nil, false
+		}
+		selected := make([]any, len(arr))
+		selected[idx] = value
+		return selected, true
+	}
+
+	// Handle field name
+	dataMap, ok := current.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	value, exists := dataMap[segment.Value]
+	if !exists {
+		return nil, false
+	}
+
+	selected, ok := selectAtSegments(value, remaining)
+	if !ok {
+		return nil, false
+	}
+	return map[string]any{segment.Value: selected}, true
+}
+
+// mergeSelected merges two selections produced by selectAtSegments. Maps are merged key by key
+// and arrays of equal length element by element; otherwise the source value wins.
+func mergeSelected(dst any, src any) any {
+	switch s := src.(type) {
+	case map[string]any:
+		d, ok := dst.(map[string]any)
+		if !ok {
+			return s
+		}
+		for key, value := range s {
+			if existing, exists := d[key]; exists {
+				d[key] = mergeSelected(existing, value)
+			} else {
+				d[key] = value
+			}
+		}
+		return d
+	case []any:
+		d, ok := dst.([]any)
+		if !ok || len(d) != len(s) {
+			return s
+		}
+		for i := range s {
+			if s[i] == nil {
+				continue
+			}
+			if d[i] == nil {
+				d[i] = s[i]
+			} else {
+				d[i] = mergeSelected(d[i], s[i])
+			}
+		}
+		return d
+	}
+	return src
+}
+
 // redactAtSegments traverses the data following the path segments and sets the final value to nil.
 func redactAtSegments(current any, segments []FieldPathSegment) {
 	if len(segments) == 0 {
@@ -1013,3 +1013,100 @@ func TestRedactFields_FieldWithDifferentTypes(t *testing.T) {
 		})
 	}
 }
+
+// =============================================================================
+// SelectFields tests
+// =============================================================================
+
+func TestSelectFields_SimpleField(t *testing.T) {
+	properties := map[string]any{
+		"name":     "test-resource",
+		"password": "secret123",
+	}
+
+	selected := SelectFields(properties, []string{"password"})
+
+	require.Equal(t, map[string]any{"password": "secret123"}, selected)
+	// The source is not modified
+	require.Equal(t, "test-resource", properties["name"])
+}
+
+func TestSelectFields_NestedAndMultiple(t *testing.T) {
+	properties := map[string]any{
+		"config": map[string]any{
+			"host":     "localhost",
+			"password": "secret",
+			"token":    "abc",
+		},
+		"apiKey": "key",
+	}
+
+	selected := SelectFields(properties, []string{"config.password", "config.token", "apiKey", "missing.field"})
+
+	require.Equal(t, map[string]any{
+		"config": map[string]any{"password": "secret", "token": "abc"},
+		"apiKey": "key",
+	}, selected)
+}
+
+func TestSelectFields_Wildcards(t *testing.T) {
+	properties := map[string]any{
+		"items": []any{
+			map[string]any{"name": "a", "password": "p1"},
+			map[string]any{"name": "b"},
+		},
+		"secrets": map[string]any{
+			"one": map[string]any{"value": "v1", "encoding": "string"},
+		},
+	}
+
+	selected := SelectFields(properties, []string{"items[*].password", "secrets[*].value"})
+
+	require.Equal(t, map[string]any{
+		"items":   []any{map[string]any{"password": "p1"}, nil},
+		"secrets": map[string]any{"one": map[string]any{"value": "v1"}},
+	}, selected)
+}
+
+func TestSelectFields_SpecificIndex(t *testing.T) {
+	properties := map[string]any{
+		"tokens": []any{"token0", "token1", "token2"},
+	}
+
+	selected := SelectFields(properties, []string{"tokens[1]", "tokens[2]", "tokens[7]"})
+
+	require.Equal(t, map[string]any{"tokens": []any{nil, "token1", "token2"}}, selected)
+}
+
+func TestSelectFields_ReturnsCopy(t *testing.T) {
+	properties := map[string]any{
+		"data": map[string]any{"key": "value"},
+	}
+
+	selected := SelectFields(properties, []string{"data"})
+	selected["data"].(map[string]any)["key"] = "changed"
+
+	require.Equal(t, "value", properties["data"].(map[string]any)["key"])
+}
+
+func TestSelectFields_NilProperties(t *testing.T) {
+	require.Empty(t, SelectFields(nil, []string{"password"}))
+}
+
+func TestMergeFields_RestoresRedactedFields(t *testing.T) {
+	properties := map[string]any{
+		"name":  "test",
+		"items": []any{map[string]any{"name": "a", "password": "p1"}, map[string]any{"name": "b"}},
+	}
+	paths := []string{"items[*].password"}
+
+	selected := SelectFields(properties, paths)
+	RedactFields(properties, paths)
+
+	merged := MergeFields(properties, selected)
+
+	require.Equal(t, map[string]any{
+		"name":  "test",
+		"items": []any{map[string]any{"name": "a", "password": "p1"}, map[string]any{"name": "b"}},
+	}, merged)
+}
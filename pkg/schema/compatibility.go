@@ -0,0 +1,387 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ChangeKind classifies a difference between two versions of a schema.
+type ChangeKind string
+
+const (
+	// ChangeKindAdditive indicates a change that existing resources and clients are compatible with.
+	ChangeKindAdditive ChangeKind = "Additive"
+
+	// ChangeKindBreaking indicates a change that can invalidate existing resources or break existing clients.
+	ChangeKindBreaking ChangeKind = "Breaking"
+)
+
+// SchemaChange describes a single difference between two versions of a schema.
+type SchemaChange struct {
+	// Kind classifies the change.
+	Kind ChangeKind
+
+	// Path is the property path of the change in dot notation, using [*] for array items and map values.
+	// The path is empty for changes to the root schema.
+	Path string
+
+	// Message describes the change.
+	Message string
+}
+
+// String returns a human-readable description of the change.
+func (c SchemaChange) String() string {
+	if c.Path == "" {
+		return fmt.Sprintf("%s: %s", c.Kind, c.Message)
+	}
+	return fmt.Sprintf("%s: %q: %s", c.Kind, c.Path, c.Message)
+}
+
+// SchemaDiff is the result of comparing two versions of a schema.
+type SchemaDiff struct {
+	// Changes lists the differences, sorted by path.
+	Changes []SchemaChange
+}
+
+// BreakingChanges returns the breaking changes in the diff.
+func (d *SchemaDiff) BreakingChanges() []SchemaChange {
+	breaking := []SchemaChange{}
+	for _, change := range d.Changes {
+		if change.Kind == ChangeKindBreaking {
+			breaking = append(breaking, change)
+		}
+	}
+	return breaking
+}
+
+// HasBreakingChanges returns true if the diff contains at least one breaking change.
+func (d *SchemaDiff) HasBreakingChanges() bool {
+	return len(d.BreakingChanges()) > 0
+}
+
+// DiffSchemas compares two versions of a resource type schema and classifies each difference as additive or
+// breaking. A change is breaking when a resource or request that is valid against the old schema can be invalid
+// against the new schema, for example a removed property, a narrowed enum or a new required property. Changes to
+// descriptions and other documentation are ignored.
+func DiffSchemas(oldSchemaData any, newSchemaData any) (*SchemaDiff, error) {
+	oldSchema, err := ConvertToOpenAPISchema(oldSchemaData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old schema: %w", err)
+	}
+
+	newSchema, err := ConvertToOpenAPISchema(newSchemaData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new schema: %w", err)
+	}
+
+	diff := &SchemaDiff{Changes: []SchemaChange{}}
+	diffSchema(oldSchema, newSchema, "", diff)
+
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Path < diff.Changes[j].Path
+	})
+
+	return diff, nil
+}
+
+func (d *SchemaDiff) add(kind ChangeKind, path string, format string, args ...any) {
+	d.Changes = append(d.Changes, SchemaChange{Kind: kind, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// diffSchema compares two schemas at the same path.
+func diffSchema(oldSchema *openapi3.Schema, newSchema *openapi3.Schema, path string, diff *SchemaDiff) {
+	oldTypes, newTypes := schemaTypes(oldSchema), schemaTypes(newSchema)
+	if !slices.Equal(oldTypes, newTypes) {
+		switch {
+		case len(newTypes) == 0:
+			diff.add(ChangeKindAdditive, path, "type constraint %s was removed", strings.Join(oldTypes, ", "))
+		case len(oldTypes) == 0:
+			diff.add(ChangeKindBreaking, path, "type constraint %s was added", strings.Join(newTypes, ", "))
+		default:
+			diff.add(ChangeKindBreaking, path, "type changed from %s to %s", strings.Join(oldTypes, ", "), strings.Join(newTypes, ", "))
+
+			// The remaining constraints are not comparable across types.
+			return
+		}
+	}
+
+	diffAnnotations(oldSchema, newSchema, path, diff)
+	diffEnum(oldSchema.Enum, newSchema.Enum, path, diff)
+	diffConstraints(oldSchema, newSchema, path, diff)
+	diffProperties(oldSchema, newSchema, path, diff)
+
+	oldItems, newItems := resolvedSchema(oldSchema.Items), resolvedSchema(newSchema.Items)
+	if oldItems != nil && newItems != nil {
+		diffSchema(oldItems, newItems, path+"[*]", diff)
+	} else if oldItems == nil && newItems != nil {
+		diff.add(ChangeKindBreaking, path, "items schema was added")
+	} else if oldItems != nil && newItems == nil {
+		diff.add(ChangeKindAdditive, path, "items schema was removed")
+	}
+}
+
+// diffAnnotations compares readOnly, nullable and the Radius annotations.
+func diffAnnotations(oldSchema *openapi3.Schema, newSchema *openapi3.Schema, path string, diff *SchemaDiff) {
+	if !oldSchema.ReadOnly && newSchema.ReadOnly {
+		diff.add(ChangeKindBreaking, path, "property became read-only")
+	} else if oldSchema.ReadOnly && !newSchema.ReadOnly {
+		diff.add(ChangeKindAdditive, path, "property is no longer read-only")
+	}
+
+	if oldSchema.Nullable && !newSchema.Nullable {
+		diff.add(ChangeKindBreaking, path, "property is no longer nullable")
+	} else if !oldSchema.Nullable && newSchema.Nullable {
+		diff.add(ChangeKindAdditive, path, "property became nullable")
+	}
+
+	if !isImmutable(oldSchema) && isImmutable(newSchema) {
+		diff.add(ChangeKindBreaking, path, "property became immutable")
+	} else if isImmutable(oldSchema) && !isImmutable(newSchema) {
+		diff.add(ChangeKindAdditive, path, "property is no longer immutable")
+	}
+
+	// Sensitive fields are stored encrypted, so changing the annotation in either direction changes how existing
+	// values are stored and read.
+	if isSensitive(oldSchema) != isSensitive(newSchema) {
+		if isSensitive(newSchema) {
+			diff.add(ChangeKindBreaking, path, "property became sensitive")
+		} else {
+			diff.add(ChangeKindBreaking, path, "property is no longer sensitive")
+		}
+	}
+}
+
+// diffEnum compares enum constraints. Removing values narrows the set of valid values.
+func diffEnum(oldEnum []any, newEnum []any, path string, diff *SchemaDiff) {
+	switch {
+	case len(oldEnum) == 0 && len(newEnum) == 0:
+		return
+	case len(oldEnum) == 0:
+		diff.add(ChangeKindBreaking, path, "enum constraint was added")
+		return
+	case len(newEnum) == 0:
+		diff.add(ChangeKindAdditive, path, "enum constraint was removed")
+		return
+	}
+
+	removed := enumDifference(oldEnum, newEnum)
+	added := enumDifference(newEnum, oldEnum)
+	if len(removed) > 0 {
+		diff.add(ChangeKindBreaking, path, "enum values were removed: %s", strings.Join(removed, ", "))
+	}
+	if len(added) > 0 {
+		diff.add(ChangeKindAdditive, path, "enum values were added: %s", strings.Join(added, ", "))
+	}
+}
+
+// enumDifference returns the values of a that are not in b, formatted for display.
+func enumDifference(a []any, b []any) []string {
+	result := []string{}
+	for _, value := range a {
+		found := slices.ContainsFunc(b, func(other any) bool {
+			return reflect.DeepEqual(value, other)
+		})
+		if !found {
+			result = append(result, fmt.Sprintf("%v", value))
+		}
+	}
+	return result
+}
+
+// diffConstraints compares the validation keywords that limit the range of valid values.
+func diffConstraints(oldSchema *openapi3.Schema, newSchema *openapi3.Schema, path string, diff *SchemaDiff) {
+	if oldSchema.Format != newSchema.Format {
+		if newSchema.Format == "" {
+			diff.add(ChangeKindAdditive, path, "format %q was removed", oldSchema.Format)
+		} else {
+			diff.add(ChangeKindBreaking, path, "format changed from %q to %q", oldSchema.Format, newSchema.Format)
+		}
+	}
+
+	if oldSchema.Pattern != newSchema.Pattern {
+		if newSchema.Pattern == "" {
+			diff.add(ChangeKindAdditive, path, "pattern %q was removed", oldSchema.Pattern)
+		} else {
+			diff.add(ChangeKindBreaking, path, "pattern changed from %q to %q", oldSchema.Pattern, newSchema.Pattern)
+		}
+	}
+
+	diffLowerBound(oldSchema.Min, newSchema.Min, "minimum", path, diff)
+	diffUpperBound(oldSchema.Max, newSchema.Max, "maximum", path, diff)
+	diffLowerBound(uintLowerBound(oldSchema.MinLength), uintLowerBound(newSchema.MinLength), "minLength", path, diff)
+	diffUpperBound(uintBound(oldSchema.MaxLength), uintBound(newSchema.MaxLength), "maxLength", path, diff)
+	diffLowerBound(uintLowerBound(oldSchema.MinItems), uintLowerBound(newSchema.MinItems), "minItems", path, diff)
+	diffUpperBound(uintBound(oldSchema.MaxItems), uintBound(newSchema.MaxItems), "maxItems", path, diff)
+
+	if !oldSchema.ExclusiveMin && newSchema.ExclusiveMin {
+		diff.add(ChangeKindBreaking, path, "minimum became exclusive")
+	}
+	if !oldSchema.ExclusiveMax && newSchema.ExclusiveMax {
+		diff.add(ChangeKindBreaking, path, "maximum became exclusive")
+	}
+}
+
+// diffLowerBound compares a lower bound. A nil bound means the keyword is not set.
+func diffLowerBound(oldBound *float64, newBound *float64, keyword string, path string, diff *SchemaDiff) {
+	switch {
+	case oldBound == nil && newBound == nil:
+	case oldBound == nil:
+		diff.add(ChangeKindBreaking, path, "%s %v was added", keyword, *newBound)
+	case newBound == nil:
+		diff.add(ChangeKindAdditive, path, "%s %v was removed", keyword, *oldBound)
+	case *newBound > *oldBound:
+		diff.add(ChangeKindBreaking, path, "%s increased from %v to %v", keyword, *oldBound, *newBound)
+	case *newBound < *oldBound:
+		diff.add(ChangeKindAdditive, path, "%s decreased from %v to %v", keyword, *oldBound, *newBound)
+	}
+}
+
+// diffUpperBound compares an upper bound. A nil bound means the keyword is not set.
+func diffUpperBound(oldBound *float64, newBound *float64, keyword string, path string, diff *SchemaDiff) {
+	switch {
+	case oldBound == nil && newBound == nil:
+	case oldBound == nil:
+		diff.add(ChangeKindBreaking, path, "%s %v was added", keyword, *newBound)
+	case newBound == nil:
+		diff.add(ChangeKindAdditive, path, "%s %v was removed", keyword, *oldBound)
+	case *newBound < *oldBound:
+		diff.add(ChangeKindBreaking, path, "%s decreased from %v to %v", keyword, *oldBound, *newBound)
+	case *newBound > *oldBound:
+		diff.add(ChangeKindAdditive, path, "%s increased from %v to %v", keyword, *oldBound, *newBound)
+	}
+}
+
+func uintBound(value *uint64) *float64 {
+	if value == nil {
+		return nil
+	}
+	f := float64(*value)
+	return &f
+}
+
+// uintLowerBound treats a zero lower bound as unset, matching the OpenAPI default.
+func uintLowerBound(value uint64) *float64 {
+	if value == 0 {
+		return nil
+	}
+	f := float64(value)
+	return &f
+}
+
+// diffProperties compares object properties, required properties and additionalProperties.
+func diffProperties(oldSchema *openapi3.Schema, newSchema *openapi3.Schema, path string, diff *SchemaDiff) {
+	for name, oldRef := range oldSchema.Properties {
+		propertyPath := joinPath(path, name)
+		newRef, ok := newSchema.Properties[name]
+		if !ok {
+			diff.add(ChangeKindBreaking, propertyPath, "property was removed")
+			continue
+		}
+
+		oldProperty, newProperty := resolvedSchema(oldRef), resolvedSchema(newRef)
+		if oldProperty != nil && newProperty != nil {
+			diffSchema(oldProperty, newProperty, propertyPath, diff)
+		}
+	}
+
+	for name := range newSchema.Properties {
+		if _, ok := oldSchema.Properties[name]; ok {
+			continue
+		}
+		if slices.Contains(newSchema.Required, name) {
+			diff.add(ChangeKindBreaking, joinPath(path, name), "required property was added")
+		} else {
+			diff.add(ChangeKindAdditive, joinPath(path, name), "optional property was added")
+		}
+	}
+
+	for _, name := range newSchema.Required {
+		if _, isNew := newSchema.Properties[name]; isNew {
+			if _, existed := oldSchema.Properties[name]; !existed {
+				// Already reported as a new required property.
+				continue
+			}
+		}
+		if !slices.Contains(oldSchema.Required, name) {
+			diff.add(ChangeKindBreaking, joinPath(path, name), "property became required")
+		}
+	}
+
+	for _, name := range oldSchema.Required {
+		if _, ok := newSchema.Properties[name]; !ok {
+			// Already reported as a removed property.
+			continue
+		}
+		if !slices.Contains(newSchema.Required, name) {
+			diff.add(ChangeKindAdditive, joinPath(path, name), "property is no longer required")
+		}
+	}
+
+	oldAllowed, newAllowed := allowsAdditionalProperties(oldSchema), allowsAdditionalProperties(newSchema)
+	switch {
+	case oldAllowed && !newAllowed:
+		diff.add(ChangeKindBreaking, path, "additional properties are no longer allowed")
+	case !oldAllowed && newAllowed:
+		diff.add(ChangeKindAdditive, path, "additional properties are now allowed")
+	case oldAllowed && newAllowed:
+		oldValue, newValue := resolvedSchema(oldSchema.AdditionalProperties.Schema), resolvedSchema(newSchema.AdditionalProperties.Schema)
+		if oldValue == nil && newValue != nil {
+			diff.add(ChangeKindBreaking, path, "additional properties schema was added")
+		} else if oldValue != nil && newValue == nil {
+			diff.add(ChangeKindAdditive, path, "additional properties schema was removed")
+		} else if oldValue != nil && newValue != nil {
+			diffSchema(oldValue, newValue, path+"[*]", diff)
+		}
+	}
+}
+
+// allowsAdditionalProperties returns true if the schema accepts properties that are not declared. Following
+// OpenAPI, an object that does not set additionalProperties accepts them.
+func allowsAdditionalProperties(schema *openapi3.Schema) bool {
+	if schema.AdditionalProperties.Schema != nil {
+		return true
+	}
+	return schema.AdditionalProperties.Has == nil || *schema.AdditionalProperties.Has
+}
+
+// isSensitive returns true if the schema is marked with x-radius-sensitive.
+func isSensitive(schema *openapi3.Schema) bool {
+	sensitive, _ := schema.Extensions[annotationRadiusSensitive].(bool)
+	return sensitive
+}
+
+// schemaTypes returns the sorted type constraint of a schema, or nil if the schema does not constrain the type. A
+// schema that declares properties without a type is treated as an object, as resource type schemas often omit the
+// type of the root schema.
+func schemaTypes(schema *openapi3.Schema) []string {
+	if schema.Type == nil || len(*schema.Type) == 0 {
+		if len(schema.Properties) > 0 {
+			return []string{openapi3.TypeObject}
+		}
+		return nil
+	}
+	types := slices.Clone(schema.Type.Slice())
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCompatibilitySchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"size": map[string]any{
+				"type": "string",
+				"enum": []any{"S", "M", "L"},
+			},
+			"replicas": map[string]any{
+				"type":    "integer",
+				"minimum": 1,
+				"maximum": 10,
+			},
+			"tags": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "string"},
+			},
+			"connections": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"source": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+		"required": []any{"environment"},
+	}
+}
+
+func Test_DiffSchemas(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(schema map[string]any)
+		expected []SchemaChange
+	}{
+		{
+			name:     "unchanged",
+			mutate:   func(schema map[string]any) {},
+			expected: []SchemaChange{},
+		},
+		{
+			name: "description changes are ignored",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "environment")["description"] = "The environment ID."
+			},
+			expected: []SchemaChange{},
+		},
+		{
+			name: "optional property added",
+			mutate: func(schema map[string]any) {
+				schemaProperties(schema)["database"] = map[string]any{"type": "string"}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindAdditive, Path: "database", Message: "optional property was added"},
+			},
+		},
+		{
+			name: "required property added",
+			mutate: func(schema map[string]any) {
+				schemaProperties(schema)["database"] = map[string]any{"type": "string"}
+				schema["required"] = []any{"environment", "database"}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "database", Message: "required property was added"},
+			},
+		},
+		{
+			name: "property removed",
+			mutate: func(schema map[string]any) {
+				delete(schemaProperties(schema), "size")
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "size", Message: "property was removed"},
+			},
+		},
+		{
+			name: "existing property became required",
+			mutate: func(schema map[string]any) {
+				schema["required"] = []any{"environment", "size"}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "size", Message: "property became required"},
+			},
+		},
+		{
+			name: "property no longer required",
+			mutate: func(schema map[string]any) {
+				delete(schema, "required")
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindAdditive, Path: "environment", Message: "property is no longer required"},
+			},
+		},
+		{
+			name: "enum narrowed and widened",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "size")["enum"] = []any{"M", "L", "XL"}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "size", Message: "enum values were removed: S"},
+				{Kind: ChangeKindAdditive, Path: "size", Message: "enum values were added: XL"},
+			},
+		},
+		{
+			name: "type changed",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "replicas")["type"] = "string"
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "replicas", Message: "type changed from integer to string"},
+			},
+		},
+		{
+			name: "range narrowed",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "replicas")["minimum"] = 2
+				delete(schemaProperty(schema, "replicas"), "maximum")
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "replicas", Message: "minimum increased from 1 to 2"},
+				{Kind: ChangeKindAdditive, Path: "replicas", Message: "maximum 10 was removed"},
+			},
+		},
+		{
+			name: "array item type changed",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "tags")["items"] = map[string]any{"type": "integer"}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "tags[*]", Message: "type changed from string to integer"},
+			},
+		},
+		{
+			name: "map value property removed",
+			mutate: func(schema map[string]any) {
+				value := schemaProperty(schema, "connections")["additionalProperties"].(map[string]any)
+				value["properties"] = map[string]any{}
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "connections[*].source", Message: "property was removed"},
+			},
+		},
+		{
+			name: "additional properties disallowed",
+			mutate: func(schema map[string]any) {
+				schema["additionalProperties"] = false
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "", Message: "additional properties are no longer allowed"},
+			},
+		},
+		{
+			name: "missing root type is treated as object",
+			mutate: func(schema map[string]any) {
+				delete(schema, "type")
+			},
+			expected: []SchemaChange{},
+		},
+		{
+			name: "type constraint removed",
+			mutate: func(schema map[string]any) {
+				delete(schemaProperty(schema, "replicas"), "type")
+				schemaProperty(schema, "replicas")["maximum"] = 5
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindAdditive, Path: "replicas", Message: "type constraint integer was removed"},
+				{Kind: ChangeKindBreaking, Path: "replicas", Message: "maximum decreased from 10 to 5"},
+			},
+		},
+		{
+			name: "annotations changed",
+			mutate: func(schema map[string]any) {
+				schemaProperty(schema, "environment")[annotationRadiusImmutable] = true
+				schemaProperty(schema, "size")["readOnly"] = true
+				schemaProperty(schema, "tags")["items"].(map[string]any)[annotationRadiusSensitive] = true
+			},
+			expected: []SchemaChange{
+				{Kind: ChangeKindBreaking, Path: "environment", Message: "property became immutable"},
+				{Kind: ChangeKindBreaking, Path: "size", Message: "property became read-only"},
+				{Kind: ChangeKindBreaking, Path: "tags[*]", Message: "property became sensitive"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSchema := testCompatibilitySchema()
+			tt.mutate(newSchema)
+
+			diff, err := DiffSchemas(testCompatibilitySchema(), newSchema)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, diff.Changes)
+
+			hasBreaking := false
+			for _, change := range tt.expected {
+				hasBreaking = hasBreaking || change.Kind == ChangeKindBreaking
+			}
+			require.Equal(t, hasBreaking, diff.HasBreakingChanges())
+		})
+	}
+}
+
+func Test_DiffSchemas_InvalidSchema(t *testing.T) {
+	_, err := DiffSchemas(map[string]any{"type": 42}, testCompatibilitySchema())
+	require.ErrorContains(t, err, "failed to parse old schema")
+}
+
+func Test_SchemaChange_String(t *testing.T) {
+	require.Equal(t, "Breaking: \"size\": property was removed", SchemaChange{Kind: ChangeKindBreaking, Path: "size", Message: "property was removed"}.String())
+	require.Equal(t, "Additive: additional properties are now allowed", SchemaChange{Kind: ChangeKindAdditive, Message: "additional properties are now allowed"}.String())
+}
+
+func schemaProperties(schema map[string]any) map[string]any {
+	return schema["properties"].(map[string]any)
+}
+
+func schemaProperty(schema map[string]any, name string) map[string]any {
+	return schemaProperties(schema)[name].(map[string]any)
+}
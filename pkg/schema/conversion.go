@@ -0,0 +1,517 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// annotationRadiusConversions is the schema annotation declaring the conversion rules of an API version. It is set
+	// at the root of the schema and maps another API version of the resource type to the rules converting resources
+	// from that version to the version of the schema.
+	annotationRadiusConversions = "x-radius-conversions"
+)
+
+// ConversionRule is a rule converting the properties of a resource between two API versions. Exactly one of Rename,
+// Move or Default is set. Paths are in dot notation relative to "properties", e.g. "config.port".
+//
+// The rules are declared in the schema of the newer API version and convert from the older version. The reverse
+// conversion applies the inverse rules in reverse order.
+type ConversionRule struct {
+	// Rename is the path of a property renamed to To. To is a property name in the same object.
+	Rename string `json:"rename,omitempty"`
+
+	// Move is the path of a property moved to the path To.
+	Move string `json:"move,omitempty"`
+
+	// To is the new name of a renamed property or the new path of a moved property.
+	To string `json:"to,omitempty"`
+
+	// Default is the path of a property introduced by the newer version. It is set to Value when it is missing, and
+	// removed by the reverse conversion.
+	Default string `json:"default,omitempty"`
+
+	// Value is the value of a Default property.
+	Value any `json:"value,omitempty"`
+}
+
+// conversionStep is an edge of the conversion graph: the rules to apply, and whether they are applied in reverse.
+type conversionStep struct {
+	rules   []ConversionRule
+	reverse bool
+}
+
+// Converter converts the properties of a resource type between its API versions, following the conversion rules
+// declared in the schemas of the API versions. Conversions can chain through intermediate API versions.
+type Converter struct {
+	// StorageVersion is the API version resources are stored with when they can be converted to it. It is the newest
+	// API version that the default API version of the resource type converts to, since only conversions to older API
+	// versions remove properties. It is empty when resources are stored with the API version they are written with.
+	StorageVersion string
+
+	steps map[string]map[string]conversionStep
+}
+
+// NewConverter creates a Converter from the schemas of the API versions of a resource type and its default API version.
+func NewConverter(schemas map[string]map[string]any, defaultVersion string) (*Converter, error) {
+	c := &Converter{steps: map[string]map[string]conversionStep{}}
+	for version, schema := range schemas {
+		conversions, err := ExtractConversionRules(schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conversion rules of API version %q: %w", version, err)
+		}
+
+		for from, rules := range conversions {
+			c.addStep(from, version, conversionStep{rules: rules})
+			c.addStep(version, from, conversionStep{rules: rules, reverse: true})
+		}
+	}
+
+	c.StorageVersion = c.newestVersion(defaultVersion)
+	return c, nil
+}
+
+// GetConverter fetches the schemas of all the API versions of a resource type and returns their Converter. Returns nil
+// if the client is nil.
+func GetConverter(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string) (*Converter, error) {
+	if ucpClient == nil {
+		return nil, nil
+	}
+
+	ID, err := resources.Parse(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	planeName := strings.Split(ID.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type %q", resourceType)
+	}
+
+	schemas := map[string]map[string]any{}
+	pager := ucpClient.NewAPIVersionsClient().NewListPager(planeName, resourceProvider, resourceTypeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, apiVersion := range page.Value {
+			if apiVersion == nil || apiVersion.Name == nil || apiVersion.Properties == nil {
+				continue
+			}
+			schemas[*apiVersion.Name] = apiVersion.Properties.Schema
+		}
+	}
+
+	resourceTypeResponse, err := ucpClient.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultVersion := ""
+	if resourceTypeResponse.Properties != nil {
+		defaultVersion = to.String(resourceTypeResponse.Properties.DefaultAPIVersion)
+	}
+
+	return NewConverter(schemas, defaultVersion)
+}
+
+func (c *Converter) addStep(from string, to string, step conversionStep) {
+	if c.steps[from] == nil {
+		c.steps[from] = map[string]conversionStep{}
+	}
+	c.steps[from][to] = step
+}
+
+// newestVersion returns the newest API version that version converts to. Rules are declared by the newer API version,
+// so this follows the conversions that are not reversed until no newer API version is declared. When the conversions
+// branch, the greatest of the newest API versions is returned.
+func (c *Converter) newestVersion(version string) string {
+	if version == "" {
+		return ""
+	}
+
+	newest := ""
+	visited := map[string]bool{version: true}
+	queue := []string{version}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		newer := false
+		for next, step := range c.steps[current] {
+			if step.reverse {
+				continue
+			}
+			newer = true
+
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+
+		if !newer && current > newest {
+			newest = current
+		}
+	}
+
+	// Circular conversions have no newest API version.
+	if newest == "" {
+		return version
+	}
+
+	return newest
+}
+
+// HasConversions returns true if any conversion rule is declared.
+func (c *Converter) HasConversions() bool {
+	return c != nil && len(c.steps) > 0
+}
+
+// CanConvert returns true if properties can be converted from one API version to the other.
+func (c *Converter) CanConvert(from string, to string) bool {
+	_, ok := c.path(from, to)
+	return ok
+}
+
+// Convert returns a copy of the properties converted from one API version to the other. It returns an error if there
+// is no conversion between the versions or if a rule cannot be applied.
+func (c *Converter) Convert(properties map[string]any, from string, to string) (map[string]any, error) {
+	path, ok := c.path(from, to)
+	if !ok {
+		return nil, fmt.Errorf("no conversion is declared from API version %q to %q", from, to)
+	}
+
+	converted, err := deepCopyProperties(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range path {
+		if err := step.apply(converted); err != nil {
+			return nil, err
+		}
+	}
+
+	return converted, nil
+}
+
+// ConvertLossless converts the properties like Convert, and returns an error if converting the result back to the
+// original API version does not give back the properties. Conversions to older API versions remove the properties
+// introduced by newer API versions. Properties set to their default value by the conversion back are ignored.
+func (c *Converter) ConvertLossless(properties map[string]any, from string, to string) (map[string]any, error) {
+	converted, err := c.Convert(properties, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := deepCopyProperties(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	roundTrip, err := c.Convert(converted, to, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsProperties(roundTrip, original) {
+		return nil, fmt.Errorf("converting from API version %q to %q loses properties", from, to)
+	}
+
+	return converted, nil
+}
+
+// path returns the shortest sequence of conversion steps between the versions.
+func (c *Converter) path(from string, to string) ([]conversionStep, bool) {
+	if from == to {
+		return nil, true
+	}
+	if c == nil {
+		return nil, false
+	}
+
+	type node struct {
+		version string
+		steps   []conversionStep
+	}
+
+	visited := map[string]bool{from: true}
+	queue := []node{{version: from}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for next, step := range c.steps[current.version] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+
+			steps := append(append([]conversionStep{}, current.steps...), step)
+			if next == to {
+				return steps, true
+			}
+			queue = append(queue, node{version: next, steps: steps})
+		}
+	}
+
+	return nil, false
+}
+
+// apply applies the rules of the step to the properties in place.
+func (s conversionStep) apply(properties map[string]any) error {
+	if !s.reverse {
+		for _, rule := range s.rules {
+			if err := rule.apply(properties); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := len(s.rules) - 1; i >= 0; i-- {
+		if err := s.rules[i].inverse().apply(properties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inverse returns the rule undoing this rule. The inverse of a Default rule removes the property, which is
+// represented as a Move without destination.
+func (r ConversionRule) inverse() ConversionRule {
+	switch {
+	case r.Rename != "":
+		return ConversionRule{Move: renamedPath(r.Rename, r.To), To: r.Rename}
+	case r.Move != "":
+		return ConversionRule{Move: r.To, To: r.Move}
+	default:
+		return ConversionRule{Move: r.Default}
+	}
+}
+
+// apply applies the rule to the properties in place.
+func (r ConversionRule) apply(properties map[string]any) error {
+	switch {
+	case r.Rename != "":
+		return moveProperty(properties, r.Rename, renamedPath(r.Rename, r.To))
+	case r.Move != "":
+		return moveProperty(properties, r.Move, r.To)
+	case r.Default != "":
+		if _, ok := getProperty(properties, r.Default); !ok {
+			return setProperty(properties, r.Default, r.Value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("conversion rule must set one of 'rename', 'move' or 'default'")
+	}
+}
+
+// validate checks that exactly one kind of rule is set and that it is complete.
+func (r ConversionRule) validate() error {
+	kinds := 0
+	for _, path := range []string{r.Rename, r.Move, r.Default} {
+		if path != "" {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("conversion rule must set exactly one of 'rename', 'move' or 'default'")
+	}
+
+	switch {
+	case r.Rename != "" && (r.To == "" || strings.Contains(r.To, ".")):
+		return fmt.Errorf("rename of %q must set 'to' to a property name", r.Rename)
+	case r.Move != "" && r.To == "":
+		return fmt.Errorf("move of %q must set 'to' to a property path", r.Move)
+	case r.Default != "" && r.Value == nil:
+		return fmt.Errorf("default of %q must set 'value'", r.Default)
+	}
+
+	return nil
+}
+
+// ExtractConversionRules returns the conversion rules declared in the schema of an API version, by the API version
+// they convert from.
+func ExtractConversionRules(schema map[string]any) (map[string][]ConversionRule, error) {
+	raw, ok := schema[annotationRadiusConversions]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	conversions := map[string][]ConversionRule{}
+	if err := json.Unmarshal(bs, &conversions); err != nil {
+		return nil, fmt.Errorf("%s must map API versions to lists of rules: %w", annotationRadiusConversions, err)
+	}
+
+	for from, rules := range conversions {
+		for i, rule := range rules {
+			if err := rule.validate(); err != nil {
+				return nil, fmt.Errorf("rule %d from API version %q: %w", i, from, err)
+			}
+		}
+	}
+
+	return conversions, nil
+}
+
+// renamedPath returns the path of the property at path once renamed to name.
+func renamedPath(path string, name string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i+1] + name
+	}
+	return name
+}
+
+// moveProperty moves the property at from to the path to. A missing property is ignored. An empty destination
+// removes the property.
+func moveProperty(properties map[string]any, from string, to string) error {
+	value, ok := getProperty(properties, from)
+	if !ok {
+		return nil
+	}
+
+	if to != "" {
+		if _, exists := getProperty(properties, to); exists {
+			return fmt.Errorf("cannot move property %q to %q: the property already exists", from, to)
+		}
+		if err := setProperty(properties, to, value); err != nil {
+			return err
+		}
+	}
+
+	deleteProperty(properties, from)
+	return nil
+}
+
+func getProperty(properties map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	value, ok := current[segments[len(segments)-1]]
+	return value, ok
+}
+
+func setProperty(properties map[string]any, path string, value any) error {
+	segments := strings.Split(path, ".")
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		existing, ok := current[segment]
+		if !ok || existing == nil {
+			next := map[string]any{}
+			current[segment] = next
+			current = next
+			continue
+		}
+
+		next, ok := existing.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot set property %q: %q is not an object", path, segment)
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+	return nil
+}
+
+// deleteProperty removes the property at path, and the objects left empty on its path.
+func deleteProperty(properties map[string]any, path string) {
+	segments := strings.SplitN(path, ".", 2)
+	if len(segments) == 1 {
+		delete(properties, path)
+		return
+	}
+
+	next, ok := properties[segments[0]].(map[string]any)
+	if !ok {
+		return
+	}
+
+	deleteProperty(next, segments[1])
+	if len(next) == 0 {
+		delete(properties, segments[0])
+	}
+}
+
+// containsProperties returns true if every property of expected is set to the same value in properties.
+func containsProperties(properties map[string]any, expected map[string]any) bool {
+	for key, value := range expected {
+		actual, ok := properties[key]
+		if !ok {
+			return false
+		}
+
+		expectedObject, ok := value.(map[string]any)
+		if !ok {
+			if !reflect.DeepEqual(actual, value) {
+				return false
+			}
+			continue
+		}
+
+		actualObject, ok := actual.(map[string]any)
+		if !ok || !containsProperties(actualObject, expectedObject) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func deepCopyProperties(properties map[string]any) (map[string]any, error) {
+	if properties == nil {
+		return map[string]any{}, nil
+	}
+
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := map[string]any{}
+	if err := json.Unmarshal(bs, &copied); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
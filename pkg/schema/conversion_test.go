@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+// testConversionSchemas returns schemas of three API versions: 2025-01-01 renames "host" to "hostname" and moves
+// "port" under "network", and 2026-01-01 adds "protocol" with a default value.
+func testConversionSchemas() map[string]map[string]any {
+	return map[string]map[string]any{
+		"2024-01-01": {
+			"type": "object",
+		},
+		"2025-01-01": {
+			"type": "object",
+			annotationRadiusConversions: map[string]any{
+				"2024-01-01": []any{
+					map[string]any{"rename": "host", "to": "hostname"},
+					map[string]any{"move": "port", "to": "network.port"},
+				},
+			},
+		},
+		"2026-01-01": {
+			"type": "object",
+			annotationRadiusConversions: map[string]any{
+				"2025-01-01": []any{
+					map[string]any{"default": "network.protocol", "value": "tcp"},
+				},
+			},
+		},
+	}
+}
+
+func TestConverter_Convert(t *testing.T) {
+	converter, err := NewConverter(testConversionSchemas(), "2026-01-01")
+	require.NoError(t, err)
+	require.True(t, converter.HasConversions())
+
+	tests := []struct {
+		name       string
+		from       string
+		to         string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "same version",
+			from:       "2024-01-01",
+			to:         "2024-01-01",
+			properties: map[string]any{"host": "localhost"},
+			expected:   map[string]any{"host": "localhost"},
+		},
+		{
+			name:       "rename and move",
+			from:       "2024-01-01",
+			to:         "2025-01-01",
+			properties: map[string]any{"host": "localhost", "port": float64(8080), "environment": "env"},
+			expected: map[string]any{
+				"hostname":    "localhost",
+				"network":     map[string]any{"port": float64(8080)},
+				"environment": "env",
+			},
+		},
+		{
+			name:       "reverse rename and move",
+			from:       "2025-01-01",
+			to:         "2024-01-01",
+			properties: map[string]any{"hostname": "localhost", "network": map[string]any{"port": float64(8080)}},
+			expected:   map[string]any{"host": "localhost", "port": float64(8080)},
+		},
+		{
+			name:       "chained conversion sets default",
+			from:       "2024-01-01",
+			to:         "2026-01-01",
+			properties: map[string]any{"host": "localhost", "port": float64(8080)},
+			expected: map[string]any{
+				"hostname": "localhost",
+				"network":  map[string]any{"port": float64(8080), "protocol": "tcp"},
+			},
+		},
+		{
+			name:       "default keeps existing value",
+			from:       "2025-01-01",
+			to:         "2026-01-01",
+			properties: map[string]any{"network": map[string]any{"protocol": "udp"}},
+			expected:   map[string]any{"network": map[string]any{"protocol": "udp"}},
+		},
+		{
+			name: "chained reverse conversion removes default",
+			from: "2026-01-01",
+			to:   "2024-01-01",
+			properties: map[string]any{
+				"hostname": "localhost",
+				"network":  map[string]any{"port": float64(8080), "protocol": "tcp"},
+			},
+			expected: map[string]any{"host": "localhost", "port": float64(8080)},
+		},
+		{
+			name:       "missing properties are ignored",
+			from:       "2024-01-01",
+			to:         "2025-01-01",
+			properties: map[string]any{"environment": "env"},
+			expected:   map[string]any{"environment": "env"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := converter.Convert(tc.properties, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, converted)
+		})
+	}
+}
+
+func TestConverter_Convert_DoesNotModifyInput(t *testing.T) {
+	converter, err := NewConverter(testConversionSchemas(), "")
+	require.NoError(t, err)
+
+	properties := map[string]any{"host": "localhost"}
+	_, err = converter.Convert(properties, "2024-01-01", "2025-01-01")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"host": "localhost"}, properties)
+}
+
+func TestConverter_Convert_Errors(t *testing.T) {
+	converter, err := NewConverter(testConversionSchemas(), "")
+	require.NoError(t, err)
+
+	t.Run("no conversion path", func(t *testing.T) {
+		require.False(t, converter.CanConvert("2024-01-01", "2023-01-01"))
+		_, err := converter.Convert(map[string]any{}, "2024-01-01", "2023-01-01")
+		require.EqualError(t, err, "no conversion is declared from API version \"2024-01-01\" to \"2023-01-01\"")
+	})
+
+	t.Run("destination exists", func(t *testing.T) {
+		_, err := converter.Convert(map[string]any{"host": "a", "hostname": "b"}, "2024-01-01", "2025-01-01")
+		require.EqualError(t, err, "cannot move property \"host\" to \"hostname\": the property already exists")
+	})
+
+	t.Run("destination parent is not an object", func(t *testing.T) {
+		_, err := converter.Convert(map[string]any{"port": float64(80), "network": "private"}, "2024-01-01", "2025-01-01")
+		require.EqualError(t, err, "cannot set property \"network.port\": \"network\" is not an object")
+	})
+}
+
+func TestConverter_StorageVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		defaultVersion string
+		expected       string
+	}{
+		{name: "oldest default version", defaultVersion: "2024-01-01", expected: "2026-01-01"},
+		{name: "intermediate default version", defaultVersion: "2025-01-01", expected: "2026-01-01"},
+		{name: "newest default version", defaultVersion: "2026-01-01", expected: "2026-01-01"},
+		{name: "no conversions", defaultVersion: "2023-01-01", expected: "2023-01-01"},
+		{name: "no default version", defaultVersion: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter, err := NewConverter(testConversionSchemas(), tt.defaultVersion)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, converter.StorageVersion)
+		})
+	}
+}
+
+func TestConverter_ConvertLossless(t *testing.T) {
+	converter, err := NewConverter(testConversionSchemas(), "")
+	require.NoError(t, err)
+
+	t.Run("newer version", func(t *testing.T) {
+		converted, err := converter.ConvertLossless(map[string]any{"host": "localhost"}, "2024-01-01", "2026-01-01")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"hostname": "localhost", "network": map[string]any{"protocol": "tcp"}}, converted)
+	})
+
+	t.Run("older version", func(t *testing.T) {
+		converted, err := converter.ConvertLossless(map[string]any{"hostname": "localhost"}, "2026-01-01", "2024-01-01")
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"host": "localhost"}, converted)
+	})
+
+	t.Run("older version loses properties", func(t *testing.T) {
+		properties := map[string]any{"hostname": "localhost", "network": map[string]any{"protocol": "udp"}}
+		_, err := converter.ConvertLossless(properties, "2026-01-01", "2024-01-01")
+		require.EqualError(t, err, "converting from API version \"2026-01-01\" to \"2024-01-01\" loses properties")
+	})
+}
+
+func TestConverter_Nil(t *testing.T) {
+	var converter *Converter
+	require.False(t, converter.HasConversions())
+	require.True(t, converter.CanConvert("2024-01-01", "2024-01-01"))
+	require.False(t, converter.CanConvert("2024-01-01", "2025-01-01"))
+}
+
+func TestExtractConversionRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		conversions any
+		expected    map[string][]ConversionRule
+		expectedErr string
+	}{
+		{
+			name:        "no conversions",
+			conversions: nil,
+			expected:    nil,
+		},
+		{
+			name: "valid rules",
+			conversions: map[string]any{
+				"2024-01-01": []any{
+					map[string]any{"rename": "config.host", "to": "hostname"},
+					map[string]any{"move": "port", "to": "network.port"},
+					map[string]any{"default": "replicas", "value": 1},
+				},
+			},
+			expected: map[string][]ConversionRule{
+				"2024-01-01": {
+					{Rename: "config.host", To: "hostname"},
+					{Move: "port", To: "network.port"},
+					{Default: "replicas", Value: float64(1)},
+				},
+			},
+		},
+		{
+			name:        "not a map",
+			conversions: []any{"2024-01-01"},
+			expectedErr: "x-radius-conversions must map API versions to lists of rules",
+		},
+		{
+			name: "several kinds",
+			conversions: map[string]any{
+				"2024-01-01": []any{map[string]any{"rename": "host", "move": "port", "to": "hostname"}},
+			},
+			expectedErr: "rule 0 from API version \"2024-01-01\": conversion rule must set exactly one of 'rename', 'move' or 'default'",
+		},
+		{
+			name: "no kind",
+			conversions: map[string]any{
+				"2024-01-01": []any{map[string]any{"to": "hostname"}},
+			},
+			expectedErr: "rule 0 from API version \"2024-01-01\": conversion rule must set exactly one of 'rename', 'move' or 'default'",
+		},
+		{
+			name: "rename to a path",
+			conversions: map[string]any{
+				"2024-01-01": []any{map[string]any{"rename": "host", "to": "config.hostname"}},
+			},
+			expectedErr: "rule 0 from API version \"2024-01-01\": rename of \"host\" must set 'to' to a property name",
+		},
+		{
+			name: "move without destination",
+			conversions: map[string]any{
+				"2024-01-01": []any{map[string]any{"move": "port"}},
+			},
+			expectedErr: "rule 0 from API version \"2024-01-01\": move of \"port\" must set 'to' to a property path",
+		},
+		{
+			name: "default without value",
+			conversions: map[string]any{
+				"2024-01-01": []any{map[string]any{"default": "replicas"}},
+			},
+			expectedErr: "rule 0 from API version \"2024-01-01\": default of \"replicas\" must set 'value'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema := map[string]any{"type": "object"}
+			if tc.conversions != nil {
+				schema[annotationRadiusConversions] = tc.conversions
+			}
+
+			rules, err := ExtractConversionRules(schema)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rules)
+		})
+	}
+}
+
+func TestGetConverter(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/planes/radius/local/resourceGroups/test/providers/Foo.Bar/myResources/test"
+
+	t.Run("nil client returns nil", func(t *testing.T) {
+		converter, err := GetConverter(ctx, nil, resourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+		require.Nil(t, converter)
+	})
+
+	t.Run("builds converter from UCP", func(t *testing.T) {
+		clientFactory, err := testConversionUCPClientFactory(testConversionSchemas(), "2026-01-01")
+		require.NoError(t, err)
+
+		converter, err := GetConverter(ctx, clientFactory, resourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+		require.Equal(t, "2026-01-01", converter.StorageVersion)
+		require.True(t, converter.CanConvert("2024-01-01", "2026-01-01"))
+	})
+
+	t.Run("invalid resource type", func(t *testing.T) {
+		clientFactory, err := testConversionUCPClientFactory(testConversionSchemas(), "2026-01-01")
+		require.NoError(t, err)
+
+		_, err = GetConverter(ctx, clientFactory, resourceID, "myResources")
+		require.EqualError(t, err, "invalid resource type \"myResources\"")
+	})
+}
+
+// testConversionUCPClientFactory creates a mock UCP client factory that lists the given schemas and returns the given
+// default API version.
+func testConversionUCPClientFactory(schemas map[string]map[string]any, defaultAPIVersion string) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse] {
+			page := v20231001preview.APIVersionsClientListResponse{}
+			for version, schema := range schemas {
+				page.Value = append(page.Value, &v20231001preview.APIVersionResource{
+					Name:       to.Ptr(version),
+					Properties: &v20231001preview.APIVersionProperties{Schema: schema},
+				})
+			}
+
+			resp := azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]{}
+			resp.AddPage(http.StatusOK, page, nil)
+			return resp
+		},
+	}
+
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], azfake.ErrorResponder) {
+			resp := azfake.Responder[v20231001preview.ResourceTypesClientGetResponse]{}
+			resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Properties: &v20231001preview.ResourceTypeProperties{
+						DefaultAPIVersion: to.Ptr(defaultAPIVersion),
+					},
+				},
+			}, nil)
+			return resp, azfake.ErrorResponder{}
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ApplyDefaults sets the properties that are missing from the resource and have a default value in the schema. Nested
+// objects are only defaulted when they are present, or when the default value of their parent creates them. Supports
+// object properties, array items, and additionalProperties (maps).
+func ApplyDefaults(properties map[string]any, schemaData any) error {
+	if schemaData == nil || properties == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return err
+	}
+
+	applyDefaults(openAPISchema, properties)
+	return nil
+}
+
+func applyDefaults(schema *openapi3.Schema, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, propRef := range schema.Properties {
+			propSchema := resolvedSchema(propRef)
+			if propSchema == nil {
+				continue
+			}
+
+			if _, ok := v[name]; !ok && propSchema.Default != nil {
+				v[name] = deepCopyValue(propSchema.Default)
+			}
+			if propValue, ok := v[name]; ok {
+				applyDefaults(propSchema, propValue)
+			}
+		}
+
+		if addPropSchema := resolvedSchema(schema.AdditionalProperties.Schema); addPropSchema != nil {
+			for name, propValue := range v {
+				if _, ok := schema.Properties[name]; !ok {
+					applyDefaults(addPropSchema, propValue)
+				}
+			}
+		}
+
+	case []any:
+		if itemSchema := resolvedSchema(schema.Items); itemSchema != nil {
+			for _, item := range v {
+				applyDefaults(itemSchema, item)
+			}
+		}
+	}
+}
+
+// RemoveReadOnlyProperties removes the properties marked readOnly in the schema from the resource, and returns their
+// paths. readOnly properties are computed by Radius and cannot be set by clients.
+func RemoveReadOnlyProperties(properties map[string]any, schemaData any) ([]string, error) {
+	if schemaData == nil || properties == nil {
+		return nil, nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	removeReadOnly(openAPISchema, properties, "", &removed)
+	return removed, nil
+}
+
+func removeReadOnly(schema *openapi3.Schema, value any, path string, removed *[]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, propRef := range schema.Properties {
+			propSchema := resolvedSchema(propRef)
+			propValue, ok := v[name]
+			if propSchema == nil || !ok {
+				continue
+			}
+
+			if propSchema.ReadOnly {
+				delete(v, name)
+				*removed = append(*removed, joinPath(path, name))
+				continue
+			}
+			removeReadOnly(propSchema, propValue, joinPath(path, name), removed)
+		}
+
+		if addPropSchema := resolvedSchema(schema.AdditionalProperties.Schema); addPropSchema != nil {
+			for name, propValue := range v {
+				if _, ok := schema.Properties[name]; !ok {
+					removeReadOnly(addPropSchema, propValue, joinPath(path, name), removed)
+				}
+			}
+		}
+
+	case []any:
+		if itemSchema := resolvedSchema(schema.Items); itemSchema != nil {
+			for _, item := range v {
+				removeReadOnly(itemSchema, item, joinPath(path, "*"), removed)
+			}
+		}
+	}
+}
+
+// ValidateImmutableProperties checks that an update does not change the properties marked with x-radius-immutable in
+// the schema. A property is immutable once it is set: it can be set by an update when the existing resource does not
+// have it, but it cannot be changed or removed afterwards. Returns a ValidationErrors listing the changed properties.
+func ValidateImmutableProperties(newProperties map[string]any, oldProperties map[string]any, schemaData any) error {
+	if schemaData == nil || oldProperties == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return err
+	}
+
+	if newProperties == nil {
+		newProperties = map[string]any{}
+	}
+
+	var errors ValidationErrors
+	validateImmutable(openAPISchema, newProperties, oldProperties, "", &errors)
+	if errors.HasErrors() {
+		return &errors
+	}
+
+	return nil
+}
+
+func validateImmutable(schema *openapi3.Schema, newValue any, oldValue any, path string, errors *ValidationErrors) {
+	if isImmutable(schema) {
+		if !reflect.DeepEqual(newValue, oldValue) {
+			errors.Add(NewConstraintError(path, "property is immutable and cannot be changed once set"))
+		}
+		return
+	}
+
+	switch oldV := oldValue.(type) {
+	case map[string]any:
+		newV, ok := newValue.(map[string]any)
+		if !ok {
+			newV = map[string]any{}
+		}
+
+		addPropSchema := resolvedSchema(schema.AdditionalProperties.Schema)
+		for name, oldPropValue := range oldV {
+			propSchema := addPropSchema
+			if propRef, ok := schema.Properties[name]; ok {
+				propSchema = resolvedSchema(propRef)
+			}
+			if propSchema == nil {
+				continue
+			}
+
+			// Removing a property removes the immutable properties it contains.
+			validateImmutable(propSchema, newV[name], oldPropValue, joinPath(path, name), errors)
+		}
+
+	case []any:
+		newV, _ := newValue.([]any)
+		itemSchema := resolvedSchema(schema.Items)
+		if itemSchema == nil {
+			return
+		}
+
+		for i := 0; i < len(oldV) && i < len(newV); i++ {
+			validateImmutable(itemSchema, newV[i], oldV[i], joinPath(path, "*"), errors)
+		}
+	}
+}
+
+// isImmutable returns true if the schema is marked with x-radius-immutable.
+func isImmutable(schema *openapi3.Schema) bool {
+	immutable, _ := schema.Extensions[annotationRadiusImmutable].(bool)
+	return immutable
+}
+
+// resolvedSchema returns the schema of a SchemaRef, or nil for references that are not resolved.
+func resolvedSchema(ref *openapi3.SchemaRef) *openapi3.Schema {
+	if ref == nil {
+		return nil
+	}
+	return ref.Value
+}
+
+// deepCopyValue copies a JSON value so that defaults are not shared between resources.
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, item := range v {
+			copied[key] = deepCopyValue(item)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, item := range v {
+			copied[i] = deepCopyValue(item)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testPropertiesSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"region": map[string]any{
+				"type":                    "string",
+				annotationRadiusImmutable: true,
+			},
+			"replicas": map[string]any{
+				"type":    "integer",
+				"default": 1,
+			},
+			"endpoint": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+			"network": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"protocol": map[string]any{
+						"type":    "string",
+						"default": "tcp",
+					},
+					"subnet": map[string]any{
+						"type":                    "string",
+						annotationRadiusImmutable: true,
+					},
+					"address": map[string]any{
+						"type":     "string",
+						"readOnly": true,
+					},
+				},
+			},
+			"ports": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"port": map[string]any{"type": "integer"},
+						"name": map[string]any{
+							"type":    "string",
+							"default": "http",
+						},
+					},
+				},
+			},
+			"labels": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"value": map[string]any{
+							"type":                    "string",
+							annotationRadiusImmutable: true,
+						},
+						"source": map[string]any{
+							"type":    "string",
+							"default": "user",
+						},
+					},
+				},
+			},
+			"tags": map[string]any{
+				"type":    "object",
+				"default": map[string]any{"managedBy": "radius"},
+			},
+		},
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "top-level defaults",
+			properties: map[string]any{"environment": "env"},
+			expected: map[string]any{
+				"environment": "env",
+				"replicas":    float64(1),
+				"tags":        map[string]any{"managedBy": "radius"},
+			},
+		},
+		{
+			name:       "existing values are kept",
+			properties: map[string]any{"replicas": float64(3), "tags": map[string]any{}},
+			expected:   map[string]any{"replicas": float64(3), "tags": map[string]any{}},
+		},
+		{
+			name: "nested, array item and map defaults",
+			properties: map[string]any{
+				"network": map[string]any{},
+				"ports":   []any{map[string]any{"port": float64(80)}, map[string]any{"port": float64(443), "name": "https"}},
+				"labels":  map[string]any{"team": map[string]any{"value": "a"}},
+			},
+			expected: map[string]any{
+				"replicas": float64(1),
+				"tags":     map[string]any{"managedBy": "radius"},
+				"network":  map[string]any{"protocol": "tcp"},
+				"ports": []any{
+					map[string]any{"port": float64(80), "name": "http"},
+					map[string]any{"port": float64(443), "name": "https"},
+				},
+				"labels": map[string]any{"team": map[string]any{"value": "a", "source": "user"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ApplyDefaults(tc.properties, testPropertiesSchema())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tc.properties)
+		})
+	}
+
+	t.Run("defaults are not shared between resources", func(t *testing.T) {
+		schema := testPropertiesSchema()
+		first := map[string]any{}
+		require.NoError(t, ApplyDefaults(first, schema))
+		first["tags"].(map[string]any)["managedBy"] = "user"
+
+		second := map[string]any{}
+		require.NoError(t, ApplyDefaults(second, schema))
+		require.Equal(t, map[string]any{"managedBy": "radius"}, second["tags"])
+	})
+
+	t.Run("nil schema", func(t *testing.T) {
+		properties := map[string]any{}
+		require.NoError(t, ApplyDefaults(properties, nil))
+		require.Empty(t, properties)
+	})
+}
+
+func TestRemoveReadOnlyProperties(t *testing.T) {
+	properties := map[string]any{
+		"environment": "env",
+		"endpoint":    "http://localhost",
+		"network": map[string]any{
+			"subnet":  "default",
+			"address": "10.0.0.1",
+		},
+	}
+
+	removed, err := RemoveReadOnlyProperties(properties, testPropertiesSchema())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"endpoint", "network.address"}, removed)
+	require.Equal(t, map[string]any{
+		"environment": "env",
+		"network":     map[string]any{"subnet": "default"},
+	}, properties)
+
+	t.Run("nil schema", func(t *testing.T) {
+		properties := map[string]any{"endpoint": "http://localhost"}
+		removed, err := RemoveReadOnlyProperties(properties, nil)
+		require.NoError(t, err)
+		require.Empty(t, removed)
+		require.Equal(t, map[string]any{"endpoint": "http://localhost"}, properties)
+	})
+}
+
+func TestValidateImmutableProperties(t *testing.T) {
+	old := map[string]any{
+		"environment": "env",
+		"region":      "westus",
+		"network":     map[string]any{"subnet": "default"},
+		"labels":      map[string]any{"team": map[string]any{"value": "a"}},
+	}
+
+	tests := []struct {
+		name        string
+		new         map[string]any
+		old         map[string]any
+		expectedErr []string
+	}{
+		{
+			name: "create",
+			new:  map[string]any{"region": "westus"},
+			old:  nil,
+		},
+		{
+			name: "unchanged",
+			new: map[string]any{
+				"environment": "other",
+				"region":      "westus",
+				"network":     map[string]any{"subnet": "default"},
+				"labels":      map[string]any{"team": map[string]any{"value": "a"}, "owner": map[string]any{"value": "b"}},
+			},
+			old: old,
+		},
+		{
+			name: "set immutable property missing from the existing resource",
+			new:  map[string]any{"region": "westus", "network": map[string]any{"subnet": "default"}, "labels": map[string]any{"team": map[string]any{"value": "a"}}},
+			old:  map[string]any{"region": "westus", "labels": map[string]any{"team": map[string]any{"value": "a"}}},
+		},
+		{
+			name: "changed",
+			new: map[string]any{
+				"region":  "eastus",
+				"network": map[string]any{"subnet": "other"},
+				"labels":  map[string]any{"team": map[string]any{"value": "b"}},
+			},
+			old:         old,
+			expectedErr: []string{"region", "network.subnet", "labels.team.value"},
+		},
+		{
+			name:        "removed",
+			new:         map[string]any{},
+			old:         old,
+			expectedErr: []string{"region", "network.subnet", "labels.team.value"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateImmutableProperties(tc.new, tc.old, testPropertiesSchema())
+			if len(tc.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErrors *ValidationErrors
+			require.ErrorAs(t, err, &validationErrors)
+
+			fields := []string{}
+			for _, e := range validationErrors.Errors {
+				require.Equal(t, ErrorTypeConstraint, e.Type)
+				require.Equal(t, "property is immutable and cannot be changed once set", e.Message)
+				fields = append(fields, e.Field)
+			}
+			require.ElementsMatch(t, tc.expectedErr, fields)
+		})
+	}
+}
@@ -40,6 +40,7 @@ const (
 // Constants for annotation names
 const (
 	annotationRadiusSensitive = "x-radius-sensitive"
+	annotationRadiusImmutable = "x-radius-immutable"
 )
 
 // joinPath concatenates two path segments with a dot separator for property path tracking.
@@ -349,6 +350,15 @@ func (v *Validator) validateRadiusConstraintsWithPath(schema *openapi3.Schema, p
 		}
 	}
 
+	// Check x-radius-immutable annotation constraints
+	if err := v.checkImmutableAnnotation(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
 	// Validate type constraints
 	if err := v.validateTypeConstraints(schema, path); err != nil {
 		if valErr, ok := err.(*ValidationError); ok {
@@ -594,6 +604,54 @@ func (v *Validator) checkSensitiveAnnotation(schema *openapi3.Schema, path strin
 	return nil
 }
 
+// checkImmutableAnnotation validates that x-radius-immutable annotation is a boolean and is not used on sensitive fields
+func (v *Validator) checkImmutableAnnotation(schema *openapi3.Schema, path string) error {
+	if schema.Extensions == nil {
+		return nil
+	}
+
+	immutable, exists := schema.Extensions[annotationRadiusImmutable]
+	if !exists {
+		return nil
+	}
+
+	boolVal, ok := immutable.(bool)
+	if !ok {
+		return NewConstraintError(path, fmt.Sprintf("%s must be a boolean value", annotationRadiusImmutable))
+	}
+
+	// Sensitive fields are encrypted before they are stored and redacted once the resource is deployed, so their
+	// stored value cannot be compared with the value of an update.
+	if boolVal && containsSensitiveField(schema) {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is not supported on fields marked with or containing fields marked with %s", annotationRadiusImmutable, annotationRadiusSensitive))
+	}
+
+	return nil
+}
+
+// containsSensitiveField returns true if the schema or one of its nested schemas is marked with x-radius-sensitive.
+func containsSensitiveField(schema *openapi3.Schema) bool {
+	if schema == nil {
+		return false
+	}
+
+	if sensitive, _ := schema.Extensions[annotationRadiusSensitive].(bool); sensitive {
+		return true
+	}
+
+	for _, propRef := range schema.Properties {
+		if propRef != nil && containsSensitiveField(propRef.Value) {
+			return true
+		}
+	}
+
+	if addPropSchema := schema.AdditionalProperties.Schema; addPropSchema != nil && containsSensitiveField(addPropSchema.Value) {
+		return true
+	}
+
+	return schema.Items != nil && containsSensitiveField(schema.Items.Value)
+}
+
 // isInternalRef checks if a $ref is an internal reference within the same document
 func (v *Validator) isInternalRef(ref string) bool {
 	// Internal references start with "#/" which means they reference within the same document
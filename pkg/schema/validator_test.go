@@ -2340,6 +2340,176 @@ func TestValidator_checkSensitiveAnnotation(t *testing.T) {
 	}
 }
 
+func TestValidator_checkImmutableAnnotation(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		path   string
+		hasErr bool
+		errMsg string
+	}{
+		{
+			name: "x-radius-immutable on string type - valid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+				},
+			},
+			path:   "region",
+			hasErr: false,
+		},
+		{
+			name: "x-radius-immutable on object type - valid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				Properties: openapi3.Schemas{
+					"zone": {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+				},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+				},
+			},
+			path:   "placement",
+			hasErr: false,
+		},
+		{
+			name: "x-radius-immutable with string value - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: "true",
+				},
+			},
+			path:   "region",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s must be a boolean value", annotationRadiusImmutable),
+		},
+		{
+			name: "x-radius-immutable on sensitive field - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+					annotationRadiusSensitive: true,
+				},
+			},
+			path:   "password",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation is not supported on fields marked with or containing fields marked with %s", annotationRadiusImmutable, annotationRadiusSensitive),
+		},
+		{
+			name: "x-radius-immutable on object containing sensitive field - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				Properties: openapi3.Schemas{
+					"password": {Value: &openapi3.Schema{
+						Type:       &openapi3.Types{"string"},
+						Extensions: map[string]any{annotationRadiusSensitive: true},
+					}},
+				},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+				},
+			},
+			path:   "credentials",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation is not supported on fields marked with or containing fields marked with %s", annotationRadiusImmutable, annotationRadiusSensitive),
+		},
+		{
+			name: "x-radius-immutable false on sensitive field - valid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: false,
+					annotationRadiusSensitive: true,
+				},
+			},
+			path:   "password",
+			hasErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkImmutableAnnotation(tt.schema, tt.path)
+			if tt.hasErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				var constraintErr *ValidationError
+				require.ErrorAs(t, err, &constraintErr)
+				require.Equal(t, ErrorTypeConstraint, constraintErr.Type)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_WithImmutableAnnotation(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	t.Run("valid immutable, readOnly and default properties", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"region": {
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"string"},
+						Extensions: map[string]any{
+							annotationRadiusImmutable: true,
+						},
+					},
+				},
+				"replicas": {
+					Value: &openapi3.Schema{
+						Type:    &openapi3.Types{"integer"},
+						Default: 1,
+					},
+				},
+				"endpoint": {
+					Value: &openapi3.Schema{
+						Type:     &openapi3.Types{"string"},
+						ReadOnly: true,
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid immutable sensitive property", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"password": {
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"string"},
+						Extensions: map[string]any{
+							annotationRadiusImmutable: true,
+							annotationRadiusSensitive: true,
+						},
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "password")
+		require.Contains(t, err.Error(), fmt.Sprintf("%s annotation is not supported", annotationRadiusImmutable))
+	})
+}
+
 func TestValidator_ValidateSchema_WithSensitiveAnnotation(t *testing.T) {
 	validator := NewValidator()
 	ctx := context.Background()
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// annotationRadiusValidationWebhook is the schema annotation declaring the admission webhook that validates the
+	// resources of an API version. It is set at the root of the schema.
+	annotationRadiusValidationWebhook = "x-radius-validation-webhook"
+
+	// DefaultWebhookTimeout is the timeout of a validation webhook call when the webhook does not declare one.
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// MaxWebhookTimeoutSeconds is the largest timeout a validation webhook can declare.
+	MaxWebhookTimeoutSeconds = 30
+)
+
+// FailurePolicy defines how a request is handled when the validation webhook cannot be called or returns an invalid
+// response.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail rejects the request. This is the default.
+	FailurePolicyFail FailurePolicy = "Fail"
+
+	// FailurePolicyIgnore accepts the request as if the webhook allowed it.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// ValidationWebhook is an HTTP admission webhook that validates the resources of an API version on create and update,
+// in addition to the schema. The webhook receives an AdmissionReview and decides whether the request is allowed.
+type ValidationWebhook struct {
+	// URL is the absolute https URL the AdmissionReview is posted to.
+	URL string `json:"url"`
+
+	// CABundle is the PEM encoded CA bundle used to verify the certificate of the webhook, base64 encoded in JSON.
+	// The system trust roots are used when it is not set.
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// TimeoutSeconds is the timeout of the call, between 1 and MaxWebhookTimeoutSeconds. DefaultWebhookTimeout is used
+	// when it is not set.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy defines how the request is handled when the call fails. FailurePolicyFail is used when it is not
+	// set.
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// Timeout returns the timeout of the webhook call.
+func (w *ValidationWebhook) Timeout() time.Duration {
+	if w.TimeoutSeconds == nil {
+		return DefaultWebhookTimeout
+	}
+	return time.Duration(*w.TimeoutSeconds) * time.Second
+}
+
+// IgnoreFailures returns true if the request is accepted when the webhook call fails.
+func (w *ValidationWebhook) IgnoreFailures() bool {
+	return w.FailurePolicy == FailurePolicyIgnore
+}
+
+// CertPool returns the CA certificates of CABundle, or nil if CABundle is not set.
+func (w *ValidationWebhook) CertPool() (*x509.CertPool, error) {
+	if len(w.CABundle) == 0 {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(w.CABundle) {
+		return nil, fmt.Errorf("caBundle must contain PEM encoded certificates")
+	}
+
+	return pool, nil
+}
+
+// validate checks that the webhook is complete and its settings are in range.
+func (w *ValidationWebhook) validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("url must be an absolute URL, got %q", w.URL)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("url must use the https scheme, got %q", u.Scheme)
+	}
+
+	if len(w.CABundle) > 0 {
+		if _, err := w.CertPool(); err != nil {
+			return err
+		}
+	}
+
+	if w.TimeoutSeconds != nil && (*w.TimeoutSeconds < 1 || *w.TimeoutSeconds > MaxWebhookTimeoutSeconds) {
+		return fmt.Errorf("timeoutSeconds must be between 1 and %d, got %d", MaxWebhookTimeoutSeconds, *w.TimeoutSeconds)
+	}
+
+	switch w.FailurePolicy {
+	case "", FailurePolicyFail, FailurePolicyIgnore:
+	default:
+		return fmt.Errorf("failurePolicy must be %q or %q, got %q", FailurePolicyFail, FailurePolicyIgnore, w.FailurePolicy)
+	}
+
+	return nil
+}
+
+// ExtractValidationWebhook returns the validation webhook declared in the schema of an API version, or nil if the
+// schema does not declare one.
+func ExtractValidationWebhook(schema map[string]any) (*ValidationWebhook, error) {
+	raw, ok := schema[annotationRadiusValidationWebhook]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &ValidationWebhook{}
+	if err := json.Unmarshal(bs, webhook); err != nil {
+		return nil, fmt.Errorf("%s must be an object with a url: %w", annotationRadiusValidationWebhook, err)
+	}
+
+	if err := webhook.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", annotationRadiusValidationWebhook, err)
+	}
+
+	return webhook, nil
+}
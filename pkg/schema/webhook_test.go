@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCABundle is a self-signed certificate for policy.radius-system.svc.
+const testCABundle = `-----BEGIN CERTIFICATE-----
+MIIBnTCCAUOgAwIBAgIUIuhhKKmTK5rNJNlubb9tQlTMX9QwCgYIKoZIzj0EAwIw
+IzEhMB8GA1UEAwwYcG9saWN5LnJhZGl1cy1zeXN0ZW0uc3ZjMCAXDTI2MTAxODIx
+MzMwNFoYDzIxMjYwOTI0MjEzMzA0WjAjMSEwHwYDVQQDDBhwb2xpY3kucmFkaXVz
+LXN5c3RlbS5zdmMwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNCAAQdFbd5wtMDIGNb
+HXsblGA3vFnkayp+5mxzKztXpZznt6tnTXlMAGn7CtTv1kBSUhycuAiPFvmUIXgX
+MecvSdC5o1MwUTAdBgNVHQ4EFgQUKjMKQocVddjA8N0pUmjiOCNQfw0wHwYDVR0j
+BBgwFoAUKjMKQocVddjA8N0pUmjiOCNQfw0wDwYDVR0TAQH/BAUwAwEB/zAKBggq
+hkjOPQQDAgNIADBFAiBJu6j/z57MziA3ONUVrNMXTBJ7IWujr3C4l4kmV6RjggIh
+AL25ebv7ubIVYN14PyLjNT7igQf9uAaFNL9/Zw7G9q6R
+-----END CERTIFICATE-----
+`
+
+var testCABundleBase64 = base64.StdEncoding.EncodeToString([]byte(testCABundle))
+
+func TestExtractValidationWebhook(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   map[string]any
+		expected *ValidationWebhook
+		err      string
+	}{
+		{
+			name:   "no webhook",
+			schema: map[string]any{"type": "object"},
+		},
+		{
+			name: "defaults",
+			schema: map[string]any{
+				"x-radius-validation-webhook": map[string]any{"url": "https://policy.example.com/validate"},
+			},
+			expected: &ValidationWebhook{URL: "https://policy.example.com/validate"},
+		},
+		{
+			name: "all settings",
+			schema: map[string]any{
+				"x-radius-validation-webhook": map[string]any{
+					"url":            "https://policy.radius-system.svc:8443/validate",
+					"caBundle":       testCABundleBase64,
+					"timeoutSeconds": 5,
+					"failurePolicy":  "Ignore",
+				},
+			},
+			expected: &ValidationWebhook{URL: "https://policy.radius-system.svc:8443/validate", CABundle: []byte(testCABundle), TimeoutSeconds: new(5), FailurePolicy: FailurePolicyIgnore},
+		},
+		{
+			name:   "not an object",
+			schema: map[string]any{"x-radius-validation-webhook": "https://policy.example.com"},
+			err:    "x-radius-validation-webhook must be an object with a url",
+		},
+		{
+			name:   "missing url",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{}},
+			err:    "url must be an absolute URL",
+		},
+		{
+			name:   "relative url",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "/validate"}},
+			err:    "url must be an absolute URL",
+		},
+		{
+			name:   "unsupported scheme",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "ftp://policy.example.com"}},
+			err:    "url must use the https scheme",
+		},
+		{
+			name:   "http url",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "http://policy.example.com"}},
+			err:    "url must use the https scheme",
+		},
+		{
+			name:   "invalid ca bundle",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "https://policy.example.com", "caBundle": base64.StdEncoding.EncodeToString([]byte("not a certificate"))}},
+			err:    "caBundle must contain PEM encoded certificates",
+		},
+		{
+			name:   "timeout out of range",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "https://policy.example.com", "timeoutSeconds": 31}},
+			err:    "timeoutSeconds must be between 1 and 30",
+		},
+		{
+			name:   "unknown failure policy",
+			schema: map[string]any{"x-radius-validation-webhook": map[string]any{"url": "https://policy.example.com", "failurePolicy": "Retry"}},
+			err:    "failurePolicy must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook, err := ExtractValidationWebhook(tt.schema)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, webhook)
+		})
+	}
+}
+
+func TestValidationWebhook_Settings(t *testing.T) {
+	webhook := &ValidationWebhook{URL: "https://policy.example.com"}
+	require.Equal(t, DefaultWebhookTimeout, webhook.Timeout())
+	require.False(t, webhook.IgnoreFailures())
+
+	webhook = &ValidationWebhook{URL: "https://policy.example.com", TimeoutSeconds: new(3), FailurePolicy: FailurePolicyIgnore}
+	require.Equal(t, 3*time.Second, webhook.Timeout())
+	require.True(t, webhook.IgnoreFailures())
+}
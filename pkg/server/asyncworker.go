@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
@@ -59,11 +60,8 @@ func (w *AsyncWorker) Name() string {
 func (w *AsyncWorker) init(ctx context.Context) error {
 	workerOptions := worker.Options{}
 	if w.options.Config.WorkerServer != nil {
-		if w.options.Config.WorkerServer.MaxOperationConcurrency != nil {
-			workerOptions.MaxOperationConcurrency = *w.options.Config.WorkerServer.MaxOperationConcurrency
-		}
-		if w.options.Config.WorkerServer.MaxOperationRetryCount != nil {
-			workerOptions.MaxOperationRetryCount = *w.options.Config.WorkerServer.MaxOperationRetryCount
+		if err := workerOptions.ApplyConfig(*w.options.Config.WorkerServer); err != nil {
+			return err
 		}
 	}
 
@@ -80,10 +78,16 @@ func (w *AsyncWorker) init(ctx context.Context) error {
 		return err
 	}
 
-	statusManager := statusmanager.New(databaseClient, queueClient, w.options.Config.Env.RoleLocation)
+	historyRecorder, err := history.FromOptions(databaseClient, w.options.Config.Env.RoleLocation, w.options.Config.OperationHistory)
+	if err != nil {
+		return err
+	}
+
+	statusManager := statusmanager.New(databaseClient, queueClient, w.options.Config.Env.RoleLocation, statusmanager.WithHistory(historyRecorder))
 
 	w.Service = worker.Service{
 		DatabaseClient:         databaseClient,
+		HistoryRecorder:        historyRecorder,
 		OperationStatusManager: statusManager,
 		Options:                workerOptions,
 		QueueClient:            queueClient,
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	extenders_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/recipes/drift"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// portableResourceTypes are the resource types of applications-rp that can be deployed by a recipe.
+var portableResourceTypes = drift.StaticResourceTypes{
+	extenders_ctrl.ResourceTypeName,
+	dapr_ctrl.DaprConfigurationStoresResourceType,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	msg_ctrl.RabbitMQQueuesResourceType,
+}
+
+// NewDriftDetectionService creates the service detecting the drift of the infrastructure deployed by the recipes of
+// portable resources. It returns nil if drift detection is disabled.
+func NewDriftDetectionService(options hostoptions.HostOptions) *drift.Service {
+	return drift.NewService("applications-rp recipe drift detection", "applications-rp-drift-detection", options.Config.DriftDetection, func(ctx context.Context) (*drift.Job, error) {
+		databaseClient, err := databaseprovider.FromOptions(options.Config.DatabaseProvider).GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := controllerconfig.New(options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &drift.Job{
+			DatabaseClient: databaseClient,
+			Engine:         config.Engine,
+			ResourceTypes:  portableResourceTypes,
+		}, nil
+	}, func() (k8s.Interface, error) {
+		return k8s.NewForConfig(options.K8sConfig)
+	})
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin contains the types of UCP's administrative API, which is used by operators (through `rad admin`)
+// to inspect and repair the state of a Radius installation. The API is implemented in pkg/ucp/frontend/admin.
+package admin
+
+import (
+	"time"
+)
+
+const (
+	// QueuesPath is the path of the queue collection, relative to UCP's path base.
+	QueuesPath = "/admin/queues"
+
+	// DeadLetterPathSegment is the path segment of the dead-letter sub-queue of a queue. For example:
+	// "/admin/queues/radius/deadletter".
+	DeadLetterPathSegment = "deadletter"
+
+	// RequeuePathSegment is the path segment of the requeue action on a dead-lettered message. For example:
+	// "/admin/queues/radius/deadletter/{message}/requeue".
+	RequeuePathSegment = "requeue"
+)
+
+// Queue describes an async operation queue.
+type Queue struct {
+	// Name is the name of the queue.
+	Name string `json:"name"`
+
+	// DeadLetterSupported is true when the queue provider supports a dead-letter sub-queue.
+	DeadLetterSupported bool `json:"deadLetterSupported"`
+
+	// DeadLetterCount is the number of messages in the dead-letter sub-queue.
+	DeadLetterCount int `json:"deadLetterCount"`
+}
+
+// QueueList is the response body for listing queues.
+type QueueList struct {
+	// Value is the list of queues.
+	Value []Queue `json:"value"`
+}
+
+// DeadLetterMessage describes a message in a dead-letter sub-queue.
+type DeadLetterMessage struct {
+	// ID is the id of the message.
+	ID string `json:"id"`
+
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueueAt is the time the message was enqueued.
+	EnqueueAt time.Time `json:"enqueueAt"`
+
+	// Reason is the reason the message was dead-lettered.
+	Reason string `json:"reason"`
+
+	// ResourceID is the id of the resource the async operation applies to.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// OperationID is the id of the async operation.
+	OperationID string `json:"operationId,omitempty"`
+
+	// OperationType is the type of the async operation, for example "APPLICATIONS.CORE/CONTAINERS|PUT".
+	OperationType string `json:"operationType,omitempty"`
+}
+
+// DeadLetterMessageList is the response body for listing dead-lettered messages.
+type DeadLetterMessageList struct {
+	// Value is the list of dead-lettered messages.
+	Value []DeadLetterMessage `json:"value"`
+}
+
+// PurgeResult is the response body for deleting all dead-lettered messages of a queue.
+type PurgeResult struct {
+	// Deleted is the number of messages that were deleted.
+	Deleted int `json:"deleted"`
+}
@@ -64,7 +64,11 @@ func resourceProviderSummaryIDFromRequest(request *ctrl.Request) (resources.ID,
 }
 
 // updateResourceProviderSummaryWithETag updates the summary with the provided function and saves it to the database client.
-func updateResourceProviderSummaryWithETag(ctx context.Context, client database.Client, summaryID resources.ID, policy summaryNotFoundPolicy, update func(summary *datamodel.ResourceProviderSummary) error) error {
+//
+// The additional operations are applied in the same transaction as the summary update, so that the summary stays
+// consistent with the resources it describes. They are still applied when the summary is not found and the policy
+// is summaryNotFoundIgnore.
+func updateResourceProviderSummaryWithETag(ctx context.Context, client database.Client, summaryID resources.ID, policy summaryNotFoundPolicy, update func(summary *datamodel.ResourceProviderSummary) error, additional ...database.Operation) error {
 	// There are a few cases here:
 	// 1. The summary does not exist and we are allowed to create it (in the resource provider).
 	// 2. The summary does not exist and we are not allowed to create it (in the child-types of resource provider).
@@ -84,7 +88,7 @@ func updateResourceProviderSummaryWithETag(ctx context.Context, client database.
 			},
 		}
 	} else if errors.Is(err, &database.ErrNotFound{}) && policy == summaryNotFoundIgnore {
-		return nil
+		return database.ExecuteTransaction(ctx, client, additional)
 	} else if errors.Is(err, &database.ErrNotFound{}) {
 		return err
 	} else if err != nil {
@@ -110,7 +114,8 @@ func updateResourceProviderSummaryWithETag(ctx context.Context, client database.
 	}
 
 	obj.Data = summary
-	err = client.Save(ctx, obj, options...)
+	operations := append([]database.Operation{database.SaveOperation(obj, options...)}, additional...)
+	err = database.ExecuteTransaction(ctx, client, operations)
 	if err != nil {
 		return err
 	}
@@ -58,11 +58,8 @@ func (w *Service) Name() string {
 
 // Run starts the background worker.
 func (w *Service) Run(ctx context.Context) error {
-	if w.options.Config.Worker.MaxOperationConcurrency != nil {
-		w.Service.Options.MaxOperationConcurrency = *w.options.Config.Worker.MaxOperationConcurrency
-	}
-	if w.options.Config.Worker.MaxOperationRetryCount != nil {
-		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
+	if err := w.Service.Options.ApplyConfig(w.options.Config.Worker); err != nil {
+		return err
 	}
 
 	databaseClient, err := w.options.DatabaseProvider.GetClient(ctx)
@@ -77,6 +74,7 @@ func (w *Service) Run(ctx context.Context) error {
 
 	w.Service.DatabaseClient = databaseClient
 	w.Service.QueueClient = queueClient
+	w.Service.HistoryRecorder = w.options.HistoryRecorder
 	w.Service.OperationStatusManager = w.options.StatusManager
 
 	opts := ctrl.Options{
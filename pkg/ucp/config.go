@@ -35,6 +35,9 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Admin is the configuration for the administrative API.
+	Admin AdminConfig `yaml:"admin"`
+
 	// Database is the configuration for the database used for resource data.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
@@ -53,6 +56,9 @@ type Config struct {
 	// Metrics is the configuration for the metrics endpoint.
 	Metrics metricsservice.Options `yaml:"metricsProvider"`
 
+	// OperationHistory is the configuration for the durable history of async operations.
+	OperationHistory hostoptions.OperationHistoryOptions `yaml:"operationHistory"`
+
 	// Profiler is the configuration for the profiler endpoint.
 	Profiler profilerservice.Options `yaml:"profilerProvider"`
 
@@ -92,11 +98,31 @@ type IdentityConfig struct {
 	AuthMethod string `yaml:"authMethod"`
 }
 
+// AdminConfig provides configuration for UCP's administrative API.
+type AdminConfig struct {
+	// Queues is the list of async operation queues, in addition to UCP's own queue, that can be managed with the
+	// administrative API. For example, the queues of applications-rp and dynamic-rp. The queues must be reachable with
+	// UCP's queue provider configuration.
+	Queues []string `yaml:"queues,omitempty"`
+
+	// AllowedPrincipals is the list of client identities, as authenticated by the Kubernetes API server, that are
+	// allowed to use the administrative API. When empty, any authenticated client is allowed.
+	AllowedPrincipals []string `yaml:"allowedPrincipals,omitempty"`
+
+	// AllowUnauthenticated allows clients without an authenticated identity to use the administrative API. This is
+	// intended for local development only, where UCP is not behind the Kubernetes API server.
+	AllowUnauthenticated bool `yaml:"allowUnauthenticated,omitempty"`
+}
+
 // RoutingConfig provides configuration for UCP routing.
 type RoutingConfig struct {
 	// DefaultDownstreamEndpoint is the default destination when a resource provider does not provide a downstream endpoint.
 	// In practice, this points to the URL of dynamic-rp.
 	DefaultDownstreamEndpoint string `yaml:"defaultDownstreamEndpoint"`
+
+	// ClientIdentity is the configuration for signing the client identity of the requests proxied to the resource
+	// providers.
+	ClientIdentity hostoptions.ClientIdentityOptions `yaml:"clientIdentity"`
 }
 
 // InitializeConfig defines the configuration for initializing the UCP server.
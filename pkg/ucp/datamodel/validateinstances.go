@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+const (
+	// ValidateInstancesActionName is the name of the action that validates the stored resources of a resource type
+	// against the schema of one of its API versions.
+	ValidateInstancesActionName = "validateInstances"
+)
+
+// ValidateInstancesRequest is the request body of the validateInstances action.
+type ValidateInstancesRequest struct {
+	// Schema is the proposed schema of the API version. The stored schema of the API version is used when it is
+	// not set.
+	Schema map[string]any `json:"schema,omitempty"`
+}
+
+// ValidateInstancesResult is the response body of the validateInstances action.
+type ValidateInstancesResult struct {
+	// ResourceType is the fully-qualified resource type, e.g. "Applications.Test/testResources".
+	ResourceType string `json:"resourceType"`
+
+	// APIVersion is the API version whose schema the resources were validated against.
+	APIVersion string `json:"apiVersion"`
+
+	// Validated is the number of resources that were validated, including the invalid ones.
+	Validated int `json:"validated"`
+
+	// Invalid lists the resources that do not satisfy the schema.
+	Invalid []InvalidInstance `json:"invalid"`
+
+	// Skipped lists the resources that could not be validated.
+	Skipped []SkippedInstance `json:"skipped"`
+}
+
+// InvalidInstance describes a stored resource that does not satisfy the schema.
+type InvalidInstance struct {
+	// ID is the resource ID.
+	ID string `json:"id"`
+
+	// Message describes why the resource is invalid.
+	Message string `json:"message"`
+}
+
+// SkippedInstance describes a stored resource that could not be validated, because it is stored with another API
+// version and cannot be converted to the validated one.
+type SkippedInstance struct {
+	// ID is the resource ID.
+	ID string `json:"id"`
+
+	// APIVersion is the API version the resource is stored with.
+	APIVersion string `json:"apiVersion"`
+
+	// Message describes why the resource was skipped.
+	Message string `json:"message"`
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin implements UCP's administrative API. The administrative API is not an ARM-style resource API: it is
+// used by operators (through `rad admin`) to inspect and repair the state of a Radius installation.
+//
+// The following routes are registered relative to UCP's path base:
+//
+//	GET    /admin/queues                                      - lists the async operation queues
+//	GET    /admin/queues/{queue}/deadletter                   - lists the dead-lettered messages of a queue
+//	DELETE /admin/queues/{queue}/deadletter                   - deletes all dead-lettered messages of a queue
+//	POST   /admin/queues/{queue}/deadletter/{message}/requeue - moves a dead-lettered message back to its queue
+//	DELETE /admin/queues/{queue}/deadletter/{message}         - deletes a dead-lettered message
+//
+// Requests must carry the client identity verified by the Kubernetes API server, see middleware.ClientIdentity.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/go-chi/chi/v5"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	adminapi "github.com/radius-project/radius/pkg/ucp/admin"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// NewQueueClients creates clients for the queues that can be managed with the administrative API. Every queue uses
+// the provider configured by options, and the queue named by options is always included.
+func NewQueueClients(ctx context.Context, options queueprovider.QueueProviderOptions, names []string) (map[string]queue.Client, error) {
+	queues := map[string]queue.Client{}
+	for _, name := range append([]string{options.Name}, names...) {
+		if _, ok := queues[name]; ok || name == "" {
+			continue
+		}
+
+		opts := options
+		opts.Name = name
+		client, err := queueprovider.New(opts).GetClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for queue %q: %w", name, err)
+		}
+		queues[name] = client
+	}
+
+	return queues, nil
+}
+
+// Options are the options for the administrative API.
+type Options struct {
+	// Queues are the queues that can be managed, by name.
+	Queues map[string]queue.Client
+
+	// AllowedPrincipals is the list of client identities allowed to use the administrative API. When empty, any
+	// authenticated client is allowed.
+	AllowedPrincipals []string
+
+	// AllowUnauthenticated allows clients without an authenticated identity. This is intended for local development only.
+	AllowUnauthenticated bool
+}
+
+// Register registers the routes of the administrative API.
+func Register(router chi.Router, pathBase string, options Options) {
+	h := &handler{
+		queues:               options.Queues,
+		allowedPrincipals:    options.AllowedPrincipals,
+		allowUnauthenticated: options.AllowUnauthenticated,
+	}
+
+	router.Route(pathBase+adminapi.QueuesPath, func(r chi.Router) {
+		r.Use(h.authorize)
+		r.Get("/", h.listQueues)
+		r.Get("/{queue}/"+adminapi.DeadLetterPathSegment, h.listDeadLetterMessages)
+		r.Delete("/{queue}/"+adminapi.DeadLetterPathSegment, h.purgeDeadLetterMessages)
+		r.Post("/{queue}/"+adminapi.DeadLetterPathSegment+"/{message}/"+adminapi.RequeuePathSegment, h.requeueDeadLetterMessage)
+		r.Delete("/{queue}/"+adminapi.DeadLetterPathSegment+"/{message}", h.deleteDeadLetterMessage)
+	})
+}
+
+type handler struct {
+	queues               map[string]queue.Client
+	allowedPrincipals    []string
+	allowUnauthenticated bool
+}
+
+// authorize is the middleware that rejects requests from clients that are not allowed to use the administrative API.
+// The client identity header is set by middleware.ClientIdentity only for authenticated clients.
+func (h *handler) authorize(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if h.allowUnauthenticated {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		principal := r.Header.Get(v1.ClientPrincipalNameHeader)
+		if principal == "" {
+			h.apply(w, r, rest.NewClientAuthenticationFailedARMResponse())
+			return
+		}
+
+		if len(h.allowedPrincipals) > 0 && !slices.Contains(h.allowedPrincipals, principal) {
+			ucplog.FromContextOrDiscard(r.Context()).Info("Rejected administrative API request", "principal", principal)
+			h.apply(w, r, forbidden(principal))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+func (h *handler) listQueues(w http.ResponseWriter, r *http.Request) {
+	result := adminapi.QueueList{Value: []adminapi.Queue{}}
+	for name, client := range h.queues {
+		q := adminapi.Queue{Name: name}
+		if dlc, ok := client.(queue.DeadLetterClient); ok {
+			messages, err := dlc.ListDeadLetterMessages(r.Context())
+			if err != nil {
+				h.apply(w, r, internalError(err))
+				return
+			}
+			q.DeadLetterSupported = true
+			q.DeadLetterCount = len(messages)
+		}
+		result.Value = append(result.Value, q)
+	}
+
+	sort.Slice(result.Value, func(i, j int) bool {
+		return result.Value[i].Name < result.Value[j].Name
+	})
+
+	h.apply(w, r, rest.NewOKResponse(result))
+}
+
+func (h *handler) listDeadLetterMessages(w http.ResponseWriter, r *http.Request) {
+	dlc, resp := h.deadLetterClient(r)
+	if resp != nil {
+		h.apply(w, r, resp)
+		return
+	}
+
+	messages, err := dlc.ListDeadLetterMessages(r.Context())
+	if err != nil {
+		h.apply(w, r, internalError(err))
+		return
+	}
+
+	result := adminapi.DeadLetterMessageList{Value: []adminapi.DeadLetterMessage{}}
+	for _, msg := range messages {
+		result.Value = append(result.Value, toDeadLetterMessage(msg))
+	}
+
+	h.apply(w, r, rest.NewOKResponse(result))
+}
+
+func (h *handler) purgeDeadLetterMessages(w http.ResponseWriter, r *http.Request) {
+	dlc, resp := h.deadLetterClient(r)
+	if resp != nil {
+		h.apply(w, r, resp)
+		return
+	}
+
+	messages, err := dlc.ListDeadLetterMessages(r.Context())
+	if err != nil {
+		h.apply(w, r, internalError(err))
+		return
+	}
+
+	result := adminapi.PurgeResult{}
+	for _, msg := range messages {
+		err := dlc.DeleteDeadLetterMessage(r.Context(), msg.ID)
+		if errors.Is(err, queue.ErrMessageNotFound) {
+			// Deleted or requeued concurrently.
+			continue
+		} else if err != nil {
+			h.apply(w, r, internalError(err))
+			return
+		}
+		result.Deleted++
+	}
+
+	h.apply(w, r, rest.NewOKResponse(result))
+}
+
+func (h *handler) requeueDeadLetterMessage(w http.ResponseWriter, r *http.Request) {
+	dlc, resp := h.deadLetterClient(r)
+	if resp != nil {
+		h.apply(w, r, resp)
+		return
+	}
+
+	id := chi.URLParam(r, "message")
+	err := dlc.RequeueDeadLetterMessage(r.Context(), id)
+	if errors.Is(err, queue.ErrMessageNotFound) {
+		h.apply(w, r, messageNotFound(id))
+		return
+	} else if err != nil {
+		h.apply(w, r, internalError(err))
+		return
+	}
+
+	ucplog.FromContextOrDiscard(r.Context()).Info("Requeued dead-lettered message", "queue", chi.URLParam(r, "queue"), "id", id)
+	h.apply(w, r, rest.NewNoContentResponse())
+}
+
+func (h *handler) deleteDeadLetterMessage(w http.ResponseWriter, r *http.Request) {
+	dlc, resp := h.deadLetterClient(r)
+	if resp != nil {
+		h.apply(w, r, resp)
+		return
+	}
+
+	id := chi.URLParam(r, "message")
+	err := dlc.DeleteDeadLetterMessage(r.Context(), id)
+	if errors.Is(err, queue.ErrMessageNotFound) {
+		h.apply(w, r, messageNotFound(id))
+		return
+	} else if err != nil {
+		h.apply(w, r, internalError(err))
+		return
+	}
+
+	ucplog.FromContextOrDiscard(r.Context()).Info("Deleted dead-lettered message", "queue", chi.URLParam(r, "queue"), "id", id)
+	h.apply(w, r, rest.NewNoContentResponse())
+}
+
+// deadLetterClient returns the dead-letter client for the queue in the request, or the error response if the queue
+// does not exist or does not support dead-lettering.
+func (h *handler) deadLetterClient(r *http.Request) (queue.DeadLetterClient, rest.Response) {
+	name := chi.URLParam(r, "queue")
+	client, ok := h.queues[name]
+	if !ok {
+		return nil, rest.NewNotFoundMessageResponse(fmt.Sprintf("The queue %q was not found.", name))
+	}
+
+	dlc, ok := client.(queue.DeadLetterClient)
+	if !ok {
+		return nil, rest.NewBadRequestResponse(fmt.Sprintf("The queue %q does not support dead-lettering.", name))
+	}
+
+	return dlc, nil
+}
+
+func (h *handler) apply(w http.ResponseWriter, r *http.Request, resp rest.Response) {
+	if err := resp.Apply(r.Context(), w, r); err != nil {
+		ucplog.FromContextOrDiscard(r.Context()).Error(err, "failed to write response")
+	}
+}
+
+func messageNotFound(id string) rest.Response {
+	return rest.NewNotFoundMessageResponse(fmt.Sprintf("The dead-lettered message %q was not found.", id))
+}
+
+func forbidden(principal string) rest.Response {
+	return &rest.ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: fmt.Sprintf("The client %q is not allowed to use the administrative API.", principal),
+			},
+		},
+	}
+}
+
+func internalError(err error) rest.Response {
+	return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: err.Error(),
+		},
+	})
+}
+
+// toDeadLetterMessage converts a queue message to its API representation. Messages that are not async operation
+// requests are returned without the operation details.
+func toDeadLetterMessage(msg *queue.Message) adminapi.DeadLetterMessage {
+	result := adminapi.DeadLetterMessage{
+		ID:           msg.ID,
+		DequeueCount: msg.DequeueCount,
+		EnqueueAt:    msg.EnqueueAt,
+		Reason:       msg.DeadLetterReason,
+	}
+
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(msg.Data, req); err == nil {
+		result.ResourceID = req.ResourceID
+		result.OperationType = req.OperationType
+		if req.ResourceID != "" {
+			result.OperationID = req.OperationID.String()
+		}
+	}
+
+	return result
+}
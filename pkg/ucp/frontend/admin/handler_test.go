@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/inmemory"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	adminapi "github.com/radius-project/radius/pkg/ucp/admin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPathBase   = "/apis/api.ucp.dev/v1alpha3"
+	testPrincipal  = "system:admin"
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test"
+)
+
+type testContext struct {
+	router    chi.Router
+	radius    *inmemory.Client
+	operation uuid.UUID
+}
+
+func setup(t *testing.T) *testContext {
+	mctrl := gomock.NewController(t)
+	radius := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+
+	router := chi.NewRouter()
+	Register(router, testPathBase, Options{
+		Queues: map[string]queue.Client{
+			"radius": radius,
+			// The mock client does not implement queue.DeadLetterClient.
+			"other": queue.NewMockClient(mctrl),
+		},
+		AllowedPrincipals: []string{testPrincipal},
+	})
+
+	return &testContext{router: router, radius: radius, operation: uuid.New()}
+}
+
+// deadLetter enqueues an async operation request and moves it to the dead-letter sub-queue.
+func (tc *testContext) deadLetter(t *testing.T) *queue.Message {
+	ctx := context.Background()
+	err := tc.radius.Enqueue(ctx, queue.NewMessage(&ctrl.Request{
+		OperationID:   tc.operation,
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+		ResourceID:    testResourceID,
+	}))
+	require.NoError(t, err)
+
+	msg, err := tc.radius.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NoError(t, tc.radius.DeadLetterMessage(ctx, msg, "exceeded max retry count"))
+
+	return msg
+}
+
+func (tc *testContext) do(t *testing.T, method string, path string, result any) int {
+	return tc.doAs(t, testPrincipal, method, path, result)
+}
+
+// doAs sends the request with the client identity set by middleware.ClientIdentity for authenticated clients.
+func (tc *testContext) doAs(t *testing.T, principal string, method string, path string, result any) int {
+	req := httptest.NewRequest(method, testPathBase+adminapi.QueuesPath+path, nil)
+	if principal != "" {
+		req.Header.Set(v1.ClientPrincipalNameHeader, principal)
+	}
+	w := httptest.NewRecorder()
+	tc.router.ServeHTTP(w, req)
+
+	if result != nil && w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+	}
+
+	return w.Code
+}
+
+func Test_ListQueues(t *testing.T) {
+	tc := setup(t)
+	tc.deadLetter(t)
+
+	result := adminapi.QueueList{}
+	code := tc.do(t, http.MethodGet, "", &result)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []adminapi.Queue{
+		{Name: "other"},
+		{Name: "radius", DeadLetterSupported: true, DeadLetterCount: 1},
+	}, result.Value)
+}
+
+func Test_Authorization(t *testing.T) {
+	tc := setup(t)
+	msg := tc.deadLetter(t)
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		code := tc.doAs(t, "", http.MethodGet, "", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		code := tc.doAs(t, "someone", http.MethodDelete, "/radius/deadletter/"+msg.ID, nil)
+		require.Equal(t, http.StatusForbidden, code)
+
+		// The message was not deleted.
+		messages, err := tc.radius.ListDeadLetterMessages(context.Background())
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+	})
+
+	t.Run("allow unauthenticated", func(t *testing.T) {
+		router := chi.NewRouter()
+		Register(router, testPathBase, Options{Queues: map[string]queue.Client{}, AllowUnauthenticated: true})
+
+		req := httptest.NewRequest(http.MethodGet, testPathBase+adminapi.QueuesPath, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func Test_ListDeadLetterMessages(t *testing.T) {
+	tc := setup(t)
+	msg := tc.deadLetter(t)
+
+	result := adminapi.DeadLetterMessageList{}
+	code := tc.do(t, http.MethodGet, "/radius/deadletter", &result)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, result.Value, 1)
+
+	actual := result.Value[0]
+	require.Equal(t, msg.ID, actual.ID)
+	require.Equal(t, 1, actual.DequeueCount)
+	require.Equal(t, "exceeded max retry count", actual.Reason)
+	require.Equal(t, testResourceID, actual.ResourceID)
+	require.Equal(t, tc.operation.String(), actual.OperationID)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", actual.OperationType)
+
+	t.Run("unknown queue", func(t *testing.T) {
+		code := tc.do(t, http.MethodGet, "/unknown/deadletter", nil)
+		require.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("dead-lettering not supported", func(t *testing.T) {
+		code := tc.do(t, http.MethodGet, "/other/deadletter", nil)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func Test_RequeueDeadLetterMessage(t *testing.T) {
+	tc := setup(t)
+	msg := tc.deadLetter(t)
+
+	code := tc.do(t, http.MethodPost, "/radius/deadletter/"+msg.ID+"/requeue", nil)
+	require.Equal(t, http.StatusNoContent, code)
+
+	requeued, err := tc.radius.Dequeue(context.Background(), queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, msg.ID, requeued.ID)
+
+	code = tc.do(t, http.MethodPost, "/radius/deadletter/"+msg.ID+"/requeue", nil)
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func Test_DeleteDeadLetterMessage(t *testing.T) {
+	tc := setup(t)
+	msg := tc.deadLetter(t)
+
+	code := tc.do(t, http.MethodDelete, "/radius/deadletter/"+msg.ID, nil)
+	require.Equal(t, http.StatusNoContent, code)
+
+	code = tc.do(t, http.MethodDelete, "/radius/deadletter/"+msg.ID, nil)
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func Test_PurgeDeadLetterMessages(t *testing.T) {
+	tc := setup(t)
+	tc.deadLetter(t)
+	tc.deadLetter(t)
+
+	result := adminapi.PurgeResult{}
+	code := tc.do(t, http.MethodDelete, "/radius/deadletter", &result)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, result.Deleted)
+
+	messages, err := tc.radius.ListDeadLetterMessages(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, messages)
+}
+
+func Test_NewQueueClients(t *testing.T) {
+	queues, err := NewQueueClients(context.Background(), queueprovider.QueueProviderOptions{
+		Provider: queueprovider.TypeInmemory,
+		Name:     "ucp",
+	}, []string{"radius", "ucp", "dynamic-rp"})
+	require.NoError(t, err)
+	require.Len(t, queues, 3)
+	require.Contains(t, queues, "ucp")
+	require.Contains(t, queues, "radius")
+	require.Contains(t, queues, "dynamic-rp")
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// requestHeaderConfigMapNamespace and requestHeaderConfigMapName identify the ConfigMap where the Kubernetes API
+	// server publishes the configuration of the request header authentication of aggregated API services.
+	requestHeaderConfigMapNamespace = "kube-system"
+	requestHeaderConfigMapName      = "extension-apiserver-authentication"
+)
+
+// requestHeaderAuthenticator authenticates the users of the requests proxied by the Kubernetes API server to the UCP
+// API service. The API server authenticates the user, and sends the user name in a request header over a connection
+// authenticated with a client certificate. The header is only trusted when the client certificate is verified.
+type requestHeaderAuthenticator struct {
+	// clientCAs are the certificate authorities of the client certificates of the API server.
+	clientCAs *x509.CertPool
+
+	// allowedNames are the allowed common names of the client certificates. All names are allowed if empty.
+	allowedNames []string
+
+	// usernameHeaders are the request headers holding the user name.
+	usernameHeaders []string
+}
+
+// loadRequestHeaderAuthenticator loads the request header authentication configuration published by the Kubernetes
+// API server.
+func loadRequestHeaderAuthenticator(ctx context.Context, client k8s.Interface) (*requestHeaderAuthenticator, error) {
+	configMap, err := client.CoreV1().ConfigMaps(requestHeaderConfigMapNamespace).Get(ctx, requestHeaderConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s/%s: %w", requestHeaderConfigMapNamespace, requestHeaderConfigMapName, err)
+	}
+
+	clientCA := configMap.Data["requestheader-client-ca-file"]
+	if clientCA == "" {
+		return nil, errors.New("request header authentication is not configured on the Kubernetes API server")
+	}
+
+	authenticator := &requestHeaderAuthenticator{clientCAs: x509.NewCertPool()}
+	if !authenticator.clientCAs.AppendCertsFromPEM([]byte(clientCA)) {
+		return nil, errors.New("failed to parse the request header client certificate authorities")
+	}
+
+	if err := unmarshalStringList(configMap.Data["requestheader-allowed-names"], &authenticator.allowedNames); err != nil {
+		return nil, fmt.Errorf("failed to parse the request header allowed names: %w", err)
+	}
+
+	if err := unmarshalStringList(configMap.Data["requestheader-username-headers"], &authenticator.usernameHeaders); err != nil {
+		return nil, fmt.Errorf("failed to parse the request header username headers: %w", err)
+	}
+
+	return authenticator, nil
+}
+
+// unmarshalStringList parses a JSON list of strings of the request header authentication ConfigMap.
+func unmarshalStringList(value string, list *[]string) error {
+	if value == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(value), list)
+}
+
+// configureTLS requests the client certificates verified by the authenticator.
+func (a *requestHeaderAuthenticator) configureTLS(config *tls.Config) {
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+	config.ClientCAs = a.clientCAs
+}
+
+// authenticate returns the user name of a request proxied by the Kubernetes API server, or an empty string if the
+// request was not sent by the API server.
+func (a *requestHeaderAuthenticator) authenticate(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, r.TLS.VerifiedChains[0][0].Subject.CommonName) {
+		return ""
+	}
+
+	for _, header := range a.usernameHeaders {
+		if username := r.Header.Get(header); username != "" {
+			return username
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCertificate(t *testing.T, commonName string) (*x509.Certificate, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestRequestHeaderAuthenticator(t *testing.T) {
+	cert, certPEM := newTestCertificate(t, "front-proxy-client")
+
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: requestHeaderConfigMapName, Namespace: requestHeaderConfigMapNamespace},
+		Data: map[string]string{
+			"requestheader-client-ca-file":   string(certPEM),
+			"requestheader-allowed-names":    `["front-proxy-client"]`,
+			"requestheader-username-headers": `["X-Remote-User"]`,
+		},
+	})
+
+	authenticator, err := loadRequestHeaderAuthenticator(context.Background(), client)
+	require.NoError(t, err)
+
+	otherCert, _ := newTestCertificate(t, "other-client")
+
+	tests := []struct {
+		name     string
+		tls      *tls.ConnectionState
+		expected string
+	}{
+		{
+			name:     "verified client certificate",
+			tls:      &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+			expected: "system:serviceaccount:default:app",
+		},
+		{
+			name:     "client certificate not allowed",
+			tls:      &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{otherCert}}},
+			expected: "",
+		},
+		{
+			name:     "no client certificate",
+			tls:      &tls.ConnectionState{},
+			expected: "",
+		},
+		{
+			name:     "no tls",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			req.Header.Set("X-Remote-User", "system:serviceaccount:default:app")
+			req.TLS = tt.tls
+
+			require.Equal(t, tt.expected, authenticator.authenticate(req))
+		})
+	}
+}
+
+func TestLoadRequestHeaderAuthenticator_NotConfigured(t *testing.T) {
+	_, err := loadRequestHeaderAuthenticator(context.Background(), fake.NewClientset())
+	require.Error(t, err)
+
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: requestHeaderConfigMapName, Namespace: requestHeaderConfigMapNamespace},
+	})
+	_, err = loadRequestHeaderAuthenticator(context.Background(), client)
+	require.Error(t, err)
+}
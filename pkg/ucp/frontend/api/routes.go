@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
@@ -164,6 +165,17 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		}
 	}
 
+	// Register the administrative API. This is not an ARM-style API, so it does not use the validator.
+	adminQueues, err := admin.NewQueueClients(ctx, options.Config.Queue, options.Config.Admin.Queues)
+	if err != nil {
+		return err
+	}
+	admin.Register(router, options.Config.Server.PathBase, admin.Options{
+		Queues:               adminQueues,
+		AllowedPrincipals:    options.Config.Admin.AllowedPrincipals,
+		AllowUnauthenticated: options.Config.Admin.AllowUnauthenticated,
+	})
+
 	// Register a catch-all route to handle requests that get dispatched to a specific plane.
 	unknownPlaneRouter := server.NewSubrouter(router, options.Config.Server.PathBase+planeTypeCollectionPath)
 	unknownPlaneRouter.HandleFunc(server.CatchAllPath, func(w http.ResponseWriter, r *http.Request) {
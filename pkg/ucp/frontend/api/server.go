@@ -19,6 +19,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -30,8 +31,10 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp"
+	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
@@ -45,6 +48,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	k8s "k8s.io/client-go/kubernetes"
 )
 
 // Service implements the hosting.Service interface for the UCP frontend API.
@@ -98,8 +102,20 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	authenticator, err := s.requestHeaderAuthenticator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var authenticate func(r *http.Request) string
+	if authenticator != nil {
+		authenticate = authenticator.authenticate
+	}
+
 	app := http.Handler(r)
 	app = servicecontext.ARMRequestCtx(s.options.Config.Server.PathBase, s.options.Config.Environment.RoleLocation)(app)
+	// The client identity is only set from the user authenticated by the Kubernetes API server.
+	app = middleware.ClientIdentity(authenticate)(app)
 	app = middleware.WithLogger(app)
 
 	app = otelhttp.NewHandler(
@@ -123,9 +139,46 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 			return ctx
 		},
 	}
+
+	if authenticator != nil {
+		server.TLSConfig = &tls.Config{}
+		authenticator.configureTLS(server.TLSConfig)
+	}
+
 	return server, nil
 }
 
+// requestHeaderAuthenticator returns the authenticator of the users of the requests proxied by the Kubernetes API
+// server, or nil if UCP is not served as a Kubernetes API service. Without authenticator, requests have no client
+// identity.
+func (s *Service) requestHeaderAuthenticator(ctx context.Context) (*requestHeaderAuthenticator, error) {
+	if s.options.Config.Server.TLSCertificateDirectory == "" || s.options.Config.UCP.Kind != ucpconfig.UCPConnectionKindKubernetes {
+		return nil, nil
+	}
+
+	config, err := kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+		QPS:   kubeutil.DefaultServerQPS,
+		Burst: kubeutil.DefaultServerBurst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+
+	client, err := k8s.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator, err := loadRequestHeaderAuthenticator(ctx, client)
+	if err != nil {
+		// Requests are still served, without client identity.
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to load the request header authentication configuration, client identities are ignored")
+		return nil, nil
+	}
+
+	return authenticator, nil
+}
+
 // configureDefaultPlanes reads the configuration file specified by the env var to configure default planes into UCP
 func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	for _, plane := range s.options.Config.Initialization.Planes {
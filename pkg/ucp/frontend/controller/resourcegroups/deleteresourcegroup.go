@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// deleteAttemptCount is the number of times to attempt the transaction that deletes a resource group.
+	deleteAttemptCount = 3
+)
+
+var _ armrpc_controller.Controller = (*DeleteResourceGroup)(nil)
+
+// DeleteResourceGroup is the controller implementation to delete a UCP resource group.
+//
+// The resource group is deleted in the same transaction as the tracked resource entries stored in it, so that
+// entries are not left behind for a resource group that no longer exists. Deleting the resource group does not delete
+// the resources themselves: callers such as rad group delete delete them through their resource providers first, and
+// their tracked entries may still be stored while the backend removes them.
+type DeleteResourceGroup struct {
+	armrpc_controller.Operation[*datamodel.ResourceGroup, datamodel.ResourceGroup]
+}
+
+// NewDeleteResourceGroup creates a new controller for deleting a resource group.
+func NewDeleteResourceGroup(opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[datamodel.ResourceGroup]) (armrpc_controller.Controller, error) {
+	return &DeleteResourceGroup{
+		Operation: armrpc_controller.NewOperation(opts, resourceOpts),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *DeleteResourceGroup) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Tracked resource entries are removed concurrently by the backend as the resources in the group are deleted,
+	// so the transaction is retried when one of the entries changes.
+	for attempt := 1; attempt <= deleteAttemptCount; attempt++ {
+		old, etag, err := r.GetResource(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if old == nil {
+			return armrpc_rest.NewNoContentResponse(), nil
+		}
+
+		if resp, err := r.PrepareResource(ctx, req, nil, old, etag); resp != nil || err != nil {
+			return resp, err
+		}
+
+		operations, err := r.deleteOperations(ctx, serviceCtx.ResourceID.String(), etag)
+		if err != nil {
+			return nil, err
+		}
+
+		err = database.ExecuteTransaction(ctx, r.DatabaseClient(), operations)
+		if errors.Is(err, &database.ErrNotFound{}) || errors.Is(err, &database.ErrConcurrency{}) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return armrpc_rest.NewOKResponse(nil), nil
+	}
+
+	return armrpc_rest.NewConflictResponse(fmt.Sprintf("resource group %q was modified while it was being deleted. Please try again.", serviceCtx.ResourceID.String())), nil
+}
+
+// deleteOperations returns the operations that delete the resource group and the tracked resource entries stored in
+// it. The ETag precondition on the resource group makes the transaction fail if it is modified concurrently.
+func (r *DeleteResourceGroup) deleteOperations(ctx context.Context, resourceGroupID string, etag string) ([]database.Operation, error) {
+	operations := []database.Operation{
+		database.DeleteOperation(resourceGroupID, database.WithETag(etag)),
+	}
+
+	query := database.Query{
+		RootScope:    resourceGroupID,
+		ResourceType: v20231001preview.ResourceType,
+	}
+
+	token := ""
+	for {
+		options := []database.QueryOptions{}
+		if token != "" {
+			options = append(options, database.WithPaginationToken(token))
+		}
+
+		result, err := r.DatabaseClient().Query(ctx, query, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			operations = append(operations, database.DeleteOperation(item.ID, database.WithETag(item.ETag)))
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			return operations, nil
+		}
+	}
+}
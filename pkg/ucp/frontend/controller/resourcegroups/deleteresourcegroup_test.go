@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+func Test_DeleteResourceGroup(t *testing.T) {
+	resourceGroupID := "/planes/radius/local/resourceGroups/test-rg"
+	otherResourceGroupID := "/planes/radius/local/resourceGroups/other-rg"
+
+	setup := func(t *testing.T) (database.Client, armrpc_controller.Controller, []string) {
+		databaseClient := inmemory.NewClient()
+
+		for _, id := range []string{resourceGroupID, otherResourceGroupID} {
+			err := databaseClient.Save(context.Background(), &database.Object{
+				Metadata: database.Metadata{ID: id},
+				Data:     &datamodel.ResourceGroup{BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: id}}},
+			})
+			require.NoError(t, err)
+		}
+
+		entryIDs := []string{}
+		for _, id := range []string{
+			resourceGroupID + "/providers/Applications.Core/applications/app",
+			resourceGroupID + "/providers/Applications.Core/containers/container",
+			otherResourceGroupID + "/providers/Applications.Core/applications/app",
+		} {
+			entryID := trackedresource.IDFor(resources.MustParse(id)).String()
+			err := databaseClient.Save(context.Background(), &database.Object{
+				Metadata: database.Metadata{ID: entryID},
+				Data:     &datamodel.GenericResource{Properties: datamodel.GenericResourceProperties{ID: id}},
+			})
+			require.NoError(t, err)
+			entryIDs = append(entryIDs, entryID)
+		}
+
+		ctrl, err := NewDeleteResourceGroup(armrpc_controller.Options{DatabaseClient: databaseClient}, armrpc_controller.ResourceOptions[datamodel.ResourceGroup]{
+			RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+			ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+		})
+		require.NoError(t, err)
+
+		return databaseClient, ctrl, entryIDs
+	}
+
+	t.Run("deletes tracked resource entries", func(t *testing.T) {
+		databaseClient, ctrl, entryIDs := setup(t)
+
+		request, err := http.NewRequest(http.MethodDelete, resourceGroupID+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewOKResponse(nil), response)
+
+		for _, id := range append([]string{resourceGroupID}, entryIDs[:2]...) {
+			_, err = databaseClient.Get(context.Background(), id)
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: id})
+		}
+
+		for _, id := range []string{otherResourceGroupID, entryIDs[2]} {
+			_, err = databaseClient.Get(context.Background(), id)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("resource group not found", func(t *testing.T) {
+		_, ctrl, _ := setup(t)
+
+		request, err := http.NewRequest(http.MethodDelete, "/planes/radius/local/resourceGroups/missing-rg?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNoContentResponse(), response)
+	})
+}
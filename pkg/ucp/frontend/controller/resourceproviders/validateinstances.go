@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	http "net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// OperationValidateInstances is the operation method of the validateInstances action.
+	OperationValidateInstances v1.OperationMethod = "ACTIONVALIDATEINSTANCES"
+
+	// validateInstancesPageSize is the maximum number of resources read from the database at once.
+	validateInstancesPageSize = 100
+)
+
+var _ armrpc_controller.Controller = (*ValidateInstances)(nil)
+
+// ValidateInstances is the controller implementation of the validateInstances action. It is a dry-run that validates
+// the stored resources of a resource type against a proposed schema for one of its API versions, and reports the
+// resources that would become invalid. Nothing is written to the database.
+type ValidateInstances struct {
+	armrpc_controller.Operation[*datamodel.APIVersion, datamodel.APIVersion]
+}
+
+// NewValidateInstances creates a new controller for the validateInstances action.
+func NewValidateInstances(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ValidateInstances{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.APIVersion]{}),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (c *ValidateInstances) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The action name is trimmed from the resource ID of POST requests, so this is the ID of the API version.
+	// Ex: /planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01
+	apiVersionID := serviceCtx.ResourceID
+	resourceTypeID := apiVersionID.Truncate()
+	if len(apiVersionID.TypeSegments()) != 3 {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid API version id %q", apiVersionID.String())), nil
+	}
+	apiVersion := apiVersionID.Name()
+	resourceType := resourceTypeID.TypeSegments()[0].Name + resources.SegmentSeparator + resourceTypeID.Name()
+
+	request := datamodel.ValidateInstancesRequest{}
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(content))) > 0 {
+		if err := json.Unmarshal(content, &request); err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid request body: %v", err)), nil
+		}
+	}
+
+	_, err = c.DatabaseClient().Get(ctx, resourceTypeID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(resourceTypeID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	schemas, err := c.storedSchemas(ctx, resourceTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed := request.Schema
+	if proposed == nil {
+		stored, ok := schemas[apiVersion]
+		if !ok {
+			return armrpc_rest.NewNotFoundResponse(apiVersionID), nil
+		}
+		proposed = stored
+	} else {
+		openAPISchema, err := schema.ConvertToOpenAPISchema(proposed)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid schema: %v", err)), nil
+		}
+		if err := schema.NewValidator().ValidateSchema(ctx, openAPISchema); err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid schema: %v", err)), nil
+		}
+	}
+
+	// Resources stored with other API versions are converted to the validated API version, using the conversion
+	// rules of the proposed schema in place of the stored ones.
+	schemas[apiVersion] = proposed
+	converter, err := schema.NewConverter(schemas, "")
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	result, err := c.validateInstances(ctx, resourceTypeID, resourceType, apiVersion, proposed, converter)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(result), nil
+}
+
+// storedSchemas returns the stored schemas of the API versions of a resource type, keyed by API version name.
+func (c *ValidateInstances) storedSchemas(ctx context.Context, resourceTypeID resources.ID) (map[string]map[string]any, error) {
+	query := database.Query{
+		RootScope:          resourceTypeID.RootScope(),
+		ResourceType:       datamodel.APIVersionResourceType,
+		RoutingScopePrefix: resourceTypeID.RoutingScope(),
+	}
+
+	schemas := map[string]map[string]any{}
+	token := ""
+	for {
+		result, err := c.DatabaseClient().Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(validateInstancesPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range result.Items {
+			apiVersion := datamodel.APIVersion{}
+			if err := result.Items[i].As(&apiVersion); err != nil {
+				return nil, err
+			}
+			schemas[apiVersion.Name] = apiVersion.Properties.Schema
+		}
+
+		if result.PaginationToken == "" {
+			return schemas, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// validateInstances validates every stored resource of the resource type against the schema.
+func (c *ValidateInstances) validateInstances(ctx context.Context, resourceTypeID resources.ID, resourceType string, apiVersion string, schemaData map[string]any, converter *schema.Converter) (*datamodel.ValidateInstancesResult, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	result := &datamodel.ValidateInstancesResult{
+		ResourceType: resourceType,
+		APIVersion:   apiVersion,
+		Invalid:      []datamodel.InvalidInstance{},
+		Skipped:      []datamodel.SkippedInstance{},
+	}
+
+	query := database.Query{
+		RootScope:      resourceTypeID.RootScope(),
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	token := ""
+	for {
+		page, err := c.DatabaseClient().Query(ctx, query, database.WithPaginationToken(token), database.WithMaxQueryItemCount(validateInstancesPageSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for i := range page.Items {
+			c.validateInstance(ctx, &page.Items[i], apiVersion, schemaData, converter, result)
+		}
+
+		if page.PaginationToken == "" {
+			break
+		}
+		token = page.PaginationToken
+	}
+
+	logger.Info("Validated stored resources against schema", "resourceType", resourceType, "apiVersion", apiVersion,
+		"validated", result.Validated, "invalid", len(result.Invalid), "skipped", len(result.Skipped))
+	return result, nil
+}
+
+// storedInstance is the subset of a stored dynamic resource needed for validation.
+type storedInstance struct {
+	v1.BaseResource
+
+	Properties map[string]any `json:"properties"`
+}
+
+// validateInstance validates a single stored resource and records the outcome in result.
+func (c *ValidateInstances) validateInstance(ctx context.Context, obj *database.Object, apiVersion string, schemaData map[string]any, converter *schema.Converter, result *datamodel.ValidateInstancesResult) {
+	instance := storedInstance{}
+	if err := obj.As(&instance); err != nil {
+		result.Skipped = append(result.Skipped, datamodel.SkippedInstance{
+			ID:      obj.ID,
+			Message: fmt.Sprintf("failed to read the stored resource: %v", err),
+		})
+		return
+	}
+
+	storedVersion := instance.InternalMetadata.UpdatedAPIVersion
+	if storedVersion == "" {
+		storedVersion = instance.InternalMetadata.CreatedAPIVersion
+	}
+
+	properties := instance.Properties
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	if storedVersion != "" && !strings.EqualFold(storedVersion, apiVersion) {
+		if !converter.CanConvert(storedVersion, apiVersion) {
+			result.Skipped = append(result.Skipped, datamodel.SkippedInstance{
+				ID:         obj.ID,
+				APIVersion: storedVersion,
+				Message:    fmt.Sprintf("the resource is stored with API version %q and cannot be converted to %q", storedVersion, apiVersion),
+			})
+			return
+		}
+
+		converted, err := converter.Convert(properties, storedVersion, apiVersion)
+		if err != nil {
+			result.Skipped = append(result.Skipped, datamodel.SkippedInstance{
+				ID:         obj.ID,
+				APIVersion: storedVersion,
+				Message:    fmt.Sprintf("failed to convert the resource to API version %q: %v", apiVersion, err),
+			})
+			return
+		}
+		properties = converted
+	}
+
+	result.Validated++
+	resourceData := map[string]any{"id": obj.ID, "properties": properties}
+	if err := schema.ValidateResourceAgainstSchema(ctx, resourceData, schemaData); err != nil {
+		result.Invalid = append(result.Invalid, datamodel.InvalidInstance{ID: obj.ID, Message: err.Error()})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	http "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	testResourceTypeID = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	testAPIVersionURL  = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01/validateInstances?api-version=2023-10-01-preview"
+)
+
+func Test_ValidateInstances(t *testing.T) {
+	requiredPort := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"port":        map[string]any{"type": "integer"},
+		},
+		"required": []any{"port"},
+	}
+
+	t.Run("stored schema", func(t *testing.T) {
+		client := setupValidateInstances(t)
+
+		result, response := runValidateInstances(t, client, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Equal(t, "Applications.Test/testResources", result.ResourceType)
+		require.Equal(t, "2025-01-01", result.APIVersion)
+		require.Equal(t, 3, result.Validated)
+		require.Empty(t, result.Invalid)
+		require.Len(t, result.Skipped, 1)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/unknown", result.Skipped[0].ID)
+		require.Equal(t, "2020-01-01", result.Skipped[0].APIVersion)
+	})
+
+	t.Run("proposed schema", func(t *testing.T) {
+		client := setupValidateInstances(t)
+
+		result, response := runValidateInstances(t, client, &datamodel.ValidateInstancesRequest{Schema: requiredPort})
+		require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+		require.Equal(t, 2, result.Validated)
+		require.Len(t, result.Invalid, 1)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/noport", result.Invalid[0].ID)
+		require.Contains(t, result.Invalid[0].Message, "port")
+
+		// The proposed schema drops the conversion rules, so resources stored with 2024-01-01 can no longer be converted.
+		require.Len(t, result.Skipped, 2)
+
+		// The dry-run does not change the stored schema.
+		obj, err := client.Get(context.Background(), testResourceTypeID+"/apiVersions/2025-01-01")
+		require.NoError(t, err)
+		apiVersion := datamodel.APIVersion{}
+		require.NoError(t, obj.As(&apiVersion))
+		require.NotContains(t, apiVersion.Properties.Schema, "required")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		client := setupValidateInstances(t)
+
+		_, response := runValidateInstances(t, client, &datamodel.ValidateInstancesRequest{Schema: map[string]any{"type": "object", "properties": map[string]any{"port": map[string]any{"type": "unknown"}}}})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("resource type not found", func(t *testing.T) {
+		client := inmemory.NewClient()
+
+		_, response := runValidateInstances(t, client, nil)
+		require.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func setupValidateInstances(t *testing.T) database.Client {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+
+	save := func(id string, data any) {
+		require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data}))
+	}
+
+	save(testResourceTypeID, &datamodel.ResourceType{})
+	save(testResourceTypeID+"/apiVersions/2024-01-01", &datamodel.APIVersion{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{Name: "2024-01-01"}},
+		Properties: datamodel.APIVersionProperties{Schema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"containerPort": map[string]any{"type": "integer"},
+			},
+		}},
+	})
+	save(testResourceTypeID+"/apiVersions/2025-01-01", &datamodel.APIVersion{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{Name: "2025-01-01"}},
+		Properties: datamodel.APIVersionProperties{Schema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"port": map[string]any{"type": "integer"},
+			},
+			"x-radius-conversions": map[string]any{
+				"2024-01-01": []any{map[string]any{"rename": "containerPort", "to": "port"}},
+			},
+		}},
+	})
+
+	// An API version of another resource type must not be used for conversions.
+	save("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/otherResources/apiVersions/2020-01-01", &datamodel.APIVersion{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{Name: "2020-01-01"}},
+	})
+
+	resource := func(name string, apiVersion string, properties map[string]any) {
+		save("/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/"+name, map[string]any{
+			"id":                "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/" + name,
+			"updatedApiVersion": apiVersion,
+			"properties":        properties,
+		})
+	}
+	resource("current", "2025-01-01", map[string]any{"port": 8080})
+	resource("noport", "2025-01-01", map[string]any{})
+	resource("converted", "2024-01-01", map[string]any{"containerPort": 80})
+	resource("unknown", "2020-01-01", map[string]any{"port": 80})
+
+	return client
+}
+
+func runValidateInstances(t *testing.T, client database.Client, body *datamodel.ValidateInstancesRequest) (*datamodel.ValidateInstancesResult, *httptest.ResponseRecorder) {
+	controller, err := NewValidateInstances(armrpc_controller.Options{DatabaseClient: client})
+	require.NoError(t, err)
+
+	content := []byte{}
+	if body != nil {
+		content, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, testAPIVersionURL, bytes.NewReader(content))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	ctx := rpctest.NewARMRequestContext(request)
+
+	response, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, response.Apply(ctx, w, request))
+
+	result := &datamodel.ValidateInstancesResult{}
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+	}
+	return result, w
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
@@ -52,10 +53,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		ResourceTypeGetter: validator.UCPResourceTypeGetter,
 	})
 
-	transport := otelhttp.NewTransport(http.DefaultTransport)
+	signingKey, err := middleware.LoadClientIdentitySigningKey(m.options.Config.Routing.ClientIdentity.SigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resource providers only trust the client identity when it is signed by UCP.
+	transport := middleware.NewClientIdentitySigner(signingKey, otelhttp.NewTransport(http.DefaultTransport))
 
 	// More convienent way to capture errors
-	var err error
 	capture := func(handler http.HandlerFunc, e error) http.HandlerFunc {
 		if e != nil {
 			err = errors.Join(err, e)
@@ -95,8 +101,11 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 					// Routes for async support: operationResults + operationStatuses
 					r.Route("/locations/{location}", func(r chi.Router) {
+						r.Get("/operationStatuses", capture(operationStatusListHandler(ctx, ctrlOptions)))
 						r.Get("/operationStatuses/{operationId}", capture(operationStatusGetHandler(ctx, ctrlOptions)))
+						r.Post("/operationStatuses/{operationId}/cancel", capture(operationCancelHandler(ctx, ctrlOptions)))
 						r.Get("/operationResults/{operationId}", capture(operationResultGetHandler(ctx, ctrlOptions)))
+						r.Get("/operations", capture(operationHistoryListHandler(ctx, ctrlOptions)))
 					})
 
 					r.Route("/resourceproviders", func(r chi.Router) {
@@ -128,6 +137,9 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 											r.With(apiValidator).Get("/", capture(apiVersionGetHandler(ctx, ctrlOptions)))
 											r.With(apiValidator).Put("/", capture(apiVersionPutHandler(ctx, ctrlOptions)))
 											r.With(apiValidator).Delete("/", capture(apiVersionDeleteHandler(ctx, ctrlOptions)))
+
+											// The validateInstances action is not part of the OpenAPI spec, so it doesn't use the apiValidator.
+											r.Post("/{action:validate[Ii]nstances}", capture(apiVersionValidateInstancesHandler(ctx, ctrlOptions)))
 										})
 									})
 								})
@@ -224,7 +236,7 @@ func resourceGroupPutHandler(ctx context.Context, ctrlOptions controller.Options
 
 func resourceGroupDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, v20231001preview.ResourceGroupType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultSyncDelete(opts, resourceGroupResourceOptions)
+		return resourcegroups_ctrl.NewDeleteResourceGroup(opts, resourceGroupResourceOptions)
 	})
 }
 
@@ -336,6 +348,12 @@ func apiVersionDeleteHandler(ctx context.Context, ctrlOptions controller.Options
 	})
 }
 
+func apiVersionValidateInstancesHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.APIVersionResourceType, resourceproviders_ctrl.OperationValidateInstances, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return resourceproviders_ctrl.NewValidateInstances(opts)
+	})
+}
+
 var locationResourceOptions = controller.ResourceOptions[datamodel.Location]{
 	RequestConverter:         converter.LocationDataModelFromVersioned,
 	ResponseConverter:        converter.LocationDataModelToVersioned,
@@ -382,6 +400,18 @@ func operationStatusGetHandler(ctx context.Context, ctrlOptions controller.Optio
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationStatus)
 }
 
+func operationStatusListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationList, ctrlOptions, defaultoperation.NewListOperationStatuses)
+}
+
+func operationCancelHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationCancel, ctrlOptions, defaultoperation.NewCancelOperation)
+}
+
+func operationHistoryListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationhistory", v1.OperationList, ctrlOptions, defaultoperation.NewListOperationHistory)
+}
+
 func operationResultGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	// NOTE: The resource type below is CORRECT. operation status and operation result use the same resource type in the database.
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationResult)
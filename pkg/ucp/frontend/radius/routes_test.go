@@ -31,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
 	"go.uber.org/mock/gomock"
 )
 
@@ -141,6 +142,11 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/someName/providers/System.Resources/resourceproviders/Applications.Test/resourcetypes/testResources/apiversions/2025-01-01",
 		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.APIVersionResourceType, Method: resourceproviders_ctrl.OperationValidateInstances},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/someName/providers/System.Resources/resourceproviders/Applications.Test/resourcetypes/testResources/apiversions/2025-01-01/validateInstances",
+		},
 
 		// Resource groups
 		{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/history"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
@@ -44,6 +45,9 @@ type Options struct {
 	// DatabaseProvider provides access to the database used for resource data.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// HistoryRecorder records the history of the async operations. It is nil if the history is disabled.
+	HistoryRecorder *history.Recorder
+
 	// Modules is the list of modules to initialize. This will default to nil (implying the default set), and
 	// can be overridden by tests.
 	Modules []modules.Initializer
@@ -87,7 +91,12 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
-	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
+	options.HistoryRecorder, err = history.FromOptions(databaseClient, config.Environment.RoleLocation, config.OperationHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation, statusmanager.WithHistory(options.HistoryRecorder))
 
 	options.SpecLoader, err = validator.LoadSpec(ctx, "ucp", swagger.SpecFilesUCP, []string{config.Server.PathBase}, "")
 	if err != nil {
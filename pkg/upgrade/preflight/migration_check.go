@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/migration"
+)
+
+// Ensure DataMigrationCheck implements PreflightCheck interface
+var _ PreflightCheck = (*DataMigrationCheck)(nil)
+
+// DataMigrationCheck runs the registered data migrations against the stored
+// resources. When apply is false the migrations are only validated.
+type DataMigrationCheck struct {
+	client   database.Client
+	registry *migration.Registry
+	apply    bool
+}
+
+// NewDataMigrationCheck creates a new data migration check.
+func NewDataMigrationCheck(client database.Client, registry *migration.Registry, apply bool) *DataMigrationCheck {
+	return &DataMigrationCheck{
+		client:   client,
+		registry: registry,
+		apply:    apply,
+	}
+}
+
+// Name returns the name of this check.
+func (d *DataMigrationCheck) Name() string {
+	return "Data Migration"
+}
+
+// Severity returns the severity level of this check.
+func (d *DataMigrationCheck) Severity() CheckSeverity {
+	return SeverityError
+}
+
+// Run executes the data migration check.
+func (d *DataMigrationCheck) Run(ctx context.Context) (bool, string, error) {
+	if d.registry.IsEmpty() {
+		return true, "No data migrations are registered", nil
+	}
+
+	runner := &migration.Runner{
+		Client:   d.client,
+		Registry: d.registry,
+		DryRun:   !d.apply,
+	}
+
+	result, err := runner.Run(ctx)
+	if err != nil {
+		return false, "Stored resources cannot be migrated to the target version", fmt.Errorf("failed to run data migrations: %w", err)
+	}
+
+	if !d.apply {
+		return true, fmt.Sprintf("%d of %d stored resources will be migrated", result.Migrated, result.Scanned), nil
+	}
+
+	message := fmt.Sprintf("%d of %d stored resources migrated", result.Migrated, result.Scanned)
+	if len(result.Conflicts) > 0 {
+		// Conflicting resources were updated concurrently, they are migrated when they are next read.
+		message += fmt.Sprintf(", %d modified concurrently (will be migrated on read)", len(result.Conflicts))
+	}
+
+	return true, message, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/components/database/migration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataMigrationCheck_Run(t *testing.T) {
+	const id = "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"
+
+	rename := func(data map[string]any) error {
+		data["b"] = data["a"]
+		delete(data, "a")
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		migrations    []migration.Migration
+		apply         bool
+		expectSuccess bool
+		expectMessage string
+		expectError   bool
+		expectData    map[string]any
+	}{
+		{
+			name:          "no migrations",
+			expectSuccess: true,
+			expectMessage: "No data migrations are registered",
+			expectData:    map[string]any{"a": "value"},
+		},
+		{
+			name:          "dry run",
+			migrations:    []migration.Migration{{ResourceType: "Applications.Test/testType", Version: 1, Migrate: rename}},
+			expectSuccess: true,
+			expectMessage: "1 of 1 stored resources will be migrated",
+			expectData:    map[string]any{"a": "value"},
+		},
+		{
+			name:          "apply",
+			migrations:    []migration.Migration{{ResourceType: "Applications.Test/testType", Version: 1, Migrate: rename}},
+			apply:         true,
+			expectSuccess: true,
+			expectMessage: "1 of 1 stored resources migrated",
+			expectData:    map[string]any{"b": "value", migration.VersionProperty: float64(1)},
+		},
+		{
+			name: "migration fails",
+			migrations: []migration.Migration{{ResourceType: "Applications.Test/testType", Version: 1, Migrate: func(data map[string]any) error {
+				return errors.New("oops")
+			}}},
+			apply:         true,
+			expectSuccess: false,
+			expectMessage: "Stored resources cannot be migrated to the target version",
+			expectError:   true,
+			expectData:    map[string]any{"a": "value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := inmemory.NewClient()
+			require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"a": "value"}}))
+
+			registry := migration.NewRegistry()
+			require.NoError(t, registry.Register(tt.migrations...))
+
+			check := NewDataMigrationCheck(client, registry, tt.apply)
+			success, message, err := check.Run(ctx)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectSuccess, success)
+			assert.Equal(t, tt.expectMessage, message)
+
+			stored, err := client.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectData, stored.Data)
+		})
+	}
+}
+
+func TestDataMigrationCheck_Properties(t *testing.T) {
+	check := NewDataMigrationCheck(inmemory.NewClient(), migration.NewRegistry(), false)
+
+	assert.Equal(t, "Data Migration", check.Name())
+	assert.Equal(t, SeverityError, check.Severity())
+}
@@ -24,6 +24,8 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/helm"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/migration"
 	"github.com/radius-project/radius/pkg/upgrade/preflight"
 )
 
@@ -32,6 +34,12 @@ type Config struct {
 	KubeContext string
 	Helm        helm.Interface
 	Output      output.Interface
+
+	// DatabaseClient is the client for the Radius database. Required for the "migrations" check.
+	DatabaseClient database.Client
+
+	// Migrations is the set of data migrations to run. Defaults to migration.Default if not set.
+	Migrations *migration.Registry
 }
 
 // Options holds the options for preflight checks
@@ -40,6 +48,14 @@ type Options struct {
 	TargetVersion  string
 	CurrentVersion string
 	Timeout        time.Duration // Timeout for all preflight checks combined, defaults to 1 minute if not set
+
+	// ApplyMigrations saves the migrated resources when running the "migrations" check. Otherwise the
+	// migrations are only validated.
+	ApplyMigrations bool
+
+	// MigrationTimeout is the timeout for the "migrations" check, defaults to 4 minutes if not set. Data migrations
+	// read every stored resource, so they run after the other checks and are not bound by Timeout.
+	MigrationTimeout time.Duration
 }
 
 // RunPreflightChecks executes all configured preflight checks
@@ -58,6 +74,9 @@ func RunPreflightChecks(ctx context.Context, config Config, options Options) err
 
 	registry := preflight.NewRegistry(config.Output)
 
+	// Data migrations are run by their own registry so they get their own timeout.
+	migrationRegistry := preflight.NewRegistry(config.Output)
+
 	config.Output.LogInfo("Running preflight checks: %s", strings.Join(options.EnabledChecks, ", "))
 	config.Output.LogInfo("Target version: %s", options.TargetVersion)
 	config.Output.LogInfo("Current version: %s", options.CurrentVersion)
@@ -103,6 +122,20 @@ func RunPreflightChecks(ctx context.Context, config Config, options Options) err
 			resourcesCheck := preflight.NewKubernetesResourceCheck(config.KubeContext)
 			registry.AddCheck(resourcesCheck)
 
+		case "migrations":
+			if config.DatabaseClient == nil {
+				config.Output.LogInfo("Warning: No database is configured, skipping data migrations")
+				continue
+			}
+
+			migrations := config.Migrations
+			if migrations == nil {
+				migrations = migration.Default
+			}
+
+			migrationCheck := preflight.NewDataMigrationCheck(config.DatabaseClient, migrations, options.ApplyMigrations)
+			migrationRegistry.AddCheck(migrationCheck)
+
 		default:
 			// Log warning but continue with other checks
 			config.Output.LogInfo("Warning: Unknown check '%s', skipping", checkName)
@@ -118,6 +151,13 @@ func RunPreflightChecks(ctx context.Context, config Config, options Options) err
 		return fmt.Errorf("preflight checks failed: %w", err)
 	}
 
+	// Data migrations run last, so that no resource is migrated unless every other check has passed.
+	migrationResults, err := runMigrationChecks(ctx, migrationRegistry, options)
+	if err != nil {
+		return err
+	}
+	results = append(results, migrationResults...)
+
 	config.Output.LogInfo("All preflight checks completed successfully")
 
 	for _, result := range results {
@@ -130,3 +170,26 @@ func RunPreflightChecks(ctx context.Context, config Config, options Options) err
 
 	return nil
 }
+
+// runMigrationChecks runs the data migration checks with the migration timeout.
+func runMigrationChecks(ctx context.Context, registry *preflight.Registry, options Options) ([]preflight.CheckResult, error) {
+	// The migration timeout must stay below the timeout of the Helm upgrade that runs the pre-upgrade job,
+	// which is 5 minutes for rad upgrade kubernetes.
+	timeout := options.MigrationTimeout
+	if timeout == 0 {
+		timeout = 4 * time.Minute
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results, err := registry.RunChecks(ctxWithTimeout)
+	if err != nil {
+		if ctxWithTimeout.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("data migrations timed out after %v", timeout)
+		}
+		return nil, fmt.Errorf("data migrations failed: %w", err)
+	}
+
+	return results, nil
+}
@@ -24,6 +24,9 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/helm"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/components/database/migration"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -128,6 +131,78 @@ func TestRunPreflightChecks_UnknownCheck(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRunPreflightChecks_MigrationsWithoutDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHelm := helm.NewMockInterface(ctrl)
+	mockOutput := output.NewMockInterface(ctrl)
+
+	mockOutput.EXPECT().LogInfo("Warning: No database is configured, skipping data migrations")
+	mockOutput.EXPECT().LogInfo(gomock.Any(), gomock.Any()).AnyTimes()
+
+	config := Config{
+		KubeContext: "test-context",
+		Helm:        mockHelm,
+		Output:      mockOutput,
+	}
+
+	options := Options{
+		EnabledChecks: []string{"migrations"},
+		TargetVersion: "0.29.0",
+	}
+
+	err := RunPreflightChecks(context.Background(), config, options)
+	require.NoError(t, err)
+}
+
+func TestRunPreflightChecks_Migrations(t *testing.T) {
+	const id = "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testType/a"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHelm := helm.NewMockInterface(ctrl)
+	mockOutput := output.NewMockInterface(ctrl)
+	mockOutput.EXPECT().LogInfo(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"a": "value"}}))
+
+	migrations := migration.NewRegistry()
+	require.NoError(t, migrations.Register(migration.Migration{
+		ResourceType: "Applications.Test/testType",
+		Version:      1,
+		Migrate: func(data map[string]any) error {
+			data["b"] = data["a"]
+			delete(data, "a")
+			return nil
+		},
+	}))
+
+	config := Config{
+		KubeContext:    "test-context",
+		Helm:           mockHelm,
+		Output:         mockOutput,
+		DatabaseClient: client,
+		Migrations:     migrations,
+	}
+
+	options := Options{
+		EnabledChecks:   []string{"migrations"},
+		TargetVersion:   "0.29.0",
+		ApplyMigrations: true,
+	}
+
+	err := RunPreflightChecks(ctx, config, options)
+	require.NoError(t, err)
+
+	stored, err := client.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"b": "value", migration.VersionProperty: float64(1)}, stored.Data)
+}
+
 func TestRunPreflightChecks_EmptyCheckNames(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -391,3 +466,38 @@ func TestRunPreflightChecks_WithTimeout(t *testing.T) {
 	err := RunPreflightChecks(context.Background(), config, options)
 	require.NoError(t, err)
 }
+
+func TestRunPreflightChecks_MigrationTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHelm := helm.NewMockInterface(ctrl)
+	mockOutput := output.NewMockInterface(ctrl)
+	mockOutput.EXPECT().LogInfo(gomock.Any(), gomock.Any()).AnyTimes()
+
+	migrations := migration.NewRegistry()
+	require.NoError(t, migrations.Register(migration.Migration{
+		ResourceType: "Applications.Test/testType",
+		Version:      1,
+		Migrate:      func(data map[string]any) error { return nil },
+	}))
+
+	config := Config{
+		KubeContext:    "test-context",
+		Helm:           mockHelm,
+		Output:         mockOutput,
+		DatabaseClient: inmemory.NewClient(),
+		Migrations:     migrations,
+	}
+
+	// The migrations are bound by MigrationTimeout, not Timeout.
+	options := Options{
+		EnabledChecks:    []string{"migrations"},
+		TargetVersion:    "0.29.0",
+		Timeout:          time.Hour,
+		MigrationTimeout: time.Nanosecond,
+	}
+
+	err := RunPreflightChecks(context.Background(), config, options)
+	require.ErrorContains(t, err, "data migrations timed out after 1ns")
+}
@@ -240,3 +240,120 @@ func RunTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
 		require.Equal(t, msgCount, recvCnt)
 	})
 }
+
+// RunDeadLetterTest tests the client's dead-letter sub-queue. The client must implement queue.DeadLetterClient.
+func RunDeadLetterTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	dlc, ok := cli.(queue.DeadLetterClient)
+	require.True(t, ok, "client must implement queue.DeadLetterClient")
+
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dlc.DeadLetterMessage(ctx, msg, "too many retries")
+		require.NoError(t, err)
+		require.Equal(t, "too many retries", msg.DeadLetterReason)
+
+		// The message is not requeued after the lock expires.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		// The message can not be finished, extended or dead-lettered again.
+		require.Error(t, cli.ExtendMessage(ctx, msg))
+		require.ErrorIs(t, dlc.DeadLetterMessage(ctx, msg, "again"), queue.ErrInvalidMessage)
+
+		messages, err := dlc.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, msg.ID, messages[0].ID)
+		require.Equal(t, 1, messages[0].DequeueCount)
+		require.Equal(t, "too many retries", messages[0].DeadLetterReason)
+		require.Equal(t, msg.Data, messages[0].Data)
+	})
+
+	t.Run("dead-letter message leased by another client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		stale := *msg
+		stale.DequeueCount--
+		err = dlc.DeadLetterMessage(ctx, &stale, "too many retries")
+		require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+
+		messages, err := dlc.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+	})
+
+	t.Run("requeue dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = dlc.DeadLetterMessage(ctx, msg, "too many retries")
+		require.NoError(t, err)
+
+		err = dlc.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		requeued, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.ID, requeued.ID)
+		require.Equal(t, 1, requeued.DequeueCount)
+		require.Empty(t, requeued.DeadLetterReason)
+		require.False(t, requeued.EnqueueAt.Before(msg.EnqueueAt))
+		require.NoError(t, cli.FinishMessage(ctx, requeued))
+
+		messages, err := dlc.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		err = dlc.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+	})
+
+	t.Run("delete dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 2)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = dlc.DeadLetterMessage(ctx, msg, "too many retries")
+		require.NoError(t, err)
+
+		// Only dead-lettered messages can be deleted.
+		other, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = dlc.DeleteDeadLetterMessage(ctx, other.ID)
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		err = dlc.DeleteDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		messages, err := dlc.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		err = dlc.DeleteDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+	})
+}
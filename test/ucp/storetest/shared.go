@@ -18,8 +18,11 @@ limitations under the License.
 package storetest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -549,4 +552,423 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 			CompareObjectLists(t, expected, objs.Items)
 		})
 	})
+
+	t.Run("query_filter_operators", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, map[string]any{
+			"name": "frontend",
+			"properties": map[string]any{
+				"owner":    "Émile",
+				"replicas": float64(1),
+				"tags":     []any{"web", "public"},
+			},
+		})
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, map[string]any{
+			"name": "backend",
+			"properties": map[string]any{
+				"replicas": float64(3),
+				"tags":     []any{"internal"},
+				"status":   "ready",
+			},
+		})
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		query := func(t *testing.T, filters ...database.QueryFilter) []database.Object {
+			objs, err := client.Query(ctx, database.Query{RootScope: RadiusScope, ScopeRecursive: true, ResourceType: ResourceType1, Filters: filters})
+			require.NoError(t, err)
+			items := objs.Items
+
+			objs, err = client.Query(ctx, database.Query{RootScope: RadiusScope, ScopeRecursive: true, ResourceType: ResourceType2, Filters: filters})
+			require.NoError(t, err)
+			return append(items, objs.Items...)
+		}
+
+		tests := []struct {
+			name     string
+			filters  []database.QueryFilter
+			expected []database.Object
+		}{
+			{
+				name:     "not_equals",
+				filters:  []database.QueryFilter{{Field: "name", Operator: database.FilterOperatorNotEquals, Value: "frontend"}},
+				expected: []database.Object{obj2},
+			},
+			{
+				name:     "equals_ignore_case",
+				filters:  []database.QueryFilter{{Field: "name", Operator: database.FilterOperatorEqualsIgnoreCase, Value: "FrontEnd"}},
+				expected: []database.Object{obj1},
+			},
+			{
+				// Only ASCII letters are compared case-insensitively.
+				name:     "equals_ignore_case_non_ascii",
+				filters:  []database.QueryFilter{{Field: "properties.owner", Operator: database.FilterOperatorEqualsIgnoreCase, Value: "ÉMILE"}},
+				expected: []database.Object{obj1},
+			},
+			{
+				name:     "equals_ignore_case_non_ascii_no_match",
+				filters:  []database.QueryFilter{{Field: "properties.owner", Operator: database.FilterOperatorEqualsIgnoreCase, Value: "émile"}},
+				expected: []database.Object{},
+			},
+			{
+				name:     "in",
+				filters:  []database.QueryFilter{{Field: "name", Operator: database.FilterOperatorIn, Values: []string{"frontend", "other"}}},
+				expected: []database.Object{obj1},
+			},
+			{
+				name:     "prefix",
+				filters:  []database.QueryFilter{{Field: "name", Operator: database.FilterOperatorPrefix, Value: "back"}},
+				expected: []database.Object{obj2},
+			},
+			{
+				name:     "exists",
+				filters:  []database.QueryFilter{{Field: "properties.status", Operator: database.FilterOperatorExists}},
+				expected: []database.Object{obj2},
+			},
+			{
+				name:     "not_exists",
+				filters:  []database.QueryFilter{{Field: "properties.status", Operator: database.FilterOperatorNotExists}},
+				expected: []database.Object{obj1},
+			},
+			{
+				name:     "greater_than",
+				filters:  []database.QueryFilter{{Field: "properties.replicas", Operator: database.FilterOperatorGreaterThan, Value: "2"}},
+				expected: []database.Object{obj2},
+			},
+			{
+				name:     "less_than_or_equal",
+				filters:  []database.QueryFilter{{Field: "properties.replicas", Operator: database.FilterOperatorLessThanOrEqual, Value: "3"}},
+				expected: []database.Object{obj1, obj2},
+			},
+			{
+				name:     "contains",
+				filters:  []database.QueryFilter{{Field: "properties.tags", Operator: database.FilterOperatorContains, Value: "public"}},
+				expected: []database.Object{obj1},
+			},
+			{
+				name: "multiple",
+				filters: []database.QueryFilter{
+					{Field: "properties.replicas", Operator: database.FilterOperatorGreaterThanOrEqual, Value: "1"},
+					{Field: "name", Operator: database.FilterOperatorNotEquals, Value: "backend"},
+				},
+				expected: []database.Object{obj1},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				CompareObjectLists(t, tt.expected, query(t, tt.filters...))
+			})
+		}
+	})
+
+	t.Run("query_pagination", func(t *testing.T) {
+		clear(t)
+
+		id := func(i int) resources.ID {
+			return parseOrPanic(fmt.Sprintf("%s/providers/%s/resource-%d", ResourceGroup1Scope, ResourceType1, i))
+		}
+
+		objs := map[int]database.Object{}
+		for _, i := range []int{5, 1, 3, 2, 4} {
+			obj := createObject(id(i), map[string]any{"value": fmt.Sprintf("%d", i)})
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+			objs[i] = obj
+		}
+
+		query := database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}
+
+		t.Run("ordered_without_limit", func(t *testing.T) {
+			result, err := client.Query(ctx, query)
+			require.NoError(t, err)
+			require.Empty(t, result.PaginationToken)
+			requireOrdered(t, []database.Object{objs[1], objs[2], objs[3], objs[4], objs[5]}, result.Items)
+		})
+
+		t.Run("pages", func(t *testing.T) {
+			actual := []database.Object{}
+			token := ""
+			for pages := 0; ; pages++ {
+				require.Less(t, pages, 3, "too many pages")
+
+				result, err := client.Query(ctx, query, database.WithMaxQueryItemCount(2), database.WithPaginationToken(token))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(result.Items), 2)
+				actual = append(actual, result.Items...)
+
+				token = result.PaginationToken
+				if token == "" {
+					break
+				}
+			}
+
+			requireOrdered(t, []database.Object{objs[1], objs[2], objs[3], objs[4], objs[5]}, actual)
+		})
+
+		t.Run("exact_page_has_no_token", func(t *testing.T) {
+			result, err := client.Query(ctx, query, database.WithMaxQueryItemCount(5))
+			require.NoError(t, err)
+			require.Len(t, result.Items, 5)
+			require.Empty(t, result.PaginationToken)
+		})
+
+		t.Run("invalid_token", func(t *testing.T) {
+			result, err := client.Query(ctx, query, database.WithMaxQueryItemCount(2), database.WithPaginationToken("not a token!"))
+			require.ErrorIs(t, err, &database.ErrInvalid{})
+			require.Nil(t, result)
+		})
+
+		t.Run("stable_under_concurrent_changes", func(t *testing.T) {
+			result, err := client.Query(ctx, query, database.WithMaxQueryItemCount(2))
+			require.NoError(t, err)
+			requireOrdered(t, []database.Object{objs[1], objs[2]}, result.Items)
+			require.NotEmpty(t, result.PaginationToken)
+
+			// Delete a returned and an unreturned resource, and insert resources before and after the cursor.
+			err = client.Delete(ctx, objs[1].ID)
+			require.NoError(t, err)
+			err = client.Delete(ctx, objs[3].ID)
+			require.NoError(t, err)
+
+			obj0 := createObject(id(0), map[string]any{"value": "0"})
+			err = client.Save(ctx, &obj0)
+			require.NoError(t, err)
+			obj9 := createObject(id(9), map[string]any{"value": "9"})
+			err = client.Save(ctx, &obj9)
+			require.NoError(t, err)
+
+			result, err = client.Query(ctx, query, database.WithMaxQueryItemCount(2), database.WithPaginationToken(result.PaginationToken))
+			require.NoError(t, err)
+			requireOrdered(t, []database.Object{objs[4], objs[5]}, result.Items)
+			require.NotEmpty(t, result.PaginationToken)
+
+			result, err = client.Query(ctx, query, database.WithMaxQueryItemCount(2), database.WithPaginationToken(result.PaginationToken))
+			require.NoError(t, err)
+			requireOrdered(t, []database.Object{obj9}, result.Items)
+			require.Empty(t, result.PaginationToken)
+		})
+	})
+}
+
+// requireOrdered compares two slices of store.Objects in order, ignoring their ETags.
+func requireOrdered(t *testing.T, expected []database.Object, actual []database.Object) {
+	t.Helper()
+
+	expectedIDs := []string{}
+	for _, obj := range expected {
+		expectedIDs = append(expectedIDs, obj.ID)
+	}
+
+	actualIDs := []string{}
+	for _, obj := range actual {
+		actualIDs = append(actualIDs, obj.ID)
+	}
+
+	require.Equal(t, expectedIDs, actualIDs)
+	CompareObjectLists(t, expected, actual)
+}
+
+// RunWatchTest tests the database Watcher's Watch method by saving and deleting objects and checking that the
+// expected events are delivered for the matching objects.
+func RunWatchTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	watcher, ok := client.(database.Watcher)
+	require.True(t, ok, "client must implement database.Watcher")
+
+	next := func(t *testing.T, events <-chan database.Event) database.Event {
+		t.Helper()
+
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "watch was closed unexpectedly")
+			return event
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for event")
+			return database.Event{}
+		}
+	}
+
+	t.Run("watch_create_update_delete", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		events, err := watcher.Watch(watchCtx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1})
+		require.NoError(t, err)
+
+		// Not part of the query, should not be reported.
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := next(t, events)
+		require.Equal(t, database.EventCreated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		obj1.Data = Data3
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event = next(t, events)
+		require.Equal(t, database.EventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		err = client.Delete(ctx, obj1.ID)
+		require.NoError(t, err)
+
+		event = next(t, events)
+		require.Equal(t, database.EventDeleted, event.Type)
+		require.Equal(t, obj1.ID, event.Object.ID)
+
+		watchCancel()
+		for range events {
+			// Drain until the channel is closed.
+		}
+	})
+
+	t.Run("watch_with_filter", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		filters := []database.QueryFilter{{Field: "value", Value: "3"}}
+		events, err := watcher.Watch(watchCtx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1, Filters: filters})
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj1.Data = Data3
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		// The create does not match the filter, the update does.
+		event := next(t, events)
+		require.Equal(t, database.EventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+	})
+}
+
+// RunTransactionTest tests the database Transactor's ExecuteTransaction method by checking that transactions
+// are applied completely when they succeed and not at all when they fail.
+func RunTransactionTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	_, ok := client.(database.Transactor)
+	require.True(t, ok, "client must implement database.Transactor")
+
+	requireNotFound := func(t *testing.T, id resources.ID) {
+		t.Helper()
+		_, err := client.Get(ctx, id.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: id.String()})
+	}
+
+	t.Run("transaction_commit", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1.Data = Data3
+		obj3 := createObject(Resource3ID, Data3)
+		err = database.ExecuteTransaction(ctx, client, []database.Operation{
+			database.SaveOperation(&obj1, database.WithETag(obj1.ETag)),
+			database.SaveOperation(&obj3),
+			database.DeleteOperation(obj2.ID, database.WithETag(obj2.ETag)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, etag.New(MarshalOrPanic(Data3)), obj1.ETag)
+		require.NotEmpty(t, obj3.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		obj3Get, err := client.Get(ctx, Resource3ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj3, obj3Get)
+
+		requireNotFound(t, Resource2ID)
+	})
+
+	t.Run("transaction_rollback_on_etag_mismatch", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		originalETag := obj1.ETag
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1.Data = Data3
+		obj3 := createObject(Resource3ID, Data3)
+		err = database.ExecuteTransaction(ctx, client, []database.Operation{
+			database.SaveOperation(&obj1),
+			database.SaveOperation(&obj3),
+			database.DeleteOperation(obj2.ID, database.WithETag(etag.New(MarshalOrPanic(Data3)))),
+		})
+		require.ErrorIs(t, err, &database.ErrConcurrency{})
+		require.Equal(t, originalETag, obj1.ETag)
+
+		obj1.Data = Data1
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+
+		requireNotFound(t, Resource3ID)
+	})
+
+	t.Run("transaction_rollback_on_not_found", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := database.ExecuteTransaction(ctx, client, []database.Operation{
+			database.SaveOperation(&obj1),
+			database.DeleteOperation(Resource2ID.String()),
+		})
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource2ID.String()})
+
+		requireNotFound(t, Resource1ID)
+	})
+
+	t.Run("transaction_duplicate_resource", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := database.ExecuteTransaction(ctx, client, []database.Operation{
+			database.SaveOperation(&obj1),
+			database.DeleteOperation(Resource1ID.String()),
+		})
+		require.ErrorIs(t, err, &database.ErrInvalid{})
+
+		requireNotFound(t, Resource1ID)
+	})
 }